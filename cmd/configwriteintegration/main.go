@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/node"
 	"github.com/boatkit-io/n2k/pkg/pgn"
@@ -53,8 +54,8 @@ func run(iface string) error {
 	log := logrus.New()
 	log.SetLevel(logrus.WarnLevel)
 
-	senderService := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(log, iface), log)
-	receiverService := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(log, iface), log)
+	senderService := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), iface), logging.FromLogrus(log))
+	receiverService := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), iface), logging.FromLogrus(log))
 	if err := senderService.Start(ctx); err != nil {
 		return err
 	}
@@ -12,11 +12,15 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/sirupsen/logrus"
 )
@@ -29,7 +33,11 @@ func main() {
 
 	// Command-line parsing
 	var canInterface string
+	var statsInterval time.Duration
+	var bitrate int
 	flag.StringVar(&canInterface, "iface", "", "CAN interface name (required)")
+	flag.DurationVar(&statsInterval, "stats", 0, "print bus load statistics at this interval (0 disables)")
+	flag.IntVar(&bitrate, "bitrate", n2k.DefaultBusBitrate, "CAN bus bitrate in bits per second, for bus load statistics")
 	flag.Parse()
 
 	if canInterface == "" {
@@ -55,10 +63,14 @@ func main() {
 	}()
 
 	// Build the pipeline
-	endpoint := socketcanendpoint.NewSocketCANEndpoint(log, canInterface)
+	endpoint := socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), canInterface)
 
 	// Wire it all up
-	bus := n2k.NewN2kService(endpoint, log)
+	var opts []n2k.ServiceOption
+	if statsInterval > 0 {
+		opts = append(opts, n2k.WithBusStatistics(bitrate, 0))
+	}
+	bus := n2k.NewN2kService(endpoint, logging.FromLogrus(log), opts...)
 
 	// Start the pipeline
 	if err := bus.Start(ctx); err != nil {
@@ -77,6 +89,23 @@ func main() {
 		return
 	}
 
+	if statsInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(statsInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if stats, ok := bus.BusStatistics(); ok {
+						printBusStatistics(os.Stdout, stats)
+					}
+				}
+			}
+		}()
+	}
+
 	// Wait for context cancellation (from signal handler)
 	<-ctx.Done()
 	log.Info("Shutting down...")
@@ -85,3 +114,25 @@ func main() {
 	}
 	log.Info("Shutdown complete")
 }
+
+// printBusStatistics writes bus load and per-source and per-PGN traffic as tables.
+func printBusStatistics(out io.Writer, stats n2k.BusStatistics) {
+	fmt.Fprintf(out, "\nBus load %.1f%% of %d bit/s, %.1f frames/s, %.1f bytes/s over %s\n",
+		stats.Utilization, stats.Bitrate, stats.FramesPerSecond, stats.BytesPerSecond, stats.Window.Round(time.Second))
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "source\tframes/s\tbytes/s\tload %\terrors/s\treassembly failures/s\t")
+	for _, source := range stats.Sources {
+		fmt.Fprintf(w, "%d\t%.1f\t%.1f\t%.2f\t%.2f\t%.2f\t\n", source.Source, source.FramesPerSecond,
+			source.BytesPerSecond, source.Utilization, source.ErrorsPerSecond, source.ReassemblyFailuresPerSecond)
+	}
+	_ = w.Flush()
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "PGN\tframes/s\tbytes/s\tload %\t")
+	for _, pgn := range stats.PGNs {
+		fmt.Fprintf(w, "%d\t%.1f\t%.1f\t%.2f\t\n", pgn.PGN, pgn.FramesPerSecond, pgn.BytesPerSecond, pgn.Utilization)
+	}
+	_ = w.Flush()
+}
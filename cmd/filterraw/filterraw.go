@@ -15,11 +15,13 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/boatkit-io/n2k/internal/adapter/canadapter"
 	"github.com/boatkit-io/n2k/internal/converter"
 	"github.com/boatkit-io/n2k/internal/pgn"
 	"github.com/boatkit-io/n2k/internal/pkt"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/brutella/can"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -36,7 +38,7 @@ type FilterOptions struct {
 // FilterRawFile filters a raw log file based on the options
 func FilterRawFile(opts FilterOptions) {
 	log := logrus.New()
-	builder := canadapter.NewMultiBuilder(log)
+	builder := canadapter.NewMultiBuilder(logging.FromLogrus(log))
 
 	file, err := os.Open(opts.InputFile)
 	if err != nil {
@@ -148,5 +150,5 @@ func processPacket(p *pkt.Packet) {
 	copy(frame.Data[:], p.Data)
 
 	// Use the converter package to format the output
-	fmt.Print(converter.RawFromCanFrame(frame))
+	fmt.Print(converter.RawFromCanFrame(frame, time.Now()))
 }
@@ -83,7 +83,7 @@ func inspectFile(path string, fields map[uint32][]string) {
 		return
 	}
 	defer f.Close() //nolint:errcheck // Read-only input.
-	builder := canadapter.NewMultiBuilder(logrus.New())
+	builder := canadapter.NewMultiBuilder(nil)
 	reader := csv.NewReader(bufio.NewReader(f))
 	for {
 		record, err := reader.Read()
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/node"
 	"github.com/boatkit-io/n2k/pkg/pgn"
@@ -40,7 +41,7 @@ func run(iface string, source, target uint8, description1, description2 string)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	log := logrus.New()
-	service := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(log, iface), log)
+	service := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), iface), logging.FromLogrus(log))
 	if err := service.Start(ctx); err != nil {
 		return err
 	}
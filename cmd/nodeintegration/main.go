@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/node"
 	"github.com/boatkit-io/n2k/pkg/pgn"
@@ -202,8 +203,8 @@ func run() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	endpoint := socketcanendpoint.NewSocketCANEndpoint(log, canInterface)
-	svc := n2k.NewN2kService(endpoint, log)
+	endpoint := socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), canInterface)
+	svc := n2k.NewN2kService(endpoint, logging.FromLogrus(log))
 
 	_, err := svc.SubscribeToAllStructs(func(p any) {
 		log.Infof("PGN DUMP: %s", n2k.DebugDumpPGN(p))
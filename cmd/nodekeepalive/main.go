@@ -14,6 +14,7 @@ import (
 	"syscall"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/node"
 	"github.com/boatkit-io/n2k/pkg/pgn"
@@ -58,7 +59,7 @@ func run(iface string, address uint8) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 	log := logrus.New()
-	service := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(log, iface), log)
+	service := n2k.NewN2kService(socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), iface), logging.FromLogrus(log))
 	if err := service.Start(ctx); err != nil {
 		return fmt.Errorf("start N2K service: %w", err)
 	}
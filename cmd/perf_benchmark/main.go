@@ -66,8 +66,8 @@ func main() {
 		runtime.ReadMemStats(&m1)
 
 		// Setup the file endpoint
-		ca := canadapter.NewCANAdapter(logrus.New())
-		ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, logrus.New())
+		ca := canadapter.NewCANAdapter(nil)
+		ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, nil)
 
 		// Create subscriber
 		subs := subscribe.New()
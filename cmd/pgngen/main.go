@@ -371,6 +371,11 @@ func (conv *canboatConverter) write() {
 		"isOptionalPGNField": func(pgn *PGN, field PGNField) bool {
 			return pgn.MinLength > 0 && uint32(field.BitOffset) >= pgn.MinLength*8
 		},
+		"isStringField":       isStringField,
+		"stringFieldMaxBytes": stringFieldMaxBytes,
+		"fieldValueKinds":     fieldValueKinds,
+		"structTypeNames":     structTypeNames,
+		"instanceField":       instanceField,
 		"needsFieldSpec": func(field PGNField) bool {
 			if reservedNumericType(field.FieldType) {
 				return true
@@ -408,6 +413,8 @@ func (conv *canboatConverter) write() {
 		"fieldspecs_generated.go":     "runtime/fieldspecs.go.tmpl",
 		"fieldspec_vars_generated.go": "runtime/fieldspec_vars.go.tmpl",
 		"fastbits_generated.go":       "runtime/fastbits.go.tmpl",
+		"fieldindex_generated.go":     "runtime/fieldindex.go.tmpl",
+		"typeids_generated.go":        "runtime/typeids.go.tmpl",
 	}
 
 	for filename, templatePath := range internalTemplates {
@@ -488,6 +495,115 @@ func reservedNumericType(fieldType string) bool {
 	return fieldType == "NUMBER" || fieldType == "DATE" || fieldType == "TIME" || fieldType == "PGN" || fieldType == "ISO_NAME" || fieldType == "DURATION" || fieldType == "DYNAMIC_FIELD_KEY" || fieldType == "DYNAMIC_FIELD_LENGTH"
 }
 
+// maxStringLAUBytes is the longest UTF-8 value a STRING_LAU length byte can describe.
+const maxStringLAUBytes = 253
+
+// isStringField reports whether the field is serialized by one of the string writers.
+func isStringField(field PGNField) bool {
+	switch field.FieldType {
+	case "STRING_FIX", "STRING_LZ", "STRING_LAU":
+		return true
+	default:
+		return false
+	}
+}
+
+// stringFieldMaxBytes returns the longest value the string field's serializer accepts.
+func stringFieldMaxBytes(field PGNField) int {
+	switch field.FieldType {
+	case "STRING_FIX":
+		return int(field.BitLength / 8)
+	case "STRING_LZ":
+		if field.BitLength >= 16 {
+			return int(field.BitLength/8) - 2 // length byte and terminator
+		}
+	}
+	return maxStringLAUBytes
+}
+
+// fieldValueKindEntry describes how one top-level field of a PGN is encoded.
+type fieldValueKindEntry struct {
+	Order     uint8
+	Kind      string
+	BitLength uint16
+}
+
+// fieldValueKinds maps each PGN to the encoding of its top-level fields by order. The
+// first variant of a PGN that defines an order wins, matching FindFieldSpec.
+func fieldValueKinds(pgns []*PGN) map[uint32][]fieldValueKindEntry {
+	kinds := make(map[uint32][]fieldValueKindEntry)
+	seen := make(map[uint32]map[uint8]bool)
+	for _, pgn := range pgns {
+		if seen[pgn.PGN] == nil {
+			seen[pgn.PGN] = make(map[uint8]bool)
+		}
+		for _, field := range pgn.Fields {
+			if seen[pgn.PGN][field.Order] {
+				continue
+			}
+			seen[pgn.PGN][field.Order] = true
+			kinds[pgn.PGN] = append(kinds[pgn.PGN], fieldValueKindEntry{
+				Order:     field.Order,
+				Kind:      fieldValueKind(field),
+				BitLength: field.BitLength,
+			})
+		}
+	}
+	for pgn := range kinds {
+		sort.Slice(kinds[pgn], func(i, j int) bool { return kinds[pgn][i].Order < kinds[pgn][j].Order })
+	}
+	return kinds
+}
+
+// fieldValueKind returns the runtime fieldValueKind constant matching the field's deserializer.
+func fieldValueKind(field PGNField) string {
+	switch field.FieldType {
+	case "RESERVED", "SPARE":
+		return "fieldValueReserved"
+	case "LOOKUP", "BITLOOKUP", "INDIRECT_LOOKUP", "FIELDTYPE_LOOKUP":
+		return "fieldValueLookup"
+	case "FIELD_INDEX", "NUMBER", "TIME", "DATE", "MMSI", "PGN", "ISO_NAME", "DURATION", "DYNAMIC_FIELD_KEY", "DYNAMIC_FIELD_LENGTH":
+		return "fieldValueNumber"
+	case "STRING_FIX":
+		return "fieldValueStringFix"
+	case "STRING_LZ":
+		return "fieldValueStringLZ"
+	case "STRING_LAU":
+		return "fieldValueStringLAU"
+	default:
+		return "fieldValueBinary"
+	}
+}
+
+// structTypeNames lists every public struct type a decoder can deliver, in the order
+// that defines their runtime type IDs. UnknownPGN always has ID 0.
+func structTypeNames(pgns []*PGN) []string {
+	names := []string{"UnknownPGN"}
+	for _, pgn := range pgns {
+		names = append(names, pgn.Id)
+		if pgn.PGN == 126208 && hasField(*pgn, "PGN") {
+			names = append(names, pgn.Id+"Partial")
+		}
+	}
+	return names
+}
+
+// instanceField returns the top-level field holding the PGN's instance, such as an
+// engine, battery or tank instance, or nil when the PGN has none. Only 8-bit numbers and
+// lookups qualify.
+func instanceField(pgn *PGN) *PGNField {
+	for i := range pgn.Fields {
+		field := &pgn.Fields[i]
+		if !strings.HasSuffix(field.Id, "Instance") {
+			continue
+		}
+		if field.FieldType == "LOOKUP" || convertFieldType(field) == "*uint8" {
+			return field
+		}
+	}
+	return nil
+}
+
 // calcMaxRawValue calculates the maximum raw value for a field.
 func calcMaxRawValue(field *PGNField) uint64 {
 	if field.BitLength == 0 { // only possible if no bitLength is specified in canboat.json
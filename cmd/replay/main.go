@@ -22,6 +22,7 @@ import (
 	"github.com/boatkit-io/n2k/pkg/endpoint"
 	"github.com/boatkit-io/n2k/pkg/endpoint/n2kfileendpoint"
 	"github.com/boatkit-io/n2k/pkg/endpoint/rawendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/sirupsen/logrus"
 )
@@ -75,13 +76,13 @@ func main() {
 	// Create the appropriate endpoint
 	var ep endpoint.Endpoint
 	if replayFile != "" && strings.HasSuffix(replayFile, ".n2k") {
-		ep = n2kfileendpoint.NewN2kFileEndpoint(replayFile, log)
+		ep = n2kfileendpoint.NewN2kFileEndpoint(replayFile, logging.FromLogrus(log))
 	} else if rawReplayFile != "" {
-		ep = rawendpoint.NewRawFileEndpoint(rawReplayFile, log)
+		ep = rawendpoint.NewRawFileEndpoint(rawReplayFile, logging.FromLogrus(log))
 	}
 
 	// Create n2k service
-	bus := n2k.NewN2kService(ep, log)
+	bus := n2k.NewN2kService(ep, logging.FromLogrus(log))
 
 	// Set up subscriptions and message processing tracking
 	var messageCount int64
@@ -18,6 +18,7 @@ import (
 	"syscall"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/boatkit-io/tugboat/pkg/units"
@@ -55,8 +56,8 @@ func main() {
 	}()
 
 	// Create SocketCANEndpoint
-	endpoint := socketcanendpoint.NewSocketCANEndpoint(log, canInterface)
-	bus = n2k.NewN2kService(endpoint, log)
+	endpoint := socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), canInterface)
+	bus = n2k.NewN2kService(endpoint, logging.FromLogrus(log))
 	if err := bus.Start(ctx); err != nil {
 		cancel()
 		log.Errorf("Failed to start bus: %v", err)
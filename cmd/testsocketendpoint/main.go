@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/brutella/can"
 	"github.com/sirupsen/logrus"
 )
@@ -49,7 +50,7 @@ func main() {
 	}()
 
 	// Create SocketCANEndpoint directly (like n2k pipeline)
-	endpoint := socketcanendpoint.NewSocketCANEndpoint(log, canInterface)
+	endpoint := socketcanendpoint.NewSocketCANEndpoint(logging.FromLogrus(log), canInterface)
 
 	// Start the endpoint in a goroutine (like n2k pipeline)
 	go func() {
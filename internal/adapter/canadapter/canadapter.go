@@ -9,27 +9,35 @@
 package canadapter
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 
 	"github.com/boatkit-io/n2k/internal/converter"
 	"github.com/boatkit-io/n2k/internal/pgn"
 	"github.com/boatkit-io/n2k/internal/pkt"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 )
 
 // CANAdapter instances on input read canbus frames from its input and outputs complete Packets.
 // On output it
 type CANAdapter struct {
 	multi *MultiBuilder // combines multiple frames into a complete Packet.
-	log   *logrus.Logger
+	log   *slog.Logger
+	clock clock.Clock
+	bus   string
 
 	handler       PacketHandler
 	frameWriterMu sync.RWMutex
 	frameWriter   endpoint.Endpoint
+	transmit      transmitter
+	seqMu         sync.Mutex
 	seqIDMap      map[uint8]map[uint32]uint8 //sourceID:PGN:last used sequenceID
 }
 
@@ -43,11 +51,13 @@ type FrameWriter interface {
 	WriteFrame(can.Frame)
 }
 
-// NewCANAdapter instantiates a new CanAdapter
-func NewCANAdapter(log *logrus.Logger) *CANAdapter {
+// NewCANAdapter instantiates a new CanAdapter. A nil log uses slog.Default().
+func NewCANAdapter(log *slog.Logger) *CANAdapter {
+	log = logging.OrDefault(log)
 	return &CANAdapter{
 		multi:    NewMultiBuilder(log),
 		log:      log,
+		clock:    clock.Real(),
 		seqIDMap: make(map[uint8]map[uint32]uint8), // SourceID, PGN, most recently used sequenceId
 	}
 }
@@ -65,6 +75,22 @@ func (c *CANAdapter) writer() endpoint.Endpoint {
 	return c.frameWriter
 }
 
+// SetReassemblyFailureHandler registers a callback for each fast packet message that
+// could not be assembled from its frames.
+func (c *CANAdapter) SetReassemblyFailureHandler(fn func(source uint8, pgn uint32)) {
+	c.multi.SetFailureHandler(fn)
+}
+
+// SetClock sets the clock timestamping received packets. A nil clock is the wall clock.
+func (c *CANAdapter) SetClock(clk clock.Clock) {
+	c.clock = clock.OrReal(clk)
+}
+
+// SetBus sets the bus ID given to the packets the adapter assembles.
+func (c *CANAdapter) SetBus(bus string) {
+	c.bus = bus
+}
+
 // SetOutput assigns a handler for any ready packets
 func (c *CANAdapter) SetOutput(ph PacketHandler) {
 	c.handler = ph
@@ -72,9 +98,18 @@ func (c *CANAdapter) SetOutput(ph PacketHandler) {
 
 // HandleMessage is how you tell CanAdapter to start processing a new message into a packet
 func (c *CANAdapter) HandleMessage(message endpoint.Message) {
+	c.HandleMessageAt(message, c.clock.Now())
+}
+
+// HandleMessageAt is HandleMessage for a message received at the given time, such as
+// when it was queued before being handled.
+func (c *CANAdapter) HandleMessageAt(message endpoint.Message, received time.Time) {
 	if frame, ok := message.(*can.Frame); ok {
 		pInfo := ExtractMessageInfo(frame)
-		p := pkt.NewPacket(pInfo, frame.Data[:])
+		pInfo.Timestamp = received
+		pInfo.Bus = c.bus
+		packet := pkt.MakePacket(pInfo, frame.Data[:])
+		p := &packet
 
 		// https://endige.com/2050/nmea-2000-pgns-deciphered/
 
@@ -93,7 +128,7 @@ func (c *CANAdapter) HandleMessage(message endpoint.Message) {
 			c.packetReady(p)
 		}
 	} else {
-		c.log.Warnf("CanAdapter expected *can.Frame, received: %T", message)
+		c.log.Warn("CanAdapter expected *can.Frame", "type", fmt.Sprintf("%T", message))
 	}
 }
 
@@ -104,9 +139,91 @@ func (c *CANAdapter) packetReady(p *pkt.Packet) {
 	}
 }
 
-// WritePgn generates one or more frames from its input and writes them to its configured endpoint.
+// WritePgn generates one or more frames from its input and writes them to its configured
+// endpoint. A message that has to wait behind others or for a rate limit is queued, and
+// WritePgn returns without waiting for it to be written. Endpoint errors are logged.
 func (c *CANAdapter) WritePgn(info pgn.MessageInfo, data []uint8) error {
-	var err error
+	return c.transmitPgn(nil, info, data, c.writeFrame)
+}
+
+// writeFrame writes a frame of a message written with WritePgn. An endpoint that reports
+// whether a frame was sent is waited for, so the transmitter is held until the frame is
+// out rather than until it is queued; such endpoints bound how long that takes. Endpoint
+// errors are logged, as WritePgn does not report them.
+func (c *CANAdapter) writeFrame(frame can.Frame) error {
+	writer := c.writer()
+	if writer == nil {
+		return errNoWriter
+	}
+	if contextWriter, ok := writer.(endpoint.ContextFrameWriter); ok {
+		if err := contextWriter.WriteFrameContext(context.Background(), frame); err != nil {
+			c.log.Warn("failed to write CAN frame", logging.Error(err))
+		}
+		return nil
+	}
+	writer.WriteFrame(frame)
+	return nil
+}
+
+// WritePgnContext is WritePgn for endpoints that report whether each frame was sent. It
+// waits for a queued message to be written, and returns the first frame's error, or an
+// *endpoint.PartialWriteError when a fast packet fails after some of its frames were sent.
+// A message still queued when ctx ends is dropped. Endpoints without
+// endpoint.ContextFrameWriter are written as with WritePgn.
+func (c *CANAdapter) WritePgnContext(ctx context.Context, info pgn.MessageInfo, data []uint8) error {
+	writer := c.writer()
+	if writer == nil {
+		return errNoWriter
+	}
+	contextWriter, reportsErrors := writer.(endpoint.ContextFrameWriter)
+	return c.transmitPgn(ctx, info, data, func(frame can.Frame) error {
+		if reportsErrors {
+			return contextWriter.WriteFrameContext(ctx, frame)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		writer.WriteFrame(frame)
+		return nil
+	})
+}
+
+// transmitPgn writes the message with write when the transmitter is free, and otherwise
+// queues its frames to be written as write would. With a nil ctx a queued message is not
+// waited for.
+func (c *CANAdapter) transmitPgn(ctx context.Context, info pgn.MessageInfo, data []uint8, write func(can.Frame) error) error {
+	frameCount := 1
+	if pgn.IsFast(info.PGN) {
+		frameCount = calcFramesRequired(len(data)) + 1
+	}
+	if c.tryDirect(info.PGN, frameCount) {
+		defer c.directDone()
+		return c.writePgn(info, data, write)
+	}
+	frames := make([]can.Frame, 0, frameCount)
+	err := c.writePgn(info, data, func(frame can.Frame) error {
+		frames = append(frames, frame)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	item := c.enqueue(ctx, info.Priority, info.PGN, frames)
+	if ctx == nil {
+		return nil
+	}
+	select {
+	case err := <-item.done:
+		return err
+	case <-ctx.Done():
+		if c.cancel(item) {
+			return ctx.Err()
+		}
+		return <-item.done
+	}
+}
+
+func (c *CANAdapter) writePgn(info pgn.MessageInfo, data []uint8, write func(can.Frame) error) error {
 	canIDData := converter.CanIDData{
 		PGN:         info.PGN,
 		SourceID:    info.SourceId,
@@ -115,11 +232,9 @@ func (c *CANAdapter) WritePgn(info pgn.MessageInfo, data []uint8) error {
 	}
 	canID := converter.CanIDFromStruct(canIDData)
 	if pgn.IsFast((info.PGN)) {
-		err = c.sendFast(info.SourceId, info.PGN, canID, data)
-	} else {
-		err = c.sendSingle(canID, data)
+		return c.sendFast(info.SourceId, info.PGN, canID, data, write)
 	}
-	return err
+	return c.sendSingle(canID, data, write)
 }
 
 // calcFramesRequired calculates the number of CAN frames required to transmit data of the specified length.
@@ -136,13 +251,14 @@ func calcFramesRequired(length int) int {
 
 // sendFast breaks the data up into the required number of packets, provides a sequenceID,
 // and passes the resulting frames on.
-func (c *CANAdapter) sendFast(sourceID uint8, pgnNum, canID uint32, data []uint8) error {
+func (c *CANAdapter) sendFast(sourceID uint8, pgnNum, canID uint32, data []uint8, write func(can.Frame) error) error {
 	var buffer [8]uint8
 	total := len(data)
 	framesRequired := calcFramesRequired(total)
 	if framesRequired > MaxFrameNum {
 		return fmt.Errorf("exceeds maximum data length for Fast PGN (223): %d", total)
 	}
+	c.seqMu.Lock()
 	if _, t := c.seqIDMap[sourceID]; !t {
 		c.seqIDMap[sourceID] = make(map[uint32]uint8)
 	}
@@ -152,6 +268,7 @@ func (c *CANAdapter) sendFast(sourceID uint8, pgnNum, canID uint32, data []uint8
 	seqID := c.seqIDMap[sourceID][pgnNum]
 	nextID := (seqID + 1) % 7
 	c.seqIDMap[sourceID][pgnNum] = nextID
+	c.seqMu.Unlock()
 	index := 0
 	for frameNum := 0; frameNum <= framesRequired; frameNum++ {
 		offset := 0
@@ -175,12 +292,17 @@ func (c *CANAdapter) sendFast(sourceID uint8, pgnNum, canID uint32, data []uint8
 					Length: uint8(can.MaxFrameDataLength),
 					Data:   buffer,
 				}
-				// invoke endpoint handler
-				if writer := c.writer(); writer != nil {
-					c.log.Debugf("Writing CAN frame: ID=0x%X, Length=%d, Data=%02X", frame.ID, frame.Length, frame.Data[:frame.Length])
-					writer.WriteFrame(frame)
-				} else {
-					c.log.Warn("frameWriter is nil, cannot write frame")
+				// invoke endpoint handler; the level is checked first so the arguments are
+				// not boxed for every frame
+				if c.log.Enabled(context.Background(), slog.LevelDebug) {
+					c.log.Debug("Writing CAN frame", logging.PGN(pgnNum), logging.Source(sourceID),
+						"id", fmt.Sprintf("0x%X", frame.ID), "data", fmt.Sprintf("%02X", frame.Data[:frame.Length]))
+				}
+				if err := write(frame); err != nil {
+					if frameNum == 0 {
+						return err
+					}
+					return &endpoint.PartialWriteError{Sent: frameNum, Total: framesRequired + 1, Err: err}
 				}
 				offset = 0
 				if index >= total {
@@ -197,7 +319,7 @@ func (c *CANAdapter) sendFast(sourceID uint8, pgnNum, canID uint32, data []uint8
 }
 
 // sendSingle creates a CAN frame for the message and sends it on.
-func (c *CANAdapter) sendSingle(canID uint32, data []uint8) error {
+func (c *CANAdapter) sendSingle(canID uint32, data []uint8, write func(can.Frame) error) error {
 	length := len(data)
 	if length > 8 {
 		return fmt.Errorf("attempt to send single PGN with data length %d; max is 8", length)
@@ -213,20 +335,17 @@ func (c *CANAdapter) sendSingle(canID uint32, data []uint8) error {
 		i++
 	}
 	// invoke endpoint handler
-	if writer := c.writer(); writer != nil {
-		writer.WriteFrame(frame)
-	}
-	return nil
+	return write(frame)
 }
 
-// ExtractMessageInfo extracts MessageInfo from a CAN frame
+// ExtractMessageInfo extracts MessageInfo from a CAN frame. The Timestamp is left for
+// the caller to set.
 func ExtractMessageInfo(message *can.Frame) pgn.MessageInfo {
 	h := converter.DecodeCanID(message.ID)
 	return pgn.MessageInfo{
-		Timestamp: h.TimeStamp,
-		PGN:       h.PGN,
-		SourceId:  h.SourceID,
-		TargetId:  h.TargetID,
-		Priority:  h.Priority,
+		PGN:      h.PGN,
+		SourceId: h.SourceID,
+		TargetId: h.TargetID,
+		Priority: h.Priority,
 	}
 }
@@ -2,6 +2,7 @@ package canadapter
 
 import (
 	"context"
+	"log/slog"
 	"testing"
 
 	"github.com/boatkit-io/n2k/internal/converter"
@@ -11,7 +12,6 @@ import (
 	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/brutella/can"
 
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,6 +57,21 @@ func (c *captureEndpoint) WriteFrame(frame can.Frame) {
 	c.frames = append(c.frames, frame)
 }
 
+// failingEndpoint accepts frames until failAfter have been written.
+type failingEndpoint struct {
+	captureEndpoint
+	failAfter int
+	err       error
+}
+
+func (f *failingEndpoint) WriteFrameContext(_ context.Context, frame can.Frame) error {
+	if len(f.frames) >= f.failAfter {
+		return f.err
+	}
+	f.frames = append(f.frames, frame)
+	return nil
+}
+
 func TestCalcFramesRequired(t *testing.T) {
 	tests := []struct {
 		length int
@@ -77,11 +92,11 @@ func TestCalcFramesRequired(t *testing.T) {
 
 func TestSendFastShortPayloadWritesOneFrame(t *testing.T) {
 	writer := &captureEndpoint{}
-	adapter := NewCANAdapter(logrus.New())
+	adapter := NewCANAdapter(slog.Default())
 	adapter.SetWriter(writer)
 
 	data := []uint8{0x11, 0x22, 0x33, 0x44, 0x55, 0x66}
-	err := adapter.sendFast(35, publicpgn.UserDatumPGN, 0x1F80523, data)
+	err := adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.UserDatumPGN, SourceId: 35, Priority: 7, TargetId: 255}, data)
 	require.NoError(t, err)
 	require.Len(t, writer.frames, 1)
 
@@ -92,4 +107,33 @@ func TestSendFastShortPayloadWritesOneFrame(t *testing.T) {
 	assert.Equal(t, data, frame.Data[2:8])
 }
 
+func TestWritePgnContextReportsEndpointErrors(t *testing.T) {
+	info := pgn.MessageInfo{PGN: publicpgn.UserDatumPGN, SourceId: 35, Priority: 7, TargetId: 255}
+	data := make([]uint8, 20)
+
+	writer := &failingEndpoint{failAfter: 0, err: endpoint.ErrTxBufferFull}
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetWriter(writer)
+	err := adapter.WritePgnContext(context.Background(), info, data)
+	assert.ErrorIs(t, err, endpoint.ErrTxBufferFull)
+	var partial *endpoint.PartialWriteError
+	assert.NotErrorAs(t, err, &partial)
+
+	writer = &failingEndpoint{failAfter: 2, err: endpoint.ErrClosed}
+	adapter.SetWriter(writer)
+	err = adapter.WritePgnContext(context.Background(), info, data)
+	require.ErrorAs(t, err, &partial)
+	assert.Equal(t, 2, partial.Sent)
+	assert.Equal(t, 3, partial.Total)
+	assert.ErrorIs(t, err, endpoint.ErrClosed)
+
+	writer = &failingEndpoint{failAfter: 3}
+	adapter.SetWriter(writer)
+	require.NoError(t, adapter.WritePgnContext(context.Background(), info, data))
+	assert.Len(t, writer.frames, 3)
+
+	adapter.SetWriter(nil)
+	assert.Error(t, adapter.WritePgnContext(context.Background(), info, data))
+}
+
 // TestRawToDataStream was removed as redundant to more comprehensive testing in tests/integration/pgn_serialization_test.go
@@ -8,10 +8,9 @@
 package canadapter
 
 import (
+	"log/slog"
 	"sync"
 
-	"github.com/sirupsen/logrus"
-
 	"github.com/boatkit-io/n2k/internal/pkt"
 )
 
@@ -23,13 +22,14 @@ import (
 // sequence ids are 0-7, so each source|PGN can have 8 sequences in simultaneous transmission
 // sequences map[sourceid]map[pgn]map[SeqId]sequence
 type MultiBuilder struct {
-	log       *logrus.Logger
+	log       *slog.Logger
 	sequences map[uint8]map[uint32]map[uint8]*sequence
 	mutex     sync.RWMutex
+	failed    func(source uint8, pgn uint32)
 }
 
 // NewMultiBuilder creates a new instance.
-func NewMultiBuilder(log *logrus.Logger) *MultiBuilder {
+func NewMultiBuilder(log *slog.Logger) *MultiBuilder {
 	mBuilder := MultiBuilder{
 		log:       log,
 		sequences: make(map[uint8]map[uint32]map[uint8]*sequence),
@@ -42,14 +42,24 @@ func NewMultiBuilder(log *logrus.Logger) *MultiBuilder {
 func (m *MultiBuilder) Add(p *pkt.Packet) {
 	p.GetSeqFrame()
 	seq := m.SeqFor(p)
-	seq.add(p)
+	failed := seq.add(p)
 	if seq.complete(p) {
-		m.mutex.Lock()
-		delete(m.sequences[p.Info.SourceId][p.Info.PGN], p.SeqId)
-		m.mutex.Unlock()
+		// the sequence is kept for the next message with its ID, reusing its buffer
+		seq.reset()
+		// a sequence that ends with missing frames is discarded
+		failed = failed || !p.Complete
+	}
+	if failed && m.failed != nil {
+		m.failed(p.Info.SourceId, p.Info.PGN)
 	}
 }
 
+// SetFailureHandler registers a callback for each message that could not be assembled,
+// such as when frames were lost or duplicated.
+func (m *MultiBuilder) SetFailureHandler(fn func(source uint8, pgn uint32)) {
+	m.failed = fn
+}
+
 // SeqFor method returns the sequence for the specified packet, creating
 // it it needed.
 func (m *MultiBuilder) SeqFor(p *pkt.Packet) *sequence {
@@ -1,6 +1,7 @@
 package canadapter
 
 import (
+	"log/slog"
 	"strings"
 	"testing"
 
@@ -8,11 +9,10 @@ import (
 	"github.com/boatkit-io/n2k/internal/pgn"
 	"github.com/boatkit-io/n2k/internal/pkt"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
-var log = logrus.StandardLogger()
+var log = slog.Default()
 
 var testData = `
 2022-12-20T04:14:09Z,6,129540,22,255,8,20,db,3c,ff,12,1a,d1,15
@@ -251,3 +251,40 @@ func TestFastPacket(t *testing.T) {
 	assert.NotEqual(t, 32, len(p.Data))
 	assert.NotEqual(t, comp, p.Data)
 }
+
+func TestMultiReportsDiscardedSequences(t *testing.T) {
+	m := NewMultiBuilder(log)
+	var failures []uint8
+	m.SetFailureHandler(func(source uint8, pgn uint32) {
+		assert.Equal(t, uint32(130820), pgn)
+		failures = append(failures, source)
+	})
+	add := func(data ...uint8) *pkt.Packet {
+		p := pkt.NewPacket(ExtractMessageInfo(&can.Frame{ID: converter.CanIDFromData(130820, 10, 1, 0), Length: 8}), data)
+		m.Add(p)
+		return p
+	}
+
+	// a second frame zero discards the first message
+	add(0xa0, 20, 1, 2, 3, 4, 5, 6)
+	add(0xa0, 20, 1, 2, 3, 4, 5, 6)
+	assert.Equal(t, []uint8{10}, failures)
+
+	// a continuation frame repeated discards the message
+	add(0xa1, 7, 8, 9, 10, 11, 12, 13)
+	add(0xa1, 7, 8, 9, 10, 11, 12, 13)
+	assert.Len(t, failures, 2)
+
+	// the rest of a message whose zero frame was lost counts once
+	add(0xa2, 14, 15, 16, 17, 18, 19, 20)
+	assert.Len(t, failures, 2)
+	add(0xc1, 7, 8, 9, 10, 11, 12, 13)
+	add(0xc2, 14, 15, 16, 17, 18, 19, 20)
+	assert.Len(t, failures, 3)
+
+	// a complete message is not a failure
+	add(0xe0, 8, 1, 2, 3, 4, 5, 6)
+	p := add(0xe1, 7, 8, 0xff, 0xff, 0xff, 0xff, 0xff)
+	assert.True(t, p.Complete)
+	assert.Len(t, failures, 3)
+}
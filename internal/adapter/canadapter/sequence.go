@@ -9,14 +9,19 @@ package canadapter
 
 import (
 	"fmt"
+	"log/slog"
 
 	"github.com/boatkit-io/n2k/internal/pkt"
-	"github.com/sirupsen/logrus"
+	"github.com/boatkit-io/n2k/pkg/logging"
 )
 
 // MaxFrameNum is the maximum frame number in a multipart NMEA message.
 const MaxFrameNum = 31
 
+// maxFastPacketLength is the most data a fast packet can carry: 6 bytes in frame 0 and 7
+// in each of the others.
+const maxFastPacketLength = 6 + MaxFrameNum*7
+
 // sequence defines data and methods to combine a sequence of packets into a single complete packet.
 // NMEA 2000 sends messages with >8 bytes of Data in multiple frames.
 // An adapter outputs a fully assembled, complete message.
@@ -29,68 +34,76 @@ const MaxFrameNum = 31
 // The sequence ID is 3 bits, so 0-7.
 // The frame number is 5 bits, so 0-31.
 // Sequence frame 0 must be received first; others can be received in any order.
+// Each frame's data is copied straight to its place in data, so assembling a message
+// does not allocate. A completed message's Data refers to data until the sequence ID is
+// used again.
 type sequence struct {
-	log      *logrus.Logger
-	zero     *pkt.Packet // packet 0 of sequence
+	log      *slog.Logger
+	started  bool // frame 0 of the sequence has been received
 	expected uint8
 	received uint8
-	contents [MaxFrameNum + 1][]uint8 // need arrays since packets can be received out of order
+	frames   uint32 // bit n is set once frame n has been received
+	data     [maxFastPacketLength]uint8
 }
 
 // add method copies the frame's data into the sequence.
 // if it's frame 0 it sets sequence info (time, expected length) and copies out 6 bytes of data.
 // else it copies 7 bytes of data.
 // it warns if a packet in the sequence has been received twice and resets the sequence.
-func (s *sequence) add(p *pkt.Packet) {
+// it returns true when a partially received message was discarded.
+func (s *sequence) add(p *pkt.Packet) bool {
+	discarded := false
 	if p.FrameNum == 0 {
-		if s.zero != nil { // we've received frame zero for a new sequence before completing the previous one.
-			s.log.Debug("Fast sequence duplicate frame zero detected. Resetting")
+		if s.started { // we've received frame zero for a new sequence before completing the previous one.
+			s.log.Debug("Fast sequence duplicate frame zero detected. Resetting",
+				logging.PGN(p.Info.PGN), logging.Source(p.Info.SourceId), "seq", p.SeqId)
 			s.reset() // so we toss the old one and start anew
+			discarded = true
 		}
-		s.zero = p
+		s.started = true
 		s.expected = p.Data[1]
-		s.contents[p.FrameNum] = p.Data[2:]
+		copy(s.data[:6], p.Data[2:])
+		s.frames |= 1
 		s.received += 6
 	} else {
 		switch {
-		case s.zero == nil: // we've received a subsequent frame before getting the first one
-			s.log.Debugf("Fast sequence received subsequent frame before zero frame. Resetting")
-			s.log.Debugf("Source: %d PGN: %d Sequence #: %d FrameNum #: %d", p.Info.SourceId, p.Info.PGN, p.SeqId, p.FrameNum)
+		case !s.started: // we've received a subsequent frame before getting the first one
+			s.log.Debug("Fast sequence received subsequent frame before zero frame. Resetting",
+				logging.PGN(p.Info.PGN), logging.Source(p.Info.SourceId), "seq", p.SeqId, "frame", p.FrameNum)
 			s.reset()
-		case s.contents[p.FrameNum] != nil: // uh-oh, we've already seen this frame
-			s.log.Debugf(
-				"Fast sequence received duplicate frame. Resetting Source: %d PGN: %d Sequence #: %d FrameNum #: %d, resetting sequence",
-				p.Info.SourceId, p.Info.PGN, p.SeqId, p.FrameNum,
-			)
+			// count a message missing its zero frame once, rather than for each stray frame
+			discarded = p.FrameNum == 1
+		case s.frames&(1<<p.FrameNum) != 0: // uh-oh, we've already seen this frame
+			s.log.Debug("Fast sequence received duplicate frame. Resetting",
+				logging.PGN(p.Info.PGN), logging.Source(p.Info.SourceId), "seq", p.SeqId, "frame", p.FrameNum)
 			s.reset()
+			discarded = true
 		default:
-			s.contents[p.FrameNum] = p.Data[1:]
+			offset := 6 + (int(p.FrameNum)-1)*7
+			copy(s.data[offset:offset+7], p.Data[1:])
+			s.frames |= 1 << p.FrameNum
 			s.received += 7
 		}
 	}
+	return discarded
 }
 
 // complete method tests if all of the expected data has been received.
-// if so it assures packets received are consecutive, copies the complete data into the current packet
-// and marks it complete.
+// if so it assures packets received are consecutive, points the current packet at the
+// complete data and marks it complete.
 func (s *sequence) complete(p *pkt.Packet) bool {
-	if s.zero != nil {
+	if s.started {
 		if s.received >= s.expected {
-			//  consolidate Data
-			results := make([]uint8, 0)
-			for i := range &s.contents {
-				d := s.contents[i]
-				if d == nil { // don't allow sparse nodes
-					p.ParseErrors = append(p.ParseErrors, fmt.Errorf("sparse Data in multi"))
-					return true
-				}
-				results = append(results, d...)
-				if len(results) >= int(s.expected) {
-					break
-				}
+			// frames 0 to last must all be present; don't allow sparse nodes
+			last := 0
+			if s.expected > 6 {
+				last = (int(s.expected) - 6 + 6) / 7
+			}
+			if last > MaxFrameNum || s.frames&(1<<(last+1)-1) != 1<<(last+1)-1 {
+				p.ParseErrors = append(p.ParseErrors, fmt.Errorf("sparse Data in multi"))
+				return true
 			}
-			results = results[:s.expected]
-			p.Data = results
+			p.Data = s.data[:s.expected]
 			p.Complete = true
 			return true
 		}
@@ -100,12 +113,11 @@ func (s *sequence) complete(p *pkt.Packet) bool {
 }
 
 // reset method clears the sequence to try again.
-// Called if we receive a duplicate packet, assuming it belongs to a new sequence.
+// Called if we receive a duplicate packet, assuming it belongs to a new sequence, and
+// once a message is complete. The data is left in place for the completed message.
 func (s *sequence) reset() {
-	s.zero = nil
+	s.started = false
 	s.expected = 0
 	s.received = 0
-	for i := range s.contents {
-		s.contents[i] = nil
-	}
+	s.frames = 0
 }
@@ -0,0 +1,371 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package canadapter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/brutella/can"
+
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
+)
+
+// TransmitQueueStats describes the adapter's outbound queue.
+type TransmitQueueStats struct {
+	// Depth is the number of messages waiting to be written.
+	Depth int
+	// Lag is how long the oldest waiting message has been queued.
+	Lag time.Duration
+	// LastDelay is how long the most recently written message was queued, zero when it
+	// was written directly.
+	LastDelay time.Duration
+	// MaxDelay is the longest any written message was queued.
+	MaxDelay time.Duration
+}
+
+// errNoWriter reports a message written while the adapter has no endpoint.
+var errNoWriter = errors.New("no endpoint to write to")
+
+// transmission is a message queued to be written, already split into its frames.
+type transmission struct {
+	priority uint8
+	pgn      uint32
+	frames   []can.Frame
+	// ctx is the context of WritePgnContext, nil for WritePgn
+	ctx        context.Context
+	enqueuedAt time.Time
+	// done receives the result once the message has been written
+	done chan error
+}
+
+// frameRateLimit limits frames to one per interval on average, allowing bursts of up to
+// burst frames. It keeps the time by which the frames already sent would have gone out at
+// the steady rate. A message may go out once that time is within the burst allowance of
+// now, even if its frames then overdraw it, so that a fast packet is never held back for
+// longer than its own frames take to earn.
+type frameRateLimit struct {
+	interval  time.Duration
+	tolerance time.Duration
+	due       time.Time
+}
+
+func newFrameRateLimit(framesPerSecond float64, burst int, now time.Time) *frameRateLimit {
+	if burst < 1 {
+		burst = 1
+	}
+	interval := time.Duration(float64(time.Second) / framesPerSecond)
+	return &frameRateLimit{interval: interval, tolerance: time.Duration(burst-1) * interval, due: now}
+}
+
+// wait returns how long until a message may go out, zero when it may now.
+func (l *frameRateLimit) wait(now time.Time) time.Duration {
+	allowed := l.due.Add(-l.tolerance)
+	if !now.Before(allowed) {
+		return 0
+	}
+	return allowed.Sub(now)
+}
+
+func (l *frameRateLimit) take(now time.Time, frames int) {
+	if l.due.Before(now) {
+		l.due = now
+	}
+	l.due = l.due.Add(time.Duration(frames) * l.interval)
+}
+
+// transmitter orders outbound messages by CAN priority and keeps them within the frame
+// rate limits. A message is written directly when nothing else is being written or
+// waiting and the limits allow it; otherwise it is queued and written by a drain
+// goroutine, lowest priority number first and in order of submission within a priority.
+// Only one message is written at a time, so the frames of a fast packet are never
+// interleaved with another message's.
+type transmitter struct {
+	mu        sync.Mutex
+	queue     []*transmission
+	busy      bool
+	draining  bool
+	wake      chan struct{}
+	global    *frameRateLimit
+	pgnLimits map[uint32]*frameRateLimit
+	lastDelay time.Duration
+	maxDelay  time.Duration
+}
+
+// SetTransmitRateLimit limits the frames written to framesPerSecond across all PGNs,
+// allowing bursts of up to burst frames. A rate of zero or less removes the limit.
+func (c *CANAdapter) SetTransmitRateLimit(framesPerSecond float64, burst int) {
+	t := &c.transmit
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.global = nil
+	if framesPerSecond > 0 {
+		t.global = newFrameRateLimit(framesPerSecond, burst, c.clock.Now())
+	}
+	t.wakeLocked()
+}
+
+// SetPGNTransmitRateLimit limits the frames written for pgnNum to framesPerSecond,
+// allowing bursts of up to burst frames. Messages of other PGNs are written past those
+// held back by the limit. A rate of zero or less removes the limit.
+func (c *CANAdapter) SetPGNTransmitRateLimit(pgnNum uint32, framesPerSecond float64, burst int) {
+	t := &c.transmit
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if framesPerSecond <= 0 {
+		delete(t.pgnLimits, pgnNum)
+	} else {
+		if t.pgnLimits == nil {
+			t.pgnLimits = make(map[uint32]*frameRateLimit)
+		}
+		t.pgnLimits[pgnNum] = newFrameRateLimit(framesPerSecond, burst, c.clock.Now())
+	}
+	t.wakeLocked()
+}
+
+// TransmitQueueStats returns the state of the outbound queue.
+func (c *CANAdapter) TransmitQueueStats() TransmitQueueStats {
+	t := &c.transmit
+	now := c.clock.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats := TransmitQueueStats{Depth: len(t.queue), LastDelay: t.lastDelay, MaxDelay: t.maxDelay}
+	for _, item := range t.queue {
+		if age := now.Sub(item.enqueuedAt); age > stats.Lag {
+			stats.Lag = age
+		}
+	}
+	return stats
+}
+
+// tryDirect claims the transmitter for a message of frames frames when it may be written
+// at once. The caller writes it and then calls directDone.
+func (c *CANAdapter) tryDirect(pgnNum uint32, frames int) bool {
+	t := &c.transmit
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.busy || len(t.queue) > 0 {
+		return false
+	}
+	if t.global == nil && len(t.pgnLimits) == 0 {
+		t.busy = true
+		t.lastDelay = 0
+		return true
+	}
+	now := c.clock.Now()
+	if t.waitLocked(pgnNum, now) > 0 {
+		return false
+	}
+	t.takeLocked(pgnNum, frames, now)
+	t.busy = true
+	t.lastDelay = 0
+	return true
+}
+
+// directDone releases the transmitter after a direct write, handing it to anything queued
+// meanwhile.
+func (c *CANAdapter) directDone() {
+	t := &c.transmit
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.busy = false
+	c.startDrainLocked()
+}
+
+// enqueue queues the frames of a message written with ctx, nil for WritePgn. The result
+// is sent on the returned transmission's done channel.
+func (c *CANAdapter) enqueue(ctx context.Context, priority uint8, pgnNum uint32, frames []can.Frame) *transmission {
+	t := &c.transmit
+	item := &transmission{
+		priority:   priority,
+		pgn:        pgnNum,
+		frames:     frames,
+		ctx:        ctx,
+		enqueuedAt: c.clock.Now(),
+		done:       make(chan error, 1),
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// the queue is kept in order of priority, then of submission
+	i := len(t.queue)
+	for i > 0 && t.queue[i-1].priority > priority {
+		i--
+	}
+	t.queue = append(t.queue, nil)
+	copy(t.queue[i+1:], t.queue[i:])
+	t.queue[i] = item
+	if t.draining {
+		t.wakeLocked()
+	} else {
+		c.startDrainLocked()
+	}
+	return item
+}
+
+// cancel removes item from the queue, reporting false when it has already been taken to
+// be written.
+func (c *CANAdapter) cancel(item *transmission) bool {
+	t := &c.transmit
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, queued := range t.queue {
+		if queued == item {
+			t.queue = append(t.queue[:i], t.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// startDrainLocked starts the drain goroutine when messages are waiting and nothing is
+// being written. The caller holds the transmitter's lock.
+func (c *CANAdapter) startDrainLocked() {
+	t := &c.transmit
+	if t.busy || t.draining || len(t.queue) == 0 {
+		return
+	}
+	t.draining = true
+	if t.wake == nil {
+		t.wake = make(chan struct{}, 1)
+	}
+	go c.drain()
+}
+
+// drain writes queued messages until the queue is empty, sleeping while the rate limits
+// hold every waiting message back.
+func (c *CANAdapter) drain() {
+	t := &c.transmit
+	for {
+		t.mu.Lock()
+		if len(t.queue) == 0 {
+			t.draining = false
+			t.mu.Unlock()
+			return
+		}
+		now := c.clock.Now()
+		item, wait := t.nextLocked(now)
+		if item == nil {
+			wake := t.wake
+			t.mu.Unlock()
+			timer := c.clock.NewTimer(wait)
+			select {
+			case <-timer.C():
+			case <-wake:
+				timer.Stop()
+			}
+			continue
+		}
+		t.takeLocked(item.pgn, len(item.frames), now)
+		t.busy = true
+		t.mu.Unlock()
+
+		err := c.writeFrames(item)
+		if err != nil && item.ctx == nil {
+			c.log.Warn("failed to write queued PGN", logging.PGN(item.pgn), logging.Error(err))
+		}
+		item.done <- err
+
+		t.mu.Lock()
+		t.busy = false
+		if err == nil {
+			// only messages that were written count towards the delays
+			t.lastDelay = now.Sub(item.enqueuedAt)
+			if t.lastDelay > t.maxDelay {
+				t.maxDelay = t.lastDelay
+			}
+		}
+		t.mu.Unlock()
+	}
+}
+
+// writeFrames writes the frames of item in order, stopping at the first error.
+func (c *CANAdapter) writeFrames(item *transmission) error {
+	for i, frame := range item.frames {
+		if err := c.writeQueuedFrame(item.ctx, frame); err != nil {
+			if i == 0 {
+				return err
+			}
+			return &endpoint.PartialWriteError{Sent: i, Total: len(item.frames), Err: err}
+		}
+	}
+	return nil
+}
+
+// writeQueuedFrame writes a queued frame as WritePgn does when ctx is nil, and as
+// WritePgnContext does otherwise.
+func (c *CANAdapter) writeQueuedFrame(ctx context.Context, frame can.Frame) error {
+	if ctx == nil {
+		return c.writeFrame(frame)
+	}
+	writer := c.writer()
+	if writer == nil {
+		return errNoWriter
+	}
+	if contextWriter, ok := writer.(endpoint.ContextFrameWriter); ok {
+		return contextWriter.WriteFrameContext(ctx, frame)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	writer.WriteFrame(frame)
+	return nil
+}
+
+// nextLocked removes and returns the first queued message the rate limits allow, or
+// returns how long until one may be allowed.
+func (t *transmitter) nextLocked(now time.Time) (*transmission, time.Duration) {
+	var wait time.Duration
+	for i, item := range t.queue {
+		w := t.waitLocked(item.pgn, now)
+		if w == 0 {
+			t.queue = append(t.queue[:i], t.queue[i+1:]...)
+			return item, 0
+		}
+		if wait == 0 || w < wait {
+			wait = w
+		}
+	}
+	return nil, wait
+}
+
+// waitLocked returns how long until a message of pgnNum may go out.
+func (t *transmitter) waitLocked(pgnNum uint32, now time.Time) time.Duration {
+	var wait time.Duration
+	if t.global != nil {
+		wait = t.global.wait(now)
+	}
+	if limit, ok := t.pgnLimits[pgnNum]; ok {
+		if w := limit.wait(now); w > wait {
+			wait = w
+		}
+	}
+	return wait
+}
+
+func (t *transmitter) takeLocked(pgnNum uint32, frames int, now time.Time) {
+	if t.global != nil {
+		t.global.take(now, frames)
+	}
+	if limit, ok := t.pgnLimits[pgnNum]; ok {
+		limit.take(now, frames)
+	}
+}
+
+// wakeLocked wakes the drain goroutine to look at the queue again.
+func (t *transmitter) wakeLocked() {
+	if t.wake == nil {
+		return
+	}
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
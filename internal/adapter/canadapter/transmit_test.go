@@ -0,0 +1,244 @@
+package canadapter
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/brutella/can"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatedEndpoint records frames, holding the first write until release is closed.
+type gatedEndpoint struct {
+	captureEndpoint
+	mu      sync.Mutex
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func newGatedEndpoint() *gatedEndpoint {
+	return &gatedEndpoint{started: make(chan struct{}), release: make(chan struct{})}
+}
+
+func (g *gatedEndpoint) WriteFrame(frame can.Frame) {
+	g.once.Do(func() {
+		close(g.started)
+		<-g.release
+	})
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.frames = append(g.frames, frame)
+}
+
+func (g *gatedEndpoint) pgns() []uint32 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	pgns := make([]uint32, len(g.frames))
+	for i, frame := range g.frames {
+		pgns[i] = converter.DecodeCanID(frame.ID).PGN
+	}
+	return pgns
+}
+
+func (g *gatedEndpoint) count() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return len(g.frames)
+}
+
+func pgnListInfo() pgn.MessageInfo {
+	return pgn.MessageInfo{PGN: publicpgn.PGNListTransmitAndReceivePGN, SourceId: 35, Priority: 6, TargetId: 255}
+}
+
+func waitForDepth(t *testing.T, adapter *CANAdapter, depth int) {
+	require.Eventually(t, func() bool { return adapter.TransmitQueueStats().Depth == depth },
+		time.Second, time.Millisecond)
+}
+
+func TestTransmitOrdersByPriority(t *testing.T) {
+	writer := newGatedEndpoint()
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetWriter(writer)
+
+	// A message being written holds the transmitter while the others queue behind it
+	go func() {
+		_ = adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 7, TargetId: 255}, make([]uint8, 8))
+	}()
+	<-writer.started
+	for i := 0; i < 3; i++ {
+		require.NoError(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)))
+	}
+	command := pgn.MessageInfo{PGN: publicpgn.HeadingTrackControlPGN, SourceId: 35, Priority: 2, TargetId: 255}
+	done := make(chan error, 1)
+	go func() { done <- adapter.WritePgnContext(context.Background(), command, make([]uint8, 21)) }()
+	waitForDepth(t, adapter, 4)
+
+	close(writer.release)
+	require.NoError(t, <-done)
+	waitForDepth(t, adapter, 0)
+	require.Eventually(t, func() bool { return writer.count() == 1+4+3*2 }, time.Second, time.Millisecond)
+
+	list, control := uint32(publicpgn.PGNListTransmitAndReceivePGN), uint32(publicpgn.HeadingTrackControlPGN)
+	assert.Equal(t, []uint32{publicpgn.RudderPGN, control, control, control, control, list, list, list, list, list, list}, writer.pgns())
+	// The frames of each fast packet are contiguous and in order
+	for i := 5; i < len(writer.frames); i += 2 {
+		assert.Equal(t, writer.frames[i].Data[0]&0xE0, writer.frames[i+1].Data[0]&0xE0)
+		assert.Equal(t, uint8(0), writer.frames[i].Data[0]&0x1F)
+		assert.Equal(t, uint8(1), writer.frames[i+1].Data[0]&0x1F)
+	}
+	assert.Positive(t, adapter.TransmitQueueStats().MaxDelay)
+}
+
+func TestTransmitPGNRateLimitLetsOtherPGNsPass(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	writer := newGatedEndpoint()
+	close(writer.release)
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetClock(fake)
+	adapter.SetWriter(writer)
+	adapter.SetPGNTransmitRateLimit(publicpgn.PGNListTransmitAndReceivePGN, 20, 2)
+
+	// The first list takes the burst, so the second waits
+	require.NoError(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)))
+	require.NoError(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)))
+	rudder := pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 7, TargetId: 255}
+	require.NoError(t, adapter.WritePgnContext(context.Background(), rudder, make([]uint8, 8)))
+
+	list := uint32(publicpgn.PGNListTransmitAndReceivePGN)
+	assert.Equal(t, []uint32{list, list, publicpgn.RudderPGN}, writer.pgns())
+	require.NoError(t, fake.BlockUntil(context.Background(), 1))
+	stats := adapter.TransmitQueueStats()
+	assert.Equal(t, 1, stats.Depth)
+
+	// Two frames at 20 per second from a burst of two leave the next list due after 50ms
+	fake.Advance(49 * time.Millisecond)
+	assert.Equal(t, 1, adapter.TransmitQueueStats().Depth)
+	require.NoError(t, fake.BlockUntil(context.Background(), 1))
+	fake.Advance(time.Millisecond)
+	waitForDepth(t, adapter, 0)
+	require.Eventually(t, func() bool { return writer.count() == 5 }, time.Second, time.Millisecond)
+	assert.Equal(t, 50*time.Millisecond, adapter.TransmitQueueStats().MaxDelay)
+}
+
+func TestTransmitGlobalRateLimit(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	writer := newGatedEndpoint()
+	close(writer.release)
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetClock(fake)
+	adapter.SetWriter(writer)
+	adapter.SetTransmitRateLimit(100, 1)
+
+	info := pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 2, TargetId: 255}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, adapter.WritePgn(info, make([]uint8, 8)))
+	}
+	assert.Equal(t, 1, writer.count())
+	require.NoError(t, fake.BlockUntil(context.Background(), 1))
+	fake.Advance(5 * time.Millisecond)
+	stats := adapter.TransmitQueueStats()
+	assert.Equal(t, 2, stats.Depth)
+	assert.Equal(t, 5*time.Millisecond, stats.Lag)
+
+	fake.Advance(5 * time.Millisecond)
+	require.Eventually(t, func() bool { return writer.count() == 2 }, time.Second, time.Millisecond)
+	require.NoError(t, fake.BlockUntil(context.Background(), 1))
+	fake.Advance(10 * time.Millisecond)
+	require.Eventually(t, func() bool { return writer.count() == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, 20*time.Millisecond, adapter.TransmitQueueStats().MaxDelay)
+}
+
+func TestTransmitContextDropsQueuedMessage(t *testing.T) {
+	writer := newGatedEndpoint()
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetWriter(writer)
+
+	go func() {
+		_ = adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 7, TargetId: 255}, make([]uint8, 8))
+	}()
+	<-writer.started
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- adapter.WritePgnContext(ctx, pgnListInfo(), make([]uint8, 13)) }()
+	waitForDepth(t, adapter, 1)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, 0, adapter.TransmitQueueStats().Depth)
+
+	close(writer.release)
+	require.Eventually(t, func() bool { return writer.count() == 1 }, time.Second, time.Millisecond)
+}
+
+// sendingEndpoint reports frames as sent once release lets them through, as an endpoint
+// with its own transmit queue does.
+type sendingEndpoint struct {
+	*gatedEndpoint
+}
+
+func (e *sendingEndpoint) WriteFrame(can.Frame) {
+	panic("frames are written with WriteFrameContext")
+}
+
+func (e *sendingEndpoint) WriteFrameContext(_ context.Context, frame can.Frame) error {
+	e.gatedEndpoint.WriteFrame(frame)
+	return nil
+}
+
+func TestTransmitHoldsUntilContextWriterSends(t *testing.T) {
+	writer := &sendingEndpoint{gatedEndpoint: newGatedEndpoint()}
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetWriter(writer)
+
+	// WritePgn waits for the endpoint to send, so later messages queue by priority
+	go func() {
+		_ = adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 7, TargetId: 255}, make([]uint8, 8))
+	}()
+	<-writer.started
+	require.NoError(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)))
+	require.NoError(t, adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.HeadingTrackControlPGN, SourceId: 35, Priority: 2, TargetId: 255}, make([]uint8, 21)))
+	waitForDepth(t, adapter, 2)
+
+	close(writer.release)
+	require.Eventually(t, func() bool { return writer.count() == 1+4+2 }, time.Second, time.Millisecond)
+	pgns := writer.pgns()
+	assert.Equal(t, uint32(publicpgn.HeadingTrackControlPGN), pgns[1])
+	assert.Equal(t, uint32(publicpgn.PGNListTransmitAndReceivePGN), pgns[5])
+}
+
+func TestTransmitWithoutWriterIsNotCountedAsWritten(t *testing.T) {
+	writer := newGatedEndpoint()
+	adapter := NewCANAdapter(slog.Default())
+	adapter.SetWriter(writer)
+
+	go func() {
+		_ = adapter.WritePgn(pgn.MessageInfo{PGN: publicpgn.RudderPGN, SourceId: 35, Priority: 7, TargetId: 255}, make([]uint8, 8))
+	}()
+	<-writer.started
+	require.NoError(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)))
+	waitForDepth(t, adapter, 1)
+	adapter.SetWriter(nil)
+	close(writer.release)
+
+	waitForDepth(t, adapter, 0)
+	require.Eventually(t, func() bool {
+		adapter.transmit.mu.Lock()
+		defer adapter.transmit.mu.Unlock()
+		return !adapter.transmit.busy && !adapter.transmit.draining
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 1, writer.count())
+	assert.Zero(t, adapter.TransmitQueueStats().MaxDelay)
+	assert.ErrorIs(t, adapter.WritePgn(pgnListInfo(), make([]uint8, 13)), errNoWriter)
+}
+
+var _ endpoint.Endpoint = (*gatedEndpoint)(nil)
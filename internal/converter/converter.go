@@ -193,7 +193,8 @@ func CanIDFromStructWithValidation(data CanIDData) (uint32, error) {
 }
 
 // FrameHeader defines a structure to capture the RAW defined information comprising a CAN Frame ID
-// and the recorded timestamp
+// and the recorded timestamp. DecodeCanID leaves TimeStamp zero for the caller to set
+// from its clock.
 type FrameHeader struct {
 	TimeStamp time.Time
 	SourceID  uint8
@@ -205,10 +206,9 @@ type FrameHeader struct {
 // DecodeCanID returns a frame header extracted from frame.Id.
 func DecodeCanID(id uint32) FrameHeader {
 	r := FrameHeader{
-		TimeStamp: time.Now(),
-		SourceID:  uint8(id & 0xFF),
-		PGN:       (id & 0x3FFFF00) >> 8,
-		Priority:  uint8((id & 0x1C000000) >> 26),
+		SourceID: uint8(id & 0xFF),
+		PGN:      (id & 0x3FFFF00) >> 8,
+		Priority: uint8((id & 0x1C000000) >> 26),
 	}
 
 	pduFormat := uint8((r.PGN & 0xFF00) >> 8)
@@ -223,11 +223,12 @@ func DecodeCanID(id uint32) FrameHeader {
 	return r
 }
 
-// RawFromCanFrame returns a string in RAW format encoding the frame.
-func RawFromCanFrame(f can.Frame) string {
+// RawFromCanFrame returns a string in RAW format encoding the frame as received at the
+// given time.
+func RawFromCanFrame(f can.Frame, at time.Time) string {
 	h := DecodeCanID(f.ID)
 	return fmt.Sprintf("%s,%d,%d,%d,%d,%d,%02x,%02x,%02x,%02x,%02x,%02x,%02x,%02x\n",
-		h.TimeStamp.Format("2006-01-02T15:04:05Z"),
+		at.Format("2006-01-02T15:04:05Z"),
 		h.Priority, h.PGN, h.SourceID, h.TargetID, f.Length,
 		f.Data[0], f.Data[1], f.Data[2], f.Data[3], f.Data[4], f.Data[5], f.Data[6], f.Data[7])
 }
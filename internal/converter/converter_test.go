@@ -129,7 +129,6 @@ func TestDecodeCanID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result := DecodeCanID(tt.id)
-			// Don't compare TimeStamp as it's set to time.Now()
 			if result.SourceID != tt.expected.SourceID {
 				t.Errorf("DecodeCanID(0x%X).SourceID = %d, want %d",
 					tt.id, result.SourceID, tt.expected.SourceID)
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+
+	"github.com/boatkit-io/n2k/internal/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	"github.com/brutella/can"
+)
+
+// busLister is implemented by endpoints that combine several named buses.
+type busLister interface {
+	Buses() []string
+}
+
+// unwrapBusMessage returns the bus a message was received on, empty for an endpoint with
+// a single bus, and the message itself.
+func unwrapBusMessage(message endpoint.Message) (string, endpoint.Message) {
+	if busMessage, ok := message.(endpoint.BusMessage); ok {
+		return busMessage.Bus, busMessage.Message
+	}
+	return "", message
+}
+
+// busAdapter returns the adapter reassembling the frames of bus on shard, so fast packets
+// from different buses are never mixed.
+func (s *N2kService) busAdapter(shard *decodeShard, bus string) *canadapter.CANAdapter {
+	if bus == "" && shard == s.shards[0] {
+		return s.adapter
+	}
+	shard.adaptersMu.Lock()
+	defer shard.adaptersMu.Unlock()
+	if adapter, ok := shard.adapters[bus]; ok {
+		return adapter
+	}
+	adapter := canadapter.NewCANAdapter(s.log.With(logging.Bus(bus)))
+	adapter.SetBus(bus)
+	adapter.SetClock(s.clock)
+	adapter.SetOutput(s)
+	if s.busStats != nil {
+		adapter.SetReassemblyFailureHandler(func(source uint8, _ uint32) {
+			s.busStats.observeReassemblyFailure(bus, source, s.clock.Now())
+		})
+	}
+	if shard.adapters == nil {
+		shard.adapters = make(map[string]*canadapter.CANAdapter)
+	}
+	shard.adapters[bus] = adapter
+	return adapter
+}
+
+// busHash returns the 32-bit FNV-1a hash of a bus ID.
+func busHash(bus string) uint32 {
+	hash := uint32(2166136261)
+	for i := 0; i < len(bus); i++ {
+		hash ^= uint32(bus[i])
+		hash *= 16777619
+	}
+	return hash
+}
+
+// WriteTo sends a PGN struct to the named buses of an endpoint with several, or to every
+// bus when none are named, and waits as WriteContext does. Endpoints with a single bus
+// ignore the names.
+func (s *N2kService) WriteTo(ctx context.Context, pgnStruct any, buses ...string) error {
+	if len(buses) > 0 {
+		ctx = endpoint.WithBuses(ctx, buses...)
+	}
+	return s.WriteContext(ctx, pgnStruct)
+}
+
+// observeWritten counts a frame written with ctx on each bus it was written to.
+func (w *frameWriter) observeWritten(ctx context.Context, frame *can.Frame) {
+	if w.stats == nil {
+		return
+	}
+	now := w.clock.Now()
+	lister, ok := w.Endpoint.(busLister)
+	if !ok {
+		w.stats.observeFrame("", frame, now)
+		return
+	}
+	buses := endpoint.BusesFromContext(ctx)
+	if len(buses) == 0 {
+		buses = lister.Buses()
+	}
+	for _, bus := range buses {
+		w.stats.observeFrame(bus, frame, now)
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/brutella/can"
+)
+
+const (
+	// DefaultBusBitrate is the NMEA 2000 bitrate in bits per second.
+	DefaultBusBitrate = 250000
+	// DefaultBusStatisticsWindow is the period over which bus statistics are averaged.
+	DefaultBusStatisticsWindow = 10 * time.Second
+	// busStatsBuckets is the number of buckets the window is divided into.
+	busStatsBuckets = 10
+	// canFrameTrailerBits are the bits after the CRC that are never stuffed: the CRC
+	// delimiter, ACK slot and delimiter, end of frame and interframe space.
+	canFrameTrailerBits = 1 + 2 + 7 + 3
+)
+
+// BusTraffic is the traffic of some part of the bus, averaged over the statistics window.
+type BusTraffic struct {
+	FramesPerSecond float64
+	// BytesPerSecond counts data bytes.
+	BytesPerSecond float64
+	// Utilization is the percentage of the bus bitrate used, including frame overhead and
+	// stuff bits.
+	Utilization float64
+}
+
+// SourceTraffic is the traffic from one source address.
+type SourceTraffic struct {
+	Source uint8
+	BusTraffic
+	// ErrorsPerSecond counts messages from the source that failed to decode.
+	ErrorsPerSecond float64
+	// ReassemblyFailuresPerSecond counts fast packet messages from the source that could
+	// not be assembled from their frames.
+	ReassemblyFailuresPerSecond float64
+}
+
+// PGNTraffic is the traffic of one PGN.
+type PGNTraffic struct {
+	PGN uint32
+	BusTraffic
+}
+
+// BusStatistics describes bus load over the statistics window. Both received and
+// transmitted frames are counted.
+type BusStatistics struct {
+	// Bitrate is the configured bus bitrate in bits per second.
+	Bitrate int
+	// Window is the period the statistics are averaged over. It is shorter than the
+	// configured window until that much time has passed.
+	Window time.Duration
+	BusTraffic
+	// Sources is ordered by source address.
+	Sources []SourceTraffic
+	// PGNs is ordered by PGN.
+	PGNs []PGNTraffic
+}
+
+type busCounts struct {
+	frames uint64
+	bytes  uint64
+	bits   uint64
+}
+
+func (c *busCounts) add(other busCounts) {
+	c.frames += other.frames
+	c.bytes += other.bytes
+	c.bits += other.bits
+}
+
+type sourceCounts struct {
+	busCounts
+	errors             uint64
+	reassemblyFailures uint64
+}
+
+func (c *sourceCounts) add(other *sourceCounts) {
+	c.busCounts.add(other.busCounts)
+	c.errors += other.errors
+	c.reassemblyFailures += other.reassemblyFailures
+}
+
+type busBucket struct {
+	// index counts bucket widths since the stats were created, and used is set once the
+	// bucket has held one
+	index   int64
+	used    bool
+	total   busCounts
+	sources map[uint8]*sourceCounts
+	pgns    map[uint32]*busCounts
+}
+
+// busStats accumulates traffic in buckets covering a rolling window.
+type busStats struct {
+	mu      sync.Mutex
+	bitrate int
+	width   time.Duration
+	created time.Time
+	buckets [busStatsBuckets]busBucket
+}
+
+func newBusStats(bitrate int, window time.Duration, now time.Time) *busStats {
+	if bitrate <= 0 {
+		bitrate = DefaultBusBitrate
+	}
+	if window <= 0 {
+		window = DefaultBusStatisticsWindow
+	}
+	width := window / busStatsBuckets
+	if width <= 0 {
+		width = 1
+	}
+	return &busStats{bitrate: bitrate, width: width, created: now}
+}
+
+// indexAt returns the index of the bucket period holding now. It is negative for a time
+// before the stats were created, as when the clock is set back.
+func (b *busStats) indexAt(now time.Time) int64 {
+	elapsed := now.Sub(b.created)
+	index := int64(elapsed / b.width)
+	if elapsed < 0 && elapsed%b.width != 0 {
+		index--
+	}
+	return index
+}
+
+// bucket returns the bucket for now, clearing it if it held an earlier period. The caller
+// holds mu.
+func (b *busStats) bucket(now time.Time) *busBucket {
+	index := b.indexAt(now)
+	bucket := &b.buckets[(index%busStatsBuckets+busStatsBuckets)%busStatsBuckets]
+	if !bucket.used || bucket.index != index {
+		*bucket = busBucket{
+			index:   index,
+			used:    true,
+			sources: make(map[uint8]*sourceCounts),
+			pgns:    make(map[uint32]*busCounts),
+		}
+	}
+	return bucket
+}
+
+func (b *busBucket) source(source uint8) *sourceCounts {
+	counts := b.sources[source]
+	if counts == nil {
+		counts = &sourceCounts{}
+		b.sources[source] = counts
+	}
+	return counts
+}
+
+// observeFrame records a frame seen on the bus.
+func (b *busStats) observeFrame(frame *can.Frame, now time.Time) {
+	length := min(int(frame.Length), len(frame.Data))
+	counts := busCounts{frames: 1, bytes: uint64(length), bits: uint64(canFrameBits(frame))}
+	id := converter.DecodeCanID(frame.ID & can.MaskIDEff)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bucket := b.bucket(now)
+	bucket.total.add(counts)
+	bucket.source(id.SourceID).busCounts.add(counts)
+	pgn := bucket.pgns[id.PGN]
+	if pgn == nil {
+		pgn = &busCounts{}
+		bucket.pgns[id.PGN] = pgn
+	}
+	pgn.add(counts)
+}
+
+// observeError records a message from source that failed to decode.
+func (b *busStats) observeError(source uint8, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bucket(now).source(source).errors++
+}
+
+// observeReassemblyFailure records a fast packet message from source that could not be
+// assembled.
+func (b *busStats) observeReassemblyFailure(source uint8, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bucket(now).source(source).reassemblyFailures++
+}
+
+// snapshot averages the buckets in the window ending at now.
+func (b *busStats) snapshot(now time.Time) BusStatistics {
+	window := b.width * busStatsBuckets
+	if elapsed := now.Sub(b.created); elapsed < window {
+		window = elapsed
+	}
+	stats := BusStatistics{Bitrate: b.bitrate, Window: window}
+
+	var total busCounts
+	sources := make(map[uint8]*sourceCounts)
+	pgns := make(map[uint32]*busCounts)
+	current := b.indexAt(now)
+
+	b.mu.Lock()
+	for i := range b.buckets {
+		bucket := &b.buckets[i]
+		if !bucket.used || bucket.index > current || bucket.index <= current-busStatsBuckets {
+			continue
+		}
+		total.add(bucket.total)
+		for source, counts := range bucket.sources {
+			if sources[source] == nil {
+				sources[source] = &sourceCounts{}
+			}
+			sources[source].add(counts)
+		}
+		for pgn, counts := range bucket.pgns {
+			if pgns[pgn] == nil {
+				pgns[pgn] = &busCounts{}
+			}
+			pgns[pgn].add(*counts)
+		}
+	}
+	b.mu.Unlock()
+
+	stats.BusTraffic = b.traffic(total, window)
+	for source, counts := range sources {
+		stats.Sources = append(stats.Sources, SourceTraffic{
+			Source:                      source,
+			BusTraffic:                  b.traffic(counts.busCounts, window),
+			ErrorsPerSecond:             perSecond(counts.errors, window),
+			ReassemblyFailuresPerSecond: perSecond(counts.reassemblyFailures, window),
+		})
+	}
+	sort.Slice(stats.Sources, func(i, j int) bool { return stats.Sources[i].Source < stats.Sources[j].Source })
+	for pgn, counts := range pgns {
+		stats.PGNs = append(stats.PGNs, PGNTraffic{PGN: pgn, BusTraffic: b.traffic(*counts, window)})
+	}
+	sort.Slice(stats.PGNs, func(i, j int) bool { return stats.PGNs[i].PGN < stats.PGNs[j].PGN })
+	return stats
+}
+
+func (b *busStats) traffic(counts busCounts, window time.Duration) BusTraffic {
+	return BusTraffic{
+		FramesPerSecond: perSecond(counts.frames, window),
+		BytesPerSecond:  perSecond(counts.bytes, window),
+		Utilization:     perSecond(counts.bits, window) / float64(b.bitrate) * 100,
+	}
+}
+
+func perSecond(count uint64, window time.Duration) float64 {
+	return rate(float64(count), window)
+}
+
+// busStatsSet keeps the statistics of all buses together and of each named bus.
+type busStatsSet struct {
+	bitrate int
+	window  time.Duration
+	all     *busStats
+
+	mu    sync.Mutex
+	buses map[string]*busStats
+}
+
+func newBusStatsSet(bitrate int, window time.Duration, now time.Time) *busStatsSet {
+	return &busStatsSet{
+		bitrate: bitrate,
+		window:  window,
+		all:     newBusStats(bitrate, window, now),
+		buses:   make(map[string]*busStats),
+	}
+}
+
+// bus returns the statistics of the named bus, creating them when create is set.
+func (b *busStatsSet) bus(id string, create bool, now time.Time) *busStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	stats := b.buses[id]
+	if stats == nil && create {
+		stats = newBusStats(b.bitrate, b.window, now)
+		b.buses[id] = stats
+	}
+	return stats
+}
+
+// observeFrame records a frame seen on bus, which is empty for a single bus.
+func (b *busStatsSet) observeFrame(bus string, frame *can.Frame, now time.Time) {
+	b.all.observeFrame(frame, now)
+	if bus != "" {
+		b.bus(bus, true, now).observeFrame(frame, now)
+	}
+}
+
+func (b *busStatsSet) observeError(bus string, source uint8, now time.Time) {
+	b.all.observeError(source, now)
+	if bus != "" {
+		b.bus(bus, true, now).observeError(source, now)
+	}
+}
+
+func (b *busStatsSet) observeReassemblyFailure(bus string, source uint8, now time.Time) {
+	b.all.observeReassemblyFailure(source, now)
+	if bus != "" {
+		b.bus(bus, true, now).observeReassemblyFailure(source, now)
+	}
+}
+
+// canFrameBits returns the length on the wire of an extended data frame, in bits. The
+// stuff bits are counted exactly from the identifier, data and CRC.
+func canFrameBits(frame *can.Frame) int {
+	length := min(int(frame.Length), len(frame.Data))
+	id := frame.ID & can.MaskIDEff
+
+	var bits canBits
+	bits.push(0, 1)                   // start of frame
+	bits.push(uint64(id>>18), 11)     // base identifier
+	bits.push(1, 1)                   // substitute remote request
+	bits.push(1, 1)                   // identifier extension
+	bits.push(uint64(id&0x3ffff), 18) // extended identifier
+	bits.push(0, 3)                   // remote transmission request, r1 and r0
+	bits.push(uint64(length), 4)      // data length code
+	for _, b := range frame.Data[:length] {
+		bits.push(uint64(b), 8)
+	}
+	bits.push(uint64(bits.crc), 15)
+
+	return bits.count + bits.stuffed + canFrameTrailerBits
+}
+
+// canBits counts the bits of a frame as they are pushed, with their stuff bits and CRC.
+type canBits struct {
+	count   int
+	stuffed int
+	crc     uint16
+	last    uint64
+	run     int
+}
+
+// push appends the n low bits of value, most significant first.
+func (c *canBits) push(value uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		bit := value >> uint(i) & 1
+		c.count++
+
+		// CRC-15/CAN; canFrameBits reads it before pushing the CRC field itself
+		next := uint16(bit) ^ (c.crc >> 14 & 1)
+		c.crc = c.crc << 1 & 0x7fff
+		if next == 1 {
+			c.crc ^= 0x4599
+		}
+
+		if c.run > 0 && bit == c.last {
+			c.run++
+		} else {
+			c.last = bit
+			c.run = 1
+		}
+		if c.run == 5 {
+			// a bit of the opposite value is inserted and starts the next run
+			c.stuffed++
+			c.last ^= 1
+			c.run = 1
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/brutella/can"
+)
+
+// decodeShard queues, reassembles and decodes the frames of the source addresses hashed
+// to it. Each shard has its own worker, so frames of one source are handled in order
+// while different sources are decoded in parallel.
+type decodeShard struct {
+	queue *messageQueue
+	// enqueue time of the message being processed, in Unix nanoseconds, or zero
+	processingNano atomic.Int64
+
+	adaptersMu sync.Mutex
+	// reassembly adapters by bus, created when the bus's first frame reaches the shard
+	adapters map[string]*canadapter.CANAdapter
+}
+
+func newDecodeShards(count int) []*decodeShard {
+	shards := make([]*decodeShard, max(count, 1))
+	for i := range shards {
+		shards[i] = &decodeShard{queue: newMessageQueue()}
+	}
+	return shards
+}
+
+// processingAge returns how long the message being processed has been in the service.
+func (d *decodeShard) processingAge(now time.Time) time.Duration {
+	processingNano := d.processingNano.Load()
+	if processingNano == 0 {
+		return 0
+	}
+	age := now.Sub(time.Unix(0, processingNano))
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// WithDecodeWorkers reassembles and decodes received frames on workers goroutines
+// instead of one. Frames are assigned to a worker by bus and source address, so each
+// source's messages are still handled in order, but subscriber callbacks and inbound
+// interceptors may run concurrently for different sources. Values below two keep the
+// single processing goroutine.
+func WithDecodeWorkers(workers int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.decodeWorkers = workers
+	}
+}
+
+// decodeShard returns the shard handling frames from the source of message on bus.
+// Messages other than frames go to the first shard.
+func (s *N2kService) decodeShard(bus string, message endpoint.Message) *decodeShard {
+	if len(s.shards) == 1 {
+		return s.shards[0]
+	}
+	frame, ok := message.(*can.Frame)
+	if !ok || frame == nil {
+		return s.shards[0]
+	}
+	// the source address is the low byte of the CAN ID
+	key := frame.ID & 0xFF
+	if bus != "" {
+		key ^= busHash(bus)
+	}
+	return s.shards[key%uint32(len(s.shards))]
+}
+
+// runDecodeShard processes the messages queued on shard until ctx is done, then discards
+// the rest.
+func (s *N2kService) runDecodeShard(ctx context.Context, shard *decodeShard) {
+	ticker := s.clock.NewTicker(messageQueueLogInterval)
+	defer ticker.Stop()
+
+	for {
+		queued, ok := shard.queue.dequeue(ctx)
+		if !ok {
+			s.discardQueuedMessages(shard)
+			return
+		}
+		s.processQueuedMessage(shard, queued)
+		s.messageQueuePending.Add(-1)
+		s.messageQueueWG.Done()
+
+		select {
+		case <-ticker.C():
+			s.maybeLogMessageQueueBacklog()
+		default:
+		}
+	}
+}
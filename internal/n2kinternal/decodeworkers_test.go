@@ -0,0 +1,99 @@
+package n2kinternal
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/brutella/can"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sourceFrames returns the frames of count headings and positions from source, each
+// numbered by its SID.
+func sourceFrames(t *testing.T, source uint8, count int) [][]can.Frame {
+	ep := &writeTestEndpoint{}
+	writer := NewN2kService(ep, slog.Default())
+	messages := make([][]can.Frame, 0, 2*count)
+	for i := range count {
+		sid := uint8(i)
+		heading := float32(1)
+		latitude := 47.6
+		require.NoError(t, writer.Write(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: source}, SID: &sid, Heading: &heading}))
+		messages = append(messages, ep.frames)
+		ep.frames = nil
+		require.NoError(t, writer.Write(publicpgn.GNSSPositionData{Info: publicpgn.MessageInfo{SourceId: source}, SID: &sid, Latitude: &latitude}))
+		messages = append(messages, ep.frames)
+		ep.frames = nil
+	}
+	return messages
+}
+
+func TestDecodeWorkersKeepEachSourceInOrder(t *testing.T) {
+	const sources, count = 8, 100
+	service := NewN2kService(&startupTestEndpoint{runCalled: make(chan struct{})}, slog.Default(),
+		WithDecodeWorkers(4), WithMessageQueueMaxAge(time.Minute))
+	require.Len(t, service.shards, 4)
+
+	var mu sync.Mutex
+	received := make(map[uint8][]uint8)
+	record := func(info publicpgn.MessageInfo, sid *uint8) {
+		mu.Lock()
+		defer mu.Unlock()
+		received[info.SourceId] = append(received[info.SourceId], *sid)
+	}
+	_, err := Subscribe(service, func(msg publicpgn.VesselHeading) { record(msg.Info, msg.SID) })
+	require.NoError(t, err)
+	_, err = Subscribe(service, func(msg publicpgn.GNSSPositionData) { record(msg.Info, msg.SID) })
+	require.NoError(t, err)
+
+	frames := make([][][]can.Frame, sources)
+	for source := range frames {
+		frames[source] = sourceFrames(t, uint8(source+1), count)
+	}
+	require.NoError(t, service.Start(context.Background()))
+	defer func() { assert.NoError(t, service.Stop()) }()
+
+	// interleave the sources frame by frame, so fast packets of different sources overlap
+	for i := range 2 * count {
+		for frame := 0; frame < len(frames[0][i]); frame++ {
+			for source := range frames {
+				if frame < len(frames[source][i]) {
+					service.HandleMessage(&frames[source][i][frame])
+				}
+			}
+		}
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		total := 0
+		for _, sids := range received {
+			total += len(sids)
+		}
+		return total == sources*2*count
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for source := uint8(1); source <= sources; source++ {
+		sids := received[source]
+		require.Len(t, sids, 2*count)
+		for i, sid := range sids {
+			assert.Equal(t, uint8(i/2), sid, "source %d message %d", source, i)
+		}
+	}
+	assert.Zero(t, service.messageQueueDropped.Load())
+}
+
+func TestDecodeWorkersReportLagOfSlowestShard(t *testing.T) {
+	service := NewN2kService(queueTestEndpoint{}, slog.Default(), WithDecodeWorkers(3), WithMessageQueueMaxAge(time.Second))
+	service.shards[2].processingNano.Store(time.Now().Add(-300 * time.Millisecond).UnixNano())
+
+	assert.GreaterOrEqual(t, service.MessageQueueLag(), 250*time.Millisecond)
+}
@@ -0,0 +1,123 @@
+package n2kinternal
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/boatkit-io/n2k/internal/converter"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/brutella/can"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The allocation budgets below guard the hot path against regressions. Decoding a
+// single-frame message allocates only the struct, its optional fields and the boxing
+// needed to dispatch it; the frame, packet and data stream are reused.
+const (
+	singleFrameReceiveAllocs = 5
+	singleFrameWriteAllocs   = 2
+)
+
+func headingFrame() *can.Frame {
+	return &can.Frame{
+		ID:     converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255),
+		Length: 8,
+		Data:   [8]uint8{1, 2, 3, 4, 5, 6, 7, 0xfc},
+	}
+}
+
+func headingStruct() publicpgn.VesselHeading {
+	heading := float32(1)
+	return publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 3}, Heading: &heading}
+}
+
+func positionFrames(t testing.TB) []can.Frame {
+	sid := uint8(1)
+	latitude, longitude := 47.6, -122.3
+	ep := &writeTestEndpoint{}
+	msg := publicpgn.GNSSPositionData{
+		Info:      publicpgn.MessageInfo{SourceId: 3},
+		SID:       &sid,
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}
+	require.NoError(t, NewN2kService(ep, slog.Default()).Write(msg))
+	require.Greater(t, len(ep.frames), 1)
+	return ep.frames
+}
+
+func TestSingleFrameHotPathAllocations(t *testing.T) {
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	received := 0
+	_, err := Subscribe(s, func(publicpgn.VesselHeading) { received++ })
+	require.NoError(t, err)
+	frame := headingFrame()
+
+	allocs := testing.AllocsPerRun(100, func() { s.HandleMessage(frame) })
+	assert.LessOrEqual(t, allocs, float64(singleFrameReceiveAllocs))
+	assert.Positive(t, received)
+
+	msg := headingStruct()
+	allocs = testing.AllocsPerRun(100, func() { _ = s.Write(msg) })
+	assert.LessOrEqual(t, allocs, float64(singleFrameWriteAllocs))
+}
+
+func TestFastPacketReassemblyReusesBuffers(t *testing.T) {
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	var positions []publicpgn.GNSSPositionData
+	_, err := Subscribe(s, func(msg publicpgn.GNSSPositionData) { positions = append(positions, msg) })
+	require.NoError(t, err)
+	frames := positionFrames(t)
+
+	for range 2 {
+		for i := range frames {
+			s.HandleMessage(&frames[i])
+		}
+	}
+	require.Len(t, positions, 2)
+	assert.InDelta(t, 47.6, *positions[0].Latitude, 1e-6)
+	assert.InDelta(t, 47.6, *positions[1].Latitude, 1e-6)
+	assert.InDelta(t, -122.3, *positions[1].Longitude, 1e-6)
+}
+
+func BenchmarkReceiveSingleFrame(b *testing.B) {
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	_, _ = Subscribe(s, func(publicpgn.VesselHeading) {})
+	frame := headingFrame()
+	b.ReportAllocs()
+	for b.Loop() {
+		s.HandleMessage(frame)
+	}
+}
+
+func BenchmarkReceiveFastPacket(b *testing.B) {
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	_, _ = Subscribe(s, func(publicpgn.GNSSPositionData) {})
+	frames := positionFrames(b)
+	b.ReportAllocs()
+	for b.Loop() {
+		for i := range frames {
+			s.HandleMessage(&frames[i])
+		}
+	}
+}
+
+func BenchmarkWriteSingleFrame(b *testing.B) {
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	msg := headingStruct()
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = s.Write(msg)
+	}
+}
+
+func BenchmarkWriteFastPacket(b *testing.B) {
+	sid := uint8(1)
+	s := NewN2kService(queueTestEndpoint{}, slog.Default())
+	msg := publicpgn.GNSSPositionData{Info: publicpgn.MessageInfo{SourceId: 3}, SID: &sid}
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = s.Write(msg)
+	}
+}
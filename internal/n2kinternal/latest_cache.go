@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"container/list"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// LatestValue is one entry of the latest-value cache.
+type LatestValue struct {
+	// Value is the most recent struct received for this key.
+	Value any
+	// Received is when Value was received.
+	Received time.Time
+	// Bus is the bus Value was received on, when the endpoint has several.
+	Bus string
+	// Source is the source address Value was sent from.
+	Source uint8
+	// Instance is Value's instance field, valid when HasInstance is set.
+	Instance    uint8
+	HasInstance bool
+}
+
+type latestCacheKey struct {
+	t           reflect.Type
+	bus         string
+	source      uint8
+	instance    uint8
+	hasInstance bool
+}
+
+// latestCache keeps the most recent struct per type, source and instance. When full it
+// evicts the entry that was updated least recently.
+type latestCache struct {
+	mu         sync.RWMutex
+	maxEntries int
+	entries    map[latestCacheKey]*list.Element
+	byType     map[reflect.Type]map[latestCacheKey]*list.Element
+	// order holds *latestCacheEntry, most recently updated first
+	order *list.List
+}
+
+type latestCacheEntry struct {
+	key   latestCacheKey
+	value LatestValue
+}
+
+func newLatestCache(maxEntries int) *latestCache {
+	return &latestCache{
+		maxEntries: maxEntries,
+		entries:    make(map[latestCacheKey]*list.Element),
+		byType:     make(map[reflect.Type]map[latestCacheKey]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// update stores p unless it is not cacheable.
+func (c *latestCache) update(p any, now time.Time) {
+	if _, unknown := p.(publicpgn.UnknownPGN); unknown {
+		return
+	}
+	info, ok := structInfo(p)
+	if !ok {
+		return
+	}
+	received := info.Timestamp
+	if received.IsZero() {
+		received = now
+	}
+	instance, hasInstance := pgn.StructInstance(p)
+	key := latestCacheKey{t: reflect.TypeOf(p), bus: info.Bus, source: info.SourceId, instance: instance, hasInstance: hasInstance}
+	entry := &latestCacheEntry{
+		key: key,
+		value: LatestValue{
+			Value:       p,
+			Received:    received,
+			Bus:         info.Bus,
+			Source:      info.SourceId,
+			Instance:    instance,
+			HasInstance: hasInstance,
+		},
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	if c.order.Len() >= c.maxEntries {
+		c.evictOldest()
+	}
+	element := c.order.PushFront(entry)
+	c.entries[key] = element
+	if c.byType[key.t] == nil {
+		c.byType[key.t] = make(map[latestCacheKey]*list.Element)
+	}
+	c.byType[key.t][key] = element
+}
+
+// evictOldest removes the least recently updated entry. The caller holds mu.
+func (c *latestCache) evictOldest() {
+	element := c.order.Back()
+	if element == nil {
+		return
+	}
+	c.order.Remove(element)
+	key := element.Value.(*latestCacheEntry).key
+	delete(c.entries, key)
+	delete(c.byType[key.t], key)
+	if len(c.byType[key.t]) == 0 {
+		delete(c.byType, key.t)
+	}
+}
+
+// latest returns the most recently received struct of type t that the filter accepts.
+func (c *latestCache) latest(t reflect.Type, filter func(any) bool) (LatestValue, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var found *LatestValue
+	for _, element := range c.byType[t] {
+		value := &element.Value.(*latestCacheEntry).value
+		if filter != nil && !filter(value.Value) {
+			continue
+		}
+		if found == nil || value.Received.After(found.Received) {
+			found = value
+		}
+	}
+	if found == nil {
+		return LatestValue{}, false
+	}
+	return *found, true
+}
+
+// snapshot returns every cached value, ordered by type name, bus, source and instance.
+func (c *latestCache) snapshot() []LatestValue {
+	c.mu.RLock()
+	values := make([]LatestValue, 0, c.order.Len())
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		values = append(values, element.Value.(*latestCacheEntry).value)
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(values, func(i, j int) bool {
+		a, b := values[i], values[j]
+		aName, bName := reflect.TypeOf(a.Value).Name(), reflect.TypeOf(b.Value).Name()
+		if aName != bName {
+			return aName < bName
+		}
+		if a.Bus != b.Bus {
+			return a.Bus < b.Bus
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		if a.HasInstance != b.HasInstance {
+			return !a.HasInstance
+		}
+		return a.Instance < b.Instance
+	})
+	return values
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DropReason identifies why received messages were dropped.
+type DropReason string
+
+const (
+	// DropBacklog counts frames rejected because the handler queue was too far behind.
+	DropBacklog DropReason = "backlog"
+	// DropStale counts queued frames discarded because they waited too long.
+	DropStale DropReason = "stale"
+	// DropSubscriberOverflow counts structs discarded by asynchronous subscribers' overflow
+	// policies.
+	DropSubscriberOverflow DropReason = "subscriber_overflow"
+)
+
+// Metrics is a snapshot of a service's counters since it was created, and of its queues.
+type Metrics struct {
+	// PGNs counts the traffic of each PGN, ordered by PGN.
+	PGNs []PGNMetrics
+	// DecodeFailures counts payloads delivered as UnknownPGN.
+	DecodeFailures uint64
+	// Drops counts dropped messages by reason.
+	Drops map[DropReason]uint64
+	// Callbacks describes each subscriber callback, ordered by struct and callback name.
+	Callbacks []CallbackMetrics
+
+	// QueueDepth is the number of received frames waiting to be processed.
+	QueueDepth int
+	// QueueLag is the age of the oldest frame waiting in or moving through the queue.
+	QueueLag time.Duration
+	// QueueMaxAge is the queue lag beyond which frames are dropped.
+	QueueMaxAge time.Duration
+	// OutboundLag is the endpoint's recent outbound queue and send latency, when it
+	// reports one.
+	OutboundLag time.Duration
+	// TransmitQueueDepth is the number of messages waiting in the transmit queue.
+	TransmitQueueDepth int
+	// TransmitQueueLag is the age of the oldest message in the transmit queue.
+	TransmitQueueLag time.Duration
+	// TransmitDelayMax is the longest any sent message waited in the transmit queue.
+	TransmitDelayMax time.Duration
+}
+
+// PGNMetrics counts the traffic of one PGN. Frames counts live frames, Packets complete
+// payloads and Structs decoded structs, including those that failed to decode.
+type PGNMetrics struct {
+	PGN            uint32
+	Frames         uint64
+	Packets        uint64
+	Structs        uint64
+	DecodeFailures uint64
+}
+
+// CallbackMetrics describes the calls to one subscriber callback.
+type CallbackMetrics struct {
+	// Struct is the name of the subscribed struct type.
+	Struct string
+	// Callback is the name of the callback function.
+	Callback string
+
+	Calls       uint64
+	Duration    time.Duration
+	MaxDuration time.Duration
+	// Dropped counts structs discarded by an asynchronous subscriber's overflow policy.
+	Dropped uint64
+	// Replaced counts queued structs that CoalesceLatest replaced with a newer struct of
+	// the same key. They are not drops: the newer struct is still delivered.
+	Replaced uint64
+}
+
+// totals copies the cumulative counters into metrics.
+func (m *processingMetrics) totals(metrics *Metrics) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics.PGNs = make([]PGNMetrics, 0, len(m.pgnTotals))
+	for _, total := range m.pgnTotals {
+		metrics.PGNs = append(metrics.PGNs, *total)
+	}
+	metrics.Callbacks = make([]CallbackMetrics, 0, len(m.callbackTotals))
+	for _, total := range m.callbackTotals {
+		metrics.Callbacks = append(metrics.Callbacks, *total)
+	}
+	metrics.DecodeFailures = m.decodeFailureTotal
+	metrics.Drops[DropSubscriberOverflow] = m.asyncDroppedTotal
+}
+
+// Metrics returns the service's counters and queue state.
+func (s *N2kService) Metrics() Metrics {
+	now := s.clock.Now()
+	queueStats := s.messageQueueStats(now)
+	transmitStats := s.adapter.TransmitQueueStats()
+	metrics := Metrics{
+		Drops: map[DropReason]uint64{
+			DropBacklog: s.messageQueueBacklogDropped.Load(),
+			DropStale:   s.messageQueueStaleDropped.Load(),
+		},
+		QueueDepth:  queueStats.depth,
+		QueueLag:    queueStats.lag,
+		QueueMaxAge: s.messageQueueMaxAge,
+		OutboundLag: s.OutboundQueueLag(),
+
+		TransmitQueueDepth: transmitStats.Depth,
+		TransmitQueueLag:   transmitStats.Lag,
+		TransmitDelayMax:   transmitStats.MaxDelay,
+	}
+	s.processingMetrics.totals(&metrics)
+
+	sort.Slice(metrics.PGNs, func(i, j int) bool { return metrics.PGNs[i].PGN < metrics.PGNs[j].PGN })
+	sort.Slice(metrics.Callbacks, func(i, j int) bool {
+		a, b := metrics.Callbacks[i], metrics.Callbacks[j]
+		if a.Struct != b.Struct {
+			return a.Struct < b.Struct
+		}
+		return a.Callback < b.Callback
+	})
+	return metrics
+}
+
+// MetricsHandler returns an http.Handler that serves Metrics in the Prometheus text
+// exposition format.
+func (s *N2kService) MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		metrics := s.Metrics()
+		_ = metrics.WritePrometheus(w)
+	})
+}
+
+// WritePrometheus writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	p := &promWriter{w: bufio.NewWriter(w)}
+
+	p.family("n2k_frames_total", "counter", "Live CAN frames processed, by PGN.")
+	for _, pgn := range m.PGNs {
+		p.sample("n2k_frames_total", pgnLabel(pgn.PGN), float64(pgn.Frames))
+	}
+	p.family("n2k_packets_total", "counter", "Complete PGN payloads processed, by PGN.")
+	for _, pgn := range m.PGNs {
+		p.sample("n2k_packets_total", pgnLabel(pgn.PGN), float64(pgn.Packets))
+	}
+	p.family("n2k_structs_total", "counter", "Structs delivered to subscribers, by PGN.")
+	for _, pgn := range m.PGNs {
+		p.sample("n2k_structs_total", pgnLabel(pgn.PGN), float64(pgn.Structs))
+	}
+	p.family("n2k_decode_failures_total", "counter", "Payloads delivered as UnknownPGN, by PGN.")
+	for _, pgn := range m.PGNs {
+		p.sample("n2k_decode_failures_total", pgnLabel(pgn.PGN), float64(pgn.DecodeFailures))
+	}
+
+	p.family("n2k_dropped_total", "counter", "Messages dropped, by reason.")
+	reasons := make([]string, 0, len(m.Drops))
+	for reason := range m.Drops {
+		reasons = append(reasons, string(reason))
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		p.sample("n2k_dropped_total", labels("reason", reason), float64(m.Drops[DropReason(reason)]))
+	}
+
+	p.family("n2k_callback_duration_seconds", "summary", "Time spent in subscriber callbacks.")
+	for _, callback := range m.Callbacks {
+		l := labels("struct", callback.Struct, "callback", callback.Callback)
+		p.sample("n2k_callback_duration_seconds_sum", l, callback.Duration.Seconds())
+		p.sample("n2k_callback_duration_seconds_count", l, float64(callback.Calls))
+	}
+	p.family("n2k_callback_duration_max_seconds", "gauge", "Longest subscriber callback.")
+	for _, callback := range m.Callbacks {
+		p.sample("n2k_callback_duration_max_seconds", labels("struct", callback.Struct, "callback", callback.Callback),
+			callback.MaxDuration.Seconds())
+	}
+	p.family("n2k_callback_dropped_total", "counter", "Structs dropped by asynchronous subscribers.")
+	for _, callback := range m.Callbacks {
+		p.sample("n2k_callback_dropped_total", labels("struct", callback.Struct, "callback", callback.Callback),
+			float64(callback.Dropped))
+	}
+	p.family("n2k_callback_replaced_total", "counter", "Queued structs replaced by newer ones by coalescing subscribers.")
+	for _, callback := range m.Callbacks {
+		p.sample("n2k_callback_replaced_total", labels("struct", callback.Struct, "callback", callback.Callback),
+			float64(callback.Replaced))
+	}
+
+	p.family("n2k_queue_depth", "gauge", "Received frames waiting to be processed.")
+	p.sample("n2k_queue_depth", "", float64(m.QueueDepth))
+	p.family("n2k_queue_lag_seconds", "gauge", "Age of the oldest frame in the handler queue.")
+	p.sample("n2k_queue_lag_seconds", "", m.QueueLag.Seconds())
+	p.family("n2k_queue_max_age_seconds", "gauge", "Queue lag beyond which frames are dropped.")
+	p.sample("n2k_queue_max_age_seconds", "", m.QueueMaxAge.Seconds())
+	p.family("n2k_outbound_lag_seconds", "gauge", "Recent outbound queue and send latency.")
+	p.sample("n2k_outbound_lag_seconds", "", m.OutboundLag.Seconds())
+	p.family("n2k_transmit_queue_depth", "gauge", "Messages waiting in the transmit queue.")
+	p.sample("n2k_transmit_queue_depth", "", float64(m.TransmitQueueDepth))
+	p.family("n2k_transmit_queue_lag_seconds", "gauge", "Age of the oldest message in the transmit queue.")
+	p.sample("n2k_transmit_queue_lag_seconds", "", m.TransmitQueueLag.Seconds())
+	p.family("n2k_transmit_delay_max_seconds", "gauge", "Longest time a sent message waited in the transmit queue.")
+	p.sample("n2k_transmit_delay_max_seconds", "", m.TransmitDelayMax.Seconds())
+
+	if p.err != nil {
+		return p.err
+	}
+	return p.w.Flush()
+}
+
+// promWriter writes the text exposition format, keeping the first error.
+type promWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (p *promWriter) family(name, kind, help string) {
+	p.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func (p *promWriter) sample(name, labels string, value float64) {
+	p.printf("%s%s %s\n", name, labels, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+func (p *promWriter) printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
+
+func pgnLabel(pgn uint32) string {
+	return labels("pgn", strconv.FormatUint(uint64(pgn), 10))
+}
+
+// labels formats name and value pairs as a label set.
+func labels(pairs ...string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(pairs[i])
+		b.WriteString(`="`)
+		b.WriteString(labelValueEscaper.Replace(pairs[i+1]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
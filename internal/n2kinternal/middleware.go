@@ -0,0 +1,224 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/adapter/canadapter"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/brutella/can"
+)
+
+// Direction tells an interceptor whether a message is being received or sent.
+type Direction int
+
+const (
+	// Inbound messages were received from the endpoint or replayed.
+	Inbound Direction = iota + 1
+	// Outbound messages are being written to the endpoint.
+	Outbound
+)
+
+// String returns the direction name.
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+}
+
+// Packet is a complete PGN payload, between the CAN frame and decoded struct levels. Fast
+// packets are reassembled inbound and not yet split outbound.
+type Packet struct {
+	Info publicpgn.MessageInfo
+	Data []uint8
+}
+
+// FrameInterceptor intercepts CAN frames. It passes a frame on by calling next, after
+// modifying it if needed, drops it by not calling next, and injects frames by calling next
+// again. Outbound, next returns the endpoint's error and ctx is the writer's context.
+type FrameInterceptor func(ctx context.Context, dir Direction, frame can.Frame, next func(can.Frame) error) error
+
+// PacketInterceptor intercepts complete PGN payloads. See FrameInterceptor.
+type PacketInterceptor func(ctx context.Context, dir Direction, packet Packet, next func(Packet) error) error
+
+// StructInterceptor intercepts decoded PGN structs. Outbound structs are intercepted
+// before they are validated and encoded. See FrameInterceptor.
+type StructInterceptor func(ctx context.Context, dir Direction, msg any, next func(any) error) error
+
+// interceptors holds the chains of a service in registration order.
+type interceptors struct {
+	frames  []FrameInterceptor
+	packets []PacketInterceptor
+	structs []StructInterceptor
+}
+
+// WithFrameInterceptor adds a CAN frame interceptor. Interceptors run in the order they
+// are added, for both received and written messages.
+func WithFrameInterceptor(interceptor FrameInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors.frames = append(options.interceptors.frames, interceptor)
+	}
+}
+
+// WithPacketInterceptor adds a PGN payload interceptor. See WithFrameInterceptor.
+func WithPacketInterceptor(interceptor PacketInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors.packets = append(options.interceptors.packets, interceptor)
+	}
+}
+
+// WithStructInterceptor adds a decoded struct interceptor. See WithFrameInterceptor.
+func WithStructInterceptor(interceptor StructInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors.structs = append(options.interceptors.structs, interceptor)
+	}
+}
+
+// runChain passes msg through chain and then to final.
+func runChain[T any, I ~func(context.Context, Direction, T, func(T) error) error](
+	ctx context.Context, dir Direction, chain []I, msg T, final func(T) error,
+) error {
+	if len(chain) == 0 {
+		return final(msg)
+	}
+	var call func(i int, msg T) error
+	call = func(i int, msg T) error {
+		if i == len(chain) {
+			return final(msg)
+		}
+		return chain[i](ctx, dir, msg, func(next T) error { return call(i+1, next) })
+	}
+	return call(0, msg)
+}
+
+// interceptInbound runs an inbound chain, logging errors since there is no caller to
+// return them to.
+func interceptInbound[T any, I ~func(context.Context, Direction, T, func(T) error) error](
+	ctx context.Context, s *N2kService, chain []I, msg T, final func(T),
+) {
+	err := runChain(ctx, Inbound, chain, msg, func(msg T) error {
+		final(msg)
+		return nil
+	})
+	if err != nil {
+		s.log.Warn("Inbound interceptor failed", logging.Error(err))
+	}
+}
+
+// packetWriter runs outbound packet interceptors in front of the CAN adapter.
+type packetWriter struct {
+	adapter *canadapter.CANAdapter
+	chain   []PacketInterceptor
+}
+
+// WritePgn writes through the chain. Interceptors are passed a copy of data, as the
+// encode buffer is reused once the write returns.
+func (w *packetWriter) WritePgn(info publicpgn.MessageInfo, data []uint8) error {
+	return runChain(context.Background(), Outbound, w.chain, Packet{Info: info, Data: slices.Clone(data)}, func(packet Packet) error {
+		return w.adapter.WritePgn(packet.Info, packet.Data)
+	})
+}
+
+// WritePgnContext writes through the chain as WritePgn does.
+func (w *packetWriter) WritePgnContext(ctx context.Context, info publicpgn.MessageInfo, data []uint8) error {
+	return runChain(ctx, Outbound, w.chain, Packet{Info: info, Data: slices.Clone(data)}, func(packet Packet) error {
+		return w.adapter.WritePgnContext(ctx, packet.Info, packet.Data)
+	})
+}
+
+// frameWriter runs outbound frame interceptors in front of an endpoint, and counts the
+// frames it writes in the bus statistics.
+type frameWriter struct {
+	endpoint.Endpoint
+	chain []FrameInterceptor
+	stats *busStatsSet
+	clock clock.Clock
+	log   func(error)
+}
+
+// WriteFrame writes through the chain. Errors are logged as WriteFrame cannot return them.
+func (w *frameWriter) WriteFrame(frame can.Frame) {
+	err := runChain(context.Background(), Outbound, w.chain, frame, func(frame can.Frame) error {
+		w.Endpoint.WriteFrame(frame)
+		w.observeWritten(context.Background(), &frame)
+		return nil
+	})
+	if err != nil {
+		w.log(err)
+	}
+}
+
+// WriteFrameContext writes through the chain, returning the endpoint's error when it
+// reports one.
+func (w *frameWriter) WriteFrameContext(ctx context.Context, frame can.Frame) error {
+	return runChain(ctx, Outbound, w.chain, frame, func(frame can.Frame) error {
+		if writer, ok := w.Endpoint.(endpoint.ContextFrameWriter); ok {
+			if err := writer.WriteFrameContext(ctx, frame); err != nil {
+				return err
+			}
+		} else {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			w.Endpoint.WriteFrame(frame)
+		}
+		w.observeWritten(ctx, &frame)
+		return nil
+	})
+}
+
+// setWriter points the CAN adapter at ep, behind the outbound frame interceptors and bus
+// statistics.
+func (s *N2kService) setWriter(ep endpoint.Endpoint) {
+	if setter, ok := ep.(endpoint.ClockSetter); ok {
+		setter.SetClock(s.clock)
+	}
+	if len(s.interceptors.frames) == 0 && s.busStats == nil {
+		s.adapter.SetWriter(ep)
+		return
+	}
+	s.adapter.SetWriter(&frameWriter{
+		Endpoint: ep,
+		chain:    s.interceptors.frames,
+		stats:    s.busStats,
+		clock:    s.clock,
+		log: func(err error) {
+			s.log.Warn("Outbound frame interceptor failed", logging.Error(err))
+		},
+	})
+}
+
+// handleFrame passes a frame received on bus at the given time through the inbound frame
+// interceptors to adapter. The interceptors' context names the bus, as endpoint.WithBuses
+// does.
+func (s *N2kService) handleFrame(adapter *canadapter.CANAdapter, bus string, message endpoint.Message, received time.Time) {
+	frame, ok := message.(*can.Frame)
+	if !ok || len(s.interceptors.frames) == 0 {
+		adapter.HandleMessageAt(message, received)
+		return
+	}
+	ctx := context.Background()
+	if bus != "" {
+		ctx = endpoint.WithBuses(ctx, bus)
+	}
+	interceptInbound(ctx, s, s.interceptors.frames, *frame, func(frame can.Frame) {
+		adapter.HandleMessageAt(&frame, received)
+	})
+}
@@ -11,6 +11,10 @@ package n2kinternal
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -19,9 +23,11 @@ import (
 	"github.com/boatkit-io/n2k/internal/pgn"
 	"github.com/boatkit-io/n2k/internal/pkt"
 	"github.com/boatkit-io/n2k/internal/subscribe"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 )
 
 // N2kService provides the internal implementation of N2K operations
@@ -32,8 +38,17 @@ type N2kService struct {
 	replayAdapter  *canadapter.CANAdapter
 	packetStruct   *pkt.PacketStruct
 	subscriber     *subscribe.SubscribeManager
+	addresses      *addressBook
+	latest         *latestCache
+	streams        *streamTracker
+	busStats       *busStatsSet
+	requests       *requestTracker
+	scheduler      *Scheduler
 	publisher      *pgn.Publisher
-	log            *logrus.Logger
+	log            *slog.Logger
+	clock          clock.Clock
+	strictWrites   bool
+	interceptors   interceptors
 
 	lifecycleOpMu sync.Mutex
 	lifecycleMu   sync.Mutex
@@ -42,13 +57,13 @@ type N2kService struct {
 
 	receivedCANFrameHook func(*can.Frame)
 
-	messageQueue               *messageQueue
+	shards                     []*decodeShard
 	messageQueueMaxAge         time.Duration
-	messageQueueProcessingNano atomic.Int64
 	messageQueueDropped        atomic.Uint64
 	messageQueueBacklogDropped atomic.Uint64
 	messageQueueStaleDropped   atomic.Uint64
 	messageQueueWG             sync.WaitGroup
+	messageQueuePending        atomic.Int64
 	processingMetrics          *processingMetrics
 
 	processorMu     sync.Mutex
@@ -117,6 +132,28 @@ const (
 
 type serviceOptions struct {
 	messageQueueMaxAge time.Duration
+	strictWrites       bool
+	partialDecodes     bool
+	retainPayloads     bool
+	latestCacheSize    int
+	streamTracking     bool
+	streamStaleFactor  float64
+	streamIntervals    map[uint32]time.Duration
+	interceptors       interceptors
+	busStatistics      bool
+	busBitrate         int
+	busWindow          time.Duration
+	decodeWorkers      int
+	clock              clock.Clock
+	transmitLimit      transmitRateLimit
+	pgnTransmitLimits  map[uint32]transmitRateLimit
+	writePolicy        *WritePolicy
+}
+
+// transmitRateLimit is a frame rate limit of the CAN adapter's transmit queue.
+type transmitRateLimit struct {
+	framesPerSecond float64
+	burst           int
 }
 
 // ServiceOption configures an N2K service.
@@ -133,8 +170,113 @@ func WithMessageQueueMaxAge(maxAge time.Duration) ServiceOption {
 	}
 }
 
-// NewN2kService creates a new internal N2K service with the specified endpoint
-func NewN2kService(ep endpoint.Endpoint, log *logrus.Logger, opts ...ServiceOption) *N2kService {
+// WithStrictWrites makes Write validate each PGN struct and reject it with a
+// *publicpgn.ValidationError instead of clamping or truncating invalid fields.
+func WithStrictWrites() ServiceOption {
+	return func(options *serviceOptions) {
+		options.strictWrites = true
+	}
+}
+
+// WithPartialDecodes delivers payloads that end or fail partway through a PGN as
+// partially decoded structs with Info.DecodeWarnings() set, instead of UnknownPGN.
+func WithPartialDecodes() ServiceOption {
+	return func(options *serviceOptions) {
+		options.partialDecodes = true
+	}
+}
+
+// WithRetainedPayloads keeps each decoded struct's original payload in Info.Details so
+// that writing it again reproduces reserved bits and trailing bytes.
+func WithRetainedPayloads() ServiceOption {
+	return func(options *serviceOptions) {
+		options.retainPayloads = true
+	}
+}
+
+// WithLatestCache keeps the most recent struct per type, source address and instance,
+// up to maxEntries entries, for Latest and LatestValues.
+func WithLatestCache(maxEntries int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.latestCacheSize = maxEntries
+	}
+}
+
+// WithStreamTracking tracks every stream of a PGN from one source address and instance,
+// learning its interval and reporting it stale when no message arrives for staleFactor
+// intervals. A staleFactor of zero uses DefaultStreamStaleFactor.
+func WithStreamTracking(staleFactor float64) ServiceOption {
+	return func(options *serviceOptions) {
+		options.streamTracking = true
+		options.streamStaleFactor = staleFactor
+	}
+}
+
+// WithStreamInterval sets the expected interval of a PGN instead of learning it, and
+// enables stream tracking.
+func WithStreamInterval(pgnNumber uint32, interval time.Duration) ServiceOption {
+	return func(options *serviceOptions) {
+		options.streamTracking = true
+		if options.streamIntervals == nil {
+			options.streamIntervals = make(map[uint32]time.Duration)
+		}
+		options.streamIntervals[pgnNumber] = interval
+	}
+}
+
+// WithBusStatistics measures bus load and per-device traffic, for a bus running at
+// bitrate and averaged over window. Zero values use DefaultBusBitrate and
+// DefaultBusStatisticsWindow.
+func WithBusStatistics(bitrate int, window time.Duration) ServiceOption {
+	return func(options *serviceOptions) {
+		options.busStatistics = true
+		options.busBitrate = bitrate
+		options.busWindow = window
+	}
+}
+
+// WithClock times the service by c instead of the wall clock: message timestamps, queue
+// ages, metrics, statistics, stream tracking, scheduled PGNs and subscription rate
+// limits. Endpoints implementing endpoint.ClockSetter are given c too, so that a
+// *clock.Fake drives a whole replay.
+func WithClock(c clock.Clock) ServiceOption {
+	return func(options *serviceOptions) {
+		options.clock = c
+	}
+}
+
+// WithTransmitRateLimit limits the frames written to the endpoint to framesPerSecond,
+// allowing bursts of up to burst frames. Messages held back wait in the transmit queue,
+// which sends them in order of CAN priority.
+func WithTransmitRateLimit(framesPerSecond float64, burst int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.transmitLimit = transmitRateLimit{framesPerSecond: framesPerSecond, burst: burst}
+	}
+}
+
+// WithPGNTransmitRateLimit limits the frames written for one PGN to framesPerSecond,
+// allowing bursts of up to burst frames. Other PGNs are sent past its held back messages.
+func WithPGNTransmitRateLimit(pgnNumber uint32, framesPerSecond float64, burst int) ServiceOption {
+	return func(options *serviceOptions) {
+		if options.pgnTransmitLimits == nil {
+			options.pgnTransmitLimits = make(map[uint32]transmitRateLimit)
+		}
+		options.pgnTransmitLimits[pgnNumber] = transmitRateLimit{framesPerSecond: framesPerSecond, burst: burst}
+	}
+}
+
+// WithWritePolicy restricts the writes of control and safety-critical PGNs to those
+// policy allows, auditing each of them.
+func WithWritePolicy(policy WritePolicy) ServiceOption {
+	return func(options *serviceOptions) {
+		options.writePolicy = &policy
+	}
+}
+
+// NewN2kService creates a new internal N2K service with the specified endpoint. A nil log
+// uses slog.Default().
+func NewN2kService(ep endpoint.Endpoint, log *slog.Logger, opts ...ServiceOption) *N2kService {
+	log = logging.OrDefault(log)
 	options := serviceOptions{
 		messageQueueMaxAge: DefaultMessageQueueMaxAge,
 	}
@@ -142,32 +284,73 @@ func NewN2kService(ep endpoint.Endpoint, log *logrus.Logger, opts ...ServiceOpti
 		opt(&options)
 	}
 
+	clk := clock.OrReal(options.clock)
 	adapter := canadapter.NewCANAdapter(log)
+	adapter.SetClock(clk)
+	adapter.SetTransmitRateLimit(options.transmitLimit.framesPerSecond, options.transmitLimit.burst)
+	for pgnNumber, limit := range options.pgnTransmitLimits {
+		adapter.SetPGNTransmitRateLimit(pgnNumber, limit.framesPerSecond, limit.burst)
+	}
 	subscriber := subscribe.New()
+	subscriber.SetClock(clk)
+	subscriber.SetTypeIndex(pgn.NumStructTypes, pgn.StructTypeID)
 
-	pub := pgn.NewPublisher(adapter)
+	var pub pgn.Publisher
+	if len(options.interceptors.packets) > 0 {
+		pub = pgn.NewPublisher(&packetWriter{adapter: adapter, chain: options.interceptors.packets})
+	} else {
+		pub = pgn.NewPublisher(adapter)
+	}
+	pub.SetClock(clk)
+	if options.writePolicy != nil {
+		pub.SetAuthorizer(NewWriteGuard(*options.writePolicy, log, clk).authorize)
+	}
 	ps := pkt.NewPacketStruct()
+	ps.SetPartialDecodes(options.partialDecodes)
+	ps.SetRetainPayloads(options.retainPayloads)
 
 	s := &N2kService{
 		endpoint:           ep,
 		adapter:            adapter,
 		packetStruct:       ps,
 		subscriber:         subscriber,
+		addresses:          newAddressBook(),
+		requests:           newRequestTracker(),
 		publisher:          &pub,
 		log:                log,
-		messageQueue:       newMessageQueue(),
+		clock:              clk,
+		strictWrites:       options.strictWrites,
+		interceptors:       options.interceptors,
+		shards:             newDecodeShards(options.decodeWorkers),
 		messageQueueMaxAge: options.messageQueueMaxAge,
-		processingMetrics:  newProcessingMetrics(),
+		processingMetrics:  newProcessingMetrics(clk.Now()),
 	}
 	endpointOutput := &serviceEndpointOutput{service: s}
 	s.endpointOutput = endpointOutput
 
+	// Scheduled PGNs are only sent while the service is running
+	s.scheduler = NewScheduler(s.Write, log)
+	s.scheduler.Pause()
+	s.scheduler.SetClock(clk)
+
 	ps.SetOutput(s)
 	adapter.SetOutput(s)
 	subscriber.SetCallbackObserver(s)
+	if options.latestCacheSize > 0 {
+		s.latest = newLatestCache(options.latestCacheSize)
+	}
+	if options.streamTracking {
+		s.streams = newStreamTracker(options.streamStaleFactor, options.streamIntervals)
+	}
+	if options.busStatistics {
+		s.busStats = newBusStatsSet(options.busBitrate, options.busWindow, clk.Now())
+		adapter.SetReassemblyFailureHandler(func(source uint8, _ uint32) {
+			s.busStats.observeReassemblyFailure("", source, clk.Now())
+		})
+	}
 
 	ep.SetOutput(endpointOutput)
-	adapter.SetWriter(ep)
+	s.setWriter(ep)
 
 	return s
 }
@@ -184,6 +367,114 @@ func (s *N2kService) SubscribeToAllStructs(callback any) (uint, error) {
 	return uint(id), err
 }
 
+// Subscribe subscribes to PGN struct type T with a type-safe callback that is dispatched
+// without reflection. Options filter which structs reach the callback.
+func Subscribe[T any](s *N2kService, callback func(T), opts ...SubscribeOption) (subscribe.Subscription, error) {
+	options := subscribeOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	changed, err := options.changed(reflect.TypeFor[T]())
+	if err != nil {
+		return subscribe.Subscription{}, err
+	}
+	return subscribe.SubscribeWithOptions(s.subscriber, subscribe.Options{
+		Filter:      options.filter(s.addresses),
+		QueueSize:   options.queueSize,
+		Overflow:    options.overflow,
+		MinInterval: options.minInterval,
+		KeepLatest:  options.keepLatest,
+		Changed:     changed,
+		Key:         coalesceKey,
+	}, callback)
+}
+
+// Latest returns the most recently received struct of type T accepted by the filter
+// options, and when it was received. ok is false when nothing matches or the service has
+// no latest-value cache. Options other than filters are ignored.
+func Latest[T any](s *N2kService, filters ...SubscribeOption) (value T, received time.Time, ok bool) {
+	if s.latest == nil {
+		return value, received, false
+	}
+	options := subscribeOptions{}
+	for _, opt := range filters {
+		opt(&options)
+	}
+	found, ok := s.latest.latest(reflect.TypeFor[T](), options.filter(s.addresses))
+	if !ok {
+		return value, received, false
+	}
+	return found.Value.(T), found.Received, true
+}
+
+// LatestValues returns every entry of the latest-value cache, ordered by type name,
+// source address and instance. It returns nil when the service has no cache.
+func (s *N2kService) LatestValues() []LatestValue {
+	if s.latest == nil {
+		return nil
+	}
+	return s.latest.snapshot()
+}
+
+// Schedule writes the struct returned by produce every interval while the service is
+// running. See Scheduler.Schedule.
+func (s *N2kService) Schedule(pgnNumber uint32, interval time.Duration, produce func() any) (*ScheduledPGN, error) {
+	return s.scheduler.Schedule(pgnNumber, interval, produce)
+}
+
+// SubscribeToStreamEvents calls callback when a tracked stream goes stale, resumes, or
+// expires. It fails when the service was created without stream tracking.
+func (s *N2kService) SubscribeToStreamEvents(callback func(StreamEvent)) (uint, error) {
+	if s.streams == nil {
+		return 0, errStreamTrackingDisabled
+	}
+	if callback == nil {
+		return 0, errors.New("stream event callback is nil")
+	}
+	return s.streams.subscribe(callback), nil
+}
+
+// UnsubscribeStreamEvents removes a stream event subscription by its ID.
+func (s *N2kService) UnsubscribeStreamEvents(id uint) error {
+	if s.streams == nil {
+		return errStreamTrackingDisabled
+	}
+	return s.streams.unsubscribe(id)
+}
+
+// Streams returns the status of every tracked stream, ordered by PGN, source address and
+// instance. It returns nil when the service has no stream tracking.
+func (s *N2kService) Streams() []StreamStatus {
+	if s.streams == nil {
+		return nil
+	}
+	return s.streams.snapshot()
+}
+
+// BusStatistics returns bus load and per-device traffic, of all buses together when the
+// endpoint has several. ok is false when the service was created without bus statistics.
+func (s *N2kService) BusStatistics() (stats BusStatistics, ok bool) {
+	if s.busStats == nil {
+		return stats, false
+	}
+	return s.busStats.all.snapshot(s.clock.Now()), true
+}
+
+// BusStatisticsFor returns the bus load and per-device traffic of one bus of an endpoint
+// with several. ok is false when the service was created without WithBusStatistics or no
+// traffic was seen on the bus.
+func (s *N2kService) BusStatisticsFor(bus string) (stats BusStatistics, ok bool) {
+	if s.busStats == nil {
+		return stats, false
+	}
+	now := s.clock.Now()
+	busStats := s.busStats.bus(bus, false, now)
+	if busStats == nil {
+		return stats, false
+	}
+	return busStats.snapshot(now), true
+}
+
 // Unsubscribe removes a subscription by its ID.
 func (s *N2kService) Unsubscribe(id uint) error {
 	return s.subscriber.Unsubscribe(subscribe.SubscriptionId(id))
@@ -191,37 +482,51 @@ func (s *N2kService) Unsubscribe(id uint) error {
 
 // SetReceivedCANFrameHook registers a callback invoked for each live CAN frame before decode.
 // The hook may be called concurrently from the endpoint goroutine.
+//
+// Deprecated: Use WithFrameInterceptor, which can also modify, drop and inject frames.
 func (s *N2kService) SetReceivedCANFrameHook(fn func(*can.Frame)) {
 	s.receivedCANFrameHook = fn
 }
 
 // HandleMessage implements endpoint.MessageHandler for live endpoint traffic.
 func (s *N2kService) HandleMessage(message endpoint.Message) {
-	if frame, ok := message.(*can.Frame); ok {
+	now := s.clock.Now()
+	bus, received := unwrapBusMessage(message)
+	if frame, ok := received.(*can.Frame); ok {
 		if s.receivedCANFrameHook != nil {
 			s.receivedCANFrameHook(frame)
 		}
+		if s.busStats != nil {
+			s.busStats.observeFrame(bus, frame, now)
+		}
 	}
 
+	shard := s.decodeShard(bus, received)
 	message = cloneMessage(message)
 	queued := queuedMessage{
 		message:    message,
-		enqueuedAt: time.Now(),
+		enqueuedAt: now,
 	}
 
 	s.processorMu.Lock()
 	if s.processorCancel == nil {
 		s.processorMu.Unlock()
-		s.processMessage(message)
+		s.processMessage(shard, message, queued.enqueuedAt)
+		releaseMessage(message)
 		return
 	}
+	// a message is rejected when the shard it would wait on is behind; other sources are
+	// still accepted by their shards
 	s.messageQueueWG.Add(1)
-	accepted, queueStats := s.messageQueue.enqueueIfCurrent(
+	s.messageQueuePending.Add(1)
+	accepted, queueStats := shard.queue.enqueueIfCurrent(
 		queued,
 		s.messageQueueMaxAge,
-		s.messageQueueProcessingAge(queued.enqueuedAt),
+		shard.processingAge(queued.enqueuedAt),
 	)
 	if !accepted {
+		releaseMessage(message)
+		s.messageQueuePending.Add(-1)
 		s.messageQueueWG.Done()
 		s.messageQueueDropped.Add(1)
 		s.messageQueueBacklogDropped.Add(1)
@@ -236,20 +541,39 @@ func (s *N2kService) HandleMessage(message endpoint.Message) {
 	}
 }
 
-func (s *N2kService) processMessage(message endpoint.Message) {
+// processMessage decodes a message the endpoint passed on at received.
+func (s *N2kService) processMessage(shard *decodeShard, message endpoint.Message, received time.Time) {
+	bus, message := unwrapBusMessage(message)
 	pgnNum, hasPGN := messagePGN(message)
-	start := time.Now()
-	s.adapter.HandleMessage(message)
-	s.processingMetrics.observeFrame(pgnNum, hasPGN, time.Since(start))
+	start := s.clock.Now()
+	s.handleFrame(s.busAdapter(shard, bus), bus, message, received)
+	s.processingMetrics.observeFrame(pgnNum, hasPGN, s.clock.Now().Sub(start))
 }
 
+// framePool holds the frame copies queued for the message processor. A frame is returned
+// once processed; packets refer to its data only while they are being handled.
+var framePool = sync.Pool{New: func() any { return new(can.Frame) }}
+
 func cloneMessage(message endpoint.Message) endpoint.Message {
+	if busMessage, ok := message.(endpoint.BusMessage); ok {
+		busMessage.Message = cloneMessage(busMessage.Message)
+		return busMessage
+	}
 	frame, ok := message.(*can.Frame)
 	if !ok || frame == nil {
 		return message
 	}
-	frameCopy := *frame
-	return &frameCopy
+	frameCopy := framePool.Get().(*can.Frame)
+	*frameCopy = *frame
+	return frameCopy
+}
+
+// releaseMessage returns a message from cloneMessage to the pool.
+func releaseMessage(message endpoint.Message) {
+	_, message = unwrapBusMessage(message)
+	if frame, ok := message.(*can.Frame); ok && frame != nil {
+		framePool.Put(frame)
+	}
 }
 
 type queuedMessage struct {
@@ -386,16 +710,58 @@ func (q *messageQueue) signal() {
 //
 //nolint:gocritic // Why: canadapter.PacketHandler currently passes packets by value.
 func (s *N2kService) HandlePacket(packet pkt.Packet) {
-	start := time.Now()
-	s.packetStruct.HandlePacket(packet)
-	s.processingMetrics.observePacket(time.Since(start))
+	start := s.clock.Now()
+	if len(s.interceptors.packets) == 0 {
+		s.packetStruct.HandlePacket(packet)
+	} else {
+		s.interceptPacket(packet)
+	}
+	s.processingMetrics.observePacket(packet.Info.PGN, s.clock.Now().Sub(start))
+}
+
+// interceptPacket runs the inbound packet interceptors. It is kept out of HandlePacket
+// so that the closure does not move every packet to the heap.
+//
+//nolint:gocritic // Why: a copy keeps HandlePacket's packet on the stack.
+func (s *N2kService) interceptPacket(packet pkt.Packet) {
+	// the packet's data is reused once it has been handled, and interceptors may keep it
+	intercept := Packet{Info: packet.Info, Data: slices.Clone(packet.Data)}
+	interceptInbound(context.Background(), s, s.interceptors.packets, intercept, func(intercepted Packet) {
+		received := packet
+		received.Info = intercepted.Info
+		received.Data = intercepted.Data
+		s.packetStruct.HandlePacket(received)
+	})
 }
 
 // HandleStruct implements pkt.StructHandler and records subscriber fanout time.
 func (s *N2kService) HandleStruct(p any) {
-	start := time.Now()
+	if len(s.interceptors.structs) == 0 {
+		s.handleStruct(p)
+		return
+	}
+	interceptInbound(context.Background(), s, s.interceptors.structs, p, s.handleStruct)
+}
+
+func (s *N2kService) handleStruct(p any) {
+	start := s.clock.Now()
+	if claim, ok := p.(publicpgn.ISOAddressClaim); ok {
+		s.addresses.observe(&claim)
+	}
+	if s.latest != nil {
+		s.latest.update(p, start)
+	}
+	if s.streams != nil {
+		s.streams.observe(p, start)
+	}
+	s.requests.observe(p)
 	s.subscriber.HandleStruct(p)
-	s.processingMetrics.observeSubscriber(time.Since(start))
+	info, hasPGN := structInfo(p)
+	_, decodeFailed := p.(publicpgn.UnknownPGN)
+	if decodeFailed && s.busStats != nil {
+		s.busStats.observeError(info.Bus, info.SourceId, s.clock.Now())
+	}
+	s.processingMetrics.observeSubscriber(info.PGN, hasPGN, decodeFailed, s.clock.Now().Sub(start))
 }
 
 // ObserveCallback records individual subscriber callback time for backlog diagnostics.
@@ -403,14 +769,30 @@ func (s *N2kService) ObserveCallback(structName, callbackName string, duration t
 	s.processingMetrics.observeCallback(structName, callbackName, duration)
 }
 
-// CallbackStarted records which synchronous callback currently owns the serial handler.
-func (s *N2kService) CallbackStarted(structName, callbackName string) {
-	s.processingMetrics.callbackStarted(structName, callbackName, time.Now())
+// ObserveAsyncDelivery records how long a struct waited in an asynchronous subscriber's queue.
+func (s *N2kService) ObserveAsyncDelivery(structName, callbackName string, lag time.Duration) {
+	s.processingMetrics.observeAsyncDelivery(structName, callbackName, lag)
+}
+
+// ObserveAsyncDrop records a struct dropped by an asynchronous subscriber's overflow policy.
+func (s *N2kService) ObserveAsyncDrop(structName, callbackName string) {
+	s.processingMetrics.observeAsyncDrop(structName, callbackName)
+}
+
+// ObserveAsyncReplace records a queued struct replaced by a newer one under CoalesceLatest.
+func (s *N2kService) ObserveAsyncReplace(structName, callbackName string) {
+	s.processingMetrics.observeAsyncReplace(structName, callbackName)
 }
 
-// CallbackFinished clears the current synchronous callback diagnostic.
-func (s *N2kService) CallbackFinished() {
-	s.processingMetrics.callbackFinished()
+// CallbackStarted records a running subscriber callback and returns the ID that
+// CallbackFinished takes. Each decode worker runs its own callbacks, so several may run at once.
+func (s *N2kService) CallbackStarted(structName, callbackName string) uint64 {
+	return s.processingMetrics.callbackStarted(structName, callbackName, s.clock.Now())
+}
+
+// CallbackFinished clears a callback recorded by CallbackStarted.
+func (s *N2kService) CallbackFinished(id uint64) {
+	s.processingMetrics.callbackFinished(id)
 }
 
 // HandleReplayCANFrame feeds a captured CAN frame through a dedicated adapter into the
@@ -418,17 +800,79 @@ func (s *N2kService) CallbackFinished() {
 func (s *N2kService) HandleReplayCANFrame(frame *can.Frame) error {
 	if s.replayAdapter == nil {
 		s.replayAdapter = canadapter.NewCANAdapter(s.log)
+		s.replayAdapter.SetClock(s.clock)
 		s.replayAdapter.SetOutput(s)
 	}
-	s.replayAdapter.HandleMessage(frame)
+	s.handleFrame(s.replayAdapter, "", frame, s.clock.Now())
 	return nil
 }
 
 // Write sends a PGN struct to the bus
 func (s *N2kService) Write(pgnStruct any) error {
+	if len(s.interceptors.structs) == 0 {
+		return s.write(pgnStruct)
+	}
+	return runChain(context.Background(), Outbound, s.interceptors.structs, pgnStruct, s.write)
+}
+
+func (s *N2kService) write(pgnStruct any) error {
+	if err := s.validateWrite(pgnStruct); err != nil {
+		return err
+	}
 	return s.publisher.Write(pgnStruct)
 }
 
+// WriteTagged sends a PGN struct to the bus like Write, returning without waiting for
+// the endpoint, but checks it against the write policy as the caller of ctx. ctx does not
+// bound the write.
+func (s *N2kService) WriteTagged(ctx context.Context, pgnStruct any) error {
+	write := func(pgnStruct any) error {
+		if err := s.validateWrite(pgnStruct); err != nil {
+			return err
+		}
+		return s.publisher.WriteTagged(ctx, pgnStruct)
+	}
+	if len(s.interceptors.structs) == 0 {
+		return write(pgnStruct)
+	}
+	return runChain(ctx, Outbound, s.interceptors.structs, pgnStruct, write)
+}
+
+// WriteContext sends a PGN struct to the bus and waits until the endpoint has sent it or
+// ctx is done. Endpoint failures such as endpoint.ErrTxBufferFull and endpoint.ErrClosed
+// are returned, and a fast packet that was only partly sent returns an
+// *endpoint.PartialWriteError.
+func (s *N2kService) WriteContext(ctx context.Context, pgnStruct any) error {
+	if len(s.interceptors.structs) == 0 {
+		return s.writeContext(ctx, pgnStruct)
+	}
+	return runChain(ctx, Outbound, s.interceptors.structs, pgnStruct, func(pgnStruct any) error {
+		return s.writeContext(ctx, pgnStruct)
+	})
+}
+
+func (s *N2kService) writeContext(ctx context.Context, pgnStruct any) error {
+	if err := s.validateWrite(pgnStruct); err != nil {
+		return err
+	}
+	return s.publisher.WriteContext(ctx, pgnStruct)
+}
+
+// validateWrite rejects invalid structs when strict writes are enabled.
+func (s *N2kService) validateWrite(pgnStruct any) error {
+	if !s.strictWrites {
+		return nil
+	}
+	violations, err := pgn.Validate(pgnStruct)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return &publicpgn.ValidationError{PGNType: fmt.Sprintf("%T", pgnStruct), Violations: violations}
+	}
+	return nil
+}
+
 // Start begins processing messages from the endpoint
 func (s *N2kService) Start(ctx context.Context) error {
 	if err := ctx.Err(); err != nil {
@@ -508,10 +952,10 @@ func (s *N2kService) runEndpoint(run *serviceEndpointRun) {
 	s.finishEndpointRun(run, result)
 
 	if err != nil && !expectedStop {
-		s.log.WithError(err).Error("N2K endpoint stopped unexpectedly")
+		s.log.Error("N2K endpoint stopped unexpectedly", logging.Error(err))
 	}
 	if closeErr != nil && !expectedStop {
-		s.log.WithError(closeErr).Warn("Failed to close stopped N2K endpoint")
+		s.log.Warn("Failed to close stopped N2K endpoint", logging.Error(closeErr))
 	}
 }
 
@@ -523,7 +967,7 @@ func (s *N2kService) closeEndpointOnContext(run *serviceEndpointRun) {
 		s.lifecycleMu.Unlock()
 		run.output.deactivate()
 		if err := run.closeEndpoint(); err != nil {
-			s.log.WithError(err).Warn("Failed to close canceled N2K endpoint")
+			s.log.Warn("Failed to close canceled N2K endpoint", logging.Error(err))
 		}
 	case <-run.done:
 	}
@@ -597,9 +1041,9 @@ func (s *N2kService) UpdateEndpoint(ep endpoint.Endpoint) error {
 	s.endpointOutput = output
 	s.lastRun = nil
 	s.lifecycleMu.Unlock()
-	s.adapter.SetWriter(ep)
+	s.setWriter(ep)
 	if closeErr != nil {
-		s.log.WithError(closeErr).Warn("Replaced N2K endpoint after its close returned an error")
+		s.log.Warn("Replaced N2K endpoint after its close returned an error", logging.Error(closeErr))
 	}
 
 	return nil
@@ -676,6 +1120,10 @@ func (s *N2kService) startMessageProcessor(ctx context.Context) {
 	s.processorDone = done
 
 	go s.runMessageProcessor(processorCtx, done)
+	if s.streams != nil {
+		go s.streams.run(processorCtx, s.clock)
+	}
+	s.scheduler.Resume()
 }
 
 func (s *N2kService) stopMessageProcessor() {
@@ -688,6 +1136,7 @@ func (s *N2kService) stopMessageProcessor() {
 		cancel()
 	}
 	s.processorMu.Unlock()
+	s.scheduler.Pause()
 
 	if done != nil {
 		<-done
@@ -704,38 +1153,30 @@ func (s *N2kService) runMessageProcessor(ctx context.Context, done chan<- struct
 		s.processorMu.Unlock()
 		close(done)
 	}()
-	ticker := time.NewTicker(messageQueueLogInterval)
-	defer ticker.Stop()
-
-	for {
-		queued, ok := s.messageQueue.dequeue(ctx)
-		if !ok {
-			s.discardQueuedMessages()
-			return
-		}
-		s.processQueuedMessage(queued)
-		s.messageQueueWG.Done()
 
-		select {
-		case <-ticker.C:
-			s.maybeLogMessageQueueBacklog()
-		default:
-		}
+	var workers sync.WaitGroup
+	for _, shard := range s.shards[1:] {
+		workers.Go(func() { s.runDecodeShard(ctx, shard) })
 	}
+	s.runDecodeShard(ctx, s.shards[0])
+	workers.Wait()
 }
 
-func (s *N2kService) discardQueuedMessages() {
-	count := s.messageQueue.discard()
+func (s *N2kService) discardQueuedMessages(shard *decodeShard) {
+	count := shard.queue.discard()
+	s.messageQueuePending.Add(-int64(count))
 	for i := 0; i < count; i++ {
 		s.messageQueueWG.Done()
 	}
 }
 
-func (s *N2kService) processQueuedMessage(queued queuedMessage) {
-	s.messageQueueProcessingNano.Store(queued.enqueuedAt.UnixNano())
-	defer s.messageQueueProcessingNano.Store(0)
+func (s *N2kService) processQueuedMessage(shard *decodeShard, queued queuedMessage) {
+	shard.processingNano.Store(queued.enqueuedAt.UnixNano())
+	defer shard.processingNano.Store(0)
+
+	defer releaseMessage(queued.message)
 
-	queueWait := time.Since(queued.enqueuedAt)
+	queueWait := s.clock.Now().Sub(queued.enqueuedAt)
 	s.processingMetrics.observeQueueWait(queueWait)
 	if queueWait > s.messageQueueMaxAge {
 		s.messageQueueDropped.Add(1)
@@ -743,7 +1184,7 @@ func (s *N2kService) processQueuedMessage(queued queuedMessage) {
 		s.maybeLogMessageQueueBacklog()
 		return
 	}
-	s.processMessage(queued.message)
+	s.processMessage(shard, queued.message, queued.enqueuedAt)
 }
 
 func (s *N2kService) waitForMessageQueueDrain(ctx context.Context) error {
@@ -766,7 +1207,7 @@ func (s *N2kService) maybeLogMessageQueueBacklog() {
 		return
 	}
 
-	now := time.Now()
+	now := s.clock.Now()
 	s.queueLogMu.Lock()
 	if !s.queueLastLog.IsZero() && now.Sub(s.queueLastLog) < messageQueueLogInterval {
 		s.queueLogMu.Unlock()
@@ -790,7 +1231,7 @@ func (s *N2kService) maybeLogMessageQueueBacklog() {
 	s.queueLastStaleDroppedLog = staleDroppedTotal
 	s.queueLogMu.Unlock()
 
-	fields := logrus.Fields{
+	fields := map[string]any{
 		"queueDepth":             queueStats.depth,
 		"queueLag":               queueStats.lag.String(),
 		"queueMaxAge":            s.messageQueueMaxAge.String(),
@@ -806,13 +1247,33 @@ func (s *N2kService) maybeLogMessageQueueBacklog() {
 	}
 	metricsSnapshot := s.processingMetrics.snapshot(now)
 	metricsSnapshot.addFields(fields)
-	s.log.WithFields(fields).Warn("N2K handler queue is falling behind")
+	s.log.Warn("N2K handler queue is falling behind", sortedFields(fields)...)
 }
 
 // MessageQueueLag returns the current age of the oldest live CAN message waiting
-// in or moving through the serial handler path.
+// in or moving through the handler path, across all decode workers.
 func (s *N2kService) MessageQueueLag() time.Duration {
-	return s.messageQueueStats(time.Now()).lag
+	return s.messageQueueStats(s.clock.Now()).lag
+}
+
+// TransmitQueueStats describes the CAN adapter's transmit queue.
+type TransmitQueueStats = canadapter.TransmitQueueStats
+
+// TransmitQueueStats returns the state of the CAN adapter's transmit queue.
+func (s *N2kService) TransmitQueueStats() TransmitQueueStats {
+	return s.adapter.TransmitQueueStats()
+}
+
+// Clock returns the clock the service is timed by.
+func (s *N2kService) Clock() clock.Clock {
+	return s.clock
+}
+
+// MessageQueuePending returns the number of received messages queued or being decoded.
+// A driver of a clock.Fake waits for it to reach zero before moving the clock on, so that
+// messages are decoded at the time they were received.
+func (s *N2kService) MessageQueuePending() int {
+	return int(s.messageQueuePending.Load())
 }
 
 // MessageQueueMaxAge returns the configured maximum tolerated live CAN message queue lag.
@@ -840,26 +1301,15 @@ type messageQueueSnapshot struct {
 }
 
 func (s *N2kService) messageQueueStats(now time.Time) messageQueueSnapshot {
-	queueStats := s.messageQueue.stats(now)
-	processingAge := s.messageQueueProcessingAge(now)
-	return messageQueueSnapshot{
-		depth:         queueStats.depth,
-		oldestAge:     queueStats.oldestAge,
-		processingAge: processingAge,
-		lag:           maxDuration(queueStats.oldestAge, processingAge),
-	}
-}
-
-func (s *N2kService) messageQueueProcessingAge(now time.Time) time.Duration {
-	processingNano := s.messageQueueProcessingNano.Load()
-	if processingNano == 0 {
-		return 0
+	var snapshot messageQueueSnapshot
+	for _, shard := range s.shards {
+		queueStats := shard.queue.stats(now)
+		snapshot.depth += queueStats.depth
+		snapshot.oldestAge = maxDuration(snapshot.oldestAge, queueStats.oldestAge)
+		snapshot.processingAge = maxDuration(snapshot.processingAge, shard.processingAge(now))
 	}
-	age := now.Sub(time.Unix(0, processingNano))
-	if age < 0 {
-		return 0
-	}
-	return age
+	snapshot.lag = maxDuration(snapshot.oldestAge, snapshot.processingAge)
+	return snapshot
 }
 
 func maxDuration(a, b time.Duration) time.Duration {
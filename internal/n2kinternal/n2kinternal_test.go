@@ -4,17 +4,27 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/multiendpoint"
+	"github.com/boatkit-io/n2k/pkg/endpoint/n2kfileendpoint"
 	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type queueTestEndpoint struct{}
@@ -158,7 +168,7 @@ func (*completedRunTestEndpoint) WriteFrame(can.Frame)              {}
 func TestStartReturnsEndpointStartupFailure(t *testing.T) {
 	wantErr := errors.New("startup failed")
 	ep := &startupTestEndpoint{startErr: wantErr, runCalled: make(chan struct{})}
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 
 	err := service.Start(context.Background())
 
@@ -171,7 +181,7 @@ func TestStartReturnsEndpointStartupFailure(t *testing.T) {
 }
 
 func TestWaitReturnsMostRecentEndpointResult(t *testing.T) {
-	service := NewN2kService(queueTestEndpoint{}, logrus.New())
+	service := NewN2kService(queueTestEndpoint{}, slog.Default())
 
 	assert.ErrorContains(t, service.Wait(context.Background()), "has not been started")
 	assert.NoError(t, service.Start(context.Background()))
@@ -182,7 +192,7 @@ func TestWaitReturnsMostRecentEndpointResult(t *testing.T) {
 
 func TestStartReturnsImmediatelyAfterSynchronousStartup(t *testing.T) {
 	ep := &startupTestEndpoint{runCalled: make(chan struct{})}
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -200,7 +210,7 @@ func TestStartReturnsImmediatelyAfterSynchronousStartup(t *testing.T) {
 
 func TestRepeatedStartDoesNotLaunchAnotherEndpointRun(t *testing.T) {
 	ep := newLifecycleTestEndpoint()
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	select {
@@ -221,7 +231,7 @@ func TestRepeatedStartDoesNotLaunchAnotherEndpointRun(t *testing.T) {
 func TestContextCancellationClosesEndpointThatDoesNotObserveContext(t *testing.T) {
 	ep := newLifecycleTestEndpoint()
 	ep.emitOnClose = true
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 	var received atomic.Int32
 	service.SetReceivedCANFrameHook(func(*can.Frame) {
 		received.Add(1)
@@ -246,7 +256,7 @@ func TestContextCancellationClosesEndpointThatDoesNotObserveContext(t *testing.T
 
 func TestStartRejectsRunThatIsAlreadyTearingDown(t *testing.T) {
 	ep := newTeardownTestEndpoint()
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	<-ep.runEntered
@@ -262,7 +272,7 @@ func TestStartRejectsRunThatIsAlreadyTearingDown(t *testing.T) {
 
 func TestStopDoesNotCloseCompletedEndpointGenerationTwice(t *testing.T) {
 	ep := &completedRunTestEndpoint{}
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	waitCtx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -275,7 +285,7 @@ func TestStopDoesNotCloseCompletedEndpointGenerationTwice(t *testing.T) {
 func TestStopBeforeRunIsScheduledDoesNotStartClosedEndpoint(t *testing.T) {
 	ep := newLifecycleTestEndpoint()
 	ep.startRelease = make(chan struct{})
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 	startErr := make(chan error, 1)
 	go func() {
 		startErr <- service.Start(context.Background())
@@ -301,7 +311,7 @@ func TestStopBeforeRunIsScheduledDoesNotStartClosedEndpoint(t *testing.T) {
 func TestStopRejectsEndpointMessagesBeforeWaitingForRun(t *testing.T) {
 	ep := newLifecycleTestEndpoint()
 	ep.emitOnClose = true
-	service := NewN2kService(ep, logrus.New())
+	service := NewN2kService(ep, slog.Default())
 	var received atomic.Int32
 	service.SetReceivedCANFrameHook(func(*can.Frame) {
 		received.Add(1)
@@ -316,7 +326,7 @@ func TestStopRejectsEndpointMessagesBeforeWaitingForRun(t *testing.T) {
 func TestUpdateEndpointWaitsForOldRunBeforeStartingReplacement(t *testing.T) {
 	oldEndpoint := newLifecycleTestEndpoint()
 	newEndpoint := newLifecycleTestEndpoint()
-	service := NewN2kService(oldEndpoint, logrus.New())
+	service := NewN2kService(oldEndpoint, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	<-oldEndpoint.runEntered
@@ -336,7 +346,7 @@ func TestUpdateEndpointReplacesEndpointAfterCloseError(t *testing.T) {
 	oldEndpoint := newLifecycleTestEndpoint()
 	oldEndpoint.closeErr = closeErr
 	newEndpoint := newLifecycleTestEndpoint()
-	service := NewN2kService(oldEndpoint, logrus.New())
+	service := NewN2kService(oldEndpoint, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	<-oldEndpoint.runEntered
@@ -353,7 +363,7 @@ func TestUpdateEndpointReplacesEndpointAfterCloseError(t *testing.T) {
 func TestRunHandleRemainsBoundToCapturedEndpointGeneration(t *testing.T) {
 	oldEndpoint := newLifecycleTestEndpoint()
 	newEndpoint := newLifecycleTestEndpoint()
-	service := NewN2kService(oldEndpoint, logrus.New())
+	service := NewN2kService(oldEndpoint, slog.Default())
 
 	assert.NoError(t, service.Start(context.Background()))
 	<-oldEndpoint.runEntered
@@ -376,16 +386,14 @@ func TestRunHandleRemainsBoundToCapturedEndpointGeneration(t *testing.T) {
 
 func TestHandleMessageLogsWhenHandlerQueueLagDrops(t *testing.T) {
 	var buf bytes.Buffer
-	log := logrus.New()
-	log.SetOutput(&buf)
-	log.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	log := slog.New(slog.NewTextHandler(&buf, nil))
 
 	service := NewN2kService(queueTestEndpoint{}, log, WithMessageQueueMaxAge(50*time.Millisecond))
 
 	_, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	service.processorCancel = cancel
-	service.messageQueueProcessingNano.Store(time.Now().Add(-100 * time.Millisecond).UnixNano())
+	service.shards[0].processingNano.Store(time.Now().Add(-100 * time.Millisecond).UnixNano())
 
 	frame := &can.Frame{
 		ID:     converter.CanIDFromData(publicpgn.PositionRapidUpdatePGN, 42, 3, 255),
@@ -403,8 +411,7 @@ func TestHandleMessageLogsWhenHandlerQueueLagDrops(t *testing.T) {
 }
 
 func TestProcessQueuedMessageDropsStaleMessage(t *testing.T) {
-	log := logrus.New()
-	log.SetOutput(&bytes.Buffer{})
+	log := slog.New(slog.DiscardHandler)
 
 	service := NewN2kService(queueTestEndpoint{}, log, WithMessageQueueMaxAge(50*time.Millisecond))
 	frame := &can.Frame{
@@ -412,7 +419,7 @@ func TestProcessQueuedMessageDropsStaleMessage(t *testing.T) {
 		Length: 8,
 	}
 
-	service.processQueuedMessage(queuedMessage{
+	service.processQueuedMessage(service.shards[0], queuedMessage{
 		message:    frame,
 		enqueuedAt: time.Now().Add(-100 * time.Millisecond),
 	})
@@ -422,33 +429,962 @@ func TestProcessQueuedMessageDropsStaleMessage(t *testing.T) {
 }
 
 func TestMessageQueueLagIncludesProcessingMessage(t *testing.T) {
-	service := NewN2kService(queueTestEndpoint{}, logrus.New(), WithMessageQueueMaxAge(time.Second))
-	service.messageQueueProcessingNano.Store(time.Now().Add(-300 * time.Millisecond).UnixNano())
+	service := NewN2kService(queueTestEndpoint{}, slog.Default(), WithMessageQueueMaxAge(time.Second))
+	service.shards[0].processingNano.Store(time.Now().Add(-300 * time.Millisecond).UnixNano())
 
 	assert.GreaterOrEqual(t, service.MessageQueueLag(), 250*time.Millisecond)
 	assert.Equal(t, time.Second, service.MessageQueueMaxAge())
 }
 
 func TestOutboundQueueLagDelegatesToEndpointReporter(t *testing.T) {
-	service := NewN2kService(lagTestEndpoint{lag: 1500 * time.Millisecond}, logrus.New())
+	service := NewN2kService(lagTestEndpoint{lag: 1500 * time.Millisecond}, slog.Default())
 
 	assert.Equal(t, 1500*time.Millisecond, service.OutboundQueueLag())
 }
 
 func TestProcessingMetricsReportsInFlightSubscriberCallback(t *testing.T) {
-	metrics := newProcessingMetrics()
+	metrics := newProcessingMetrics(time.Now())
 	started := time.Unix(100, 0)
-	metrics.callbackStarted("ISORequest", "node.handleIsoRequest", started)
+	id := metrics.callbackStarted("ISORequest", "node.handleIsoRequest", started)
 
-	fields := logrus.Fields{}
+	fields := map[string]any{}
 	snapshot := metrics.snapshot(started.Add(2 * time.Second))
 	snapshot.addFields(fields)
 	assert.Equal(t, "ISORequest/node.handleIsoRequest", fields["subscriberCallbackInFlight"])
 	assert.Equal(t, "2s", fields["subscriberCallbackInFlightAge"])
 
-	metrics.callbackFinished()
-	fields = logrus.Fields{}
+	metrics.callbackFinished(id)
+	fields = map[string]any{}
 	snapshot = metrics.snapshot(started.Add(3 * time.Second))
 	snapshot.addFields(fields)
 	assert.NotContains(t, fields, "subscriberCallbackInFlight")
 }
+
+func TestProcessingMetricsTracksConcurrentCallbacks(t *testing.T) {
+	metrics := newProcessingMetrics(time.Now())
+	started := time.Unix(100, 0)
+	slow := metrics.callbackStarted("ISORequest", "node.handleIsoRequest", started)
+	fast := metrics.callbackStarted("VesselHeading", "main.heading", started.Add(time.Second))
+
+	// a callback finishing on another worker leaves the slow one reported
+	metrics.callbackFinished(fast)
+	fields := map[string]any{}
+	snapshot := metrics.snapshot(started.Add(3 * time.Second))
+	snapshot.addFields(fields)
+	assert.Equal(t, 1, fields["subscriberCallbacksInFlight"])
+	assert.Equal(t, "ISORequest/node.handleIsoRequest", fields["subscriberCallbackInFlight"])
+	assert.Equal(t, "3s", fields["subscriberCallbackInFlightAge"])
+
+	again := metrics.callbackStarted("VesselHeading", "main.heading", started.Add(4*time.Second))
+	fields = map[string]any{}
+	snapshot = metrics.snapshot(started.Add(5 * time.Second))
+	snapshot.addFields(fields)
+	assert.Equal(t, 2, fields["subscriberCallbacksInFlight"])
+	assert.Equal(t, "ISORequest/node.handleIsoRequest", fields["subscriberCallbackInFlight"])
+
+	metrics.callbackFinished(slow)
+	metrics.callbackFinished(again)
+	fields = map[string]any{}
+	snapshot = metrics.snapshot(started.Add(6 * time.Second))
+	snapshot.addFields(fields)
+	assert.NotContains(t, fields, "subscriberCallbackInFlight")
+}
+
+func TestProcessingMetricsReportsAsyncSubscribers(t *testing.T) {
+	metrics := newProcessingMetrics(time.Now())
+	started := time.Unix(100, 0)
+	metrics.observeAsyncDelivery("BatteryStatus", "main.storeBattery", 30*time.Millisecond)
+	metrics.observeAsyncDelivery("BatteryStatus", "main.storeBattery", 10*time.Millisecond)
+	metrics.observeAsyncDrop("BatteryStatus", "main.storeBattery")
+	metrics.observeAsyncReplace("BatteryStatus", "main.storeBattery")
+	metrics.observeAsyncReplace("BatteryStatus", "main.storeBattery")
+
+	fields := map[string]any{}
+	snapshot := metrics.snapshot(started)
+	snapshot.addFields(fields)
+	assert.Equal(t, uint64(2), fields["asyncSubscriberDeliveries"])
+	assert.Equal(t, uint64(1), fields["asyncSubscriberDropped"])
+	assert.Equal(t, uint64(2), fields["asyncSubscriberReplaced"])
+	assert.Equal(t, 30.0, fields["asyncSubscriberLagMaxMs"])
+	assert.Equal(t, "BatteryStatus/main.storeBattery count=2 dropped=1 replaced=2 avgLagMs=20.000 maxLagMs=30.000",
+		fields["topAsyncSubscribers"])
+
+	totals := Metrics{Drops: map[DropReason]uint64{}}
+	metrics.totals(&totals)
+	assert.Equal(t, []CallbackMetrics{{Struct: "BatteryStatus", Callback: "main.storeBattery", Dropped: 1, Replaced: 2}},
+		totals.Callbacks)
+	assert.Equal(t, uint64(1), totals.Drops[DropSubscriberOverflow])
+
+	fields = map[string]any{}
+	snapshot = metrics.snapshot(started.Add(time.Second))
+	snapshot.addFields(fields)
+	assert.NotContains(t, fields, "asyncSubscriberDeliveries")
+}
+
+type writeTestEndpoint struct {
+	queueTestEndpoint
+	frames []can.Frame
+}
+
+func (e *writeTestEndpoint) WriteFrame(frame can.Frame) { e.frames = append(e.frames, frame) }
+
+func TestStrictWritesRejectInvalidStruct(t *testing.T) {
+	heading := float32(7)
+	msg := publicpgn.VesselHeading{Heading: &heading}
+
+	lenient := &writeTestEndpoint{}
+	assert.NoError(t, NewN2kService(lenient, slog.Default()).Write(msg))
+	assert.Len(t, lenient.frames, 1)
+
+	strict := &writeTestEndpoint{}
+	err := NewN2kService(strict, slog.Default(), WithStrictWrites()).Write(msg)
+	var validationErr *publicpgn.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Len(t, validationErr.Violations, 1)
+	assert.Equal(t, "Heading", validationErr.Violations[0].Field)
+	assert.Empty(t, strict.frames)
+
+	heading = 1
+	assert.NoError(t, NewN2kService(strict, slog.Default(), WithStrictWrites()).Write(msg))
+	assert.Len(t, strict.frames, 1)
+}
+
+type contextWriteTestEndpoint struct {
+	writeTestEndpoint
+	err error
+}
+
+func (e *contextWriteTestEndpoint) WriteFrameContext(_ context.Context, frame can.Frame) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.frames = append(e.frames, frame)
+	return nil
+}
+
+func TestWriteContextReturnsEndpointErrors(t *testing.T) {
+	heading := float32(1)
+	msg := publicpgn.VesselHeading{Heading: &heading}
+
+	ep := &contextWriteTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(), WithStrictWrites())
+	require.NoError(t, s.WriteContext(context.Background(), msg))
+	assert.Len(t, ep.frames, 1)
+
+	ep.err = endpoint.ErrTxBufferFull
+	assert.ErrorIs(t, s.WriteContext(context.Background(), msg), endpoint.ErrTxBufferFull)
+	assert.NoError(t, s.Write(msg), "Write does not report endpoint errors")
+
+	heading = 7
+	var validationErr *publicpgn.ValidationError
+	assert.ErrorAs(t, s.WriteContext(context.Background(), msg), &validationErr)
+
+	heading = 1
+	plain := &writeTestEndpoint{}
+	assert.NoError(t, NewN2kService(plain, slog.Default()).WriteContext(context.Background(), msg))
+	assert.Len(t, plain.frames, 1)
+}
+
+func TestTypedSubscribeUsesGeneratedTypeIndex(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+
+	var headings []publicpgn.VesselHeading
+	sub, err := Subscribe(s, func(msg publicpgn.VesselHeading) { headings = append(headings, msg) })
+	assert.NoError(t, err)
+	var unknown []publicpgn.UnknownPGN
+	_, err = Subscribe(s, func(msg publicpgn.UnknownPGN) { unknown = append(unknown, msg) })
+	assert.NoError(t, err)
+
+	heading := float32(1)
+	s.HandleStruct(publicpgn.VesselHeading{Heading: &heading})
+	s.HandleStruct(publicpgn.UnknownPGN{})
+	s.HandleStruct(publicpgn.WindData{})
+	assert.Len(t, headings, 1)
+	assert.Equal(t, heading, *headings[0].Heading)
+	assert.Len(t, unknown, 1)
+
+	assert.NoError(t, sub.Unsubscribe())
+	s.HandleStruct(publicpgn.VesselHeading{Heading: &heading})
+	assert.Len(t, headings, 1)
+}
+
+func TestTypedSubscribeFilters(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+
+	var fromSource, toDestination, forInstance []publicpgn.BatteryStatus
+	_, err := Subscribe(s, func(msg publicpgn.BatteryStatus) { fromSource = append(fromSource, msg) }, FromSource(10, 11))
+	assert.NoError(t, err)
+	_, err = Subscribe(s, func(msg publicpgn.BatteryStatus) { toDestination = append(toDestination, msg) }, ToDestination(20))
+	assert.NoError(t, err)
+	_, err = Subscribe(s, func(msg publicpgn.BatteryStatus) { forInstance = append(forInstance, msg) },
+		ForInstance(2), FromSource(10))
+	assert.NoError(t, err)
+
+	one, two := uint8(1), uint8(2)
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 10, TargetId: 255}, Instance: &one})
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 11, TargetId: 20}, Instance: &two})
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 10, TargetId: 255}, Instance: &two})
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 10, TargetId: 255}})
+	assert.Len(t, fromSource, 4)
+	assert.Len(t, toDestination, 1)
+	assert.Len(t, forInstance, 1)
+	assert.Equal(t, uint8(10), forInstance[0].Info.SourceId)
+
+	var engines []publicpgn.EngineParametersRapidUpdate
+	_, err = Subscribe(s, func(msg publicpgn.EngineParametersRapidUpdate) { engines = append(engines, msg) }, ForInstance(1))
+	assert.NoError(t, err)
+	s.HandleStruct(publicpgn.EngineParametersRapidUpdate{Instance: 0})
+	s.HandleStruct(publicpgn.EngineParametersRapidUpdate{Instance: 1})
+	assert.Len(t, engines, 1)
+}
+
+func TestTypedSubscribeDeviceFilterFollowsAddressChanges(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+
+	unique := uint32(1234)
+	claim := publicpgn.ISOAddressClaim{
+		Info:             publicpgn.MessageInfo{SourceId: 30},
+		UniqueNumber:     &unique,
+		ManufacturerCode: 135,
+	}
+	name := publicpgn.NameFromAddressClaim(&claim)
+
+	var headings []publicpgn.VesselHeading
+	_, err := Subscribe(s, func(msg publicpgn.VesselHeading) { headings = append(headings, msg) }, FromDevice(name))
+	assert.NoError(t, err)
+
+	// Nothing is delivered before the device's claim is seen
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 30}})
+	assert.Empty(t, headings)
+
+	s.HandleStruct(claim)
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 30}})
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 31}})
+	assert.Len(t, headings, 1)
+
+	// The device moves to a new address
+	claim.Info.SourceId = 31
+	s.HandleStruct(claim)
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 30}})
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 31}})
+	assert.Len(t, headings, 2)
+	assert.Equal(t, uint8(31), headings[1].Info.SourceId)
+
+	// The device loses its address
+	claim.Info.SourceId = 254
+	s.HandleStruct(claim)
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 31}})
+	assert.Len(t, headings, 2)
+}
+
+func TestAsyncSubscribeDeliversOnSubscriberGoroutine(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+
+	got := make(chan publicpgn.BatteryStatus, 1)
+	_, err := Subscribe(s, func(msg publicpgn.BatteryStatus) { got <- msg },
+		WithAsyncDelivery(4, CoalesceLatest), FromSource(10))
+	assert.NoError(t, err)
+
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 11}})
+	s.HandleStruct(publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{SourceId: 10}})
+	select {
+	case msg := <-got:
+		assert.Equal(t, uint8(10), msg.Info.SourceId)
+	case <-time.After(time.Second):
+		t.Fatal("async subscriber was not called")
+	}
+}
+
+func TestTypedSubscribeDeadband(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+
+	var headings []*float32
+	_, err := Subscribe(s, func(msg publicpgn.VesselHeading) { headings = append(headings, msg.Heading) },
+		WithDeadband("Heading", 0.1))
+	assert.NoError(t, err)
+
+	for _, heading := range []float32{1.0, 1.05, 1.2, 1.15, 0.9} {
+		s.HandleStruct(publicpgn.VesselHeading{Heading: &heading})
+	}
+	assert.Len(t, headings, 3)
+	assert.Equal(t, float32(1.2), *headings[1])
+	assert.Equal(t, float32(0.9), *headings[2])
+
+	// A value becoming unavailable is a change
+	s.HandleStruct(publicpgn.VesselHeading{})
+	s.HandleStruct(publicpgn.VesselHeading{})
+	assert.Len(t, headings, 4)
+	assert.Nil(t, headings[3])
+
+	_, err = Subscribe(s, func(publicpgn.VesselHeading) {}, WithDeadband("Missing", 1))
+	assert.Error(t, err)
+	_, err = Subscribe(s, func(publicpgn.VesselHeading) {}, WithDeadband("Info", 1))
+	assert.Error(t, err)
+}
+
+func TestLatestCache(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default(), WithLatestCache(3))
+
+	_, _, ok := Latest[publicpgn.BatteryStatus](s)
+	assert.False(t, ok)
+
+	start := time.Unix(100, 0)
+	battery := func(source, instance uint8, voltage float32, received time.Time) publicpgn.BatteryStatus {
+		return publicpgn.BatteryStatus{
+			Info:     publicpgn.MessageInfo{SourceId: source, Timestamp: received},
+			Instance: &instance,
+			Voltage:  &voltage,
+		}
+	}
+	s.HandleStruct(battery(10, 1, 12.5, start))
+	s.HandleStruct(battery(10, 2, 13.0, start.Add(time.Second)))
+	s.HandleStruct(battery(10, 1, 12.6, start.Add(2*time.Second)))
+
+	msg, received, ok := Latest[publicpgn.BatteryStatus](s, ForInstance(1))
+	assert.True(t, ok)
+	assert.Equal(t, float32(12.6), *msg.Voltage)
+	assert.Equal(t, start.Add(2*time.Second), received)
+
+	msg, _, ok = Latest[publicpgn.BatteryStatus](s)
+	assert.True(t, ok)
+	assert.Equal(t, uint8(1), *msg.Instance)
+
+	_, _, ok = Latest[publicpgn.BatteryStatus](s, FromSource(11))
+	assert.False(t, ok)
+
+	// Unknown PGNs are not cached
+	s.HandleStruct(publicpgn.UnknownPGN{})
+	assert.Len(t, s.LatestValues(), 2)
+
+	// The least recently updated entry is evicted when full
+	heading := float32(1)
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 5}, Heading: &heading})
+	s.HandleStruct(battery(11, 1, 12.0, start.Add(3*time.Second)))
+	values := s.LatestValues()
+	assert.Len(t, values, 3)
+	assert.IsType(t, publicpgn.BatteryStatus{}, values[0].Value)
+	assert.Equal(t, uint8(10), values[0].Source)
+	assert.Equal(t, uint8(1), values[0].Instance)
+	assert.Equal(t, uint8(11), values[1].Source)
+	assert.IsType(t, publicpgn.VesselHeading{}, values[2].Value)
+	assert.False(t, values[2].HasInstance)
+}
+
+func TestLatestWithoutCache(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+	s.HandleStruct(publicpgn.VesselHeading{})
+
+	_, _, ok := Latest[publicpgn.VesselHeading](s)
+	assert.False(t, ok)
+	assert.Nil(t, s.LatestValues())
+}
+
+func TestLatestCacheConcurrentAccess(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default(), WithLatestCache(8))
+
+	var wg sync.WaitGroup
+	for writer := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				instance := uint8(i % 4)
+				s.HandleStruct(publicpgn.BatteryStatus{
+					Info:     publicpgn.MessageInfo{SourceId: uint8(writer)},
+					Instance: &instance,
+				})
+			}
+		}()
+	}
+	for range 200 {
+		_, _, _ = Latest[publicpgn.BatteryStatus](s, ForInstance(1))
+		assert.LessOrEqual(t, len(s.LatestValues()), 8)
+	}
+	wg.Wait()
+	assert.Len(t, s.LatestValues(), 8)
+}
+
+func TestStreamTrackerReportsStaleAndResumedStreams(t *testing.T) {
+	tracker := newStreamTracker(0, map[uint32]time.Duration{127250: 100 * time.Millisecond})
+	var events []StreamEvent
+	id := tracker.subscribe(func(event StreamEvent) { events = append(events, event) })
+
+	start := time.Unix(100, 0)
+	battery := func(instance uint8) publicpgn.BatteryStatus {
+		return publicpgn.BatteryStatus{Info: publicpgn.MessageInfo{PGN: 127508, SourceId: 10}, Instance: &instance}
+	}
+	for i := 0; i < 5; i++ {
+		tracker.observe(battery(1), start.Add(time.Duration(i)*time.Second))
+	}
+	// A stream seen once has no interval and never goes stale
+	tracker.observe(battery(2), start)
+	// A configured interval applies from the first message
+	tracker.observe(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{PGN: 127250, SourceId: 5}}, start.Add(4*time.Second))
+	tracker.observe(publicpgn.UnknownPGN{Info: publicpgn.MessageInfo{PGN: 65280, SourceId: 7}}, start)
+
+	streams := tracker.snapshot()
+	require.Len(t, streams, 3)
+	assert.Equal(t, StreamKey{PGN: 127250, Source: 5}, streams[0].StreamKey)
+	assert.Equal(t, StreamKey{PGN: 127508, Source: 10, Instance: 1, HasInstance: true}, streams[1].StreamKey)
+	assert.Equal(t, time.Second, streams[1].Interval)
+	assert.Equal(t, time.Duration(0), streams[2].Interval)
+
+	tracker.check(start.Add(6 * time.Second))
+	require.Len(t, events, 1)
+	assert.Equal(t, StreamStale, events[0].Kind)
+	assert.Equal(t, uint32(127250), events[0].Stream.PGN)
+	assert.Equal(t, 2*time.Second, events[0].Gap)
+
+	tracker.check(start.Add(8 * time.Second))
+	require.Len(t, events, 2)
+	assert.Equal(t, StreamStale, events[1].Kind)
+	assert.Equal(t, uint32(127508), events[1].Stream.PGN)
+	assert.True(t, events[1].Stream.Stale)
+
+	// Stale streams are reported once
+	tracker.check(start.Add(20 * time.Second))
+	assert.Len(t, events, 2)
+
+	tracker.observe(battery(1), start.Add(24*time.Second))
+	require.Len(t, events, 3)
+	assert.Equal(t, StreamResumed, events[2].Kind)
+	assert.Equal(t, 20*time.Second, events[2].Gap)
+	assert.False(t, events[2].Stream.Stale)
+	// The outage does not change the learned interval
+	assert.Equal(t, time.Second, tracker.snapshot()[1].Interval)
+
+	require.NoError(t, tracker.unsubscribe(id))
+	assert.Error(t, tracker.unsubscribe(id))
+}
+
+func TestStreamTrackerExpiresSilentStreams(t *testing.T) {
+	tracker := newStreamTracker(0, nil)
+	var events []StreamEvent
+	tracker.subscribe(func(event StreamEvent) { events = append(events, event) })
+
+	start := time.Unix(100, 0)
+	heading := func(source uint8) publicpgn.VesselHeading {
+		return publicpgn.VesselHeading{Info: publicpgn.MessageInfo{PGN: 127250, SourceId: source}}
+	}
+	// a slow stream, whose expiry is a multiple of its interval
+	for i := 0; i < 5; i++ {
+		tracker.observe(heading(1), start.Add(time.Duration(i)*10*time.Second))
+	}
+	// a stream seen once, which never goes stale
+	tracker.observe(heading(2), start)
+	last := start.Add(40 * time.Second)
+
+	tracker.check(last.Add(time.Minute))
+	require.Len(t, events, 1)
+	assert.Equal(t, StreamStale, events[0].Kind)
+
+	// the unlearned stream expires after the minimum, silently since it was never stale
+	tracker.check(start.Add(streamExpiryMinimum + time.Second))
+	assert.Len(t, events, 1)
+	require.Len(t, tracker.snapshot(), 1)
+	assert.Equal(t, uint8(1), tracker.snapshot()[0].Source)
+
+	tracker.check(last.Add(streamExpiryFactor*10*time.Second + time.Second))
+	require.Len(t, events, 2)
+	assert.Equal(t, StreamExpired, events[1].Kind)
+	assert.Equal(t, uint8(1), events[1].Stream.Source)
+	assert.Equal(t, streamExpiryFactor*10*time.Second+time.Second, events[1].Gap)
+	assert.Empty(t, tracker.snapshot())
+
+	// an expired stream that comes back is new, so it is not reported resumed
+	tracker.observe(heading(1), last.Add(time.Hour))
+	assert.Len(t, events, 2)
+	assert.Len(t, tracker.snapshot(), 1)
+}
+
+func TestStreamTrackingServiceAPI(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+	_, err := s.SubscribeToStreamEvents(func(StreamEvent) {})
+	assert.Error(t, err)
+	assert.Nil(t, s.Streams())
+
+	s = NewN2kService(&writeTestEndpoint{}, slog.Default(), WithStreamTracking(2))
+	_, err = s.SubscribeToStreamEvents(nil)
+	assert.Error(t, err)
+	id, err := s.SubscribeToStreamEvents(func(StreamEvent) {})
+	require.NoError(t, err)
+
+	heading := float32(1)
+	s.HandleStruct(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{PGN: 127250, SourceId: 5}, Heading: &heading})
+	streams := s.Streams()
+	require.Len(t, streams, 1)
+	assert.Equal(t, uint32(127250), streams[0].PGN)
+	assert.Equal(t, 2.0, s.streams.staleFactor)
+
+	require.NoError(t, s.UnsubscribeStreamEvents(id))
+}
+
+// answerRequest delivers structs once a request is outstanding.
+func answerRequest(s *N2kService, structs ...any) {
+	go func() {
+		for s.requests.count.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		for _, p := range structs {
+			s.HandleStruct(p)
+		}
+	}()
+}
+
+func TestRequestAddressed(t *testing.T) {
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default())
+	info := func(source uint8) publicpgn.MessageInfo {
+		return publicpgn.MessageInfo{PGN: publicpgn.ProductInformationPGN, SourceId: source, TargetId: 255}
+	}
+
+	answerRequest(s, publicpgn.ProductInformation{Info: info(0x24)}, publicpgn.ProductInformation{Info: info(0x23)})
+	responses, err := s.Request(context.Background(), publicpgn.ProductInformationPGN, 0x23)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, uint8(0x23), responses[0].(publicpgn.ProductInformation).Info.SourceId)
+	assert.Len(t, ep.frames, 1)
+	assert.Equal(t, int32(0), s.requests.count.Load())
+
+	requested := uint32(publicpgn.ProductInformationPGN)
+	answerRequest(s, publicpgn.ISOAcknowledgement{
+		Info:    publicpgn.MessageInfo{PGN: publicpgn.ISOAcknowledgementPGN, SourceId: 0x23, TargetId: 0},
+		Control: publicpgn.Nak,
+		PGN:     &requested,
+	})
+	_, err = s.Request(context.Background(), publicpgn.ProductInformationPGN, 0x23)
+	var requestErr *RequestError
+	require.ErrorAs(t, err, &requestErr)
+	assert.Equal(t, publicpgn.Nak, requestErr.Control)
+	assert.Equal(t, uint8(0x23), requestErr.Source)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = s.Request(ctx, publicpgn.ProductInformationPGN, 0x23)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = s.SendRequest(context.Background(), &publicpgn.ISORequest{})
+	assert.Error(t, err)
+}
+
+func TestSendRequestWritesWithContextAndLeavesRequestUnchanged(t *testing.T) {
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default())
+	requested := uint32(publicpgn.ProductInformationPGN)
+	request := &publicpgn.ISORequest{Info: publicpgn.MessageInfo{TargetId: 0x23}, PGN: &requested}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.SendRequest(ctx, request)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, ep.frames, "a done context stops the write")
+	assert.Equal(t, publicpgn.MessageInfo{TargetId: 0x23}, request.Info)
+}
+
+func TestRequestGlobalCollectsUntilDeadline(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default())
+	requested := uint32(publicpgn.ProductInformationPGN)
+	answerRequest(s,
+		publicpgn.ProductInformation{Info: publicpgn.MessageInfo{PGN: publicpgn.ProductInformationPGN, SourceId: 0x23}},
+		publicpgn.ISOAcknowledgement{Info: publicpgn.MessageInfo{SourceId: 0x25}, Control: publicpgn.Nak, PGN: &requested},
+		publicpgn.VesselHeading{Info: publicpgn.MessageInfo{PGN: 127250, SourceId: 0x23}},
+		publicpgn.ProductInformation{Info: publicpgn.MessageInfo{PGN: publicpgn.ProductInformationPGN, SourceId: 0x24}},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	responses, err := s.Request(ctx, publicpgn.ProductInformationPGN, 255)
+	require.NoError(t, err)
+	assert.Len(t, responses, 2)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.Request(canceled, publicpgn.ProductInformationPGN, 255)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+type scheduleRecorder struct {
+	mu      sync.Mutex
+	written []any
+}
+
+func (r *scheduleRecorder) write(p any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.written = append(r.written, p)
+	return nil
+}
+
+func (r *scheduleRecorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.written)
+}
+
+func TestSchedulerSendsPeriodically(t *testing.T) {
+	recorder := &scheduleRecorder{}
+	scheduler := NewScheduler(recorder.write, slog.Default())
+
+	_, err := scheduler.Schedule(127250, 0, func() any { return nil })
+	assert.Error(t, err)
+	_, err = scheduler.Schedule(127250, time.Second, nil)
+	assert.Error(t, err)
+
+	heading, err := scheduler.Schedule(127250, 10*time.Millisecond, func() any { return publicpgn.VesselHeading{} })
+	require.NoError(t, err)
+	skipped, err := scheduler.Schedule(127508, 10*time.Millisecond, func() any { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{127250, 127508}, scheduler.PGNs())
+	// Successive schedules start at different points in their interval
+	assert.NotEqual(t, heading.phase, skipped.phase)
+
+	assert.Eventually(t, func() bool { return recorder.count() >= 3 }, time.Second, time.Millisecond)
+
+	scheduler.Pause()
+	// Let a send that was already under way finish
+	time.Sleep(20 * time.Millisecond)
+	paused := recorder.count()
+	heading.Trigger()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, paused, recorder.count())
+
+	heading.SetInterval(time.Hour)
+	assert.Equal(t, time.Hour, heading.Interval())
+	assert.True(t, scheduler.SetInterval(127250, 0))
+	assert.Equal(t, 10*time.Millisecond, heading.Interval())
+	assert.False(t, scheduler.SetInterval(130306, time.Second))
+
+	heading.SetInterval(time.Hour)
+	scheduler.Resume()
+	assert.True(t, scheduler.Trigger(127250))
+	assert.Equal(t, paused+1, recorder.count())
+
+	heading.Stop()
+	skipped.Stop()
+	assert.Empty(t, scheduler.PGNs())
+	assert.False(t, scheduler.Trigger(127250))
+}
+
+func TestServiceSchedulerRunsWhileStarted(t *testing.T) {
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default())
+	scheduled, err := s.Schedule(127250, time.Millisecond, func() any { return publicpgn.VesselHeading{} })
+	require.NoError(t, err)
+	defer scheduled.Stop()
+	assert.True(t, s.scheduler.Paused())
+
+	s.startMessageProcessor(context.Background())
+	assert.False(t, s.scheduler.Paused())
+	s.stopMessageProcessor()
+	assert.True(t, s.scheduler.Paused())
+}
+
+func TestInterceptorsModifyDropAndInject(t *testing.T) {
+	var order []string
+	errBlocked := errors.New("blocked")
+	blocked := false
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(),
+		WithFrameInterceptor(func(_ context.Context, dir Direction, frame can.Frame, next func(can.Frame) error) error {
+			order = append(order, "frame "+dir.String())
+			if dir == Outbound && blocked {
+				return errBlocked
+			}
+			if dir == Inbound {
+				frame.ID = converter.CanIDFromData(publicpgn.VesselHeadingPGN, 9, 2, 255)
+			}
+			return next(frame)
+		}),
+		WithPacketInterceptor(func(_ context.Context, dir Direction, packet Packet, next func(Packet) error) error {
+			order = append(order, "packet "+dir.String())
+			return next(packet)
+		}),
+		WithStructInterceptor(func(_ context.Context, dir Direction, msg any, next func(any) error) error {
+			order = append(order, "drop "+dir.String())
+			if _, ok := msg.(publicpgn.WindData); ok {
+				return nil
+			}
+			return next(msg)
+		}),
+		WithStructInterceptor(func(_ context.Context, dir Direction, msg any, next func(any) error) error {
+			order = append(order, "duplicate "+dir.String())
+			if err := next(msg); err != nil {
+				return err
+			}
+			return next(msg)
+		}),
+	)
+
+	var headings []publicpgn.VesselHeading
+	_, err := Subscribe(s, func(msg publicpgn.VesselHeading) { headings = append(headings, msg) })
+	require.NoError(t, err)
+	var winds []publicpgn.WindData
+	_, err = Subscribe(s, func(msg publicpgn.WindData) { winds = append(winds, msg) })
+	require.NoError(t, err)
+
+	s.HandleMessage(&can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8})
+	require.Len(t, headings, 2)
+	assert.Equal(t, uint8(9), headings[0].Info.SourceId)
+	assert.Equal(t, []string{"frame inbound", "packet inbound", "drop inbound", "duplicate inbound"}, order)
+
+	s.HandleStruct(publicpgn.WindData{})
+	assert.Empty(t, winds)
+
+	order = nil
+	heading := float32(1)
+	require.NoError(t, s.Write(publicpgn.VesselHeading{Heading: &heading}))
+	assert.Len(t, ep.frames, 2)
+	assert.Equal(t, []string{
+		"drop outbound", "duplicate outbound",
+		"packet outbound", "frame outbound",
+		"packet outbound", "frame outbound",
+	}, order)
+
+	blocked = true
+	assert.ErrorIs(t, s.WriteContext(context.Background(), publicpgn.VesselHeading{Heading: &heading}), errBlocked)
+	assert.Len(t, ep.frames, 2)
+}
+
+func TestMetricsSnapshotAndPrometheusHandler(t *testing.T) {
+	s := NewN2kService(&writeTestEndpoint{}, slog.Default(), WithMessageQueueMaxAge(time.Second))
+	_, err := Subscribe(s, func(publicpgn.VesselHeading) {})
+	require.NoError(t, err)
+
+	s.HandleMessage(&can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8})
+	s.HandleMessage(&can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8})
+	s.HandleStruct(publicpgn.UnknownPGN{Info: publicpgn.MessageInfo{PGN: 65300}})
+	s.messageQueueBacklogDropped.Add(3)
+
+	metrics := s.Metrics()
+	assert.Equal(t, []PGNMetrics{
+		{PGN: 65300, Structs: 1, DecodeFailures: 1},
+		{PGN: publicpgn.VesselHeadingPGN, Frames: 2, Packets: 2, Structs: 2},
+	}, metrics.PGNs)
+	assert.Equal(t, uint64(1), metrics.DecodeFailures)
+	assert.Equal(t, uint64(3), metrics.Drops[DropBacklog])
+	assert.Equal(t, time.Second, metrics.QueueMaxAge)
+	require.Len(t, metrics.Callbacks, 1)
+	assert.Equal(t, "VesselHeading", metrics.Callbacks[0].Struct)
+	assert.Equal(t, uint64(2), metrics.Callbacks[0].Calls)
+
+	recorder := httptest.NewRecorder()
+	s.MetricsHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := recorder.Body.String()
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/plain; version=0.0.4")
+	assert.Contains(t, body, "# TYPE n2k_frames_total counter\n")
+	assert.Contains(t, body, "n2k_frames_total{pgn=\"127250\"} 2\n")
+	assert.Contains(t, body, "n2k_decode_failures_total{pgn=\"65300\"} 1\n")
+	assert.Contains(t, body, "n2k_dropped_total{reason=\"backlog\"} 3\n")
+	assert.Contains(t, body, "n2k_callback_duration_seconds_count{struct=\"VesselHeading\",callback=")
+	assert.Contains(t, body, "n2k_queue_max_age_seconds 1\n")
+}
+
+func TestPrometheusLabelsAreEscaped(t *testing.T) {
+	assert.Equal(t, `{a="x\"y\\z\n"}`, labels("a", "x\"y\\z\n"))
+}
+
+func TestCANFrameBits(t *testing.T) {
+	var crc canBits
+	for _, b := range []byte("123456789") {
+		crc.push(uint64(b), 8)
+	}
+	assert.Equal(t, uint16(0x059e), crc.crc, "CRC-15/CAN check value")
+
+	for _, frame := range []can.Frame{
+		{ID: 0, Length: 0},
+		{ID: 0, Length: 8},
+		{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8,
+			Data: [8]uint8{0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55}},
+		{ID: can.MaskIDEff, Length: 8, Data: [8]uint8{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	} {
+		unstuffed := 67 + 8*int(frame.Length)
+		bits := canFrameBits(&frame)
+		assert.GreaterOrEqual(t, bits, unstuffed)
+		assert.LessOrEqual(t, bits, unstuffed+(54+8*int(frame.Length)-1)/4)
+	}
+	zeros := can.Frame{ID: 0, Length: 8}
+	alternating := can.Frame{ID: 0x0aaaaaaa, Length: 8, Data: [8]uint8{0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55}}
+	assert.Greater(t, canFrameBits(&zeros), canFrameBits(&alternating))
+}
+
+func TestBusStatisticsRates(t *testing.T) {
+	start := time.Unix(1000, 0)
+	stats := newBusStats(0, 0, start)
+	heading := can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8}
+	wind := can.Frame{ID: converter.CanIDFromData(publicpgn.WindDataPGN, 7, 2, 255), Length: 8}
+	for i := range 20 {
+		stats.observeFrame(&heading, start.Add(time.Duration(i)*100*time.Millisecond))
+	}
+	stats.observeFrame(&wind, start.Add(time.Second))
+	stats.observeError(7, start.Add(time.Second))
+	stats.observeReassemblyFailure(7, start.Add(time.Second))
+
+	snapshot := stats.snapshot(start.Add(2 * time.Second))
+	assert.Equal(t, DefaultBusBitrate, snapshot.Bitrate)
+	assert.Equal(t, 2*time.Second, snapshot.Window)
+	assert.InDelta(t, 10.5, snapshot.FramesPerSecond, 0.001)
+	assert.InDelta(t, 84, snapshot.BytesPerSecond, 0.001)
+	bits := float64(20*canFrameBits(&heading) + canFrameBits(&wind))
+	assert.InDelta(t, bits/2/DefaultBusBitrate*100, snapshot.Utilization, 0.001)
+
+	require.Len(t, snapshot.Sources, 2)
+	assert.Equal(t, uint8(7), snapshot.Sources[0].Source)
+	assert.InDelta(t, 0.5, snapshot.Sources[0].FramesPerSecond, 0.001)
+	assert.InDelta(t, 0.5, snapshot.Sources[0].ErrorsPerSecond, 0.001)
+	assert.InDelta(t, 0.5, snapshot.Sources[0].ReassemblyFailuresPerSecond, 0.001)
+	assert.InDelta(t, 10, snapshot.Sources[1].FramesPerSecond, 0.001)
+	require.Len(t, snapshot.PGNs, 2)
+	assert.Equal(t, uint32(publicpgn.VesselHeadingPGN), snapshot.PGNs[0].PGN)
+
+	// Traffic older than the window is forgotten
+	snapshot = stats.snapshot(start.Add(30 * time.Second))
+	assert.Equal(t, DefaultBusStatisticsWindow, snapshot.Window)
+	assert.Zero(t, snapshot.FramesPerSecond)
+	assert.Empty(t, snapshot.Sources)
+}
+
+func TestBusStatisticsBeforeUnixEpoch(t *testing.T) {
+	// A fake clock starting at the zero time is long before 1970
+	start := time.Time{}
+	stats := newBusStats(0, 0, start)
+	heading := can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8}
+	for i := range 10 {
+		stats.observeFrame(&heading, start.Add(time.Duration(i)*100*time.Millisecond))
+	}
+	snapshot := stats.snapshot(start.Add(time.Second))
+	assert.InDelta(t, 10, snapshot.FramesPerSecond, 0.001)
+
+	// A clock set back before the stats were created still lands in a bucket
+	stats.observeFrame(&heading, start.Add(-1500*time.Millisecond))
+}
+
+func TestServiceBusStatisticsCountReceivedAndWrittenFrames(t *testing.T) {
+	_, ok := NewN2kService(&writeTestEndpoint{}, slog.Default()).BusStatistics()
+	assert.False(t, ok)
+
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(), WithBusStatistics(250000, time.Minute))
+	s.HandleMessage(&can.Frame{ID: converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255), Length: 8})
+	heading := float32(1)
+	require.NoError(t, s.Write(publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 3}, Heading: &heading}))
+	require.Len(t, ep.frames, 1)
+
+	stats, ok := s.BusStatistics()
+	require.True(t, ok)
+	require.Len(t, stats.Sources, 2)
+	assert.Equal(t, uint8(3), stats.Sources[0].Source)
+	assert.Equal(t, uint8(42), stats.Sources[1].Source)
+	assert.Greater(t, stats.Utilization, 0.0)
+}
+
+func TestMultipleBusesReassembleSeparatelyAndWriteToNamedBuses(t *testing.T) {
+	a, b := &writeTestEndpoint{}, &writeTestEndpoint{}
+	multi, err := multiendpoint.NewMultiEndpoint(slog.Default(),
+		multiendpoint.Bus{ID: "a", Endpoint: a}, multiendpoint.Bus{ID: "b", Endpoint: b})
+	require.NoError(t, err)
+	s := NewN2kService(multi, slog.Default(), WithBusStatistics(0, time.Minute))
+
+	var all, fromB []publicpgn.GNSSPositionData
+	_, err = Subscribe(s, func(msg publicpgn.GNSSPositionData) { all = append(all, msg) })
+	require.NoError(t, err)
+	_, err = Subscribe(s, func(msg publicpgn.GNSSPositionData) { fromB = append(fromB, msg) }, FromBus("b"))
+	require.NoError(t, err)
+
+	// fresh services start at the same sequence ID, so the buses' frames would mix if
+	// they shared a reassembler
+	fastFrames := func(sid uint8) []can.Frame {
+		ep := &writeTestEndpoint{}
+		require.NoError(t, NewN2kService(ep, slog.Default()).Write(publicpgn.GNSSPositionData{
+			Info: publicpgn.MessageInfo{SourceId: 7}, SID: &sid,
+		}))
+		require.Greater(t, len(ep.frames), 1)
+		return ep.frames
+	}
+	framesA, framesB := fastFrames(1), fastFrames(2)
+	for i := range framesA {
+		s.HandleMessage(endpoint.BusMessage{Bus: "a", Message: &framesA[i]})
+		s.HandleMessage(endpoint.BusMessage{Bus: "b", Message: &framesB[i]})
+	}
+	require.Len(t, all, 2)
+	assert.Equal(t, "a", all[0].Info.Bus)
+	assert.Equal(t, uint8(1), *all[0].SID)
+	assert.Equal(t, "b", all[1].Info.Bus)
+	assert.Equal(t, uint8(2), *all[1].SID)
+	require.Len(t, fromB, 1)
+	assert.Equal(t, "b", fromB[0].Info.Bus)
+
+	heading := float32(1)
+	msg := publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 3}, Heading: &heading}
+	require.NoError(t, s.WriteTo(context.Background(), msg, "b"))
+	assert.Empty(t, a.frames)
+	assert.Len(t, b.frames, 1)
+	require.NoError(t, s.Write(msg))
+	assert.Len(t, a.frames, 1)
+	assert.Len(t, b.frames, 2)
+	assert.ErrorContains(t, s.WriteTo(context.Background(), msg, "c"), "unknown bus c")
+
+	for _, bus := range []string{"a", "b"} {
+		stats, ok := s.BusStatisticsFor(bus)
+		require.True(t, ok)
+		require.Len(t, stats.Sources, 2, bus)
+		assert.Equal(t, uint8(3), stats.Sources[0].Source)
+		assert.Equal(t, uint8(7), stats.Sources[1].Source)
+	}
+	_, ok := s.BusStatisticsFor("c")
+	assert.False(t, ok)
+}
+
+func TestFakeClockDrivesReplay(t *testing.T) {
+	id := converter.CanIDFromData(publicpgn.VesselHeadingPGN, 42, 2, 255)
+	var replay string
+	for _, offset := range []string{"0.000000", "0.100000", "2.000000", "7200.500000"} {
+		replay += fmt.Sprintf(" (%s)  can1  %08X   [8]  01 02 03 04 05 06 07 FC\n", offset, id)
+	}
+	path := filepath.Join(t.TempDir(), "replay.n2k")
+	require.NoError(t, os.WriteFile(path, []byte(replay), 0o600))
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := clock.NewFake(start)
+	log := slog.New(slog.DiscardHandler)
+	service := NewN2kService(n2kfileendpoint.NewN2kFileEndpoint(path, log), log, WithClock(fake), WithLatestCache(8))
+	var mu sync.Mutex
+	var received []time.Duration
+	_, err := service.SubscribeToStruct(publicpgn.VesselHeading{}, func(heading publicpgn.VesselHeading) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, heading.Info.Timestamp.Sub(start))
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, service.Start(ctx))
+	defer func() { _ = service.Stop() }()
+	// Jump to each deadline once playback waits for its next frame, besides the decode
+	// worker's ticker, and every frame received so far has been decoded
+	go func() {
+		for fake.BlockUntil(ctx, 2) == nil {
+			if service.MessageQueuePending() > 0 {
+				runtime.Gosched()
+				continue
+			}
+			next, _ := fake.Next()
+			fake.Set(next)
+		}
+	}()
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer waitCancel()
+	require.NoError(t, service.Wait(waitCtx))
+	require.Eventually(t, func() bool { return service.MessageQueuePending() == 0 }, time.Second, time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []time.Duration{0, 100 * time.Millisecond, 2 * time.Second, 2*time.Hour + 500*time.Millisecond}, received)
+	_, latestReceived, ok := Latest[publicpgn.VesselHeading](service)
+	require.True(t, ok)
+	assert.Equal(t, start.Add(2*time.Hour+500*time.Millisecond), latestReceived)
+	metrics := service.Metrics()
+	assert.Zero(t, metrics.Drops[DropStale])
+	assert.Zero(t, metrics.Drops[DropBacklog])
+}
@@ -9,6 +9,7 @@ package n2kinternal
 
 import (
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
 	"sync"
@@ -17,7 +18,6 @@ import (
 	"github.com/boatkit-io/n2k/internal/converter"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -36,10 +36,45 @@ type processingMetrics struct {
 	queueWaitStats  durationStats
 
 	pgns      map[uint32]uint64
-	callbacks map[string]*durationStats
+	callbacks map[callbackKey]*durationStats
+
+	// inFlight holds every running callback by invocation, since each decode worker runs
+	// its own callbacks
+	inFlight       map[uint64]inFlightCallback
+	lastCallbackID uint64
+
+	asyncLagStats    durationStats
+	asyncDropped     uint64
+	asyncReplaced    uint64
+	asyncSubscribers map[callbackKey]*asyncSubscriberStats
+
+	// The totals are kept since the service was created and are not reset by snapshot.
+	pgnTotals          map[uint32]*PGNMetrics
+	callbackTotals     map[callbackKey]*CallbackMetrics
+	asyncDroppedTotal  uint64
+	decodeFailureTotal uint64
+}
+
+// callbackKey identifies a subscriber callback. A struct key, unlike a joined string,
+// does not allocate on each callback.
+type callbackKey struct {
+	structName   string
+	callbackName string
+}
+
+func (k callbackKey) String() string {
+	return k.structName + "/" + k.callbackName
+}
 
-	inFlightCallback      string
-	inFlightCallbackStart time.Time
+type inFlightCallback struct {
+	key   callbackKey
+	start time.Time
+}
+
+type asyncSubscriberStats struct {
+	lag      durationStats
+	dropped  uint64
+	replaced uint64
 }
 
 type durationStats struct {
@@ -59,15 +94,27 @@ type processingMetricsSnapshot struct {
 
 	topPGNs             string
 	topCallbacks        string
+	inFlightCallbacks   int
 	inFlightCallback    string
 	inFlightCallbackAge time.Duration
+
+	asyncLagStats       durationStats
+	asyncDropped        uint64
+	asyncReplaced       uint64
+	topAsyncSubscribers string
 }
 
-func newProcessingMetrics() *processingMetrics {
+func newProcessingMetrics(now time.Time) *processingMetrics {
 	return &processingMetrics{
-		windowStart: time.Now(),
+		windowStart: now,
 		pgns:        map[uint32]uint64{},
-		callbacks:   map[string]*durationStats{},
+		callbacks:   map[callbackKey]*durationStats{},
+		inFlight:    map[uint64]inFlightCallback{},
+
+		asyncSubscribers: map[callbackKey]*asyncSubscriberStats{},
+
+		pgnTotals:      map[uint32]*PGNMetrics{},
+		callbackTotals: map[callbackKey]*CallbackMetrics{},
 	}
 }
 
@@ -80,32 +127,68 @@ func (m *processingMetrics) observeFrame(pgn uint32, hasPGN bool, duration time.
 	m.frameStats.observe(duration)
 	if hasPGN {
 		m.pgns[pgn]++
+		m.pgnTotal(pgn).Frames++
 	}
 }
 
-func (m *processingMetrics) observePacket(duration time.Duration) {
+func (m *processingMetrics) observePacket(pgn uint32, duration time.Duration) {
 	if m == nil {
 		return
 	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.packetStats.observe(duration)
+	m.pgnTotal(pgn).Packets++
 }
 
-func (m *processingMetrics) observeSubscriber(duration time.Duration) {
+// observeSubscriber records the fanout of a struct of pgn, which failed to decode when
+// decodeFailed is set.
+func (m *processingMetrics) observeSubscriber(pgn uint32, hasPGN, decodeFailed bool, duration time.Duration) {
 	if m == nil {
 		return
 	}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.subscriberStats.observe(duration)
+	if decodeFailed {
+		m.decodeFailureTotal++
+	}
+	if !hasPGN {
+		return
+	}
+	total := m.pgnTotal(pgn)
+	total.Structs++
+	if decodeFailed {
+		total.DecodeFailures++
+	}
+}
+
+// pgnTotal returns the totals for one PGN. The caller holds mu.
+func (m *processingMetrics) pgnTotal(pgn uint32) *PGNMetrics {
+	total := m.pgnTotals[pgn]
+	if total == nil {
+		total = &PGNMetrics{PGN: pgn}
+		m.pgnTotals[pgn] = total
+	}
+	return total
+}
+
+// callbackTotal returns the totals for one subscriber callback. The caller holds mu.
+func (m *processingMetrics) callbackTotal(structName, callbackName string) *CallbackMetrics {
+	key := callbackKey{structName: structName, callbackName: callbackName}
+	total := m.callbackTotals[key]
+	if total == nil {
+		total = &CallbackMetrics{Struct: structName, Callback: callbackName}
+		m.callbackTotals[key] = total
+	}
+	return total
 }
 
 func (m *processingMetrics) observeCallback(structName, callbackName string, duration time.Duration) {
 	if m == nil {
 		return
 	}
-	key := structName + "/" + callbackName
+	key := callbackKey{structName: structName, callbackName: callbackName}
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.callbackStats.observe(duration)
@@ -115,26 +198,81 @@ func (m *processingMetrics) observeCallback(structName, callbackName string, dur
 		m.callbacks[key] = stats
 	}
 	stats.observe(duration)
+
+	total := m.callbackTotal(structName, callbackName)
+	total.Calls++
+	total.Duration += duration
+	if duration > total.MaxDuration {
+		total.MaxDuration = duration
+	}
+}
+
+// callbackStarted records a running callback and returns the ID to finish it with.
+func (m *processingMetrics) callbackStarted(structName, callbackName string, now time.Time) uint64 {
+	if m == nil {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastCallbackID++
+	m.inFlight[m.lastCallbackID] = inFlightCallback{
+		key:   callbackKey{structName: structName, callbackName: callbackName},
+		start: now,
+	}
+	return m.lastCallbackID
 }
 
-func (m *processingMetrics) callbackStarted(structName, callbackName string, now time.Time) {
+func (m *processingMetrics) callbackFinished(id uint64) {
 	if m == nil {
 		return
 	}
 	m.mu.Lock()
-	m.inFlightCallback = structName + "/" + callbackName
-	m.inFlightCallbackStart = now
+	delete(m.inFlight, id)
 	m.mu.Unlock()
 }
 
-func (m *processingMetrics) callbackFinished() {
+func (m *processingMetrics) observeAsyncDelivery(structName, callbackName string, lag time.Duration) {
 	if m == nil {
 		return
 	}
 	m.mu.Lock()
-	m.inFlightCallback = ""
-	m.inFlightCallbackStart = time.Time{}
-	m.mu.Unlock()
+	defer m.mu.Unlock()
+	m.asyncLagStats.observe(lag)
+	m.asyncSubscriber(structName, callbackName).lag.observe(lag)
+}
+
+func (m *processingMetrics) observeAsyncDrop(structName, callbackName string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.asyncDropped++
+	m.asyncSubscriber(structName, callbackName).dropped++
+	m.asyncDroppedTotal++
+	m.callbackTotal(structName, callbackName).Dropped++
+}
+
+func (m *processingMetrics) observeAsyncReplace(structName, callbackName string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.asyncReplaced++
+	m.asyncSubscriber(structName, callbackName).replaced++
+	m.callbackTotal(structName, callbackName).Replaced++
+}
+
+// asyncSubscriber returns the stats for one asynchronous subscriber. The caller holds mu.
+func (m *processingMetrics) asyncSubscriber(structName, callbackName string) *asyncSubscriberStats {
+	key := callbackKey{structName: structName, callbackName: callbackName}
+	stats := m.asyncSubscribers[key]
+	if stats == nil {
+		stats = &asyncSubscriberStats{}
+		m.asyncSubscribers[key] = stats
+	}
+	return stats
 }
 
 func (m *processingMetrics) observeQueueWait(duration time.Duration) {
@@ -154,18 +292,32 @@ func (m *processingMetrics) snapshot(now time.Time) processingMetricsSnapshot {
 	defer m.mu.Unlock()
 
 	snapshot := processingMetricsSnapshot{
-		interval:         now.Sub(m.windowStart),
-		frameStats:       m.frameStats,
-		packetStats:      m.packetStats,
-		subscriberStats:  m.subscriberStats,
-		callbackStats:    m.callbackStats,
-		queueWaitStats:   m.queueWaitStats,
-		topPGNs:          formatTopPGNs(m.pgns, processingMetricsTopCount),
-		topCallbacks:     formatTopCallbacks(m.callbacks, processingMetricsTopCount),
-		inFlightCallback: m.inFlightCallback,
+		interval:        now.Sub(m.windowStart),
+		frameStats:      m.frameStats,
+		packetStats:     m.packetStats,
+		subscriberStats: m.subscriberStats,
+		callbackStats:   m.callbackStats,
+		queueWaitStats:  m.queueWaitStats,
+		topPGNs:         formatTopPGNs(m.pgns, processingMetricsTopCount),
+		topCallbacks:    formatTopCallbacks(m.callbacks, processingMetricsTopCount),
+
+		asyncLagStats:       m.asyncLagStats,
+		asyncDropped:        m.asyncDropped,
+		asyncReplaced:       m.asyncReplaced,
+		topAsyncSubscribers: formatTopAsyncSubscribers(m.asyncSubscribers, processingMetricsTopCount),
 	}
-	if !m.inFlightCallbackStart.IsZero() {
-		snapshot.inFlightCallbackAge = now.Sub(m.inFlightCallbackStart)
+	// the oldest running callback is the one most likely to be holding up its worker
+	snapshot.inFlightCallbacks = len(m.inFlight)
+	var oldest inFlightCallback
+	for _, callback := range m.inFlight {
+		if oldest.start.IsZero() || callback.start.Before(oldest.start) ||
+			(callback.start.Equal(oldest.start) && callback.key.String() < oldest.key.String()) {
+			oldest = callback
+		}
+	}
+	if !oldest.start.IsZero() {
+		snapshot.inFlightCallback = oldest.key.String()
+		snapshot.inFlightCallbackAge = now.Sub(oldest.start)
 	}
 
 	m.windowStart = now
@@ -175,12 +327,16 @@ func (m *processingMetrics) snapshot(now time.Time) processingMetricsSnapshot {
 	m.callbackStats = durationStats{}
 	m.queueWaitStats = durationStats{}
 	m.pgns = map[uint32]uint64{}
-	m.callbacks = map[string]*durationStats{}
+	m.callbacks = map[callbackKey]*durationStats{}
+	m.asyncLagStats = durationStats{}
+	m.asyncDropped = 0
+	m.asyncReplaced = 0
+	m.asyncSubscribers = map[callbackKey]*asyncSubscriberStats{}
 
 	return snapshot
 }
 
-func (s *processingMetricsSnapshot) addFields(fields logrus.Fields) {
+func (s *processingMetricsSnapshot) addFields(fields map[string]any) {
 	if s.interval > 0 {
 		fields["processingInterval"] = s.interval.String()
 		fields["processedFrameRateHz"] = rate(float64(s.frameStats.count), s.interval)
@@ -206,9 +362,20 @@ func (s *processingMetricsSnapshot) addFields(fields logrus.Fields) {
 		fields["topSubscriberCallbacks"] = s.topCallbacks
 	}
 	if s.inFlightCallback != "" {
+		fields["subscriberCallbacksInFlight"] = s.inFlightCallbacks
 		fields["subscriberCallbackInFlight"] = s.inFlightCallback
 		fields["subscriberCallbackInFlightAge"] = s.inFlightCallbackAge.String()
 	}
+	if s.asyncLagStats.count > 0 || s.asyncDropped > 0 || s.asyncReplaced > 0 {
+		fields["asyncSubscriberDeliveries"] = s.asyncLagStats.count
+		fields["asyncSubscriberLagAvgMs"] = millis(s.asyncLagStats.avg())
+		fields["asyncSubscriberLagMaxMs"] = millis(s.asyncLagStats.max)
+		fields["asyncSubscriberDropped"] = s.asyncDropped
+		fields["asyncSubscriberReplaced"] = s.asyncReplaced
+	}
+	if s.topAsyncSubscribers != "" {
+		fields["topAsyncSubscribers"] = s.topAsyncSubscribers
+	}
 }
 
 func (s *durationStats) observe(duration time.Duration) {
@@ -274,17 +441,17 @@ func formatTopPGNs(counts map[uint32]uint64, limit int) string {
 	return strings.Join(parts, ",")
 }
 
-func formatTopCallbacks(callbacks map[string]*durationStats, limit int) string {
+func formatTopCallbacks(callbacks map[callbackKey]*durationStats, limit int) string {
 	type item struct {
 		name  string
 		stats durationStats
 	}
 	items := make([]item, 0, len(callbacks))
-	for name, stats := range callbacks {
+	for key, stats := range callbacks {
 		if stats == nil {
 			continue
 		}
-		items = append(items, item{name: name, stats: *stats})
+		items = append(items, item{name: key.String(), stats: *stats})
 	}
 	sort.Slice(items, func(i, j int) bool {
 		if items[i].stats.total == items[j].stats.total {
@@ -302,3 +469,50 @@ func formatTopCallbacks(callbacks map[string]*durationStats, limit int) string {
 	}
 	return strings.Join(parts, "; ")
 }
+
+func formatTopAsyncSubscribers(subscribers map[callbackKey]*asyncSubscriberStats, limit int) string {
+	type item struct {
+		name  string
+		stats asyncSubscriberStats
+	}
+	items := make([]item, 0, len(subscribers))
+	for key, stats := range subscribers {
+		if stats == nil {
+			continue
+		}
+		items = append(items, item{name: key.String(), stats: *stats})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].stats.dropped != items[j].stats.dropped {
+			return items[i].stats.dropped > items[j].stats.dropped
+		}
+		if items[i].stats.lag.max == items[j].stats.lag.max {
+			return items[i].name < items[j].name
+		}
+		return items[i].stats.lag.max > items[j].stats.lag.max
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		parts = append(parts, fmt.Sprintf("%s count=%d dropped=%d replaced=%d avgLagMs=%.3f maxLagMs=%.3f",
+			item.name, item.stats.lag.count, item.stats.dropped, item.stats.replaced,
+			millis(item.stats.lag.avg()), millis(item.stats.lag.max)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// sortedFields returns fields as log arguments ordered by key.
+func sortedFields(fields map[string]any) []any {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := make([]any, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, slog.Any(key, fields[key]))
+	}
+	return args
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// DefaultRequestTimeout bounds a request whose context has no deadline. Global requests
+// collect responses for this long.
+const DefaultRequestTimeout = time.Second
+
+// globalAddress is the destination of requests to every device.
+const globalAddress = 255
+
+// RequestError reports a request that the destination rejected with an ISO
+// Acknowledgement.
+type RequestError struct {
+	// PGN is the requested PGN.
+	PGN uint32
+	// Source is the address of the device that rejected the request.
+	Source uint8
+	// Control is the acknowledgement's control code, such as Nak or AccessDenied.
+	Control publicpgn.ISOControlConst
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("request for PGN %d rejected by 0x%02x: %s", e.PGN, e.Source, e.Control)
+}
+
+// pendingRequest collects the responses to one ISO Request.
+type pendingRequest struct {
+	pgn         uint32
+	source      uint8
+	destination uint8
+
+	responses []any
+	err       error
+	done      chan struct{}
+	finished  bool
+}
+
+// requestTracker matches received structs against outstanding requests.
+type requestTracker struct {
+	mu      sync.Mutex
+	pending map[*pendingRequest]struct{}
+	// count lets observe skip the lock while nothing is outstanding
+	count atomic.Int32
+}
+
+func newRequestTracker() *requestTracker {
+	return &requestTracker{pending: make(map[*pendingRequest]struct{})}
+}
+
+func (t *requestTracker) add(requested uint32, source, destination uint8) *pendingRequest {
+	req := &pendingRequest{pgn: requested, source: source, destination: destination, done: make(chan struct{})}
+	t.mu.Lock()
+	t.pending[req] = struct{}{}
+	t.count.Add(1)
+	t.mu.Unlock()
+	return req
+}
+
+func (t *requestTracker) remove(req *pendingRequest) {
+	t.mu.Lock()
+	if _, ok := t.pending[req]; ok {
+		delete(t.pending, req)
+		t.count.Add(-1)
+	}
+	t.mu.Unlock()
+}
+
+// observe records p as a response to every outstanding request it answers.
+func (t *requestTracker) observe(p any) {
+	if t.count.Load() == 0 {
+		return
+	}
+	info, ok := structInfo(p)
+	if !ok {
+		return
+	}
+	ack, isAck := p.(publicpgn.ISOAcknowledgement)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for req := range t.pending {
+		if req.finished {
+			continue
+		}
+		if isAck {
+			req.observeAcknowledgement(&ack)
+			continue
+		}
+		if info.PGN != req.pgn || (req.destination != globalAddress && info.SourceId != req.destination) {
+			continue
+		}
+		req.responses = append(req.responses, p)
+		if req.destination != globalAddress {
+			req.finish(nil)
+		}
+	}
+}
+
+// observeAcknowledgement fails an addressed request that its destination rejected.
+// Devices do not acknowledge global requests, so those ignore acknowledgements.
+func (req *pendingRequest) observeAcknowledgement(ack *publicpgn.ISOAcknowledgement) {
+	if req.destination == globalAddress || ack.PGN == nil || *ack.PGN != req.pgn {
+		return
+	}
+	if ack.Info.SourceId != req.destination || ack.Control == publicpgn.Ack_2 {
+		return
+	}
+	if ack.Info.TargetId != req.source && ack.Info.TargetId != globalAddress {
+		return
+	}
+	req.finish(&RequestError{PGN: req.pgn, Source: ack.Info.SourceId, Control: ack.Control})
+}
+
+// finish completes the request. The caller holds the tracker lock.
+func (req *pendingRequest) finish(err error) {
+	req.err = err
+	req.finished = true
+	close(req.done)
+}
+
+// result returns the responses collected so far.
+func (t *requestTracker) result(req *pendingRequest) ([]any, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return req.responses, req.err
+}
+
+// Request sends an ISO Request for requested to destination and waits for the response.
+// See SendRequest.
+func (s *N2kService) Request(ctx context.Context, requested uint32, destination uint8) ([]any, error) {
+	return s.SendRequest(ctx, &publicpgn.ISORequest{
+		Info: publicpgn.MessageInfo{TargetId: destination},
+		PGN:  &requested,
+	})
+}
+
+// SendRequest sends request and waits for the structs that answer it. An addressed
+// request returns the first response from its destination, or a *RequestError when the
+// destination rejects it. A global request collects responses from every device until
+// ctx's deadline, or DefaultRequestTimeout when ctx has none. The request is written with
+// WriteContext, so ctx also bounds the write and carries its caller tag.
+func (s *N2kService) SendRequest(ctx context.Context, request *publicpgn.ISORequest) ([]any, error) {
+	if request == nil || request.PGN == nil {
+		return nil, errors.New("request has no requested PGN")
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultRequestTimeout)
+		defer cancel()
+	}
+	// The defaults are filled in on a copy, leaving the caller's request as it was
+	sent := *request
+	if sent.Info.PGN == 0 {
+		sent.Info.PGN = publicpgn.ISORequestPGN
+	}
+	if sent.Info.Priority == 0 {
+		sent.Info.Priority = 6
+	}
+
+	requested, destination := *sent.PGN, sent.Info.TargetId
+	pending := s.requests.add(requested, sent.Info.SourceId, destination)
+	defer s.requests.remove(pending)
+
+	if err := s.WriteContext(ctx, &sent); err != nil {
+		return nil, fmt.Errorf("failed to send request for PGN %d: %w", requested, err)
+	}
+
+	select {
+	case <-pending.done:
+		return s.requests.result(pending)
+	case <-ctx.Done():
+	}
+	select {
+	case <-pending.done:
+		return s.requests.result(pending)
+	default:
+	}
+
+	responses, _ := s.requests.result(pending)
+	if destination == globalAddress && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return responses, nil
+	}
+	if destination != globalAddress {
+		return nil, fmt.Errorf("no response to request for PGN %d from 0x%02x: %w", requested, destination, ctx.Err())
+	}
+	return responses, ctx.Err()
+}
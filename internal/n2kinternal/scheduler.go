@@ -0,0 +1,320 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/logging"
+)
+
+// schedulePhaseStep spreads the first transmission of successive schedules across their
+// interval. The golden ratio keeps any number of phases evenly spaced.
+var schedulePhaseStep = (math.Sqrt(5) - 1) / 2
+
+// Scheduler writes registered PGNs periodically. Schedules are staggered so that PGNs
+// registered together do not go out in one burst.
+type Scheduler struct {
+	mu        sync.Mutex
+	write     func(any) error
+	log       *slog.Logger
+	clock     clock.Clock
+	schedules map[*ScheduledPGN]struct{}
+	paused    bool
+	phase     float64
+}
+
+// ScheduledPGN is one PGN registered with a Scheduler.
+type ScheduledPGN struct {
+	scheduler *Scheduler
+	pgn       uint32
+	produce   func() any
+
+	// sendMu keeps a trigger and a timer from writing at the same time
+	sendMu          sync.Mutex
+	interval        time.Duration
+	defaultInterval time.Duration
+	phase           float64
+	next            time.Time
+	timer           clock.Timer
+	// generation invalidates timer callbacks that were already running when their
+	// timer was replaced
+	generation uint64
+	stopped    bool
+}
+
+// NewScheduler creates a scheduler that sends with write and logs failed writes to log.
+// A new scheduler is running; Pause stops it.
+func NewScheduler(write func(any) error, log *slog.Logger) *Scheduler {
+	return &Scheduler{
+		write:     write,
+		log:       logging.OrDefault(log),
+		clock:     clock.Real(),
+		schedules: make(map[*ScheduledPGN]struct{}),
+	}
+}
+
+// SetLogger replaces the logger for failed writes.
+func (s *Scheduler) SetLogger(log *slog.Logger) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.log = logging.OrDefault(log)
+}
+
+// SetClock replaces the clock that transmissions are timed by. A nil clock is the wall
+// clock. Running schedules restart at their phase.
+func (s *Scheduler) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = clock.OrReal(c)
+	if s.paused {
+		return
+	}
+	now := s.clock.Now()
+	for scheduled := range s.schedules {
+		scheduled.stopTimer()
+		scheduled.start(now)
+	}
+}
+
+// Schedule writes the struct returned by produce every interval. produce returning nil
+// skips that transmission.
+func (s *Scheduler) Schedule(pgnNumber uint32, interval time.Duration, produce func() any) (*ScheduledPGN, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("schedule for PGN %d needs a positive interval, got %s", pgnNumber, interval)
+	}
+	if produce == nil {
+		return nil, errors.New("schedule producer is nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scheduled := &ScheduledPGN{
+		scheduler:       s,
+		pgn:             pgnNumber,
+		produce:         produce,
+		interval:        interval,
+		defaultInterval: interval,
+		phase:           s.phase,
+	}
+	s.phase = math.Mod(s.phase+schedulePhaseStep, 1)
+	s.schedules[scheduled] = struct{}{}
+	if !s.paused {
+		scheduled.start(s.clock.Now())
+	}
+	return scheduled, nil
+}
+
+// Pause stops all transmissions until Resume.
+func (s *Scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.paused {
+		return
+	}
+	s.paused = true
+	for scheduled := range s.schedules {
+		scheduled.stopTimer()
+	}
+}
+
+// Resume restarts transmissions stopped by Pause, staggered as when they were scheduled.
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.paused {
+		return
+	}
+	s.paused = false
+	now := s.clock.Now()
+	for scheduled := range s.schedules {
+		scheduled.start(now)
+	}
+}
+
+// Paused reports whether the scheduler is paused.
+func (s *Scheduler) Paused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.paused
+}
+
+// SetInterval changes the interval of every schedule for pgnNumber and reports whether
+// there were any. An interval of zero restores the interval each was scheduled with.
+func (s *Scheduler) SetInterval(pgnNumber uint32, interval time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	found := false
+	for scheduled := range s.schedules {
+		if scheduled.pgn == pgnNumber {
+			scheduled.setIntervalLocked(interval)
+			found = true
+		}
+	}
+	return found
+}
+
+// Trigger sends every schedule for pgnNumber now and reports whether there were any.
+func (s *Scheduler) Trigger(pgnNumber uint32) bool {
+	s.mu.Lock()
+	var matches []*ScheduledPGN
+	for scheduled := range s.schedules {
+		if scheduled.pgn == pgnNumber {
+			matches = append(matches, scheduled)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, scheduled := range matches {
+		scheduled.Trigger()
+	}
+	return len(matches) > 0
+}
+
+// PGNs returns the scheduled PGN numbers in ascending order.
+func (s *Scheduler) PGNs() []uint32 {
+	s.mu.Lock()
+	seen := make(map[uint32]struct{}, len(s.schedules))
+	pgns := make([]uint32, 0, len(s.schedules))
+	for scheduled := range s.schedules {
+		if _, ok := seen[scheduled.pgn]; !ok {
+			seen[scheduled.pgn] = struct{}{}
+			pgns = append(pgns, scheduled.pgn)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(pgns, func(i, j int) bool { return pgns[i] < pgns[j] })
+	return pgns
+}
+
+// PGN returns the scheduled PGN number.
+func (p *ScheduledPGN) PGN() uint32 {
+	return p.pgn
+}
+
+// Interval returns the current interval.
+func (p *ScheduledPGN) Interval() time.Duration {
+	p.scheduler.mu.Lock()
+	defer p.scheduler.mu.Unlock()
+	return p.interval
+}
+
+// SetInterval changes the interval, taking effect from the last transmission. An
+// interval of zero restores the interval the PGN was scheduled with.
+func (p *ScheduledPGN) SetInterval(interval time.Duration) {
+	p.scheduler.mu.Lock()
+	defer p.scheduler.mu.Unlock()
+	p.setIntervalLocked(interval)
+}
+
+// Trigger sends the PGN now, such as when its value changes, and restarts its interval.
+// It does nothing while the scheduler is paused.
+func (p *ScheduledPGN) Trigger() {
+	p.scheduler.mu.Lock()
+	if p.stopped || p.scheduler.paused {
+		p.scheduler.mu.Unlock()
+		return
+	}
+	p.stopTimer()
+	p.next = p.scheduler.clock.Now().Add(p.interval)
+	p.armLocked()
+	p.scheduler.mu.Unlock()
+
+	p.send()
+}
+
+// Stop removes the schedule.
+func (p *ScheduledPGN) Stop() {
+	p.scheduler.mu.Lock()
+	defer p.scheduler.mu.Unlock()
+	p.stopped = true
+	p.stopTimer()
+	delete(p.scheduler.schedules, p)
+}
+
+// start arms the first transmission at the schedule's phase. The caller holds the
+// scheduler lock.
+func (p *ScheduledPGN) start(now time.Time) {
+	p.next = now.Add(time.Duration(p.phase * float64(p.interval)))
+	p.armLocked()
+}
+
+// setIntervalLocked applies a new interval. The caller holds the scheduler lock.
+func (p *ScheduledPGN) setIntervalLocked(interval time.Duration) {
+	if interval <= 0 {
+		interval = p.defaultInterval
+	}
+	if interval == p.interval {
+		return
+	}
+	p.next = p.next.Add(interval - p.interval)
+	p.interval = interval
+	if p.timer != nil {
+		p.stopTimer()
+		p.armLocked()
+	}
+}
+
+// armLocked starts the timer for the next transmission. The caller holds the scheduler
+// lock.
+func (p *ScheduledPGN) armLocked() {
+	generation := p.generation
+	clk := p.scheduler.clock
+	p.timer = clk.AfterFunc(p.next.Sub(clk.Now()), func() { p.fire(generation) })
+}
+
+// stopTimer stops the pending transmission. The caller holds the scheduler lock.
+func (p *ScheduledPGN) stopTimer() {
+	p.generation++
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+}
+
+// fire sends the PGN and arms the next transmission.
+func (p *ScheduledPGN) fire(generation uint64) {
+	p.scheduler.mu.Lock()
+	if p.stopped || p.scheduler.paused || generation != p.generation {
+		p.scheduler.mu.Unlock()
+		return
+	}
+	// Keep to the schedule without drifting, but skip transmissions missed while late
+	now := p.scheduler.clock.Now()
+	p.next = p.next.Add(p.interval)
+	if p.next.Before(now) {
+		p.next = now.Add(p.interval)
+	}
+	p.armLocked()
+	p.scheduler.mu.Unlock()
+
+	p.send()
+}
+
+func (p *ScheduledPGN) send() {
+	p.sendMu.Lock()
+	defer p.sendMu.Unlock()
+	msg := p.produce()
+	if msg == nil {
+		return
+	}
+	if err := p.scheduler.write(msg); err != nil {
+		p.scheduler.mu.Lock()
+		log := p.scheduler.log
+		p.scheduler.mu.Unlock()
+		log.Warn("failed to write scheduled PGN", logging.PGN(p.pgn), logging.Error(err))
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+const (
+	// DefaultStreamStaleFactor is the number of expected intervals a stream may go
+	// without a message before it is reported stale.
+	DefaultStreamStaleFactor = 3.0
+	// streamLearnSamples is the number of intervals observed before a learned interval
+	// is trusted.
+	streamLearnSamples = 3
+	// streamCheckInterval is how often streams are checked for staleness.
+	streamCheckInterval = 250 * time.Millisecond
+	// streamExpiryFactor is the number of expected intervals a stream may go without a
+	// message before it is no longer tracked.
+	streamExpiryFactor = 100
+	// streamExpiryMinimum is the shortest time a stream is kept without messages. It also
+	// expires streams whose interval was never learned.
+	streamExpiryMinimum = 10 * time.Minute
+)
+
+var errStreamTrackingDisabled = errors.New("stream tracking is not enabled")
+
+// StreamEventKind identifies a stream event.
+type StreamEventKind int
+
+const (
+	// StreamStale reports a stream that stopped arriving.
+	StreamStale StreamEventKind = iota + 1
+	// StreamResumed reports a stale stream that started arriving again.
+	StreamResumed
+	// StreamExpired reports a stale stream that has been silent long enough to be
+	// forgotten. If it starts arriving again it is tracked as a new stream.
+	StreamExpired
+)
+
+// String returns the event kind name.
+func (k StreamEventKind) String() string {
+	switch k {
+	case StreamStale:
+		return "stale"
+	case StreamResumed:
+		return "resumed"
+	case StreamExpired:
+		return "expired"
+	default:
+		return fmt.Sprintf("StreamEventKind(%d)", int(k))
+	}
+}
+
+// StreamKey identifies a stream of one PGN from one source and instance.
+type StreamKey struct {
+	// Bus is the bus the stream is received on, when the endpoint has several.
+	Bus    string
+	PGN    uint32
+	Source uint8
+	// Instance is valid when HasInstance is set.
+	Instance    uint8
+	HasInstance bool
+}
+
+// StreamStatus describes a tracked stream.
+type StreamStatus struct {
+	StreamKey
+	// Interval is the expected interval between messages, learned or configured. It is
+	// zero until enough messages have been seen to learn it.
+	Interval time.Duration
+	// LastSeen is when the last message arrived.
+	LastSeen time.Time
+	// Stale is set while the stream is overdue.
+	Stale bool
+}
+
+// StreamEvent reports a stream going stale, resuming, or expiring.
+type StreamEvent struct {
+	Kind   StreamEventKind
+	Stream StreamStatus
+	// Gap is the time since the last message for StreamStale and StreamExpired, and the
+	// length of the outage for StreamResumed.
+	Gap time.Duration
+}
+
+type trackedStream struct {
+	status     StreamStatus
+	samples    int
+	configured bool
+}
+
+// streamTracker learns the interval of every stream and reports streams that stop.
+type streamTracker struct {
+	mu          sync.Mutex
+	streams     map[StreamKey]*trackedStream
+	staleFactor float64
+	intervals   map[uint32]time.Duration
+
+	// publishMu orders event delivery; it is taken before mu when both are held
+	publishMu        sync.Mutex
+	subscribers      map[uint]func(StreamEvent)
+	nextSubscriberID uint
+}
+
+func newStreamTracker(staleFactor float64, intervals map[uint32]time.Duration) *streamTracker {
+	if staleFactor <= 0 {
+		staleFactor = DefaultStreamStaleFactor
+	}
+	return &streamTracker{
+		streams:     make(map[StreamKey]*trackedStream),
+		staleFactor: staleFactor,
+		intervals:   intervals,
+		subscribers: make(map[uint]func(StreamEvent)),
+	}
+}
+
+// observe records a message and reports a resumed stream.
+func (t *streamTracker) observe(p any, now time.Time) {
+	if _, unknown := p.(publicpgn.UnknownPGN); unknown {
+		return
+	}
+	info, ok := structInfo(p)
+	if !ok {
+		return
+	}
+	instance, hasInstance := pgn.StructInstance(p)
+	key := StreamKey{Bus: info.Bus, PGN: info.PGN, Source: info.SourceId, Instance: instance, HasInstance: hasInstance}
+
+	t.mu.Lock()
+	stream := t.streams[key]
+	if stream == nil {
+		stream = &trackedStream{status: StreamStatus{StreamKey: key}}
+		if interval, ok := t.intervals[key.PGN]; ok {
+			stream.status.Interval = interval
+			stream.configured = true
+		}
+		stream.status.LastSeen = now
+		t.streams[key] = stream
+		t.mu.Unlock()
+		return
+	}
+
+	gap := now.Sub(stream.status.LastSeen)
+	stream.status.LastSeen = now
+	if stream.status.Stale {
+		stream.status.Stale = false
+		event := StreamEvent{Kind: StreamResumed, Stream: stream.status, Gap: gap}
+		t.mu.Unlock()
+		t.publish([]StreamEvent{event})
+		return
+	}
+	if !stream.configured && gap > 0 {
+		// Exponentially weighted average of the intervals between messages
+		if stream.samples == 0 {
+			stream.status.Interval = gap
+		} else {
+			stream.status.Interval += (gap - stream.status.Interval) / 8
+		}
+		stream.samples++
+	}
+	t.mu.Unlock()
+}
+
+// check reports streams that have gone stale by now, and forgets streams that have been
+// silent long enough to expire.
+func (t *streamTracker) check(now time.Time) {
+	t.publishMu.Lock()
+	defer t.publishMu.Unlock()
+
+	var events []StreamEvent
+	t.mu.Lock()
+	for key, stream := range t.streams {
+		gap := now.Sub(stream.status.LastSeen)
+		if gap > max(stream.status.Interval*streamExpiryFactor, streamExpiryMinimum) {
+			delete(t.streams, key)
+			// only streams reported stale are reported expired
+			if stream.status.Stale {
+				events = append(events, StreamEvent{Kind: StreamExpired, Stream: stream.status, Gap: gap})
+			}
+			continue
+		}
+		if stream.status.Stale || !stream.trusted() {
+			continue
+		}
+		if gap > time.Duration(float64(stream.status.Interval)*t.staleFactor) {
+			stream.status.Stale = true
+			events = append(events, StreamEvent{Kind: StreamStale, Stream: stream.status, Gap: gap})
+		}
+	}
+	t.mu.Unlock()
+
+	sortStreamEvents(events)
+	t.deliverLocked(events)
+}
+
+func (s *trackedStream) trusted() bool {
+	return s.status.Interval > 0 && (s.configured || s.samples >= streamLearnSamples)
+}
+
+// run checks for stale streams until ctx is done.
+func (t *streamTracker) run(ctx context.Context, clk clock.Clock) {
+	ticker := clk.NewTicker(streamCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C():
+			t.check(now)
+		}
+	}
+}
+
+func (t *streamTracker) publish(events []StreamEvent) {
+	t.publishMu.Lock()
+	defer t.publishMu.Unlock()
+	t.deliverLocked(events)
+}
+
+// deliverLocked calls the subscribers with events. The caller holds publishMu.
+func (t *streamTracker) deliverLocked(events []StreamEvent) {
+	if len(events) == 0 {
+		return
+	}
+	t.mu.Lock()
+	subscribers := make([]func(StreamEvent), 0, len(t.subscribers))
+	for _, subscriber := range t.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	t.mu.Unlock()
+
+	for _, event := range events {
+		for _, subscriber := range subscribers {
+			subscriber(event)
+		}
+	}
+}
+
+func (t *streamTracker) subscribe(callback func(StreamEvent)) uint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nextSubscriberID++
+	t.subscribers[t.nextSubscriberID] = callback
+	return t.nextSubscriberID
+}
+
+func (t *streamTracker) unsubscribe(id uint) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.subscribers[id]; !ok {
+		return fmt.Errorf("stream event subscription %d not found", id)
+	}
+	delete(t.subscribers, id)
+	return nil
+}
+
+// snapshot returns the status of every tracked stream.
+func (t *streamTracker) snapshot() []StreamStatus {
+	t.mu.Lock()
+	statuses := make([]StreamStatus, 0, len(t.streams))
+	for _, stream := range t.streams {
+		statuses = append(statuses, stream.status)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return streamKeyLess(statuses[i].StreamKey, statuses[j].StreamKey)
+	})
+	return statuses
+}
+
+func sortStreamEvents(events []StreamEvent) {
+	sort.Slice(events, func(i, j int) bool {
+		return streamKeyLess(events[i].Stream.StreamKey, events[j].Stream.StreamKey)
+	})
+}
+
+func streamKeyLess(a, b StreamKey) bool {
+	if a.Bus != b.Bus {
+		return a.Bus < b.Bus
+	}
+	if a.PGN != b.PGN {
+		return a.PGN < b.PGN
+	}
+	if a.Source != b.Source {
+		return a.Source < b.Source
+	}
+	if a.HasInstance != b.HasInstance {
+		return !a.HasInstance
+	}
+	return a.Instance < b.Instance
+}
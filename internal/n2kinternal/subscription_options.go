@@ -0,0 +1,343 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/internal/subscribe"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+type subscribeOptions struct {
+	buses        []string
+	sources      []uint8
+	names        []uint64
+	destinations []uint8
+	instances    []uint8
+
+	queueSize int
+	overflow  OverflowPolicy
+
+	minInterval time.Duration
+	keepLatest  bool
+	deadbands   []deadband
+}
+
+type deadband struct {
+	field string
+	delta float64
+}
+
+// OverflowPolicy selects what an asynchronous subscription does when its queue is full.
+type OverflowPolicy = subscribe.OverflowPolicy
+
+const (
+	// DropOldest discards the oldest queued message.
+	DropOldest = subscribe.DropOldest
+	// DropNewest discards the incoming message.
+	DropNewest = subscribe.DropNewest
+	// CoalesceLatest replaces a queued message from the same source and instance.
+	CoalesceLatest = subscribe.CoalesceLatest
+)
+
+// SubscribeOption filters the structs delivered to a typed subscription. Different
+// options must all match; the values given to one option are alternatives.
+type SubscribeOption func(*subscribeOptions)
+
+// FromBus delivers only structs received on one of the named buses of an endpoint with
+// several.
+func FromBus(buses ...string) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.buses = append(options.buses, buses...)
+	}
+}
+
+// FromSource delivers only structs sent from one of the given source addresses.
+func FromSource(addresses ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.sources = append(options.sources, addresses...)
+	}
+}
+
+// FromDevice delivers only structs sent by one of the devices with the given NAMEs.
+// Source addresses are resolved through observed address claims, so the filter follows
+// a device when it claims a new address.
+func FromDevice(names ...uint64) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.names = append(options.names, names...)
+	}
+}
+
+// ToDestination delivers only structs addressed to one of the given destinations.
+func ToDestination(addresses ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.destinations = append(options.destinations, addresses...)
+	}
+}
+
+// ForInstance delivers only structs whose instance field, such as the engine, battery
+// or tank instance, is one of the given values. Structs without an instance field or
+// with the instance not available are not delivered.
+func ForInstance(instances ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.instances = append(options.instances, instances...)
+	}
+}
+
+// WithAsyncDelivery delivers structs to the callback on the subscription's own
+// goroutine through a queue of queueSize structs, applying policy when it is full.
+func WithAsyncDelivery(queueSize int, policy OverflowPolicy) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.queueSize = queueSize
+		options.overflow = policy
+	}
+}
+
+// WithMinInterval delivers at most one struct per source address and instance per
+// interval; structs arriving sooner are dropped unless WithLatestValue is set.
+func WithMinInterval(interval time.Duration) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.minInterval = interval
+	}
+}
+
+// WithLatestValue delivers the latest struct held back by WithMinInterval when the
+// interval ends, from a timer goroutine.
+func WithLatestValue() SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.keepLatest = true
+	}
+}
+
+// WithDeadband delivers a struct only when the named numeric field differs by at least
+// delta from the last struct delivered for the same source address and instance, or
+// becomes available or unavailable. With several deadbands a change in any field is
+// delivered. Unit fields are compared in the unit NMEA 2000 encodes them in.
+func WithDeadband(field string, delta float64) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.deadbands = append(options.deadbands, deadband{field: field, delta: delta})
+	}
+}
+
+// changed returns a function reporting whether a struct of type t moved outside any
+// deadband, or nil when no deadbands are set.
+func (o *subscribeOptions) changed(t reflect.Type) (func(last, next any) bool, error) {
+	if len(o.deadbands) == 0 {
+		return nil, nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("deadband set on non-struct type %s", t)
+	}
+	indexes := make([]int, len(o.deadbands))
+	for i, band := range o.deadbands {
+		field, ok := t.FieldByName(band.field)
+		if !ok || len(field.Index) != 1 {
+			return nil, fmt.Errorf("deadband field %s not found in %s", band.field, t.Name())
+		}
+		if !isNumericFieldType(field.Type) {
+			return nil, fmt.Errorf("deadband field %s.%s is not numeric", t.Name(), band.field)
+		}
+		indexes[i] = field.Index[0]
+	}
+	bands := o.deadbands
+	return func(last, next any) bool {
+		lastValue, nextValue := reflect.ValueOf(last), reflect.ValueOf(next)
+		for i, index := range indexes {
+			a, aOK := numericFieldValue(lastValue.Field(index))
+			b, bOK := numericFieldValue(nextValue.Field(index))
+			if aOK != bOK || (aOK && math.Abs(b-a) >= bands[i].delta) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// isNumericFieldType reports whether a field of type t can carry a deadband.
+func isNumericFieldType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		if t.Elem().Kind() == reflect.Struct {
+			_, err := pgn.BaseUnitValue(reflect.New(t.Elem()).Interface())
+			return err == nil
+		}
+		t = t.Elem()
+	}
+	//nolint:exhaustive // Why: only numeric kinds qualify.
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// numericFieldValue returns the value of a numeric, pointer to numeric, or units field.
+// ok is false when the value is not available.
+func numericFieldValue(v reflect.Value) (float64, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0, false
+		}
+		if v.Elem().Kind() == reflect.Struct {
+			value, err := pgn.BaseUnitValue(v.Interface())
+			return float64(value), err == nil
+		}
+		v = v.Elem()
+	}
+
+	//nolint:exhaustive // Why: only numeric kinds have a value.
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// coalesceKey groups structs of one type by bus, source address and instance. Buses are
+// told apart by a hash of their ID.
+func coalesceKey(p any) uint64 {
+	var key uint64
+	if info, ok := structInfo(p); ok {
+		key = uint64(info.SourceId) << 16
+		if info.Bus != "" {
+			key |= uint64(busHash(info.Bus)) << 32
+		}
+	}
+	if instance, ok := pgn.StructInstance(p); ok {
+		key |= 1<<8 | uint64(instance)
+	}
+	return key
+}
+
+// filter returns a subscription filter for the options, or nil when none are set.
+func (o *subscribeOptions) filter(addresses *addressBook) func(any) bool {
+	if len(o.buses) == 0 && len(o.sources) == 0 && len(o.names) == 0 && len(o.destinations) == 0 &&
+		len(o.instances) == 0 {
+		return nil
+	}
+	options := *o
+	return func(p any) bool {
+		if len(options.instances) > 0 {
+			instance, ok := pgn.StructInstance(p)
+			if !ok || !slices.Contains(options.instances, instance) {
+				return false
+			}
+		}
+		if len(options.buses) == 0 && len(options.sources) == 0 && len(options.names) == 0 &&
+			len(options.destinations) == 0 {
+			return true
+		}
+		info, ok := structInfo(p)
+		if !ok {
+			return false
+		}
+		if len(options.buses) > 0 && !slices.Contains(options.buses, info.Bus) {
+			return false
+		}
+		if len(options.sources) > 0 && !slices.Contains(options.sources, info.SourceId) {
+			return false
+		}
+		if len(options.destinations) > 0 && !slices.Contains(options.destinations, info.TargetId) {
+			return false
+		}
+		if len(options.names) > 0 {
+			name, ok := addresses.name(info.Bus, info.SourceId)
+			if !ok || !slices.Contains(options.names, name) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+var messageInfoType = reflect.TypeFor[publicpgn.MessageInfo]()
+
+// structInfo returns the Info field of a generated or registered PGN struct.
+func structInfo(p any) (publicpgn.MessageInfo, bool) {
+	if info, ok := pgn.StructInfo(p); ok {
+		return info, true
+	}
+	v := reflect.ValueOf(p)
+	if v.Kind() != reflect.Struct {
+		return publicpgn.MessageInfo{}, false
+	}
+	field := v.FieldByName("Info")
+	if !field.IsValid() || field.Type() != messageInfoType {
+		return publicpgn.MessageInfo{}, false
+	}
+	return field.Interface().(publicpgn.MessageInfo), true
+}
+
+// addressBook tracks which device NAME holds each source address of each bus, from
+// observed address claims.
+type addressBook struct {
+	mu     sync.RWMutex
+	names  map[busAddress]uint64
+	byName map[busName]uint8
+}
+
+type busAddress struct {
+	bus     string
+	address uint8
+}
+
+type busName struct {
+	bus  string
+	name uint64
+}
+
+func newAddressBook() *addressBook {
+	return &addressBook{
+		names:  make(map[busAddress]uint64),
+		byName: make(map[busName]uint8),
+	}
+}
+
+// observe records the NAME announced by an address claim. A claim from the null
+// address means the device lost its address.
+func (b *addressBook) observe(claim *publicpgn.ISOAddressClaim) {
+	name := busName{bus: claim.Info.Bus, name: publicpgn.NameFromAddressClaim(claim)}
+	address := busAddress{bus: claim.Info.Bus, address: claim.Info.SourceId}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if previous, ok := b.byName[name]; ok {
+		delete(b.names, busAddress{bus: name.bus, address: previous})
+		delete(b.byName, name)
+	}
+	if address.address > 253 {
+		return
+	}
+	if previousName, ok := b.names[address]; ok {
+		delete(b.byName, busName{bus: address.bus, name: previousName})
+	}
+	b.names[address] = name.name
+	b.byName[name] = address.address
+}
+
+// name returns the NAME last claimed at address on bus.
+func (b *addressBook) name(bus string, address uint8) (uint64, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	name, ok := b.names[busAddress{bus: bus, address: address}]
+	return name, ok
+}
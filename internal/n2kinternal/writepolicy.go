@@ -0,0 +1,315 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2kinternal
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// WriteClass says how much harm writing a PGN can do.
+type WriteClass int
+
+const (
+	// WriteInformational PGNs report state and are always written.
+	WriteInformational WriteClass = iota
+	// WriteControl PGNs operate equipment, such as switching circuits or lights.
+	WriteControl
+	// WriteSafetyCritical PGNs steer, propel or anchor the boat.
+	WriteSafetyCritical
+)
+
+func (c WriteClass) String() string {
+	switch c {
+	case WriteInformational:
+		return "informational"
+	case WriteControl:
+		return "control"
+	case WriteSafetyCritical:
+		return "safety-critical"
+	default:
+		return fmt.Sprintf("WriteClass(%d)", int(c))
+	}
+}
+
+// writeClasses classifies the generated struct types that command other devices. Types
+// are classified rather than PGNs, as manufacturers share proprietary PGN numbers.
+var writeClasses = map[reflect.Type]WriteClass{
+	reflect.TypeFor[publicpgn.SwitchBankControl]():                    WriteControl,
+	reflect.TypeFor[publicpgn.CarlingBreakerCommand]():                WriteControl,
+	reflect.TypeFor[publicpgn.CarlingDCConfigurationCommand]():        WriteControl,
+	reflect.TypeFor[publicpgn.BepMarineCzoneCircuitControl]():         WriteControl,
+	reflect.TypeFor[publicpgn.NavicoNaviopSwitchControl]():            WriteControl,
+	reflect.TypeFor[publicpgn.LoadControllerConnectionStateControl](): WriteControl,
+	reflect.TypeFor[publicpgn.LumishoreLightControl]():                WriteControl,
+	reflect.TypeFor[publicpgn.AirmarHeaterControl]():                  WriteControl,
+	reflect.TypeFor[publicpgn.WebastoHvacCommand]():                   WriteControl,
+	reflect.TypeFor[publicpgn.MaretronDometicHvacControlStatus]():     WriteControl,
+	reflect.TypeFor[publicpgn.MaretronAlertControl]():                 WriteControl,
+	reflect.TypeFor[publicpgn.SeatalkWirelessKeypadLightControl]():    WriteControl,
+	reflect.TypeFor[publicpgn.SeatalkWirelessKeypadControl]():         WriteControl,
+	reflect.TypeFor[publicpgn.NMEACommandGroupFunction]():             WriteControl,
+	reflect.TypeFor[publicpgn.ISOCommandedAddress]():                  WriteControl,
+	reflect.TypeFor[publicpgn.AISAssignmentModeCommand]():             WriteControl,
+
+	reflect.TypeFor[publicpgn.HeadingTrackControl]():            WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.GarminAutopilotRateOfTurnOrder](): WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.GarminAutopilotTurnAngleOrder]():  WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.GarminAutopilotManeuver]():        WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SeatalkPilotMode]():               WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SeatalkPilotLockedHeading]():      WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SeatalkPilotAutoTurn]():           WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SeatalkKeypadMessage]():           WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApStandby]():         WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApNodrift]():         WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApWind]():            WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApNav]():             WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApHeading]():         WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApTack]():            WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApFollowUp]():        WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetCommandApChangeCourse]():    WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SimnetApCommand]():                WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.WindlassControlStatus]():          WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.MaretronWindlassControlCommand](): WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.ThrusterControlStatus]():          WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.YanmarThrottleControl]():          WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.SuzukiTrollModeControl]():         WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.ElevatorMotorControl]():           WriteSafetyCritical,
+	reflect.TypeFor[publicpgn.LinearActuatorControlStatus]():    WriteSafetyCritical,
+}
+
+// generatedPGNPkgPath is the package of the generated PGN structs.
+var generatedPGNPkgPath = reflect.TypeFor[publicpgn.MessageInfo]().PkgPath()
+
+// ClassifyWrite returns the default class of a PGN struct or a pointer to one. A type
+// registered with RegisterPGN takes the class of its PGN number, so an application's own
+// struct for a control PGN is guarded like the generated one.
+func ClassifyWrite(pgnStruct any) WriteClass {
+	return classifyWrite(pgnStruct, nil)
+}
+
+// classifyWrite is ClassifyWrite for a struct already encoded with info, or not yet
+// encoded when info is nil.
+func classifyWrite(pgnStruct any, info *publicpgn.MessageInfo) WriteClass {
+	t := reflect.TypeOf(pgnStruct)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if class, ok := writeClasses[t]; ok || t == nil || t.PkgPath() == generatedPGNPkgPath {
+		return class
+	}
+	if info == nil {
+		encoded, err := pgn.EncodedInfo(pgnStruct)
+		if err != nil {
+			return WriteInformational
+		}
+		info = &encoded
+	}
+	return ClassifyPGN(info.PGN)
+}
+
+// pgnWriteClasses is the highest class of the types of each PGN in writeClasses.
+var pgnWriteClasses = sync.OnceValue(func() map[uint32]WriteClass {
+	classes := make(map[uint32]WriteClass, len(writeClasses))
+	for t, class := range writeClasses {
+		info, err := pgn.EncodedInfo(reflect.New(t).Interface())
+		if err == nil && class > classes[info.PGN] {
+			classes[info.PGN] = class
+		}
+	}
+	return classes
+})
+
+// ClassifyPGN returns the highest class of the struct types of a PGN, for registered types
+// and traffic that has not been decoded. Manufacturers share proprietary PGNs, so some of
+// their other messages are classified as control too.
+func ClassifyPGN(pgnNumber uint32) WriteClass {
+	return pgnWriteClasses()[pgnNumber]
+}
+
+type callerTagKey struct{}
+
+// WithCallerTag returns a context naming the caller writing with it, for the allowlists
+// of a WritePolicy and its audit log.
+func WithCallerTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, callerTagKey{}, tag)
+}
+
+// CallerTag returns the caller tag of ctx, empty when it has none.
+func CallerTag(ctx context.Context) string {
+	tag, _ := ctx.Value(callerTagKey{}).(string)
+	return tag
+}
+
+type writeAuditedKey struct{}
+
+// MarkWriteAudited returns a context noting that a write has already been authorized and
+// audited by another guard, such as a node's. A guard given it still refuses the writes
+// its own policy denies, but does not audit the ones it allows a second time.
+func MarkWriteAudited(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writeAuditedKey{}, true)
+}
+
+// WritePolicy restricts the writes of control and safety-critical PGNs. Informational
+// PGNs are always written. A control write needs a rule allowing it, and a
+// safety-critical write a rule that also sets SafetyCritical; with no rules, neither is
+// written.
+type WritePolicy struct {
+	// Classes overrides the class of PGNs, such as to protect a proprietary PGN defined
+	// with RegisterPGN that no generated type classifies.
+	Classes map[uint32]WriteClass
+	// Allow lists the control and safety-critical writes that are permitted.
+	Allow []WriteRule
+	// DryRun writes everything, auditing the writes that would be denied.
+	DryRun bool
+	// Audit is given each control and safety-critical write, allowed or denied. When nil,
+	// writes are logged instead.
+	Audit func(WriteAudit)
+}
+
+// WriteRule allows writes matching all of its lists. An empty list matches anything.
+type WriteRule struct {
+	PGNs         []uint32
+	Destinations []uint8
+	// Tags are the caller tags allowed, set with WithCallerTag.
+	Tags []string
+	// SafetyCritical allows safety-critical PGNs as well as control ones.
+	SafetyCritical bool
+}
+
+// WriteAudit records a control or safety-critical write.
+type WriteAudit struct {
+	Time time.Time
+	PGN  uint32
+	// Type is the name of the struct written.
+	Type        string
+	Class       WriteClass
+	Source      uint8
+	Destination uint8
+	Tag         string
+	Allowed     bool
+	// DryRun is set when a denied write was written because the policy is a dry run.
+	DryRun bool
+}
+
+// WriteDeniedError reports a write refused by a WritePolicy.
+type WriteDeniedError struct {
+	PGN         uint32
+	Type        string
+	Class       WriteClass
+	Destination uint8
+	Tag         string
+}
+
+func (e *WriteDeniedError) Error() string {
+	return fmt.Sprintf("write of %s PGN %d (%s) to 0x%02x by %q denied by write policy",
+		e.Class, e.PGN, e.Type, e.Destination, e.Tag)
+}
+
+// WriteGuard applies a WritePolicy, logging its audit entries when the policy has no
+// Audit function.
+type WriteGuard struct {
+	policy WritePolicy
+	log    *slog.Logger
+	clock  clock.Clock
+}
+
+// NewWriteGuard returns a guard applying policy. A nil log uses slog.Default(), and a nil
+// clock the wall clock.
+func NewWriteGuard(policy WritePolicy, log *slog.Logger, clk clock.Clock) *WriteGuard {
+	policy.Allow = slices.Clone(policy.Allow)
+	return &WriteGuard{policy: policy, log: logging.OrDefault(log), clock: clock.OrReal(clk)}
+}
+
+// Authorize returns a *WriteDeniedError when the policy refuses the write of pgnStruct
+// by the caller tagged in ctx.
+func (g *WriteGuard) Authorize(ctx context.Context, pgnStruct any) error {
+	info, err := pgn.EncodedInfo(pgnStruct)
+	if err != nil {
+		return err
+	}
+	return g.authorize(ctx, pgnStruct, info)
+}
+
+// authorize is Authorize for a struct already encoded with info.
+func (g *WriteGuard) authorize(ctx context.Context, pgnStruct any, info publicpgn.MessageInfo) error {
+	class, ok := g.policy.Classes[info.PGN]
+	if !ok {
+		class = classifyWrite(pgnStruct, &info)
+	}
+	if class == WriteInformational {
+		return nil
+	}
+	tag := CallerTag(ctx)
+	allowed := g.allows(class, info.PGN, info.TargetId, tag)
+	if allowed && ctx.Value(writeAuditedKey{}) != nil {
+		return nil
+	}
+	audit := WriteAudit{
+		Time:        g.clock.Now(),
+		PGN:         info.PGN,
+		Type:        reflect.TypeOf(pgnStruct).String(),
+		Class:       class,
+		Source:      info.SourceId,
+		Destination: info.TargetId,
+		Tag:         tag,
+		Allowed:     allowed,
+		DryRun:      !allowed && g.policy.DryRun,
+	}
+	g.record(audit)
+	if allowed || g.policy.DryRun {
+		return nil
+	}
+	return &WriteDeniedError{PGN: info.PGN, Type: audit.Type, Class: class, Destination: info.TargetId, Tag: tag}
+}
+
+func (g *WriteGuard) allows(class WriteClass, pgnNumber uint32, destination uint8, tag string) bool {
+	for _, rule := range g.policy.Allow {
+		if class == WriteSafetyCritical && !rule.SafetyCritical {
+			continue
+		}
+		if len(rule.PGNs) > 0 && !slices.Contains(rule.PGNs, pgnNumber) {
+			continue
+		}
+		if len(rule.Destinations) > 0 && !slices.Contains(rule.Destinations, destination) {
+			continue
+		}
+		if len(rule.Tags) > 0 && !slices.Contains(rule.Tags, tag) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (g *WriteGuard) record(audit WriteAudit) {
+	if g.policy.Audit != nil {
+		g.policy.Audit(audit)
+		return
+	}
+	attrs := []any{logging.PGN(audit.PGN), logging.Source(audit.Source), logging.Destination(audit.Destination),
+		"type", audit.Type, "class", audit.Class.String(), "tag", audit.Tag}
+	switch {
+	case audit.Allowed:
+		g.log.Info("Control write allowed", attrs...)
+	case audit.DryRun:
+		g.log.Warn("Control write would be denied", attrs...)
+	default:
+		g.log.Warn("Control write denied", attrs...)
+	}
+}
@@ -0,0 +1,148 @@
+package n2kinternal
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func switchOn(destination uint8) *publicpgn.SwitchBankControl {
+	instance := uint8(1)
+	return &publicpgn.SwitchBankControl{
+		Info:     publicpgn.MessageInfo{SourceId: 3, TargetId: destination},
+		Instance: &instance,
+		Switch1:  publicpgn.On_2,
+	}
+}
+
+func TestClassifyWrite(t *testing.T) {
+	assert.Equal(t, WriteControl, ClassifyWrite(publicpgn.SwitchBankControl{}))
+	assert.Equal(t, WriteControl, ClassifyWrite(&publicpgn.BepMarineCzoneCircuitControl{}))
+	assert.Equal(t, WriteSafetyCritical, ClassifyWrite(publicpgn.HeadingTrackControl{}))
+	assert.Equal(t, WriteSafetyCritical, ClassifyWrite(&publicpgn.SimnetCommandApHeading{}))
+	assert.Equal(t, WriteInformational, ClassifyWrite(publicpgn.VesselHeading{}))
+	assert.Equal(t, WriteInformational, ClassifyWrite(nil))
+
+	assert.Equal(t, WriteControl, ClassifyPGN(publicpgn.SwitchBankControlPGN))
+	assert.Equal(t, WriteSafetyCritical, ClassifyPGN(publicpgn.HeadingTrackControlPGN))
+	assert.Equal(t, WriteInformational, ClassifyPGN(publicpgn.VesselHeadingPGN))
+}
+
+func TestWritePolicyAllowlists(t *testing.T) {
+	var audits []WriteAudit
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(), WithWritePolicy(WritePolicy{
+		Allow: []WriteRule{
+			{PGNs: []uint32{publicpgn.SwitchBankControlPGN}, Destinations: []uint8{0x20}, Tags: []string{"panel"}},
+			{Tags: []string{"autopilot"}, SafetyCritical: true},
+		},
+		Audit: func(audit WriteAudit) { audits = append(audits, audit) },
+	}))
+
+	// Informational PGNs are written without being audited
+	require.NoError(t, s.Write(headingStruct()))
+	assert.Len(t, ep.frames, 1)
+	assert.Empty(t, audits)
+
+	// Untagged, to another destination, and by another caller are all denied
+	panel := WithCallerTag(context.Background(), "panel")
+	var denied *WriteDeniedError
+	require.ErrorAs(t, s.Write(switchOn(0x20)), &denied)
+	assert.Equal(t, WriteControl, denied.Class)
+	assert.Equal(t, uint32(publicpgn.SwitchBankControlPGN), denied.PGN)
+	assert.ErrorAs(t, s.WriteContext(panel, switchOn(0x21)), &denied)
+	assert.ErrorAs(t, s.WriteContext(WithCallerTag(context.Background(), "ui"), switchOn(0x20)), &denied)
+	assert.Len(t, ep.frames, 1)
+
+	require.NoError(t, s.WriteContext(panel, switchOn(0x20)))
+	assert.Len(t, ep.frames, 2)
+
+	// Safety-critical writes need a rule allowing them explicitly
+	command := &publicpgn.HeadingTrackControl{Info: publicpgn.MessageInfo{SourceId: 3, TargetId: 0x40}}
+	assert.ErrorAs(t, s.WriteContext(panel, command), &denied)
+	assert.Equal(t, WriteSafetyCritical, denied.Class)
+	require.NoError(t, s.WriteContext(WithCallerTag(context.Background(), "autopilot"), command))
+
+	require.Len(t, audits, 6)
+	assert.Equal(t, []bool{false, false, false, true, false, true},
+		[]bool{audits[0].Allowed, audits[1].Allowed, audits[2].Allowed, audits[3].Allowed, audits[4].Allowed, audits[5].Allowed})
+	assert.Equal(t, WriteAudit{
+		Time:        audits[3].Time,
+		PGN:         publicpgn.SwitchBankControlPGN,
+		Type:        "*pgn.SwitchBankControl",
+		Class:       WriteControl,
+		Source:      3,
+		Destination: 0x20,
+		Tag:         "panel",
+		Allowed:     true,
+	}, audits[3])
+	assert.False(t, audits[3].Time.IsZero())
+}
+
+func TestWritePolicyDryRunAndClassOverrides(t *testing.T) {
+	var audits []WriteAudit
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(), WithWritePolicy(WritePolicy{
+		Classes: map[uint32]WriteClass{publicpgn.VesselHeadingPGN: WriteControl},
+		DryRun:  true,
+		Audit:   func(audit WriteAudit) { audits = append(audits, audit) },
+	}))
+
+	require.NoError(t, s.Write(switchOn(0x20)))
+	require.NoError(t, s.Write(headingStruct()))
+	assert.Len(t, ep.frames, 2)
+	require.Len(t, audits, 2)
+	for _, audit := range audits {
+		assert.False(t, audit.Allowed)
+		assert.True(t, audit.DryRun)
+	}
+	assert.Equal(t, uint32(publicpgn.VesselHeadingPGN), audits[1].PGN)
+}
+
+// autopilotCommand is an application's own struct for the Heading/Track Control PGN.
+type autopilotCommand struct {
+	Info    publicpgn.MessageInfo
+	Heading uint16
+}
+
+var registerAutopilotCommand = sync.OnceValue(func() error {
+	return pgn.RegisterPGN(pgn.RegisteredPGN{
+		PGN:  publicpgn.HeadingTrackControlPGN,
+		Type: reflect.TypeFor[autopilotCommand](),
+		Fast: true,
+		Decode: func(info publicpgn.MessageInfo, data []uint8) (any, error) {
+			return autopilotCommand{Info: info}, nil
+		},
+		Encode: func(v any) (*publicpgn.MessageInfo, []uint8, error) {
+			msg := *v.(*autopilotCommand)
+			return &msg.Info, []uint8{uint8(msg.Heading), uint8(msg.Heading >> 8)}, nil
+		},
+	})
+})
+
+func TestWritePolicyGuardsRegisteredTypesByPGN(t *testing.T) {
+	require.NoError(t, registerAutopilotCommand())
+	command := &autopilotCommand{Info: publicpgn.MessageInfo{SourceId: 3, TargetId: 0x40}, Heading: 90}
+	assert.Equal(t, WriteSafetyCritical, ClassifyWrite(command))
+
+	var audits []WriteAudit
+	ep := &writeTestEndpoint{}
+	s := NewN2kService(ep, slog.Default(), WithWritePolicy(WritePolicy{
+		Allow: []WriteRule{{Tags: []string{"panel"}}},
+		Audit: func(audit WriteAudit) { audits = append(audits, audit) },
+	}))
+	var denied *WriteDeniedError
+	require.ErrorAs(t, s.WriteContext(WithCallerTag(context.Background(), "panel"), command), &denied)
+	assert.Equal(t, WriteSafetyCritical, denied.Class)
+	assert.Empty(t, ep.frames)
+	require.Len(t, audits, 1)
+	assert.Equal(t, "*n2kinternal.autopilotCommand", audits[0].Type)
+}
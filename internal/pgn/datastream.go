@@ -9,6 +9,7 @@ package pgn
 
 import (
 	"math"
+	"sync"
 )
 
 // DataStream instances provide methods to read/write data types to/from a stream.
@@ -19,6 +20,10 @@ type DataStream struct {
 
 	byteOffset uint16
 	bitOffset  uint8
+
+	// entryEnds, when set, collects the bit offset after each entry of repeating sets 1
+	// and 2, so Validate can tell how many entries fit from a single encode.
+	entryEnds *[2][]uint32
 }
 
 // GetData returns the DataStream's current contents
@@ -35,11 +40,35 @@ func NewDataStream(data []uint8) *DataStream {
 	}
 }
 
+var dataStreamPool = sync.Pool{New: func() any { return new(DataStream) }}
+
+// AcquireDataStream returns a pooled DataStream reading data, so decoding does not
+// allocate one per message. Return it with ReleaseDataStream once decoding is done.
+func AcquireDataStream(data []uint8) *DataStream {
+	s := dataStreamPool.Get().(*DataStream)
+	*s = DataStream{data: data}
+	return s
+}
+
+// ReleaseDataStream returns a DataStream from AcquireDataStream to the pool.
+func ReleaseDataStream(s *DataStream) {
+	s.data = nil
+	dataStreamPool.Put(s)
+}
+
 // getBitOffset method returns the cursor in bits.
 func (s *DataStream) getBitOffset() uint32 {
 	return uint32(s.byteOffset)*8 + uint32(s.bitOffset)
 }
 
+// endRepeatingEntry records the end of an entry of repeating set 1 or 2 when the stream
+// collects entry ends.
+func (s *DataStream) endRepeatingEntry(set int) {
+	if s.entryEnds != nil {
+		s.entryEnds[set-1] = append(s.entryEnds[set-1], s.getBitOffset())
+	}
+}
+
 // resetToStart method resets the stream. Used for testing.
 func (s *DataStream) resetToStart() {
 	s.byteOffset = 0
@@ -21734,12 +21734,18 @@ var PgnDiscriminatorMap = map[uint32][]PgnDiscriminator{
 
 // HasDecoder reports whether this runtime has at least one decoder for pgn.
 func HasDecoder(pgn uint32) bool {
-	_, exists := KnownPGNs[pgn]
-	return exists
+	if _, exists := KnownPGNs[pgn]; exists {
+		return true
+	}
+	return hasRegisteredDecoder(pgn)
 }
 
 // FindDecoder finds the appropriate decoder for a PGN based on match field values
 func FindDecoder(stream *DataStream, pgn uint32) (func(MessageInfo, *DataStream) (any, error), error) {
+	if decoder, ok := findRegisteredDecoder(stream, pgn); ok {
+		return decoder, nil
+	}
+
 	discriminators, exists := PgnDiscriminatorMap[pgn]
 	if !exists {
 		return nil, fmt.Errorf("no decoder found for PGN %d", pgn)
@@ -2,13 +2,23 @@
 package pgn
 
 import (
-	"fmt"
-
 	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 )
 
 // EncodeStruct encodes a public PGN struct to NMEA 2000 wire format.
+// When the struct's Info carries the payload it was decoded from, bits not affected
+// by field changes are copied from that payload.
 func EncodeStruct(s any, stream *DataStream) (*publicpgn.MessageInfo, error) {
+	info, err := encodeStructFields(s, stream)
+	if err != nil || info == nil || len(info.Payload()) == 0 {
+		return info, err
+	}
+	restoreOriginalPayload(s, info, stream)
+	return info, nil
+}
+
+// encodeStructFields encodes a public PGN struct from its field values alone.
+func encodeStructFields(s any, stream *DataStream) (*publicpgn.MessageInfo, error) {
 	switch p := s.(type) {
 	case *publicpgn.ZeroXe8000Xee00StandardizedSingleFrameAddressed:
 		return EncodeZeroXe8000Xee00StandardizedSingleFrameAddressed(p, stream)
@@ -2459,6 +2469,6 @@ func EncodeStruct(s any, stream *DataStream) (*publicpgn.MessageInfo, error) {
 	case publicpgn.FusionMenuCount:
 		return EncodeFusionMenuCount(&p, stream)
 	default:
-		return nil, fmt.Errorf("trying to encode a struct that isn't a PGN: %T", s)
+		return encodeRegisteredStruct(s, stream)
 	}
 }
@@ -4924,6 +4924,7 @@ func encodeNMEARequestGroupFunctionRepeating1(p *publicpgn.NMEARequestGroupFunct
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -4979,6 +4980,7 @@ func encodeNMEACommandGroupFunctionRepeating1(p *publicpgn.NMEACommandGroupFunct
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5030,6 +5032,7 @@ func encodeNMEAAcknowledgeGroupFunctionRepeating1(p *publicpgn.NMEAAcknowledgeGr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5101,6 +5104,7 @@ func encodeNMEAReadFieldsGroupFunctionRepeating1(p *publicpgn.NMEAReadFieldsGrou
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5114,6 +5118,7 @@ func encodeNMEAReadFieldsGroupFunctionRepeating2(p *publicpgn.NMEAReadFieldsGrou
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(2)
     }
     return nil
 }
@@ -5185,6 +5190,7 @@ func encodeNMEAReadFieldsReplyGroupFunctionRepeating1(p *publicpgn.NMEAReadField
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5202,6 +5208,7 @@ func encodeNMEAReadFieldsReplyGroupFunctionRepeating2(p *publicpgn.NMEAReadField
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(2)
     }
     return nil
 }
@@ -5273,6 +5280,7 @@ func encodeNMEAWriteFieldsGroupFunctionRepeating1(p *publicpgn.NMEAWriteFieldsGr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5290,6 +5298,7 @@ func encodeNMEAWriteFieldsGroupFunctionRepeating2(p *publicpgn.NMEAWriteFieldsGr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(2)
     }
     return nil
 }
@@ -5361,6 +5370,7 @@ func encodeNMEAWriteFieldsReplyGroupFunctionRepeating1(p *publicpgn.NMEAWriteFie
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -5378,6 +5388,7 @@ func encodeNMEAWriteFieldsReplyGroupFunctionRepeating2(p *publicpgn.NMEAWriteFie
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(2)
     }
     return nil
 }
@@ -5410,6 +5421,7 @@ func encodePGNListTransmitAndReceiveRepeating1(p *publicpgn.PGNListTransmitAndRe
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -7152,6 +7164,7 @@ func encodeAirmarCalibrateSpeedRepeating1(p *publicpgn.AirmarCalibrateSpeed, str
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -8149,6 +8162,7 @@ func encodeAlertThresholdRepeating1(p *publicpgn.AlertThreshold, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -8225,6 +8239,7 @@ func encodeAlertValueRepeating1(p *publicpgn.AlertValue, stream *DataStream) err
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -9528,6 +9543,7 @@ func encodeACInputStatusRepeating1(p *publicpgn.ACInputStatus, stream *DataStrea
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -9600,6 +9616,7 @@ func encodeACOutputStatusRepeating1(p *publicpgn.ACOutputStatus, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -11276,6 +11293,7 @@ func encodeGNSSPositionDataRepeating1(p *publicpgn.GNSSPositionData, stream *Dat
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -11979,6 +11997,7 @@ func encodeNavigationRouteWPInformationRepeating1(p *publicpgn.NavigationRouteWP
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -12258,6 +12277,7 @@ func encodeGNSSSatsInViewRepeating1(p *publicpgn.GNSSSatsInView, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -13069,6 +13089,7 @@ func encodeAISAcknowledgeRepeating1(p *publicpgn.AISAcknowledge, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -13593,6 +13614,7 @@ func encodeAISDataLinkManagementMessageRepeating1(p *publicpgn.AISDataLinkManage
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -13895,6 +13917,7 @@ func encodeDSCDistressCallInformationRepeating1(p *publicpgn.DSCDistressCallInfo
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -14007,6 +14030,7 @@ func encodeDSCCallInformationRepeating1(p *publicpgn.DSCCallInformation, stream
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -14276,6 +14300,7 @@ func encodeAISMultiSlotBinaryMessageDeprecatedRepeating1(p *publicpgn.AISMultiSl
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -14557,6 +14582,7 @@ func encodeAISAcknowledgeBinaryRepeating1(p *publicpgn.AISAcknowledgeBinary, str
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -14900,6 +14926,7 @@ func encodeRouteAndWPServiceDatabaseListRepeating1(p *publicpgn.RouteAndWPServic
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -14960,6 +14987,7 @@ func encodeRouteAndWPServiceRouteListRepeating1(p *publicpgn.RouteAndWPServiceRo
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15079,6 +15107,7 @@ func encodeRouteAndWPServiceRouteWPNamePositionRepeating1(p *publicpgn.RouteAndW
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15131,6 +15160,7 @@ func encodeRouteAndWPServiceRouteWPNameRepeating1(p *publicpgn.RouteAndWPService
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15191,6 +15221,7 @@ func encodeRouteAndWPServiceXTELimitNavigationMethodRepeating1(p *publicpgn.Rout
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15243,6 +15274,7 @@ func encodeRouteAndWPServiceWPCommentRepeating1(p *publicpgn.RouteAndWPServiceWP
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15291,6 +15323,7 @@ func encodeRouteAndWPServiceRouteCommentRepeating1(p *publicpgn.RouteAndWPServic
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15335,6 +15368,7 @@ func encodeRouteAndWPServiceDatabaseCommentRepeating1(p *publicpgn.RouteAndWPSer
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15387,6 +15421,7 @@ func encodeRouteAndWPServiceRadiusOfTurnRepeating1(p *publicpgn.RouteAndWPServic
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -15447,6 +15482,7 @@ func encodeRouteAndWPServiceWPListWPNamePositionRepeating1(p *publicpgn.RouteAnd
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -16378,6 +16414,7 @@ func encodeLightingSceneRepeating1(p *publicpgn.LightingScene, stream *DataStrea
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -16505,6 +16542,7 @@ func encodeLightingDeviceEnumerationRepeating1(p *publicpgn.LightingDeviceEnumer
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -16561,6 +16599,7 @@ func encodeLightingColorSequenceRepeating1(p *publicpgn.LightingColorSequence, s
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -16952,6 +16991,7 @@ func encodeLibraryDataGroupRepeating1(p *publicpgn.LibraryDataGroup, stream *Dat
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -17075,6 +17115,7 @@ func encodeSupportedSourceDataRepeating1(p *publicpgn.SupportedSourceData, strea
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -17119,6 +17160,7 @@ func encodeSupportedZoneDataRepeating1(p *publicpgn.SupportedZoneData, stream *D
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -17377,6 +17419,7 @@ func encodeZoneConfigurationDeprecatedRepeating1(p *publicpgn.ZoneConfigurationD
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -17460,6 +17503,7 @@ func encodeAvailableAudioEQPresetsRepeating1(p *publicpgn.AvailableAudioEQPreset
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -17512,6 +17556,7 @@ func encodeAvailableBluetoothAddressesRepeating1(p *publicpgn.AvailableBluetooth
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -18871,6 +18916,7 @@ func encodeMaretronAnnunciatorCapabilitiesRepeating1(p *publicpgn.MaretronAnnunc
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -20551,6 +20597,7 @@ func encodeFusionSettingsRepeating1(p *publicpgn.FusionSettings, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -21958,6 +22005,7 @@ func encodeNavicoUdbDatabaseObjectDumpRepeating1(p *publicpgn.NavicoUdbDatabaseO
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -22265,6 +22313,7 @@ func encodeNavicoDataTypeSourceDirectoryRepeating1(p *publicpgn.NavicoDataTypeSo
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -22341,6 +22390,7 @@ func encodeNavicoDataTypeSourceDirectoryFullReportRepeating1(p *publicpgn.Navico
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -22440,6 +22490,7 @@ func encodeBGKeyValueDataRepeating1(p *publicpgn.BGKeyValueData, stream *DataStr
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -22535,6 +22586,7 @@ func encodeMercuryEngineKeyValueDataRepeating1(p *publicpgn.MercuryEngineKeyValu
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -22790,6 +22842,7 @@ func encodeMaretronSwitchIndicatorStatusRepeating1(p *publicpgn.MaretronSwitchIn
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -24559,6 +24612,7 @@ func encodeFurunoMultiSatsInViewExtendedRepeating1(p *publicpgn.FurunoMultiSatsI
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -25709,6 +25763,7 @@ func encodeNavicoDiagnosticDataRepeating1(p *publicpgn.NavicoDiagnosticData, str
         if err != nil {
             return err
         }
+        stream.endRepeatingEntry(1)
     }
     return nil
 }
@@ -0,0 +1,6709 @@
+// Code generated by "cmd/pgngen"; DO NOT EDIT.
+package pgn
+
+// fieldSpecIndex maps "<PGN struct>.<field>" to the generated FieldSpec for that field.
+// Fields of repeating sets use the parent PGN struct name.
+var fieldSpecIndex = map[string]*FieldSpec{
+    "ISOAcknowledgement.GroupFunction": &fieldSpec_ISOAcknowledgement_GroupFunction,
+    "ISOAcknowledgement.PGN": &fieldSpec_ISOAcknowledgement_PGN,
+    "ISORequest.PGN": &fieldSpec_ISORequest_PGN,
+    "ISOTransportProtocolDataTransfer.SID": &fieldSpec_ISOTransportProtocolDataTransfer_SID,
+    "ISOTransportProtocolConnectionManagementRequestToSend.GroupFunctionCode": &fieldSpec_ISOTransportProtocolConnectionManagementRequestToSend_GroupFunctionCode,
+    "ISOTransportProtocolConnectionManagementRequestToSend.MessageSize": &fieldSpec_ISOTransportProtocolConnectionManagementRequestToSend_MessageSize,
+    "ISOTransportProtocolConnectionManagementRequestToSend.Packets": &fieldSpec_ISOTransportProtocolConnectionManagementRequestToSend_Packets,
+    "ISOTransportProtocolConnectionManagementRequestToSend.PacketsReply": &fieldSpec_ISOTransportProtocolConnectionManagementRequestToSend_PacketsReply,
+    "ISOTransportProtocolConnectionManagementRequestToSend.PGN": &fieldSpec_ISOTransportProtocolConnectionManagementRequestToSend_PGN,
+    "ISOTransportProtocolConnectionManagementClearToSend.GroupFunctionCode": &fieldSpec_ISOTransportProtocolConnectionManagementClearToSend_GroupFunctionCode,
+    "ISOTransportProtocolConnectionManagementClearToSend.MaxPackets": &fieldSpec_ISOTransportProtocolConnectionManagementClearToSend_MaxPackets,
+    "ISOTransportProtocolConnectionManagementClearToSend.NextSID": &fieldSpec_ISOTransportProtocolConnectionManagementClearToSend_NextSID,
+    "ISOTransportProtocolConnectionManagementClearToSend.PGN": &fieldSpec_ISOTransportProtocolConnectionManagementClearToSend_PGN,
+    "ISOTransportProtocolConnectionManagementEndOfMessage.GroupFunctionCode": &fieldSpec_ISOTransportProtocolConnectionManagementEndOfMessage_GroupFunctionCode,
+    "ISOTransportProtocolConnectionManagementEndOfMessage.TotalMessageSize": &fieldSpec_ISOTransportProtocolConnectionManagementEndOfMessage_TotalMessageSize,
+    "ISOTransportProtocolConnectionManagementEndOfMessage.TotalNumberOfFramesReceived": &fieldSpec_ISOTransportProtocolConnectionManagementEndOfMessage_TotalNumberOfFramesReceived,
+    "ISOTransportProtocolConnectionManagementEndOfMessage.PGN": &fieldSpec_ISOTransportProtocolConnectionManagementEndOfMessage_PGN,
+    "ISOTransportProtocolConnectionManagementBroadcastAnnounce.GroupFunctionCode": &fieldSpec_ISOTransportProtocolConnectionManagementBroadcastAnnounce_GroupFunctionCode,
+    "ISOTransportProtocolConnectionManagementBroadcastAnnounce.MessageSize": &fieldSpec_ISOTransportProtocolConnectionManagementBroadcastAnnounce_MessageSize,
+    "ISOTransportProtocolConnectionManagementBroadcastAnnounce.Packets": &fieldSpec_ISOTransportProtocolConnectionManagementBroadcastAnnounce_Packets,
+    "ISOTransportProtocolConnectionManagementBroadcastAnnounce.PGN": &fieldSpec_ISOTransportProtocolConnectionManagementBroadcastAnnounce_PGN,
+    "ISOTransportProtocolConnectionManagementAbort.GroupFunctionCode": &fieldSpec_ISOTransportProtocolConnectionManagementAbort_GroupFunctionCode,
+    "ISOTransportProtocolConnectionManagementAbort.PGN": &fieldSpec_ISOTransportProtocolConnectionManagementAbort_PGN,
+    "ISOAddressClaim.UniqueNumber": &fieldSpec_ISOAddressClaim_UniqueNumber,
+    "ISOAddressClaim.DeviceInstanceLower": &fieldSpec_ISOAddressClaim_DeviceInstanceLower,
+    "ISOAddressClaim.DeviceInstanceUpper": &fieldSpec_ISOAddressClaim_DeviceInstanceUpper,
+    "ISOAddressClaim.SystemInstance": &fieldSpec_ISOAddressClaim_SystemInstance,
+    "SeatalkWirelessKeypadLightControl.ManufacturerCode": &fieldSpec_SeatalkWirelessKeypadLightControl_ManufacturerCode,
+    "SeatalkWirelessKeypadLightControl.IndustryCode": &fieldSpec_SeatalkWirelessKeypadLightControl_IndustryCode,
+    "SeatalkWirelessKeypadLightControl.ProprietaryID": &fieldSpec_SeatalkWirelessKeypadLightControl_ProprietaryID,
+    "SeatalkWirelessKeypadLightControl.Variant": &fieldSpec_SeatalkWirelessKeypadLightControl_Variant,
+    "SeatalkWirelessKeypadLightControl.WirelessSetting": &fieldSpec_SeatalkWirelessKeypadLightControl_WirelessSetting,
+    "SeatalkWirelessKeypadLightControl.WiredSetting": &fieldSpec_SeatalkWirelessKeypadLightControl_WiredSetting,
+    "SeatalkWirelessKeypadControl.ManufacturerCode": &fieldSpec_SeatalkWirelessKeypadControl_ManufacturerCode,
+    "SeatalkWirelessKeypadControl.IndustryCode": &fieldSpec_SeatalkWirelessKeypadControl_IndustryCode,
+    "SeatalkWirelessKeypadControl.Pid": &fieldSpec_SeatalkWirelessKeypadControl_Pid,
+    "SeatalkWirelessKeypadControl.Variant": &fieldSpec_SeatalkWirelessKeypadControl_Variant,
+    "SeatalkWirelessKeypadControl.BeepControl": &fieldSpec_SeatalkWirelessKeypadControl_BeepControl,
+    "VictronVeCANRegister.ManufacturerCode": &fieldSpec_VictronVeCANRegister_ManufacturerCode,
+    "VictronVeCANRegister.IndustryCode": &fieldSpec_VictronVeCANRegister_IndustryCode,
+    "VictronVeCANRegister.RegisterID": &fieldSpec_VictronVeCANRegister_RegisterID,
+    "CarlingBreakerCommand.ManufacturerCode": &fieldSpec_CarlingBreakerCommand_ManufacturerCode,
+    "CarlingBreakerCommand.IndustryCode": &fieldSpec_CarlingBreakerCommand_IndustryCode,
+    "CarlingBreakerCommand.MessageType": &fieldSpec_CarlingBreakerCommand_MessageType,
+    "CarlingBreakerCommand.BreakerMapping1": &fieldSpec_CarlingBreakerCommand_BreakerMapping1,
+    "CarlingBreakerCommand.BreakerMapping2": &fieldSpec_CarlingBreakerCommand_BreakerMapping2,
+    "CarlingBreakerCommand.BreakerMapping3": &fieldSpec_CarlingBreakerCommand_BreakerMapping3,
+    "CarlingBreakerCommand.BreakerCommand": &fieldSpec_CarlingBreakerCommand_BreakerCommand,
+    "CarlingBreakerCommand.DimValue": &fieldSpec_CarlingBreakerCommand_DimValue,
+    "SimnetKeepAlive.ManufacturerCode": &fieldSpec_SimnetKeepAlive_ManufacturerCode,
+    "SimnetKeepAlive.IndustryCode": &fieldSpec_SimnetKeepAlive_IndustryCode,
+    "SimnetKeepAlive.Command": &fieldSpec_SimnetKeepAlive_Command,
+    "SimnetKeepAlive.Reply": &fieldSpec_SimnetKeepAlive_Reply,
+    "Bus1PhaseCBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_Bus1PhaseCBasicACQuantities_LineLineACRMSVoltage,
+    "Bus1PhaseCBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_Bus1PhaseCBasicACQuantities_LineNeutralACRMSVoltage,
+    "Bus1PhaseCBasicACQuantities.ACFrequency": &fieldSpec_Bus1PhaseCBasicACQuantities_ACFrequency,
+    "Bus1PhaseBBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_Bus1PhaseBBasicACQuantities_LineLineACRMSVoltage,
+    "Bus1PhaseBBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_Bus1PhaseBBasicACQuantities_LineNeutralACRMSVoltage,
+    "Bus1PhaseBBasicACQuantities.ACFrequency": &fieldSpec_Bus1PhaseBBasicACQuantities_ACFrequency,
+    "Bus1PhaseABasicACQuantities.LineLineACRMSVoltage": &fieldSpec_Bus1PhaseABasicACQuantities_LineLineACRMSVoltage,
+    "Bus1PhaseABasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_Bus1PhaseABasicACQuantities_LineNeutralACRMSVoltage,
+    "Bus1PhaseABasicACQuantities.ACFrequency": &fieldSpec_Bus1PhaseABasicACQuantities_ACFrequency,
+    "Bus1AverageBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_Bus1AverageBasicACQuantities_LineLineACRMSVoltage,
+    "Bus1AverageBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_Bus1AverageBasicACQuantities_LineNeutralACRMSVoltage,
+    "Bus1AverageBasicACQuantities.ACFrequency": &fieldSpec_Bus1AverageBasicACQuantities_ACFrequency,
+    "UtilityTotalACEnergy.TotalEnergyExport": &fieldSpec_UtilityTotalACEnergy_TotalEnergyExport,
+    "UtilityTotalACEnergy.TotalEnergyImport": &fieldSpec_UtilityTotalACEnergy_TotalEnergyImport,
+    "UtilityPhaseCACReactivePower.ReactivePower": &fieldSpec_UtilityPhaseCACReactivePower_ReactivePower,
+    "UtilityPhaseCACReactivePower.PowerFactor": &fieldSpec_UtilityPhaseCACReactivePower_PowerFactor,
+    "UtilityPhaseCACPower.RealPower": &fieldSpec_UtilityPhaseCACPower_RealPower,
+    "UtilityPhaseCACPower.ApparentPower": &fieldSpec_UtilityPhaseCACPower_ApparentPower,
+    "UtilityPhaseCBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_UtilityPhaseCBasicACQuantities_LineLineACRMSVoltage,
+    "UtilityPhaseCBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_UtilityPhaseCBasicACQuantities_LineNeutralACRMSVoltage,
+    "UtilityPhaseCBasicACQuantities.ACFrequency": &fieldSpec_UtilityPhaseCBasicACQuantities_ACFrequency,
+    "UtilityPhaseCBasicACQuantities.ACRMSCurrent": &fieldSpec_UtilityPhaseCBasicACQuantities_ACRMSCurrent,
+    "UtilityPhaseBACReactivePower.ReactivePower": &fieldSpec_UtilityPhaseBACReactivePower_ReactivePower,
+    "UtilityPhaseBACReactivePower.PowerFactor": &fieldSpec_UtilityPhaseBACReactivePower_PowerFactor,
+    "UtilityPhaseBACPower.RealPower": &fieldSpec_UtilityPhaseBACPower_RealPower,
+    "UtilityPhaseBACPower.ApparentPower": &fieldSpec_UtilityPhaseBACPower_ApparentPower,
+    "UtilityPhaseBBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_UtilityPhaseBBasicACQuantities_LineLineACRMSVoltage,
+    "UtilityPhaseBBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_UtilityPhaseBBasicACQuantities_LineNeutralACRMSVoltage,
+    "UtilityPhaseBBasicACQuantities.ACFrequency": &fieldSpec_UtilityPhaseBBasicACQuantities_ACFrequency,
+    "UtilityPhaseBBasicACQuantities.ACRMSCurrent": &fieldSpec_UtilityPhaseBBasicACQuantities_ACRMSCurrent,
+    "UtilityPhaseAACReactivePower.ReactivePower": &fieldSpec_UtilityPhaseAACReactivePower_ReactivePower,
+    "UtilityPhaseAACReactivePower.PowerFactor": &fieldSpec_UtilityPhaseAACReactivePower_PowerFactor,
+    "UtilityPhaseAACPower.RealPower": &fieldSpec_UtilityPhaseAACPower_RealPower,
+    "UtilityPhaseAACPower.ApparentPower": &fieldSpec_UtilityPhaseAACPower_ApparentPower,
+    "UtilityPhaseABasicACQuantities.LineLineACRMSVoltage": &fieldSpec_UtilityPhaseABasicACQuantities_LineLineACRMSVoltage,
+    "UtilityPhaseABasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_UtilityPhaseABasicACQuantities_LineNeutralACRMSVoltage,
+    "UtilityPhaseABasicACQuantities.ACFrequency": &fieldSpec_UtilityPhaseABasicACQuantities_ACFrequency,
+    "UtilityPhaseABasicACQuantities.ACRMSCurrent": &fieldSpec_UtilityPhaseABasicACQuantities_ACRMSCurrent,
+    "UtilityTotalACReactivePower.ReactivePower": &fieldSpec_UtilityTotalACReactivePower_ReactivePower,
+    "UtilityTotalACReactivePower.PowerFactor": &fieldSpec_UtilityTotalACReactivePower_PowerFactor,
+    "UtilityTotalACPower.RealPower": &fieldSpec_UtilityTotalACPower_RealPower,
+    "UtilityTotalACPower.ApparentPower": &fieldSpec_UtilityTotalACPower_ApparentPower,
+    "UtilityAverageBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_UtilityAverageBasicACQuantities_LineLineACRMSVoltage,
+    "UtilityAverageBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_UtilityAverageBasicACQuantities_LineNeutralACRMSVoltage,
+    "UtilityAverageBasicACQuantities.ACFrequency": &fieldSpec_UtilityAverageBasicACQuantities_ACFrequency,
+    "UtilityAverageBasicACQuantities.ACRMSCurrent": &fieldSpec_UtilityAverageBasicACQuantities_ACRMSCurrent,
+    "GeneratorTotalACEnergy.TotalEnergyExport": &fieldSpec_GeneratorTotalACEnergy_TotalEnergyExport,
+    "GeneratorTotalACEnergy.TotalEnergyImport": &fieldSpec_GeneratorTotalACEnergy_TotalEnergyImport,
+    "GeneratorPhaseCACReactivePower.ReactivePower": &fieldSpec_GeneratorPhaseCACReactivePower_ReactivePower,
+    "GeneratorPhaseCACReactivePower.PowerFactor": &fieldSpec_GeneratorPhaseCACReactivePower_PowerFactor,
+    "GeneratorPhaseCACPower.RealPower": &fieldSpec_GeneratorPhaseCACPower_RealPower,
+    "GeneratorPhaseCACPower.ApparentPower": &fieldSpec_GeneratorPhaseCACPower_ApparentPower,
+    "GeneratorPhaseCBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_GeneratorPhaseCBasicACQuantities_LineLineACRMSVoltage,
+    "GeneratorPhaseCBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_GeneratorPhaseCBasicACQuantities_LineNeutralACRMSVoltage,
+    "GeneratorPhaseCBasicACQuantities.ACFrequency": &fieldSpec_GeneratorPhaseCBasicACQuantities_ACFrequency,
+    "GeneratorPhaseCBasicACQuantities.ACRMSCurrent": &fieldSpec_GeneratorPhaseCBasicACQuantities_ACRMSCurrent,
+    "GeneratorPhaseBACReactivePower.ReactivePower": &fieldSpec_GeneratorPhaseBACReactivePower_ReactivePower,
+    "GeneratorPhaseBACReactivePower.PowerFactor": &fieldSpec_GeneratorPhaseBACReactivePower_PowerFactor,
+    "GeneratorPhaseBACPower.RealPower": &fieldSpec_GeneratorPhaseBACPower_RealPower,
+    "GeneratorPhaseBACPower.ApparentPower": &fieldSpec_GeneratorPhaseBACPower_ApparentPower,
+    "GeneratorPhaseBBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_GeneratorPhaseBBasicACQuantities_LineLineACRMSVoltage,
+    "GeneratorPhaseBBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_GeneratorPhaseBBasicACQuantities_LineNeutralACRMSVoltage,
+    "GeneratorPhaseBBasicACQuantities.ACFrequency": &fieldSpec_GeneratorPhaseBBasicACQuantities_ACFrequency,
+    "GeneratorPhaseBBasicACQuantities.ACRMSCurrent": &fieldSpec_GeneratorPhaseBBasicACQuantities_ACRMSCurrent,
+    "GeneratorPhaseAACReactivePower.ReactivePower": &fieldSpec_GeneratorPhaseAACReactivePower_ReactivePower,
+    "GeneratorPhaseAACReactivePower.PowerFactor": &fieldSpec_GeneratorPhaseAACReactivePower_PowerFactor,
+    "GeneratorPhaseAACPower.RealPower": &fieldSpec_GeneratorPhaseAACPower_RealPower,
+    "GeneratorPhaseAACPower.ApparentPower": &fieldSpec_GeneratorPhaseAACPower_ApparentPower,
+    "GeneratorPhaseABasicACQuantities.LineLineACRMSVoltage": &fieldSpec_GeneratorPhaseABasicACQuantities_LineLineACRMSVoltage,
+    "GeneratorPhaseABasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_GeneratorPhaseABasicACQuantities_LineNeutralACRMSVoltage,
+    "GeneratorPhaseABasicACQuantities.ACFrequency": &fieldSpec_GeneratorPhaseABasicACQuantities_ACFrequency,
+    "GeneratorPhaseABasicACQuantities.ACRMSCurrent": &fieldSpec_GeneratorPhaseABasicACQuantities_ACRMSCurrent,
+    "GeneratorTotalACReactivePower.ReactivePower": &fieldSpec_GeneratorTotalACReactivePower_ReactivePower,
+    "GeneratorTotalACReactivePower.PowerFactor": &fieldSpec_GeneratorTotalACReactivePower_PowerFactor,
+    "GeneratorTotalACPower.RealPower": &fieldSpec_GeneratorTotalACPower_RealPower,
+    "GeneratorTotalACPower.ApparentPower": &fieldSpec_GeneratorTotalACPower_ApparentPower,
+    "GeneratorAverageBasicACQuantities.LineLineACRMSVoltage": &fieldSpec_GeneratorAverageBasicACQuantities_LineLineACRMSVoltage,
+    "GeneratorAverageBasicACQuantities.LineNeutralACRMSVoltage": &fieldSpec_GeneratorAverageBasicACQuantities_LineNeutralACRMSVoltage,
+    "GeneratorAverageBasicACQuantities.ACFrequency": &fieldSpec_GeneratorAverageBasicACQuantities_ACFrequency,
+    "GeneratorAverageBasicACQuantities.ACRMSCurrent": &fieldSpec_GeneratorAverageBasicACQuantities_ACRMSCurrent,
+    "ISOCommandedAddress.DeviceInstanceLower": &fieldSpec_ISOCommandedAddress_DeviceInstanceLower,
+    "ISOCommandedAddress.DeviceInstanceUpper": &fieldSpec_ISOCommandedAddress_DeviceInstanceUpper,
+    "ISOCommandedAddress.SystemInstance": &fieldSpec_ISOCommandedAddress_SystemInstance,
+    "ISOCommandedAddress.NewSourceAddress": &fieldSpec_ISOCommandedAddress_NewSourceAddress,
+    "FurunoHeave.ManufacturerCode": &fieldSpec_FurunoHeave_ManufacturerCode,
+    "FurunoHeave.IndustryCode": &fieldSpec_FurunoHeave_IndustryCode,
+    "FurunoHeave.Heave": &fieldSpec_FurunoHeave_Heave,
+    "HondaEngineData.ManufacturerCode": &fieldSpec_HondaEngineData_ManufacturerCode,
+    "HondaEngineData.IndustryCode": &fieldSpec_HondaEngineData_IndustryCode,
+    "YanmarEngineDataA.ManufacturerCode": &fieldSpec_YanmarEngineDataA_ManufacturerCode,
+    "YanmarEngineDataA.IndustryCode": &fieldSpec_YanmarEngineDataA_IndustryCode,
+    "YanmarEngineDataA.ThrottlePosition": &fieldSpec_YanmarEngineDataA_ThrottlePosition,
+    "YanmarEngineDataA.EngineSpeed": &fieldSpec_YanmarEngineDataA_EngineSpeed,
+    "MaretronKeelPosition.ManufacturerCode": &fieldSpec_MaretronKeelPosition_ManufacturerCode,
+    "MaretronKeelPosition.IndustryCode": &fieldSpec_MaretronKeelPosition_IndustryCode,
+    "MercuryEngineData.ManufacturerCode": &fieldSpec_MercuryEngineData_ManufacturerCode,
+    "MercuryEngineData.IndustryCode": &fieldSpec_MercuryEngineData_IndustryCode,
+    "NavicoDeviceStatus.ManufacturerCode": &fieldSpec_NavicoDeviceStatus_ManufacturerCode,
+    "NavicoDeviceStatus.IndustryCode": &fieldSpec_NavicoDeviceStatus_IndustryCode,
+    "NavicoDeviceStatus.ReportType": &fieldSpec_NavicoDeviceStatus_ReportType,
+    "BepMarineCzoneCircuitControl.ManufacturerCode": &fieldSpec_BepMarineCzoneCircuitControl_ManufacturerCode,
+    "BepMarineCzoneCircuitControl.IndustryCode": &fieldSpec_BepMarineCzoneCircuitControl_IndustryCode,
+    "BepMarineCzoneCircuitControl.CircuitID": &fieldSpec_BepMarineCzoneCircuitControl_CircuitID,
+    "BepMarineCzoneCircuitControl.FieldB": &fieldSpec_BepMarineCzoneCircuitControl_FieldB,
+    "BepMarineCzoneCircuitControl.LevelOrValue": &fieldSpec_BepMarineCzoneCircuitControl_LevelOrValue,
+    "BepMarineCzoneCircuitControl.UnknownA": &fieldSpec_BepMarineCzoneCircuitControl_UnknownA,
+    "BepMarineCzoneCircuitControl.CommandActive": &fieldSpec_BepMarineCzoneCircuitControl_CommandActive,
+    "BepMarineCzoneCircuitControl.UnknownB": &fieldSpec_BepMarineCzoneCircuitControl_UnknownB,
+    "BepMarineCzoneCircuitControl.UnknownC": &fieldSpec_BepMarineCzoneCircuitControl_UnknownC,
+    "BepMarineCzoneCircuitControl.UnknownD": &fieldSpec_BepMarineCzoneCircuitControl_UnknownD,
+    "BepMarineCzoneCircuitControl.UnknownE": &fieldSpec_BepMarineCzoneCircuitControl_UnknownE,
+    "BepMarineCzoneCircuitControl.UnknownF": &fieldSpec_BepMarineCzoneCircuitControl_UnknownF,
+    "YanmarEngineDataB.ManufacturerCode": &fieldSpec_YanmarEngineDataB_ManufacturerCode,
+    "YanmarEngineDataB.IndustryCode": &fieldSpec_YanmarEngineDataB_IndustryCode,
+    "BepMarineProprietaryPGN65281.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65281_ManufacturerCode,
+    "BepMarineProprietaryPGN65281.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65281_IndustryCode,
+    "MaretronNumberOfChannels.ManufacturerCode": &fieldSpec_MaretronNumberOfChannels_ManufacturerCode,
+    "MaretronNumberOfChannels.IndustryCode": &fieldSpec_MaretronNumberOfChannels_IndustryCode,
+    "MaretronNumberOfChannels.PGN": &fieldSpec_MaretronNumberOfChannels_PGN,
+    "MaretronNumberOfChannels.NumberOfChannels": &fieldSpec_MaretronNumberOfChannels_NumberOfChannels,
+    "BepMarineCzoneAlarmEvent.ManufacturerCode": &fieldSpec_BepMarineCzoneAlarmEvent_ManufacturerCode,
+    "BepMarineCzoneAlarmEvent.IndustryCode": &fieldSpec_BepMarineCzoneAlarmEvent_IndustryCode,
+    "BepMarineCzoneAlarmEvent.Dipswitch": &fieldSpec_BepMarineCzoneAlarmEvent_Dipswitch,
+    "BepMarineCzoneChannelState.ManufacturerCode": &fieldSpec_BepMarineCzoneChannelState_ManufacturerCode,
+    "BepMarineCzoneChannelState.IndustryCode": &fieldSpec_BepMarineCzoneChannelState_IndustryCode,
+    "BepMarineCzoneChannelState.Dipswitch": &fieldSpec_BepMarineCzoneChannelState_Dipswitch,
+    "BepMarineCzoneChannelState.Channel0Mode": &fieldSpec_BepMarineCzoneChannelState_Channel0Mode,
+    "BepMarineCzoneChannelState.Channel1Mode": &fieldSpec_BepMarineCzoneChannelState_Channel1Mode,
+    "BepMarineCzoneChannelState.Channel2Mode": &fieldSpec_BepMarineCzoneChannelState_Channel2Mode,
+    "BepMarineCzoneChannelState.Channel3Mode": &fieldSpec_BepMarineCzoneChannelState_Channel3Mode,
+    "BepMarineCzoneChannelState.Channel4Mode": &fieldSpec_BepMarineCzoneChannelState_Channel4Mode,
+    "BepMarineCzoneChannelState.Channel5Mode": &fieldSpec_BepMarineCzoneChannelState_Channel5Mode,
+    "BepMarineCzoneChannelState.Channel0Value": &fieldSpec_BepMarineCzoneChannelState_Channel0Value,
+    "BepMarineCzoneChannelState.Channel1Value": &fieldSpec_BepMarineCzoneChannelState_Channel1Value,
+    "BepMarineCzoneChannelState.Channel2Value": &fieldSpec_BepMarineCzoneChannelState_Channel2Value,
+    "BepMarineCzoneChannelState.Channel3Value": &fieldSpec_BepMarineCzoneChannelState_Channel3Value,
+    "BepMarineCzoneChannelState.Channel4Value": &fieldSpec_BepMarineCzoneChannelState_Channel4Value,
+    "BepMarineCzoneChannelState.Channel5Value": &fieldSpec_BepMarineCzoneChannelState_Channel5Value,
+    "BepMarineCzoneChannelState.Flag": &fieldSpec_BepMarineCzoneChannelState_Flag,
+    "MaretronProprietaryDCBreakerCurrent.ManufacturerCode": &fieldSpec_MaretronProprietaryDCBreakerCurrent_ManufacturerCode,
+    "MaretronProprietaryDCBreakerCurrent.IndustryCode": &fieldSpec_MaretronProprietaryDCBreakerCurrent_IndustryCode,
+    "MaretronProprietaryDCBreakerCurrent.BankInstance": &fieldSpec_MaretronProprietaryDCBreakerCurrent_BankInstance,
+    "MaretronProprietaryDCBreakerCurrent.IndicatorNumber": &fieldSpec_MaretronProprietaryDCBreakerCurrent_IndicatorNumber,
+    "MaretronProprietaryDCBreakerCurrent.BreakerCurrent": &fieldSpec_MaretronProprietaryDCBreakerCurrent_BreakerCurrent,
+    "HondaEngineAlerts.ManufacturerCode": &fieldSpec_HondaEngineAlerts_ManufacturerCode,
+    "HondaEngineAlerts.IndustryCode": &fieldSpec_HondaEngineAlerts_IndustryCode,
+    "BepMarineCzoneCircuitStatus.ManufacturerCode": &fieldSpec_BepMarineCzoneCircuitStatus_ManufacturerCode,
+    "BepMarineCzoneCircuitStatus.IndustryCode": &fieldSpec_BepMarineCzoneCircuitStatus_IndustryCode,
+    "BepMarineCzoneCircuitStatus.Dipswitch": &fieldSpec_BepMarineCzoneCircuitStatus_Dipswitch,
+    "BepMarineCzoneCircuitStatus.Type": &fieldSpec_BepMarineCzoneCircuitStatus_Type,
+    "AirmarBootStateAcknowledgment.ManufacturerCode": &fieldSpec_AirmarBootStateAcknowledgment_ManufacturerCode,
+    "AirmarBootStateAcknowledgment.IndustryCode": &fieldSpec_AirmarBootStateAcknowledgment_IndustryCode,
+    "LowranceTemperature.ManufacturerCode": &fieldSpec_LowranceTemperature_ManufacturerCode,
+    "LowranceTemperature.IndustryCode": &fieldSpec_LowranceTemperature_IndustryCode,
+    "LowranceTemperature.ActualTemperature": &fieldSpec_LowranceTemperature_ActualTemperature,
+    "MaretronUniversalConfigurationSf.ManufacturerCode": &fieldSpec_MaretronUniversalConfigurationSf_ManufacturerCode,
+    "MaretronUniversalConfigurationSf.IndustryCode": &fieldSpec_MaretronUniversalConfigurationSf_IndustryCode,
+    "ChetcoDimmer.ManufacturerCode": &fieldSpec_ChetcoDimmer_ManufacturerCode,
+    "ChetcoDimmer.IndustryCode": &fieldSpec_ChetcoDimmer_IndustryCode,
+    "ChetcoDimmer.Instance": &fieldSpec_ChetcoDimmer_Instance,
+    "ChetcoDimmer.Dimmer1": &fieldSpec_ChetcoDimmer_Dimmer1,
+    "ChetcoDimmer.Dimmer2": &fieldSpec_ChetcoDimmer_Dimmer2,
+    "ChetcoDimmer.Dimmer3": &fieldSpec_ChetcoDimmer_Dimmer3,
+    "ChetcoDimmer.Dimmer4": &fieldSpec_ChetcoDimmer_Dimmer4,
+    "ChetcoDimmer.Control": &fieldSpec_ChetcoDimmer_Control,
+    "AirmarBootStateRequest.ManufacturerCode": &fieldSpec_AirmarBootStateRequest_ManufacturerCode,
+    "AirmarBootStateRequest.IndustryCode": &fieldSpec_AirmarBootStateRequest_IndustryCode,
+    "MaretronFluidFlowRate.ManufacturerCode": &fieldSpec_MaretronFluidFlowRate_ManufacturerCode,
+    "MaretronFluidFlowRate.IndustryCode": &fieldSpec_MaretronFluidFlowRate_IndustryCode,
+    "MaretronFluidFlowRate.SID": &fieldSpec_MaretronFluidFlowRate_SID,
+    "MaretronFluidFlowRate.FlowRateInstance": &fieldSpec_MaretronFluidFlowRate_FlowRateInstance,
+    "MaretronFluidFlowRate.FluidFlowRate": &fieldSpec_MaretronFluidFlowRate_FluidFlowRate,
+    "AirmarAccessLevel.ManufacturerCode": &fieldSpec_AirmarAccessLevel_ManufacturerCode,
+    "AirmarAccessLevel.IndustryCode": &fieldSpec_AirmarAccessLevel_IndustryCode,
+    "AirmarAccessLevel.FormatCode": &fieldSpec_AirmarAccessLevel_FormatCode,
+    "AirmarAccessLevel.AccessSeedKey": &fieldSpec_AirmarAccessLevel_AccessSeedKey,
+    "SimnetConfigureTemperatureSensor.ManufacturerCode": &fieldSpec_SimnetConfigureTemperatureSensor_ManufacturerCode,
+    "SimnetConfigureTemperatureSensor.IndustryCode": &fieldSpec_SimnetConfigureTemperatureSensor_IndustryCode,
+    "MaretronTripVolume.ManufacturerCode": &fieldSpec_MaretronTripVolume_ManufacturerCode,
+    "MaretronTripVolume.IndustryCode": &fieldSpec_MaretronTripVolume_IndustryCode,
+    "MaretronTripVolume.SID": &fieldSpec_MaretronTripVolume_SID,
+    "MaretronTripVolume.VolumeInstance": &fieldSpec_MaretronTripVolume_VolumeInstance,
+    "MaretronTripVolume.TripVolume": &fieldSpec_MaretronTripVolume_TripVolume,
+    "SeatalkAlarm.ManufacturerCode": &fieldSpec_SeatalkAlarm_ManufacturerCode,
+    "SeatalkAlarm.IndustryCode": &fieldSpec_SeatalkAlarm_IndustryCode,
+    "SeatalkAlarm.SID": &fieldSpec_SeatalkAlarm_SID,
+    "Maretron420Ma.ManufacturerCode": &fieldSpec_Maretron420Ma_ManufacturerCode,
+    "Maretron420Ma.IndustryCode": &fieldSpec_Maretron420Ma_IndustryCode,
+    "Maretron420Ma.SID": &fieldSpec_Maretron420Ma_SID,
+    "Maretron420Ma.DataInstance": &fieldSpec_Maretron420Ma_DataInstance,
+    "Maretron420Ma.FourTwoZeroMaData": &fieldSpec_Maretron420Ma_FourTwoZeroMaData,
+    "SimnetTrimTabSensorCalibration.ManufacturerCode": &fieldSpec_SimnetTrimTabSensorCalibration_ManufacturerCode,
+    "SimnetTrimTabSensorCalibration.IndustryCode": &fieldSpec_SimnetTrimTabSensorCalibration_IndustryCode,
+    "Maretron010V.ManufacturerCode": &fieldSpec_Maretron010V_ManufacturerCode,
+    "Maretron010V.IndustryCode": &fieldSpec_Maretron010V_IndustryCode,
+    "Maretron010V.SID": &fieldSpec_Maretron010V_SID,
+    "Maretron010V.DataInstance": &fieldSpec_Maretron010V_DataInstance,
+    "Maretron010V.ZeroOneZeroVData": &fieldSpec_Maretron010V_ZeroOneZeroVData,
+    "SimnetPaddleWheelSpeedConfiguration.ManufacturerCode": &fieldSpec_SimnetPaddleWheelSpeedConfiguration_ManufacturerCode,
+    "SimnetPaddleWheelSpeedConfiguration.IndustryCode": &fieldSpec_SimnetPaddleWheelSpeedConfiguration_IndustryCode,
+    "MaretronRotationalRate.ManufacturerCode": &fieldSpec_MaretronRotationalRate_ManufacturerCode,
+    "MaretronRotationalRate.IndustryCode": &fieldSpec_MaretronRotationalRate_IndustryCode,
+    "MaretronRotationalRate.SID": &fieldSpec_MaretronRotationalRate_SID,
+    "MaretronRotationalRate.DataInstance": &fieldSpec_MaretronRotationalRate_DataInstance,
+    "MaretronRotationalRate.RotationalRate": &fieldSpec_MaretronRotationalRate_RotationalRate,
+    "BepMarineCzoneModuleAnnounce.ManufacturerCode": &fieldSpec_BepMarineCzoneModuleAnnounce_ManufacturerCode,
+    "BepMarineCzoneModuleAnnounce.IndustryCode": &fieldSpec_BepMarineCzoneModuleAnnounce_IndustryCode,
+    "BepMarineCzoneModuleAnnounce.Unique": &fieldSpec_BepMarineCzoneModuleAnnounce_Unique,
+    "BepMarineCzoneModuleAnnounce.FieldB": &fieldSpec_BepMarineCzoneModuleAnnounce_FieldB,
+    "BepMarineCzoneModuleAnnounce.FieldC": &fieldSpec_BepMarineCzoneModuleAnnounce_FieldC,
+    "BepMarineCzoneModuleAnnounce.Dipswitch": &fieldSpec_BepMarineCzoneModuleAnnounce_Dipswitch,
+    "MaretronResistance.ManufacturerCode": &fieldSpec_MaretronResistance_ManufacturerCode,
+    "MaretronResistance.IndustryCode": &fieldSpec_MaretronResistance_IndustryCode,
+    "MaretronResistance.SID": &fieldSpec_MaretronResistance_SID,
+    "MaretronResistance.DataInstance": &fieldSpec_MaretronResistance_DataInstance,
+    "MaretronResistance.Resistance": &fieldSpec_MaretronResistance_Resistance,
+    "SimnetClearFluidLevelWarnings.ManufacturerCode": &fieldSpec_SimnetClearFluidLevelWarnings_ManufacturerCode,
+    "SimnetClearFluidLevelWarnings.IndustryCode": &fieldSpec_SimnetClearFluidLevelWarnings_IndustryCode,
+    "MaretronAutomationFunctionMaster.ManufacturerCode": &fieldSpec_MaretronAutomationFunctionMaster_ManufacturerCode,
+    "MaretronAutomationFunctionMaster.IndustryCode": &fieldSpec_MaretronAutomationFunctionMaster_IndustryCode,
+    "SimnetLgc2000Configuration.ManufacturerCode": &fieldSpec_SimnetLgc2000Configuration_ManufacturerCode,
+    "SimnetLgc2000Configuration.IndustryCode": &fieldSpec_SimnetLgc2000Configuration_IndustryCode,
+    "LowranceGPSConfiguration.ManufacturerCode": &fieldSpec_LowranceGPSConfiguration_ManufacturerCode,
+    "LowranceGPSConfiguration.IndustryCode": &fieldSpec_LowranceGPSConfiguration_IndustryCode,
+    "LowranceGPSConfiguration.A": &fieldSpec_LowranceGPSConfiguration_A,
+    "LowranceGPSConfiguration.B": &fieldSpec_LowranceGPSConfiguration_B,
+    "LowranceGPSConfiguration.C": &fieldSpec_LowranceGPSConfiguration_C,
+    "LowranceGPSConfiguration.D": &fieldSpec_LowranceGPSConfiguration_D,
+    "LowranceGPSConfiguration.E": &fieldSpec_LowranceGPSConfiguration_E,
+    "LowranceGPSConfiguration.F": &fieldSpec_LowranceGPSConfiguration_F,
+    "LowranceGPSConfiguration.G": &fieldSpec_LowranceGPSConfiguration_G,
+    "LowranceGPSConfiguration.H": &fieldSpec_LowranceGPSConfiguration_H,
+    "LowranceGPSConfiguration.I": &fieldSpec_LowranceGPSConfiguration_I,
+    "DiverseYachtServicesLoadCell.ManufacturerCode": &fieldSpec_DiverseYachtServicesLoadCell_ManufacturerCode,
+    "DiverseYachtServicesLoadCell.IndustryCode": &fieldSpec_DiverseYachtServicesLoadCell_IndustryCode,
+    "DiverseYachtServicesLoadCell.Instance": &fieldSpec_DiverseYachtServicesLoadCell_Instance,
+    "DiverseYachtServicesLoadCell.LoadCell": &fieldSpec_DiverseYachtServicesLoadCell_LoadCell,
+    "BepMarineProprietaryPGN65294.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65294_ManufacturerCode,
+    "BepMarineProprietaryPGN65294.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65294_IndustryCode,
+    "BepMarineCzoneAlarm.ManufacturerCode": &fieldSpec_BepMarineCzoneAlarm_ManufacturerCode,
+    "BepMarineCzoneAlarm.IndustryCode": &fieldSpec_BepMarineCzoneAlarm_IndustryCode,
+    "BepMarineCzoneAlarm.DeviceID": &fieldSpec_BepMarineCzoneAlarm_DeviceID,
+    "BepMarineCzoneAlarm.Channel": &fieldSpec_BepMarineCzoneAlarm_Channel,
+    "BepMarineCzoneAlarm.SeverityCode": &fieldSpec_BepMarineCzoneAlarm_SeverityCode,
+    "BepMarineCzoneAlarm.StateFlag": &fieldSpec_BepMarineCzoneAlarm_StateFlag,
+    "BepMarineCzoneAlarm.AckFlag": &fieldSpec_BepMarineCzoneAlarm_AckFlag,
+    "BepMarineProprietaryPGN65296.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65296_ManufacturerCode,
+    "BepMarineProprietaryPGN65296.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65296_IndustryCode,
+    "BepMarineProprietaryPGN65297.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65297_ManufacturerCode,
+    "BepMarineProprietaryPGN65297.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65297_IndustryCode,
+    "SuzukiEngineDataA.ManufacturerCode": &fieldSpec_SuzukiEngineDataA_ManufacturerCode,
+    "SuzukiEngineDataA.IndustryCode": &fieldSpec_SuzukiEngineDataA_IndustryCode,
+    "SuzukiEngineDataB.ManufacturerCode": &fieldSpec_SuzukiEngineDataB_ManufacturerCode,
+    "SuzukiEngineDataB.IndustryCode": &fieldSpec_SuzukiEngineDataB_IndustryCode,
+    "BepMarineCzoneAlarmStringRequest.ManufacturerCode": &fieldSpec_BepMarineCzoneAlarmStringRequest_ManufacturerCode,
+    "BepMarineCzoneAlarmStringRequest.IndustryCode": &fieldSpec_BepMarineCzoneAlarmStringRequest_IndustryCode,
+    "BepMarineCzoneAlarmStringRequest.DeviceID": &fieldSpec_BepMarineCzoneAlarmStringRequest_DeviceID,
+    "BepMarineCzoneAlarmStringRequest.Channel": &fieldSpec_BepMarineCzoneAlarmStringRequest_Channel,
+    "SuzukiEngineDataC.ManufacturerCode": &fieldSpec_SuzukiEngineDataC_ManufacturerCode,
+    "SuzukiEngineDataC.IndustryCode": &fieldSpec_SuzukiEngineDataC_IndustryCode,
+    "BepMarineProprietaryPGN65300.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65300_ManufacturerCode,
+    "BepMarineProprietaryPGN65300.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65300_IndustryCode,
+    "CarlingSwitchboardStatus.ManufacturerCode": &fieldSpec_CarlingSwitchboardStatus_ManufacturerCode,
+    "CarlingSwitchboardStatus.IndustryCode": &fieldSpec_CarlingSwitchboardStatus_IndustryCode,
+    "CarlingSwitchboardStatus.MessageType": &fieldSpec_CarlingSwitchboardStatus_MessageType,
+    "BepMarineCzone65301.ManufacturerCode": &fieldSpec_BepMarineCzone65301_ManufacturerCode,
+    "BepMarineCzone65301.IndustryCode": &fieldSpec_BepMarineCzone65301_IndustryCode,
+    "BepMarineCzone65301.Field1": &fieldSpec_BepMarineCzone65301_Field1,
+    "BepMarineCzone65301.Field2": &fieldSpec_BepMarineCzone65301_Field2,
+    "BepMarineCzone65301.Field3": &fieldSpec_BepMarineCzone65301_Field3,
+    "SimnetApUnknown1.ManufacturerCode": &fieldSpec_SimnetApUnknown1_ManufacturerCode,
+    "SimnetApUnknown1.IndustryCode": &fieldSpec_SimnetApUnknown1_IndustryCode,
+    "SimnetApUnknown1.Type": &fieldSpec_SimnetApUnknown1_Type,
+    "SimnetApUnknown1.Value": &fieldSpec_SimnetApUnknown1_Value,
+    "SuzukiEngineDataD.ManufacturerCode": &fieldSpec_SuzukiEngineDataD_ManufacturerCode,
+    "SuzukiEngineDataD.IndustryCode": &fieldSpec_SuzukiEngineDataD_IndustryCode,
+    "LowranceVesselSetupEngineAndTankConfiguration.ManufacturerCode": &fieldSpec_LowranceVesselSetupEngineAndTankConfiguration_ManufacturerCode,
+    "LowranceVesselSetupEngineAndTankConfiguration.IndustryCode": &fieldSpec_LowranceVesselSetupEngineAndTankConfiguration_IndustryCode,
+    "LowranceVesselSetupEngineAndTankConfiguration.NumberOfEngines": &fieldSpec_LowranceVesselSetupEngineAndTankConfiguration_NumberOfEngines,
+    "LowranceVesselSetupEngineAndTankConfiguration.NumberOfFuelTanks": &fieldSpec_LowranceVesselSetupEngineAndTankConfiguration_NumberOfFuelTanks,
+    "LowranceVesselSetupEngineAndTankConfiguration.TotalFuelCapacity": &fieldSpec_LowranceVesselSetupEngineAndTankConfiguration_TotalFuelCapacity,
+    "SuzukiEngineDataE.ManufacturerCode": &fieldSpec_SuzukiEngineDataE_ManufacturerCode,
+    "SuzukiEngineDataE.IndustryCode": &fieldSpec_SuzukiEngineDataE_IndustryCode,
+    "BepMarineProprietaryPGN65304.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65304_ManufacturerCode,
+    "BepMarineProprietaryPGN65304.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65304_IndustryCode,
+    "LowranceVesselSetupEngineAndTankConfigurationBroadcast.ManufacturerCode": &fieldSpec_LowranceVesselSetupEngineAndTankConfigurationBroadcast_ManufacturerCode,
+    "LowranceVesselSetupEngineAndTankConfigurationBroadcast.IndustryCode": &fieldSpec_LowranceVesselSetupEngineAndTankConfigurationBroadcast_IndustryCode,
+    "LowranceVesselSetupEngineAndTankConfigurationBroadcast.NumberOfEngines": &fieldSpec_LowranceVesselSetupEngineAndTankConfigurationBroadcast_NumberOfEngines,
+    "LowranceVesselSetupEngineAndTankConfigurationBroadcast.NumberOfFuelTanks": &fieldSpec_LowranceVesselSetupEngineAndTankConfigurationBroadcast_NumberOfFuelTanks,
+    "LowranceVesselSetupEngineAndTankConfigurationBroadcast.TotalFuelCapacity": &fieldSpec_LowranceVesselSetupEngineAndTankConfigurationBroadcast_TotalFuelCapacity,
+    "SimnetDeviceStatus.ManufacturerCode": &fieldSpec_SimnetDeviceStatus_ManufacturerCode,
+    "SimnetDeviceStatus.IndustryCode": &fieldSpec_SimnetDeviceStatus_IndustryCode,
+    "SimnetDeviceStatus.Report": &fieldSpec_SimnetDeviceStatus_Report,
+    "SimnetDeviceStatusRequest.ManufacturerCode": &fieldSpec_SimnetDeviceStatusRequest_ManufacturerCode,
+    "SimnetDeviceStatusRequest.IndustryCode": &fieldSpec_SimnetDeviceStatusRequest_IndustryCode,
+    "SimnetDeviceStatusRequest.Report": &fieldSpec_SimnetDeviceStatusRequest_Report,
+    "SimnetPilotMode.ManufacturerCode": &fieldSpec_SimnetPilotMode_ManufacturerCode,
+    "SimnetPilotMode.IndustryCode": &fieldSpec_SimnetPilotMode_IndustryCode,
+    "SimnetPilotMode.Report": &fieldSpec_SimnetPilotMode_Report,
+    "SimnetDeviceModeRequest.ManufacturerCode": &fieldSpec_SimnetDeviceModeRequest_ManufacturerCode,
+    "SimnetDeviceModeRequest.IndustryCode": &fieldSpec_SimnetDeviceModeRequest_IndustryCode,
+    "SimnetDeviceModeRequest.Report": &fieldSpec_SimnetDeviceModeRequest_Report,
+    "SimnetSailingProcessorStatus.ManufacturerCode": &fieldSpec_SimnetSailingProcessorStatus_ManufacturerCode,
+    "SimnetSailingProcessorStatus.IndustryCode": &fieldSpec_SimnetSailingProcessorStatus_IndustryCode,
+    "SimnetSailingProcessorStatus.Report": &fieldSpec_SimnetSailingProcessorStatus_Report,
+    "BepMarineProprietaryPGN65306.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65306_ManufacturerCode,
+    "BepMarineProprietaryPGN65306.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65306_IndustryCode,
+    "BepMarineProprietaryPGN65308.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65308_ManufacturerCode,
+    "BepMarineProprietaryPGN65308.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65308_IndustryCode,
+    "NavicoWirelessBatteryStatus.ManufacturerCode": &fieldSpec_NavicoWirelessBatteryStatus_ManufacturerCode,
+    "NavicoWirelessBatteryStatus.IndustryCode": &fieldSpec_NavicoWirelessBatteryStatus_IndustryCode,
+    "NavicoWirelessBatteryStatus.Status": &fieldSpec_NavicoWirelessBatteryStatus_Status,
+    "NavicoWirelessBatteryStatus.BatteryStatus": &fieldSpec_NavicoWirelessBatteryStatus_BatteryStatus,
+    "NavicoWirelessBatteryStatus.BatteryChargeStatus": &fieldSpec_NavicoWirelessBatteryStatus_BatteryChargeStatus,
+    "NavicoWirelessBatteryStatus.A": &fieldSpec_NavicoWirelessBatteryStatus_A,
+    "BepMarineProprietaryPGN65310.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65310_ManufacturerCode,
+    "BepMarineProprietaryPGN65310.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65310_IndustryCode,
+    "BepMarineProprietaryPGN65311.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65311_ManufacturerCode,
+    "BepMarineProprietaryPGN65311.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65311_IndustryCode,
+    "NavicoWirelessSignalStatus.ManufacturerCode": &fieldSpec_NavicoWirelessSignalStatus_ManufacturerCode,
+    "NavicoWirelessSignalStatus.IndustryCode": &fieldSpec_NavicoWirelessSignalStatus_IndustryCode,
+    "NavicoWirelessSignalStatus.Unknown": &fieldSpec_NavicoWirelessSignalStatus_Unknown,
+    "NavicoWirelessSignalStatus.SignalStrength": &fieldSpec_NavicoWirelessSignalStatus_SignalStrength,
+    "NavicoWirelessSignalStatus.A": &fieldSpec_NavicoWirelessSignalStatus_A,
+    "NavicoDepthQuality.ManufacturerCode": &fieldSpec_NavicoDepthQuality_ManufacturerCode,
+    "NavicoDepthQuality.IndustryCode": &fieldSpec_NavicoDepthQuality_IndustryCode,
+    "NavicoDepthQuality.Instance": &fieldSpec_NavicoDepthQuality_Instance,
+    "NavicoDepthQuality.DepthQuality": &fieldSpec_NavicoDepthQuality_DepthQuality,
+    "BepMarineProprietaryPGN65314.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65314_ManufacturerCode,
+    "BepMarineProprietaryPGN65314.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65314_IndustryCode,
+    "YamahaGearStatus.ManufacturerCode": &fieldSpec_YamahaGearStatus_ManufacturerCode,
+    "YamahaGearStatus.IndustryCode": &fieldSpec_YamahaGearStatus_IndustryCode,
+    "SuzukiTrollModeControl.ManufacturerCode": &fieldSpec_SuzukiTrollModeControl_ManufacturerCode,
+    "SuzukiTrollModeControl.IndustryCode": &fieldSpec_SuzukiTrollModeControl_IndustryCode,
+    "BepMarineProprietaryPGN65316.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65316_ManufacturerCode,
+    "BepMarineProprietaryPGN65316.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65316_IndustryCode,
+    "NavicoProprietary2.ManufacturerCode": &fieldSpec_NavicoProprietary2_ManufacturerCode,
+    "NavicoProprietary2.IndustryCode": &fieldSpec_NavicoProprietary2_IndustryCode,
+    "SimnetDataSourceSelectionRequest.ManufacturerCode": &fieldSpec_SimnetDataSourceSelectionRequest_ManufacturerCode,
+    "SimnetDataSourceSelectionRequest.IndustryCode": &fieldSpec_SimnetDataSourceSelectionRequest_IndustryCode,
+    "SimnetDataSourceSelectionRequest.SourceClass": &fieldSpec_SimnetDataSourceSelectionRequest_SourceClass,
+    "SimnetAnalogTelemetry.ManufacturerCode": &fieldSpec_SimnetAnalogTelemetry_ManufacturerCode,
+    "SimnetAnalogTelemetry.IndustryCode": &fieldSpec_SimnetAnalogTelemetry_IndustryCode,
+    "SimnetAnalogTelemetry.SubType": &fieldSpec_SimnetAnalogTelemetry_SubType,
+    "SimnetAnalogTelemetry.Channel": &fieldSpec_SimnetAnalogTelemetry_Channel,
+    "SimnetAnalogTelemetry.Value": &fieldSpec_SimnetAnalogTelemetry_Value,
+    "BepMarineProprietaryPGN65325.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN65325_ManufacturerCode,
+    "BepMarineProprietaryPGN65325.IndustryCode": &fieldSpec_BepMarineProprietaryPGN65325_IndustryCode,
+    "YamahaEngineDataA.ManufacturerCode": &fieldSpec_YamahaEngineDataA_ManufacturerCode,
+    "YamahaEngineDataA.IndustryCode": &fieldSpec_YamahaEngineDataA_IndustryCode,
+    "BGProprietary.ManufacturerCode": &fieldSpec_BGProprietary_ManufacturerCode,
+    "BGProprietary.IndustryCode": &fieldSpec_BGProprietary_IndustryCode,
+    "YanmarEngineDataC.ManufacturerCode": &fieldSpec_YanmarEngineDataC_ManufacturerCode,
+    "YanmarEngineDataC.IndustryCode": &fieldSpec_YanmarEngineDataC_IndustryCode,
+    "SimnetAutopilotModeState.ManufacturerCode": &fieldSpec_SimnetAutopilotModeState_ManufacturerCode,
+    "SimnetAutopilotModeState.IndustryCode": &fieldSpec_SimnetAutopilotModeState_IndustryCode,
+    "SimnetAutopilotModeState.C": &fieldSpec_SimnetAutopilotModeState_C,
+    "SimnetAutopilotModeState.D": &fieldSpec_SimnetAutopilotModeState_D,
+    "SimnetAutopilotModeState.Flags": &fieldSpec_SimnetAutopilotModeState_Flags,
+    "SimnetAutopilotAngle.ManufacturerCode": &fieldSpec_SimnetAutopilotAngle_ManufacturerCode,
+    "SimnetAutopilotAngle.IndustryCode": &fieldSpec_SimnetAutopilotAngle_IndustryCode,
+    "SimnetAutopilotAngle.Angle": &fieldSpec_SimnetAutopilotAngle_Angle,
+    "YamahaEngineDataB.ManufacturerCode": &fieldSpec_YamahaEngineDataB_ManufacturerCode,
+    "YamahaEngineDataB.IndustryCode": &fieldSpec_YamahaEngineDataB_IndustryCode,
+    "SeatalkPilotWindDatum.ManufacturerCode": &fieldSpec_SeatalkPilotWindDatum_ManufacturerCode,
+    "SeatalkPilotWindDatum.IndustryCode": &fieldSpec_SeatalkPilotWindDatum_IndustryCode,
+    "SeatalkPilotWindDatum.WindDatum": &fieldSpec_SeatalkPilotWindDatum_WindDatum,
+    "SeatalkPilotWindDatum.RollingAverageWindAngle": &fieldSpec_SeatalkPilotWindDatum_RollingAverageWindAngle,
+    "YanmarEngineDataD.ManufacturerCode": &fieldSpec_YanmarEngineDataD_ManufacturerCode,
+    "YanmarEngineDataD.IndustryCode": &fieldSpec_YanmarEngineDataD_IndustryCode,
+    "YanmarEngineDataE.ManufacturerCode": &fieldSpec_YanmarEngineDataE_ManufacturerCode,
+    "YanmarEngineDataE.IndustryCode": &fieldSpec_YanmarEngineDataE_IndustryCode,
+    "YanmarEngineDataF.ManufacturerCode": &fieldSpec_YanmarEngineDataF_ManufacturerCode,
+    "YanmarEngineDataF.IndustryCode": &fieldSpec_YanmarEngineDataF_IndustryCode,
+    "SimnetMagneticField.FieldX": &fieldSpec_SimnetMagneticField_FieldX,
+    "SimnetMagneticField.FieldY": &fieldSpec_SimnetMagneticField_FieldY,
+    "SimnetMagneticField.FieldZ": &fieldSpec_SimnetMagneticField_FieldZ,
+    "SimnetMagneticField.Marker": &fieldSpec_SimnetMagneticField_Marker,
+    "SeatalkPilotHeading.ManufacturerCode": &fieldSpec_SeatalkPilotHeading_ManufacturerCode,
+    "SeatalkPilotHeading.IndustryCode": &fieldSpec_SeatalkPilotHeading_IndustryCode,
+    "SeatalkPilotHeading.SID": &fieldSpec_SeatalkPilotHeading_SID,
+    "SeatalkPilotHeading.HeadingTrue": &fieldSpec_SeatalkPilotHeading_HeadingTrue,
+    "SeatalkPilotHeading.HeadingMagnetic": &fieldSpec_SeatalkPilotHeading_HeadingMagnetic,
+    "SeatalkPilotLockedHeading.ManufacturerCode": &fieldSpec_SeatalkPilotLockedHeading_ManufacturerCode,
+    "SeatalkPilotLockedHeading.IndustryCode": &fieldSpec_SeatalkPilotLockedHeading_IndustryCode,
+    "SeatalkPilotLockedHeading.SID": &fieldSpec_SeatalkPilotLockedHeading_SID,
+    "SeatalkPilotLockedHeading.TargetHeadingTrue": &fieldSpec_SeatalkPilotLockedHeading_TargetHeadingTrue,
+    "SeatalkPilotLockedHeading.TargetHeadingMagnetic": &fieldSpec_SeatalkPilotLockedHeading_TargetHeadingMagnetic,
+    "SeatalkSilenceAlarm.ManufacturerCode": &fieldSpec_SeatalkSilenceAlarm_ManufacturerCode,
+    "SeatalkSilenceAlarm.IndustryCode": &fieldSpec_SeatalkSilenceAlarm_IndustryCode,
+    "SeatalkKeypadMessage.ManufacturerCode": &fieldSpec_SeatalkKeypadMessage_ManufacturerCode,
+    "SeatalkKeypadMessage.IndustryCode": &fieldSpec_SeatalkKeypadMessage_IndustryCode,
+    "SeatalkKeypadMessage.ProprietaryID": &fieldSpec_SeatalkKeypadMessage_ProprietaryID,
+    "SeatalkKeypadMessage.FirstKey": &fieldSpec_SeatalkKeypadMessage_FirstKey,
+    "SeatalkKeypadMessage.SecondKey": &fieldSpec_SeatalkKeypadMessage_SecondKey,
+    "SeatalkKeypadMessage.FirstKeyState": &fieldSpec_SeatalkKeypadMessage_FirstKeyState,
+    "SeatalkKeypadMessage.SecondKeyState": &fieldSpec_SeatalkKeypadMessage_SecondKeyState,
+    "SeatalkKeypadMessage.EncoderPosition": &fieldSpec_SeatalkKeypadMessage_EncoderPosition,
+    "SeatalkKeypadHeartbeat.ManufacturerCode": &fieldSpec_SeatalkKeypadHeartbeat_ManufacturerCode,
+    "SeatalkKeypadHeartbeat.IndustryCode": &fieldSpec_SeatalkKeypadHeartbeat_IndustryCode,
+    "SeatalkKeypadHeartbeat.ProprietaryID": &fieldSpec_SeatalkKeypadHeartbeat_ProprietaryID,
+    "SeatalkKeypadHeartbeat.Variant": &fieldSpec_SeatalkKeypadHeartbeat_Variant,
+    "SeatalkKeypadHeartbeat.Status": &fieldSpec_SeatalkKeypadHeartbeat_Status,
+    "SeatalkPilotMode.ManufacturerCode": &fieldSpec_SeatalkPilotMode_ManufacturerCode,
+    "SeatalkPilotMode.IndustryCode": &fieldSpec_SeatalkPilotMode_IndustryCode,
+    "LumishoreLightStatus.ManufacturerCode": &fieldSpec_LumishoreLightStatus_ManufacturerCode,
+    "LumishoreLightStatus.IndustryCode": &fieldSpec_LumishoreLightStatus_IndustryCode,
+    "LumishoreLightStatus.ZoneIndex": &fieldSpec_LumishoreLightStatus_ZoneIndex,
+    "LumishoreLightStatus.Red": &fieldSpec_LumishoreLightStatus_Red,
+    "LumishoreLightStatus.Green": &fieldSpec_LumishoreLightStatus_Green,
+    "LumishoreLightStatus.Blue": &fieldSpec_LumishoreLightStatus_Blue,
+    "LumishoreLightStatus.ColdWhite": &fieldSpec_LumishoreLightStatus_ColdWhite,
+    "LumishoreLightStatus.WarmWhite": &fieldSpec_LumishoreLightStatus_WarmWhite,
+    "AirmarDepthQualityFactor.ManufacturerCode": &fieldSpec_AirmarDepthQualityFactor_ManufacturerCode,
+    "AirmarDepthQualityFactor.IndustryCode": &fieldSpec_AirmarDepthQualityFactor_IndustryCode,
+    "AirmarDepthQualityFactor.SID": &fieldSpec_AirmarDepthQualityFactor_SID,
+    "AirmarSpeedPulseCount.ManufacturerCode": &fieldSpec_AirmarSpeedPulseCount_ManufacturerCode,
+    "AirmarSpeedPulseCount.IndustryCode": &fieldSpec_AirmarSpeedPulseCount_IndustryCode,
+    "AirmarSpeedPulseCount.SID": &fieldSpec_AirmarSpeedPulseCount_SID,
+    "AirmarSpeedPulseCount.DurationOfInterval": &fieldSpec_AirmarSpeedPulseCount_DurationOfInterval,
+    "AirmarSpeedPulseCount.NumberOfPulsesReceived": &fieldSpec_AirmarSpeedPulseCount_NumberOfPulsesReceived,
+    "AirmarDeviceInformation.ManufacturerCode": &fieldSpec_AirmarDeviceInformation_ManufacturerCode,
+    "AirmarDeviceInformation.IndustryCode": &fieldSpec_AirmarDeviceInformation_IndustryCode,
+    "AirmarDeviceInformation.SID": &fieldSpec_AirmarDeviceInformation_SID,
+    "AirmarDeviceInformation.InternalDeviceTemperature": &fieldSpec_AirmarDeviceInformation_InternalDeviceTemperature,
+    "AirmarDeviceInformation.SupplyVoltage": &fieldSpec_AirmarDeviceInformation_SupplyVoltage,
+    "SimnetApUnknown3.ManufacturerCode": &fieldSpec_SimnetApUnknown3_ManufacturerCode,
+    "SimnetApUnknown3.IndustryCode": &fieldSpec_SimnetApUnknown3_IndustryCode,
+    "SimnetApUnknown3.Value": &fieldSpec_SimnetApUnknown3_Value,
+    "SimnetApUnknown3.C": &fieldSpec_SimnetApUnknown3_C,
+    "SimnetApUnknown3.D": &fieldSpec_SimnetApUnknown3_D,
+    "SimnetApUnknown3.SubIndex": &fieldSpec_SimnetApUnknown3_SubIndex,
+    "YamahaEngineDataC.ManufacturerCode": &fieldSpec_YamahaEngineDataC_ManufacturerCode,
+    "YamahaEngineDataC.IndustryCode": &fieldSpec_YamahaEngineDataC_IndustryCode,
+    "NavicoNaviopSwitchStatus.ManufacturerCode": &fieldSpec_NavicoNaviopSwitchStatus_ManufacturerCode,
+    "NavicoNaviopSwitchStatus.IndustryCode": &fieldSpec_NavicoNaviopSwitchStatus_IndustryCode,
+    "NavicoNaviopSwitchControl.ManufacturerCode": &fieldSpec_NavicoNaviopSwitchControl_ManufacturerCode,
+    "NavicoNaviopSwitchControl.IndustryCode": &fieldSpec_NavicoNaviopSwitchControl_IndustryCode,
+    "YamahaEngineDataD.ManufacturerCode": &fieldSpec_YamahaEngineDataD_ManufacturerCode,
+    "YamahaEngineDataD.IndustryCode": &fieldSpec_YamahaEngineDataD_IndustryCode,
+    "SimnetAutopilotMode.ManufacturerCode": &fieldSpec_SimnetAutopilotMode_ManufacturerCode,
+    "SimnetAutopilotMode.IndustryCode": &fieldSpec_SimnetAutopilotMode_IndustryCode,
+    "NMEARequestGroupFunction.FunctionCode": &fieldSpec_NMEARequestGroupFunction_FunctionCode,
+    "NMEARequestGroupFunction.PGN": &fieldSpec_NMEARequestGroupFunction_PGN,
+    "NMEARequestGroupFunction.TransmissionInterval": &fieldSpec_NMEARequestGroupFunction_TransmissionInterval,
+    "NMEARequestGroupFunction.TransmissionIntervalOffset": &fieldSpec_NMEARequestGroupFunction_TransmissionIntervalOffset,
+    "NMEARequestGroupFunction.NumberOfParameters": &fieldSpec_NMEARequestGroupFunction_NumberOfParameters,
+    "NMEARequestGroupFunction.Parameter": &fieldSpec_NMEARequestGroupFunction_Parameter,
+    "NMEARequestGroupFunction.Value": &fieldSpec_NMEARequestGroupFunction_Value,
+    "NMEACommandGroupFunction.FunctionCode": &fieldSpec_NMEACommandGroupFunction_FunctionCode,
+    "NMEACommandGroupFunction.PGN": &fieldSpec_NMEACommandGroupFunction_PGN,
+    "NMEACommandGroupFunction.NumberOfParameters": &fieldSpec_NMEACommandGroupFunction_NumberOfParameters,
+    "NMEACommandGroupFunction.Parameter": &fieldSpec_NMEACommandGroupFunction_Parameter,
+    "NMEACommandGroupFunction.Value": &fieldSpec_NMEACommandGroupFunction_Value,
+    "NMEAAcknowledgeGroupFunction.FunctionCode": &fieldSpec_NMEAAcknowledgeGroupFunction_FunctionCode,
+    "NMEAAcknowledgeGroupFunction.PGN": &fieldSpec_NMEAAcknowledgeGroupFunction_PGN,
+    "NMEAAcknowledgeGroupFunction.NumberOfParameters": &fieldSpec_NMEAAcknowledgeGroupFunction_NumberOfParameters,
+    "NMEAReadFieldsGroupFunction.FunctionCode": &fieldSpec_NMEAReadFieldsGroupFunction_FunctionCode,
+    "NMEAReadFieldsGroupFunction.PGN": &fieldSpec_NMEAReadFieldsGroupFunction_PGN,
+    "NMEAReadFieldsGroupFunction.UniqueID": &fieldSpec_NMEAReadFieldsGroupFunction_UniqueID,
+    "NMEAReadFieldsGroupFunction.NumberOfSelectionPairs": &fieldSpec_NMEAReadFieldsGroupFunction_NumberOfSelectionPairs,
+    "NMEAReadFieldsGroupFunction.NumberOfParameters": &fieldSpec_NMEAReadFieldsGroupFunction_NumberOfParameters,
+    "NMEAReadFieldsGroupFunction.SelectionParameter": &fieldSpec_NMEAReadFieldsGroupFunction_SelectionParameter,
+    "NMEAReadFieldsGroupFunction.SelectionValue": &fieldSpec_NMEAReadFieldsGroupFunction_SelectionValue,
+    "NMEAReadFieldsGroupFunction.Parameter": &fieldSpec_NMEAReadFieldsGroupFunction_Parameter,
+    "NMEAReadFieldsReplyGroupFunction.FunctionCode": &fieldSpec_NMEAReadFieldsReplyGroupFunction_FunctionCode,
+    "NMEAReadFieldsReplyGroupFunction.PGN": &fieldSpec_NMEAReadFieldsReplyGroupFunction_PGN,
+    "NMEAReadFieldsReplyGroupFunction.UniqueID": &fieldSpec_NMEAReadFieldsReplyGroupFunction_UniqueID,
+    "NMEAReadFieldsReplyGroupFunction.NumberOfSelectionPairs": &fieldSpec_NMEAReadFieldsReplyGroupFunction_NumberOfSelectionPairs,
+    "NMEAReadFieldsReplyGroupFunction.NumberOfParameters": &fieldSpec_NMEAReadFieldsReplyGroupFunction_NumberOfParameters,
+    "NMEAReadFieldsReplyGroupFunction.SelectionParameter": &fieldSpec_NMEAReadFieldsReplyGroupFunction_SelectionParameter,
+    "NMEAReadFieldsReplyGroupFunction.SelectionValue": &fieldSpec_NMEAReadFieldsReplyGroupFunction_SelectionValue,
+    "NMEAReadFieldsReplyGroupFunction.Parameter": &fieldSpec_NMEAReadFieldsReplyGroupFunction_Parameter,
+    "NMEAReadFieldsReplyGroupFunction.Value": &fieldSpec_NMEAReadFieldsReplyGroupFunction_Value,
+    "NMEAWriteFieldsGroupFunction.FunctionCode": &fieldSpec_NMEAWriteFieldsGroupFunction_FunctionCode,
+    "NMEAWriteFieldsGroupFunction.PGN": &fieldSpec_NMEAWriteFieldsGroupFunction_PGN,
+    "NMEAWriteFieldsGroupFunction.UniqueID": &fieldSpec_NMEAWriteFieldsGroupFunction_UniqueID,
+    "NMEAWriteFieldsGroupFunction.NumberOfSelectionPairs": &fieldSpec_NMEAWriteFieldsGroupFunction_NumberOfSelectionPairs,
+    "NMEAWriteFieldsGroupFunction.NumberOfParameters": &fieldSpec_NMEAWriteFieldsGroupFunction_NumberOfParameters,
+    "NMEAWriteFieldsGroupFunction.SelectionParameter": &fieldSpec_NMEAWriteFieldsGroupFunction_SelectionParameter,
+    "NMEAWriteFieldsGroupFunction.SelectionValue": &fieldSpec_NMEAWriteFieldsGroupFunction_SelectionValue,
+    "NMEAWriteFieldsGroupFunction.Parameter": &fieldSpec_NMEAWriteFieldsGroupFunction_Parameter,
+    "NMEAWriteFieldsGroupFunction.Value": &fieldSpec_NMEAWriteFieldsGroupFunction_Value,
+    "NMEAWriteFieldsReplyGroupFunction.FunctionCode": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_FunctionCode,
+    "NMEAWriteFieldsReplyGroupFunction.PGN": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_PGN,
+    "NMEAWriteFieldsReplyGroupFunction.UniqueID": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_UniqueID,
+    "NMEAWriteFieldsReplyGroupFunction.NumberOfSelectionPairs": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_NumberOfSelectionPairs,
+    "NMEAWriteFieldsReplyGroupFunction.NumberOfParameters": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_NumberOfParameters,
+    "NMEAWriteFieldsReplyGroupFunction.SelectionParameter": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_SelectionParameter,
+    "NMEAWriteFieldsReplyGroupFunction.SelectionValue": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_SelectionValue,
+    "NMEAWriteFieldsReplyGroupFunction.Parameter": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_Parameter,
+    "NMEAWriteFieldsReplyGroupFunction.Value": &fieldSpec_NMEAWriteFieldsReplyGroupFunction_Value,
+    "PGNListTransmitAndReceive.PGN": &fieldSpec_PGNListTransmitAndReceive_PGN,
+    "GarminAhrsAttCOGSourceValidFlag.ManufacturerCode": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_ManufacturerCode,
+    "GarminAhrsAttCOGSourceValidFlag.IndustryCode": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_IndustryCode,
+    "GarminAhrsAttCOGSourceValidFlag.SubProtocolID": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_SubProtocolID,
+    "GarminAhrsAttCOGSourceValidFlag.WrapperByte1": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_WrapperByte1,
+    "GarminAhrsAttCOGSourceValidFlag.WrapperByte2": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_WrapperByte2,
+    "GarminAhrsAttCOGSourceValidFlag.AttMessageID": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_AttMessageID,
+    "GarminAhrsAttCOGSourceValidFlag.COGSourceFlags": &fieldSpec_GarminAhrsAttCOGSourceValidFlag_COGSourceFlags,
+    "GarminAhrsAttDeviceFlags.ManufacturerCode": &fieldSpec_GarminAhrsAttDeviceFlags_ManufacturerCode,
+    "GarminAhrsAttDeviceFlags.IndustryCode": &fieldSpec_GarminAhrsAttDeviceFlags_IndustryCode,
+    "GarminAhrsAttDeviceFlags.SubProtocolID": &fieldSpec_GarminAhrsAttDeviceFlags_SubProtocolID,
+    "GarminAhrsAttDeviceFlags.WrapperByte1": &fieldSpec_GarminAhrsAttDeviceFlags_WrapperByte1,
+    "GarminAhrsAttDeviceFlags.WrapperByte2": &fieldSpec_GarminAhrsAttDeviceFlags_WrapperByte2,
+    "GarminAhrsAttDeviceFlags.AttMessageID": &fieldSpec_GarminAhrsAttDeviceFlags_AttMessageID,
+    "GarminAhrsAttDeviceFlags.DeviceFlags": &fieldSpec_GarminAhrsAttDeviceFlags_DeviceFlags,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.ManufacturerCode": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_ManufacturerCode,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.IndustryCode": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_IndustryCode,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.SubProtocolID": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_SubProtocolID,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.WrapperByte1": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_WrapperByte1,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.WrapperByte2": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_WrapperByte2,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.AttMessageID": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_AttMessageID,
+    "GarminAhrsAttNonDefaultCalibrationMatrixPresent.CalibrationMatrixPresent": &fieldSpec_GarminAhrsAttNonDefaultCalibrationMatrixPresent_CalibrationMatrixPresent,
+    "GarminAhrsAttSetNorthState.ManufacturerCode": &fieldSpec_GarminAhrsAttSetNorthState_ManufacturerCode,
+    "GarminAhrsAttSetNorthState.IndustryCode": &fieldSpec_GarminAhrsAttSetNorthState_IndustryCode,
+    "GarminAhrsAttSetNorthState.SubProtocolID": &fieldSpec_GarminAhrsAttSetNorthState_SubProtocolID,
+    "GarminAhrsAttSetNorthState.WrapperByte1": &fieldSpec_GarminAhrsAttSetNorthState_WrapperByte1,
+    "GarminAhrsAttSetNorthState.WrapperByte2": &fieldSpec_GarminAhrsAttSetNorthState_WrapperByte2,
+    "GarminAhrsAttSetNorthState.AttMessageID": &fieldSpec_GarminAhrsAttSetNorthState_AttMessageID,
+    "GarminAhrsAttSetNorthState.SetNorthState": &fieldSpec_GarminAhrsAttSetNorthState_SetNorthState,
+    "GarminAutopilotHeadingToSteer.ManufacturerCode": &fieldSpec_GarminAutopilotHeadingToSteer_ManufacturerCode,
+    "GarminAutopilotHeadingToSteer.IndustryCode": &fieldSpec_GarminAutopilotHeadingToSteer_IndustryCode,
+    "GarminAutopilotHeadingToSteer.SubProtocolID": &fieldSpec_GarminAutopilotHeadingToSteer_SubProtocolID,
+    "GarminAutopilotHeadingToSteer.WrapperByte1": &fieldSpec_GarminAutopilotHeadingToSteer_WrapperByte1,
+    "GarminAutopilotHeadingToSteer.WrapperByte2": &fieldSpec_GarminAutopilotHeadingToSteer_WrapperByte2,
+    "GarminAutopilotHeadingToSteer.FieldGroup": &fieldSpec_GarminAutopilotHeadingToSteer_FieldGroup,
+    "GarminAutopilotHeadingToSteer.Field": &fieldSpec_GarminAutopilotHeadingToSteer_Field,
+    "GarminAutopilotRateOfTurn.ManufacturerCode": &fieldSpec_GarminAutopilotRateOfTurn_ManufacturerCode,
+    "GarminAutopilotRateOfTurn.IndustryCode": &fieldSpec_GarminAutopilotRateOfTurn_IndustryCode,
+    "GarminAutopilotRateOfTurn.SubProtocolID": &fieldSpec_GarminAutopilotRateOfTurn_SubProtocolID,
+    "GarminAutopilotRateOfTurn.WrapperByte1": &fieldSpec_GarminAutopilotRateOfTurn_WrapperByte1,
+    "GarminAutopilotRateOfTurn.WrapperByte2": &fieldSpec_GarminAutopilotRateOfTurn_WrapperByte2,
+    "GarminAutopilotRateOfTurn.FieldGroup": &fieldSpec_GarminAutopilotRateOfTurn_FieldGroup,
+    "GarminAutopilotRateOfTurn.Field": &fieldSpec_GarminAutopilotRateOfTurn_Field,
+    "GarminAutopilotRateOfTurnOrder.ManufacturerCode": &fieldSpec_GarminAutopilotRateOfTurnOrder_ManufacturerCode,
+    "GarminAutopilotRateOfTurnOrder.IndustryCode": &fieldSpec_GarminAutopilotRateOfTurnOrder_IndustryCode,
+    "GarminAutopilotRateOfTurnOrder.SubProtocolID": &fieldSpec_GarminAutopilotRateOfTurnOrder_SubProtocolID,
+    "GarminAutopilotRateOfTurnOrder.WrapperByte1": &fieldSpec_GarminAutopilotRateOfTurnOrder_WrapperByte1,
+    "GarminAutopilotRateOfTurnOrder.WrapperByte2": &fieldSpec_GarminAutopilotRateOfTurnOrder_WrapperByte2,
+    "GarminAutopilotRateOfTurnOrder.FieldGroup": &fieldSpec_GarminAutopilotRateOfTurnOrder_FieldGroup,
+    "GarminAutopilotRateOfTurnOrder.Field": &fieldSpec_GarminAutopilotRateOfTurnOrder_Field,
+    "GarminAutopilotSpeed.ManufacturerCode": &fieldSpec_GarminAutopilotSpeed_ManufacturerCode,
+    "GarminAutopilotSpeed.IndustryCode": &fieldSpec_GarminAutopilotSpeed_IndustryCode,
+    "GarminAutopilotSpeed.SubProtocolID": &fieldSpec_GarminAutopilotSpeed_SubProtocolID,
+    "GarminAutopilotSpeed.WrapperByte1": &fieldSpec_GarminAutopilotSpeed_WrapperByte1,
+    "GarminAutopilotSpeed.WrapperByte2": &fieldSpec_GarminAutopilotSpeed_WrapperByte2,
+    "GarminAutopilotSpeed.FieldGroup": &fieldSpec_GarminAutopilotSpeed_FieldGroup,
+    "GarminAutopilotSpeed.Field": &fieldSpec_GarminAutopilotSpeed_Field,
+    "GarminAutopilotSystemVoltage.ManufacturerCode": &fieldSpec_GarminAutopilotSystemVoltage_ManufacturerCode,
+    "GarminAutopilotSystemVoltage.IndustryCode": &fieldSpec_GarminAutopilotSystemVoltage_IndustryCode,
+    "GarminAutopilotSystemVoltage.SubProtocolID": &fieldSpec_GarminAutopilotSystemVoltage_SubProtocolID,
+    "GarminAutopilotSystemVoltage.WrapperByte1": &fieldSpec_GarminAutopilotSystemVoltage_WrapperByte1,
+    "GarminAutopilotSystemVoltage.WrapperByte2": &fieldSpec_GarminAutopilotSystemVoltage_WrapperByte2,
+    "GarminAutopilotSystemVoltage.FieldGroup": &fieldSpec_GarminAutopilotSystemVoltage_FieldGroup,
+    "GarminAutopilotSystemVoltage.Field": &fieldSpec_GarminAutopilotSystemVoltage_Field,
+    "GarminAutopilotSystemVoltage.SystemVoltage": &fieldSpec_GarminAutopilotSystemVoltage_SystemVoltage,
+    "GarminAutopilotTurnAngleOrder.ManufacturerCode": &fieldSpec_GarminAutopilotTurnAngleOrder_ManufacturerCode,
+    "GarminAutopilotTurnAngleOrder.IndustryCode": &fieldSpec_GarminAutopilotTurnAngleOrder_IndustryCode,
+    "GarminAutopilotTurnAngleOrder.SubProtocolID": &fieldSpec_GarminAutopilotTurnAngleOrder_SubProtocolID,
+    "GarminAutopilotTurnAngleOrder.WrapperByte1": &fieldSpec_GarminAutopilotTurnAngleOrder_WrapperByte1,
+    "GarminAutopilotTurnAngleOrder.WrapperByte2": &fieldSpec_GarminAutopilotTurnAngleOrder_WrapperByte2,
+    "GarminAutopilotTurnAngleOrder.FieldGroup": &fieldSpec_GarminAutopilotTurnAngleOrder_FieldGroup,
+    "GarminAutopilotTurnAngleOrder.Field": &fieldSpec_GarminAutopilotTurnAngleOrder_Field,
+    "GarminAutopilotTurnAngleOrder.TurnAngleOrder": &fieldSpec_GarminAutopilotTurnAngleOrder_TurnAngleOrder,
+    "GarminAutopilotTurnAngleMeasured.ManufacturerCode": &fieldSpec_GarminAutopilotTurnAngleMeasured_ManufacturerCode,
+    "GarminAutopilotTurnAngleMeasured.IndustryCode": &fieldSpec_GarminAutopilotTurnAngleMeasured_IndustryCode,
+    "GarminAutopilotTurnAngleMeasured.SubProtocolID": &fieldSpec_GarminAutopilotTurnAngleMeasured_SubProtocolID,
+    "GarminAutopilotTurnAngleMeasured.WrapperByte1": &fieldSpec_GarminAutopilotTurnAngleMeasured_WrapperByte1,
+    "GarminAutopilotTurnAngleMeasured.WrapperByte2": &fieldSpec_GarminAutopilotTurnAngleMeasured_WrapperByte2,
+    "GarminAutopilotTurnAngleMeasured.FieldGroup": &fieldSpec_GarminAutopilotTurnAngleMeasured_FieldGroup,
+    "GarminAutopilotTurnAngleMeasured.Field": &fieldSpec_GarminAutopilotTurnAngleMeasured_Field,
+    "GarminAutopilotTurnAngleMeasured.TurnAngleMeasured": &fieldSpec_GarminAutopilotTurnAngleMeasured_TurnAngleMeasured,
+    "GarminAutopilotEngineRPMA.ManufacturerCode": &fieldSpec_GarminAutopilotEngineRPMA_ManufacturerCode,
+    "GarminAutopilotEngineRPMA.IndustryCode": &fieldSpec_GarminAutopilotEngineRPMA_IndustryCode,
+    "GarminAutopilotEngineRPMA.SubProtocolID": &fieldSpec_GarminAutopilotEngineRPMA_SubProtocolID,
+    "GarminAutopilotEngineRPMA.WrapperByte1": &fieldSpec_GarminAutopilotEngineRPMA_WrapperByte1,
+    "GarminAutopilotEngineRPMA.WrapperByte2": &fieldSpec_GarminAutopilotEngineRPMA_WrapperByte2,
+    "GarminAutopilotEngineRPMA.FieldGroup": &fieldSpec_GarminAutopilotEngineRPMA_FieldGroup,
+    "GarminAutopilotEngineRPMA.Field": &fieldSpec_GarminAutopilotEngineRPMA_Field,
+    "GarminAutopilotEngineRPMA.EngineSpeed": &fieldSpec_GarminAutopilotEngineRPMA_EngineSpeed,
+    "GarminAutopilotEngineRPMB.ManufacturerCode": &fieldSpec_GarminAutopilotEngineRPMB_ManufacturerCode,
+    "GarminAutopilotEngineRPMB.IndustryCode": &fieldSpec_GarminAutopilotEngineRPMB_IndustryCode,
+    "GarminAutopilotEngineRPMB.SubProtocolID": &fieldSpec_GarminAutopilotEngineRPMB_SubProtocolID,
+    "GarminAutopilotEngineRPMB.WrapperByte1": &fieldSpec_GarminAutopilotEngineRPMB_WrapperByte1,
+    "GarminAutopilotEngineRPMB.WrapperByte2": &fieldSpec_GarminAutopilotEngineRPMB_WrapperByte2,
+    "GarminAutopilotEngineRPMB.FieldGroup": &fieldSpec_GarminAutopilotEngineRPMB_FieldGroup,
+    "GarminAutopilotEngineRPMB.Field": &fieldSpec_GarminAutopilotEngineRPMB_Field,
+    "GarminAutopilotEngineRPMB.EngineSpeed": &fieldSpec_GarminAutopilotEngineRPMB_EngineSpeed,
+    "GarminAutopilotResponseSetting.ManufacturerCode": &fieldSpec_GarminAutopilotResponseSetting_ManufacturerCode,
+    "GarminAutopilotResponseSetting.IndustryCode": &fieldSpec_GarminAutopilotResponseSetting_IndustryCode,
+    "GarminAutopilotResponseSetting.SubProtocolID": &fieldSpec_GarminAutopilotResponseSetting_SubProtocolID,
+    "GarminAutopilotResponseSetting.WrapperByte1": &fieldSpec_GarminAutopilotResponseSetting_WrapperByte1,
+    "GarminAutopilotResponseSetting.WrapperByte2": &fieldSpec_GarminAutopilotResponseSetting_WrapperByte2,
+    "GarminAutopilotResponseSetting.FieldGroup": &fieldSpec_GarminAutopilotResponseSetting_FieldGroup,
+    "GarminAutopilotResponseSetting.Field": &fieldSpec_GarminAutopilotResponseSetting_Field,
+    "GarminAutopilotResponseSetting.ResponseSetting": &fieldSpec_GarminAutopilotResponseSetting_ResponseSetting,
+    "GarminAutopilotModeState.ManufacturerCode": &fieldSpec_GarminAutopilotModeState_ManufacturerCode,
+    "GarminAutopilotModeState.IndustryCode": &fieldSpec_GarminAutopilotModeState_IndustryCode,
+    "GarminAutopilotModeState.SubProtocolID": &fieldSpec_GarminAutopilotModeState_SubProtocolID,
+    "GarminAutopilotModeState.WrapperByte1": &fieldSpec_GarminAutopilotModeState_WrapperByte1,
+    "GarminAutopilotModeState.WrapperByte2": &fieldSpec_GarminAutopilotModeState_WrapperByte2,
+    "GarminAutopilotModeState.FieldGroup": &fieldSpec_GarminAutopilotModeState_FieldGroup,
+    "GarminAutopilotModeState.Field": &fieldSpec_GarminAutopilotModeState_Field,
+    "GarminAutopilotHeartbeat.ManufacturerCode": &fieldSpec_GarminAutopilotHeartbeat_ManufacturerCode,
+    "GarminAutopilotHeartbeat.IndustryCode": &fieldSpec_GarminAutopilotHeartbeat_IndustryCode,
+    "GarminAutopilotHeartbeat.SubProtocolID": &fieldSpec_GarminAutopilotHeartbeat_SubProtocolID,
+    "GarminAutopilotHeartbeat.WrapperByte1": &fieldSpec_GarminAutopilotHeartbeat_WrapperByte1,
+    "GarminAutopilotHeartbeat.WrapperByte2": &fieldSpec_GarminAutopilotHeartbeat_WrapperByte2,
+    "GarminAutopilotHeartbeat.FieldGroup": &fieldSpec_GarminAutopilotHeartbeat_FieldGroup,
+    "GarminAutopilotHeartbeat.Field": &fieldSpec_GarminAutopilotHeartbeat_Field,
+    "GarminAutopilotManeuver.ManufacturerCode": &fieldSpec_GarminAutopilotManeuver_ManufacturerCode,
+    "GarminAutopilotManeuver.IndustryCode": &fieldSpec_GarminAutopilotManeuver_IndustryCode,
+    "GarminAutopilotManeuver.SubProtocolID": &fieldSpec_GarminAutopilotManeuver_SubProtocolID,
+    "GarminAutopilotManeuver.WrapperByte1": &fieldSpec_GarminAutopilotManeuver_WrapperByte1,
+    "GarminAutopilotManeuver.WrapperByte2": &fieldSpec_GarminAutopilotManeuver_WrapperByte2,
+    "GarminAutopilotManeuver.FieldGroup": &fieldSpec_GarminAutopilotManeuver_FieldGroup,
+    "GarminAutopilotManeuver.ManeuverCode": &fieldSpec_GarminAutopilotManeuver_ManeuverCode,
+    "GarminAutopilotManeuver.Value": &fieldSpec_GarminAutopilotManeuver_Value,
+    "Seatalk1PilotMode.ManufacturerCode": &fieldSpec_Seatalk1PilotMode_ManufacturerCode,
+    "Seatalk1PilotMode.IndustryCode": &fieldSpec_Seatalk1PilotMode_IndustryCode,
+    "Seatalk1PilotMode.ProprietaryID": &fieldSpec_Seatalk1PilotMode_ProprietaryID,
+    "Seatalk1PilotMode.Command": &fieldSpec_Seatalk1PilotMode_Command,
+    "Seatalk1PilotMode.Seatalk1Command": &fieldSpec_Seatalk1PilotMode_Seatalk1Command,
+    "Seatalk1PilotMode.SubMode": &fieldSpec_Seatalk1PilotMode_SubMode,
+    "Seatalk1PilotHullType.ManufacturerCode": &fieldSpec_Seatalk1PilotHullType_ManufacturerCode,
+    "Seatalk1PilotHullType.IndustryCode": &fieldSpec_Seatalk1PilotHullType_IndustryCode,
+    "Seatalk1PilotHullType.ProprietaryID": &fieldSpec_Seatalk1PilotHullType_ProprietaryID,
+    "Seatalk1PilotHullType.Command": &fieldSpec_Seatalk1PilotHullType_Command,
+    "SeatalkPilotAutoTurn.ManufacturerCode": &fieldSpec_SeatalkPilotAutoTurn_ManufacturerCode,
+    "SeatalkPilotAutoTurn.IndustryCode": &fieldSpec_SeatalkPilotAutoTurn_IndustryCode,
+    "SeatalkPilotAutoTurn.ProprietaryID": &fieldSpec_SeatalkPilotAutoTurn_ProprietaryID,
+    "SeatalkPilotAutoTurn.Command": &fieldSpec_SeatalkPilotAutoTurn_Command,
+    "Seatalk1DeviceIdentification.ManufacturerCode": &fieldSpec_Seatalk1DeviceIdentification_ManufacturerCode,
+    "Seatalk1DeviceIdentification.IndustryCode": &fieldSpec_Seatalk1DeviceIdentification_IndustryCode,
+    "Seatalk1DeviceIdentification.ProprietaryID": &fieldSpec_Seatalk1DeviceIdentification_ProprietaryID,
+    "Seatalk1DeviceIdentification.Command": &fieldSpec_Seatalk1DeviceIdentification_Command,
+    "Seatalk1DeviceIdentification.Seatalk1Command": &fieldSpec_Seatalk1DeviceIdentification_Seatalk1Command,
+    "Seatalk1DisplayBrightness.ManufacturerCode": &fieldSpec_Seatalk1DisplayBrightness_ManufacturerCode,
+    "Seatalk1DisplayBrightness.IndustryCode": &fieldSpec_Seatalk1DisplayBrightness_IndustryCode,
+    "Seatalk1DisplayBrightness.ProprietaryID": &fieldSpec_Seatalk1DisplayBrightness_ProprietaryID,
+    "Seatalk1DisplayBrightness.Command1": &fieldSpec_Seatalk1DisplayBrightness_Command1,
+    "Seatalk1DisplayBrightness.Command": &fieldSpec_Seatalk1DisplayBrightness_Command,
+    "Seatalk1DisplayBrightness.Brightness": &fieldSpec_Seatalk1DisplayBrightness_Brightness,
+    "Seatalk1DisplayColor.ManufacturerCode": &fieldSpec_Seatalk1DisplayColor_ManufacturerCode,
+    "Seatalk1DisplayColor.IndustryCode": &fieldSpec_Seatalk1DisplayColor_IndustryCode,
+    "Seatalk1DisplayColor.ProprietaryID": &fieldSpec_Seatalk1DisplayColor_ProprietaryID,
+    "Seatalk1DisplayColor.Command1": &fieldSpec_Seatalk1DisplayColor_Command1,
+    "Seatalk1DisplayColor.Command": &fieldSpec_Seatalk1DisplayColor_Command,
+    "Seatalk1Keystroke.ManufacturerCode": &fieldSpec_Seatalk1Keystroke_ManufacturerCode,
+    "Seatalk1Keystroke.IndustryCode": &fieldSpec_Seatalk1Keystroke_IndustryCode,
+    "Seatalk1Keystroke.ProprietaryID": &fieldSpec_Seatalk1Keystroke_ProprietaryID,
+    "Seatalk1Keystroke.Command": &fieldSpec_Seatalk1Keystroke_Command,
+    "Seatalk1Keystroke.Seatalk1Command": &fieldSpec_Seatalk1Keystroke_Seatalk1Command,
+    "Seatalk1Keystroke.Device": &fieldSpec_Seatalk1Keystroke_Device,
+    "Seatalk1Keystroke.Keyinverted": &fieldSpec_Seatalk1Keystroke_Keyinverted,
+    "FusionMediaControl.ManufacturerCode": &fieldSpec_FusionMediaControl_ManufacturerCode,
+    "FusionMediaControl.IndustryCode": &fieldSpec_FusionMediaControl_IndustryCode,
+    "FusionMediaControl.ProprietaryID": &fieldSpec_FusionMediaControl_ProprietaryID,
+    "FusionMediaControl.SourceID": &fieldSpec_FusionMediaControl_SourceID,
+    "FusionSiriusControl.ManufacturerCode": &fieldSpec_FusionSiriusControl_ManufacturerCode,
+    "FusionSiriusControl.IndustryCode": &fieldSpec_FusionSiriusControl_IndustryCode,
+    "FusionSiriusControl.ProprietaryID": &fieldSpec_FusionSiriusControl_ProprietaryID,
+    "FusionSiriusControl.SourceID": &fieldSpec_FusionSiriusControl_SourceID,
+    "FusionSiriusControl.Data": &fieldSpec_FusionSiriusControl_Data,
+    "FusionRequestStatus.ManufacturerCode": &fieldSpec_FusionRequestStatus_ManufacturerCode,
+    "FusionRequestStatus.IndustryCode": &fieldSpec_FusionRequestStatus_IndustryCode,
+    "FusionRequestStatus.ProprietaryID": &fieldSpec_FusionRequestStatus_ProprietaryID,
+    "FusionSetSource.ManufacturerCode": &fieldSpec_FusionSetSource_ManufacturerCode,
+    "FusionSetSource.IndustryCode": &fieldSpec_FusionSetSource_IndustryCode,
+    "FusionSetSource.ProprietaryID": &fieldSpec_FusionSetSource_ProprietaryID,
+    "FusionSetSource.SourceID": &fieldSpec_FusionSetSource_SourceID,
+    "FusionSetMute.ManufacturerCode": &fieldSpec_FusionSetMute_ManufacturerCode,
+    "FusionSetMute.IndustryCode": &fieldSpec_FusionSetMute_IndustryCode,
+    "FusionSetMute.ProprietaryID": &fieldSpec_FusionSetMute_ProprietaryID,
+    "FusionSetZoneVolume.ManufacturerCode": &fieldSpec_FusionSetZoneVolume_ManufacturerCode,
+    "FusionSetZoneVolume.IndustryCode": &fieldSpec_FusionSetZoneVolume_IndustryCode,
+    "FusionSetZoneVolume.ProprietaryID": &fieldSpec_FusionSetZoneVolume_ProprietaryID,
+    "FusionSetZoneVolume.Zone": &fieldSpec_FusionSetZoneVolume_Zone,
+    "FusionSetZoneVolume.Volume": &fieldSpec_FusionSetZoneVolume_Volume,
+    "FusionSetAllVolumes.ManufacturerCode": &fieldSpec_FusionSetAllVolumes_ManufacturerCode,
+    "FusionSetAllVolumes.IndustryCode": &fieldSpec_FusionSetAllVolumes_IndustryCode,
+    "FusionSetAllVolumes.ProprietaryID": &fieldSpec_FusionSetAllVolumes_ProprietaryID,
+    "FusionSetAllVolumes.Zone1": &fieldSpec_FusionSetAllVolumes_Zone1,
+    "FusionSetAllVolumes.Zone2": &fieldSpec_FusionSetAllVolumes_Zone2,
+    "FusionSetAllVolumes.Zone3": &fieldSpec_FusionSetAllVolumes_Zone3,
+    "FusionSetAllVolumes.Zone4": &fieldSpec_FusionSetAllVolumes_Zone4,
+    "FusionSetPower.ManufacturerCode": &fieldSpec_FusionSetPower_ManufacturerCode,
+    "FusionSetPower.IndustryCode": &fieldSpec_FusionSetPower_IndustryCode,
+    "FusionSetPower.ProprietaryID": &fieldSpec_FusionSetPower_ProprietaryID,
+    "AirmarAttitudeOffset.ManufacturerCode": &fieldSpec_AirmarAttitudeOffset_ManufacturerCode,
+    "AirmarAttitudeOffset.IndustryCode": &fieldSpec_AirmarAttitudeOffset_IndustryCode,
+    "AirmarAttitudeOffset.ProprietaryID": &fieldSpec_AirmarAttitudeOffset_ProprietaryID,
+    "AirmarAttitudeOffset.AzimuthOffset": &fieldSpec_AirmarAttitudeOffset_AzimuthOffset,
+    "AirmarAttitudeOffset.PitchOffset": &fieldSpec_AirmarAttitudeOffset_PitchOffset,
+    "AirmarAttitudeOffset.RollOffset": &fieldSpec_AirmarAttitudeOffset_RollOffset,
+    "AirmarCalibrateCompass.ManufacturerCode": &fieldSpec_AirmarCalibrateCompass_ManufacturerCode,
+    "AirmarCalibrateCompass.IndustryCode": &fieldSpec_AirmarCalibrateCompass_IndustryCode,
+    "AirmarCalibrateCompass.ProprietaryID": &fieldSpec_AirmarCalibrateCompass_ProprietaryID,
+    "AirmarCalibrateCompass.VerifyScore": &fieldSpec_AirmarCalibrateCompass_VerifyScore,
+    "AirmarCalibrateCompass.XAxisGainValue": &fieldSpec_AirmarCalibrateCompass_XAxisGainValue,
+    "AirmarCalibrateCompass.YAxisGainValue": &fieldSpec_AirmarCalibrateCompass_YAxisGainValue,
+    "AirmarCalibrateCompass.ZAxisGainValue": &fieldSpec_AirmarCalibrateCompass_ZAxisGainValue,
+    "AirmarCalibrateCompass.XAxisLinearOffset": &fieldSpec_AirmarCalibrateCompass_XAxisLinearOffset,
+    "AirmarCalibrateCompass.YAxisLinearOffset": &fieldSpec_AirmarCalibrateCompass_YAxisLinearOffset,
+    "AirmarCalibrateCompass.ZAxisLinearOffset": &fieldSpec_AirmarCalibrateCompass_ZAxisLinearOffset,
+    "AirmarCalibrateCompass.XAxisAngularOffset": &fieldSpec_AirmarCalibrateCompass_XAxisAngularOffset,
+    "AirmarCalibrateCompass.PitchAndRollDamping": &fieldSpec_AirmarCalibrateCompass_PitchAndRollDamping,
+    "AirmarCalibrateCompass.CompassRateGyroDamping": &fieldSpec_AirmarCalibrateCompass_CompassRateGyroDamping,
+    "AirmarTrueWindOptions.ManufacturerCode": &fieldSpec_AirmarTrueWindOptions_ManufacturerCode,
+    "AirmarTrueWindOptions.IndustryCode": &fieldSpec_AirmarTrueWindOptions_IndustryCode,
+    "AirmarTrueWindOptions.ProprietaryID": &fieldSpec_AirmarTrueWindOptions_ProprietaryID,
+    "AirmarSimulateMode.ManufacturerCode": &fieldSpec_AirmarSimulateMode_ManufacturerCode,
+    "AirmarSimulateMode.IndustryCode": &fieldSpec_AirmarSimulateMode_IndustryCode,
+    "AirmarSimulateMode.ProprietaryID": &fieldSpec_AirmarSimulateMode_ProprietaryID,
+    "AirmarCalibrateDepth.ManufacturerCode": &fieldSpec_AirmarCalibrateDepth_ManufacturerCode,
+    "AirmarCalibrateDepth.IndustryCode": &fieldSpec_AirmarCalibrateDepth_IndustryCode,
+    "AirmarCalibrateDepth.ProprietaryID": &fieldSpec_AirmarCalibrateDepth_ProprietaryID,
+    "AirmarCalibrateDepth.SpeedOfSoundMode": &fieldSpec_AirmarCalibrateDepth_SpeedOfSoundMode,
+    "AirmarCalibrateSpeed.ManufacturerCode": &fieldSpec_AirmarCalibrateSpeed_ManufacturerCode,
+    "AirmarCalibrateSpeed.IndustryCode": &fieldSpec_AirmarCalibrateSpeed_IndustryCode,
+    "AirmarCalibrateSpeed.ProprietaryID": &fieldSpec_AirmarCalibrateSpeed_ProprietaryID,
+    "AirmarCalibrateSpeed.NumberOfPairsOfDataPoints": &fieldSpec_AirmarCalibrateSpeed_NumberOfPairsOfDataPoints,
+    "AirmarCalibrateSpeed.InputFrequency": &fieldSpec_AirmarCalibrateSpeed_InputFrequency,
+    "AirmarCalibrateSpeed.OutputSpeed": &fieldSpec_AirmarCalibrateSpeed_OutputSpeed,
+    "AirmarCalibrateTemperature.ManufacturerCode": &fieldSpec_AirmarCalibrateTemperature_ManufacturerCode,
+    "AirmarCalibrateTemperature.IndustryCode": &fieldSpec_AirmarCalibrateTemperature_IndustryCode,
+    "AirmarCalibrateTemperature.ProprietaryID": &fieldSpec_AirmarCalibrateTemperature_ProprietaryID,
+    "AirmarCalibrateTemperature.TemperatureOffset": &fieldSpec_AirmarCalibrateTemperature_TemperatureOffset,
+    "AirmarSpeedFilterNone.ManufacturerCode": &fieldSpec_AirmarSpeedFilterNone_ManufacturerCode,
+    "AirmarSpeedFilterNone.IndustryCode": &fieldSpec_AirmarSpeedFilterNone_IndustryCode,
+    "AirmarSpeedFilterNone.ProprietaryID": &fieldSpec_AirmarSpeedFilterNone_ProprietaryID,
+    "AirmarSpeedFilterNone.FilterType": &fieldSpec_AirmarSpeedFilterNone_FilterType,
+    "AirmarSpeedFilterNone.SampleInterval": &fieldSpec_AirmarSpeedFilterNone_SampleInterval,
+    "AirmarSpeedFilterIIR.ManufacturerCode": &fieldSpec_AirmarSpeedFilterIIR_ManufacturerCode,
+    "AirmarSpeedFilterIIR.IndustryCode": &fieldSpec_AirmarSpeedFilterIIR_IndustryCode,
+    "AirmarSpeedFilterIIR.ProprietaryID": &fieldSpec_AirmarSpeedFilterIIR_ProprietaryID,
+    "AirmarSpeedFilterIIR.FilterType": &fieldSpec_AirmarSpeedFilterIIR_FilterType,
+    "AirmarSpeedFilterIIR.SampleInterval": &fieldSpec_AirmarSpeedFilterIIR_SampleInterval,
+    "AirmarSpeedFilterIIR.FilterDuration": &fieldSpec_AirmarSpeedFilterIIR_FilterDuration,
+    "AirmarTemperatureFilterNone.ManufacturerCode": &fieldSpec_AirmarTemperatureFilterNone_ManufacturerCode,
+    "AirmarTemperatureFilterNone.IndustryCode": &fieldSpec_AirmarTemperatureFilterNone_IndustryCode,
+    "AirmarTemperatureFilterNone.ProprietaryID": &fieldSpec_AirmarTemperatureFilterNone_ProprietaryID,
+    "AirmarTemperatureFilterNone.FilterType": &fieldSpec_AirmarTemperatureFilterNone_FilterType,
+    "AirmarTemperatureFilterNone.SampleInterval": &fieldSpec_AirmarTemperatureFilterNone_SampleInterval,
+    "AirmarTemperatureFilterIIR.ManufacturerCode": &fieldSpec_AirmarTemperatureFilterIIR_ManufacturerCode,
+    "AirmarTemperatureFilterIIR.IndustryCode": &fieldSpec_AirmarTemperatureFilterIIR_IndustryCode,
+    "AirmarTemperatureFilterIIR.ProprietaryID": &fieldSpec_AirmarTemperatureFilterIIR_ProprietaryID,
+    "AirmarTemperatureFilterIIR.FilterType": &fieldSpec_AirmarTemperatureFilterIIR_FilterType,
+    "AirmarTemperatureFilterIIR.SampleInterval": &fieldSpec_AirmarTemperatureFilterIIR_SampleInterval,
+    "AirmarTemperatureFilterIIR.FilterDuration": &fieldSpec_AirmarTemperatureFilterIIR_FilterDuration,
+    "AirmarNMEA2000Options.ManufacturerCode": &fieldSpec_AirmarNMEA2000Options_ManufacturerCode,
+    "AirmarNMEA2000Options.IndustryCode": &fieldSpec_AirmarNMEA2000Options_IndustryCode,
+    "AirmarNMEA2000Options.ProprietaryID": &fieldSpec_AirmarNMEA2000Options_ProprietaryID,
+    "AirmarAddressableMultiFrame.ManufacturerCode": &fieldSpec_AirmarAddressableMultiFrame_ManufacturerCode,
+    "AirmarAddressableMultiFrame.IndustryCode": &fieldSpec_AirmarAddressableMultiFrame_IndustryCode,
+    "AirmarAddressableMultiFrame.ProprietaryID": &fieldSpec_AirmarAddressableMultiFrame_ProprietaryID,
+    "MaretronDeviationCalibrationResponse.ManufacturerCode": &fieldSpec_MaretronDeviationCalibrationResponse_ManufacturerCode,
+    "MaretronDeviationCalibrationResponse.IndustryCode": &fieldSpec_MaretronDeviationCalibrationResponse_IndustryCode,
+    "MaretronDeviationCalibrationResponse.SoftwareCode": &fieldSpec_MaretronDeviationCalibrationResponse_SoftwareCode,
+    "MaretronDeviationCalibrationResponse.Command": &fieldSpec_MaretronDeviationCalibrationResponse_Command,
+    "MaretronProprietaryConfiguration.ManufacturerCode": &fieldSpec_MaretronProprietaryConfiguration_ManufacturerCode,
+    "MaretronProprietaryConfiguration.IndustryCode": &fieldSpec_MaretronProprietaryConfiguration_IndustryCode,
+    "MaretronProprietaryConfiguration.SoftwareCode": &fieldSpec_MaretronProprietaryConfiguration_SoftwareCode,
+    "CarlingDCConfigurationCommand.ManufacturerCode": &fieldSpec_CarlingDCConfigurationCommand_ManufacturerCode,
+    "CarlingDCConfigurationCommand.IndustryCode": &fieldSpec_CarlingDCConfigurationCommand_IndustryCode,
+    "CarlingDCConfigurationCommand.MessageType": &fieldSpec_CarlingDCConfigurationCommand_MessageType,
+    "CarlingDCConfigurationCommand.BreakerMapping1": &fieldSpec_CarlingDCConfigurationCommand_BreakerMapping1,
+    "CarlingDCConfigurationCommand.BreakerMapping2": &fieldSpec_CarlingDCConfigurationCommand_BreakerMapping2,
+    "CarlingDCConfigurationCommand.BreakerMapping3": &fieldSpec_CarlingDCConfigurationCommand_BreakerMapping3,
+    "CarlingDCConfigurationCommand.InrushDelay": &fieldSpec_CarlingDCConfigurationCommand_InrushDelay,
+    "CarlingDCConfigurationCommand.TripDelay": &fieldSpec_CarlingDCConfigurationCommand_TripDelay,
+    "CarlingDCConfigurationCommand.ConfigurationFlags": &fieldSpec_CarlingDCConfigurationCommand_ConfigurationFlags,
+    "CarlingDCConfigurationCommand.CurrentRating": &fieldSpec_CarlingDCConfigurationCommand_CurrentRating,
+    "CarlingDCConfigurationCommand.FactoryMaxRating": &fieldSpec_CarlingDCConfigurationCommand_FactoryMaxRating,
+    "CarlingDCConfigurationCommand.ScheduleBLoadShedPriority": &fieldSpec_CarlingDCConfigurationCommand_ScheduleBLoadShedPriority,
+    "CarlingDCConfigurationCommand.ScheduleALoadShedPriority": &fieldSpec_CarlingDCConfigurationCommand_ScheduleALoadShedPriority,
+    "CarlingDCConfigurationCommand.DimValue": &fieldSpec_CarlingDCConfigurationCommand_DimValue,
+    "CarlingDCConfigurationCommand.BreakerGroup": &fieldSpec_CarlingDCConfigurationCommand_BreakerGroup,
+    "CarlingDCConfigurationCommand.FlashMapIndex": &fieldSpec_CarlingDCConfigurationCommand_FlashMapIndex,
+    "LumishoreProprietary.ManufacturerCode": &fieldSpec_LumishoreProprietary_ManufacturerCode,
+    "LumishoreProprietary.IndustryCode": &fieldSpec_LumishoreProprietary_IndustryCode,
+    "GarminDayMode.ManufacturerCode": &fieldSpec_GarminDayMode_ManufacturerCode,
+    "GarminDayMode.IndustryCode": &fieldSpec_GarminDayMode_IndustryCode,
+    "GarminDayMode.UnknownID1": &fieldSpec_GarminDayMode_UnknownID1,
+    "GarminDayMode.UnknownID2": &fieldSpec_GarminDayMode_UnknownID2,
+    "GarminDayMode.UnknownID3": &fieldSpec_GarminDayMode_UnknownID3,
+    "GarminDayMode.UnknownID4": &fieldSpec_GarminDayMode_UnknownID4,
+    "GarminDayMode.Mode": &fieldSpec_GarminDayMode_Mode,
+    "GarminNightMode.ManufacturerCode": &fieldSpec_GarminNightMode_ManufacturerCode,
+    "GarminNightMode.IndustryCode": &fieldSpec_GarminNightMode_IndustryCode,
+    "GarminNightMode.UnknownID1": &fieldSpec_GarminNightMode_UnknownID1,
+    "GarminNightMode.UnknownID2": &fieldSpec_GarminNightMode_UnknownID2,
+    "GarminNightMode.UnknownID3": &fieldSpec_GarminNightMode_UnknownID3,
+    "GarminNightMode.UnknownID4": &fieldSpec_GarminNightMode_UnknownID4,
+    "GarminNightMode.Mode": &fieldSpec_GarminNightMode_Mode,
+    "GarminColorMode.ManufacturerCode": &fieldSpec_GarminColorMode_ManufacturerCode,
+    "GarminColorMode.IndustryCode": &fieldSpec_GarminColorMode_IndustryCode,
+    "GarminColorMode.UnknownID1": &fieldSpec_GarminColorMode_UnknownID1,
+    "GarminColorMode.UnknownID2": &fieldSpec_GarminColorMode_UnknownID2,
+    "GarminColorMode.UnknownID3": &fieldSpec_GarminColorMode_UnknownID3,
+    "GarminColorMode.UnknownID4": &fieldSpec_GarminColorMode_UnknownID4,
+    "GarminColorMode.Mode": &fieldSpec_GarminColorMode_Mode,
+    "Alert.AlertSystem": &fieldSpec_Alert_AlertSystem,
+    "Alert.AlertSubSystem": &fieldSpec_Alert_AlertSubSystem,
+    "Alert.AlertID": &fieldSpec_Alert_AlertID,
+    "Alert.DataSourceNetworkIDName": &fieldSpec_Alert_DataSourceNetworkIDName,
+    "Alert.DataSourceInstance": &fieldSpec_Alert_DataSourceInstance,
+    "Alert.DataSourceIndexSource": &fieldSpec_Alert_DataSourceIndexSource,
+    "Alert.AlertOccurrenceNumber": &fieldSpec_Alert_AlertOccurrenceNumber,
+    "Alert.AcknowledgeSourceNetworkIDName": &fieldSpec_Alert_AcknowledgeSourceNetworkIDName,
+    "Alert.AlertPriority": &fieldSpec_Alert_AlertPriority,
+    "AlertResponse.AlertSystem": &fieldSpec_AlertResponse_AlertSystem,
+    "AlertResponse.AlertSubSystem": &fieldSpec_AlertResponse_AlertSubSystem,
+    "AlertResponse.AlertID": &fieldSpec_AlertResponse_AlertID,
+    "AlertResponse.DataSourceNetworkIDName": &fieldSpec_AlertResponse_DataSourceNetworkIDName,
+    "AlertResponse.DataSourceInstance": &fieldSpec_AlertResponse_DataSourceInstance,
+    "AlertResponse.DataSourceIndexSource": &fieldSpec_AlertResponse_DataSourceIndexSource,
+    "AlertResponse.AlertOccurrenceNumber": &fieldSpec_AlertResponse_AlertOccurrenceNumber,
+    "AlertResponse.AcknowledgeSourceNetworkIDName": &fieldSpec_AlertResponse_AcknowledgeSourceNetworkIDName,
+    "AlertText.AlertSystem": &fieldSpec_AlertText_AlertSystem,
+    "AlertText.AlertSubSystem": &fieldSpec_AlertText_AlertSubSystem,
+    "AlertText.AlertID": &fieldSpec_AlertText_AlertID,
+    "AlertText.DataSourceNetworkIDName": &fieldSpec_AlertText_DataSourceNetworkIDName,
+    "AlertText.DataSourceInstance": &fieldSpec_AlertText_DataSourceInstance,
+    "AlertText.DataSourceIndexSource": &fieldSpec_AlertText_DataSourceIndexSource,
+    "AlertText.AlertOccurrenceNumber": &fieldSpec_AlertText_AlertOccurrenceNumber,
+    "AlertConfiguration.AlertSystem": &fieldSpec_AlertConfiguration_AlertSystem,
+    "AlertConfiguration.AlertSubSystem": &fieldSpec_AlertConfiguration_AlertSubSystem,
+    "AlertConfiguration.AlertID": &fieldSpec_AlertConfiguration_AlertID,
+    "AlertConfiguration.DataSourceNetworkIDName": &fieldSpec_AlertConfiguration_DataSourceNetworkIDName,
+    "AlertConfiguration.DataSourceInstance": &fieldSpec_AlertConfiguration_DataSourceInstance,
+    "AlertConfiguration.DataSourceIndexSource": &fieldSpec_AlertConfiguration_DataSourceIndexSource,
+    "AlertConfiguration.AlertOccurrenceNumber": &fieldSpec_AlertConfiguration_AlertOccurrenceNumber,
+    "AlertConfiguration.AlertControl": &fieldSpec_AlertConfiguration_AlertControl,
+    "AlertConfiguration.UserDefinedAlertAssignment": &fieldSpec_AlertConfiguration_UserDefinedAlertAssignment,
+    "AlertConfiguration.ReactivationPeriod": &fieldSpec_AlertConfiguration_ReactivationPeriod,
+    "AlertConfiguration.TemporarySilencePeriod": &fieldSpec_AlertConfiguration_TemporarySilencePeriod,
+    "AlertConfiguration.EscalationPeriod": &fieldSpec_AlertConfiguration_EscalationPeriod,
+    "AlertThreshold.AlertSystem": &fieldSpec_AlertThreshold_AlertSystem,
+    "AlertThreshold.AlertSubSystem": &fieldSpec_AlertThreshold_AlertSubSystem,
+    "AlertThreshold.AlertID": &fieldSpec_AlertThreshold_AlertID,
+    "AlertThreshold.DataSourceNetworkIDName": &fieldSpec_AlertThreshold_DataSourceNetworkIDName,
+    "AlertThreshold.DataSourceInstance": &fieldSpec_AlertThreshold_DataSourceInstance,
+    "AlertThreshold.DataSourceIndexSource": &fieldSpec_AlertThreshold_DataSourceIndexSource,
+    "AlertThreshold.AlertOccurrenceNumber": &fieldSpec_AlertThreshold_AlertOccurrenceNumber,
+    "AlertThreshold.NumberOfParameters": &fieldSpec_AlertThreshold_NumberOfParameters,
+    "AlertThreshold.ParameterNumber": &fieldSpec_AlertThreshold_ParameterNumber,
+    "AlertThreshold.TriggerMethod": &fieldSpec_AlertThreshold_TriggerMethod,
+    "AlertThreshold.ThresholdDataFormat": &fieldSpec_AlertThreshold_ThresholdDataFormat,
+    "AlertThreshold.ThresholdLevel": &fieldSpec_AlertThreshold_ThresholdLevel,
+    "AlertValue.AlertSystem": &fieldSpec_AlertValue_AlertSystem,
+    "AlertValue.AlertSubSystem": &fieldSpec_AlertValue_AlertSubSystem,
+    "AlertValue.AlertID": &fieldSpec_AlertValue_AlertID,
+    "AlertValue.DataSourceNetworkIDName": &fieldSpec_AlertValue_DataSourceNetworkIDName,
+    "AlertValue.DataSourceInstance": &fieldSpec_AlertValue_DataSourceInstance,
+    "AlertValue.DataSourceIndexSource": &fieldSpec_AlertValue_DataSourceIndexSource,
+    "AlertValue.AlertOccurrenceNumber": &fieldSpec_AlertValue_AlertOccurrenceNumber,
+    "AlertValue.NumberOfParameters": &fieldSpec_AlertValue_NumberOfParameters,
+    "AlertValue.ValueParameterNumber": &fieldSpec_AlertValue_ValueParameterNumber,
+    "AlertValue.ValueDataFormat": &fieldSpec_AlertValue_ValueDataFormat,
+    "AlertValue.ValueData": &fieldSpec_AlertValue_ValueData,
+    "SystemTime.SID": &fieldSpec_SystemTime_SID,
+    "SystemTime.Date": &fieldSpec_SystemTime_Date,
+    "SystemTime.Time": &fieldSpec_SystemTime_Time,
+    "Heartbeat.DataTransmitOffset": &fieldSpec_Heartbeat_DataTransmitOffset,
+    "Heartbeat.SequenceCounter": &fieldSpec_Heartbeat_SequenceCounter,
+    "ProductInformation.NMEA2000Version": &fieldSpec_ProductInformation_NMEA2000Version,
+    "ProductInformation.ProductCode": &fieldSpec_ProductInformation_ProductCode,
+    "ProductInformation.LoadEquivalency": &fieldSpec_ProductInformation_LoadEquivalency,
+    "ManOverboardNotification.SID": &fieldSpec_ManOverboardNotification_SID,
+    "ManOverboardNotification.MOBEmitterID": &fieldSpec_ManOverboardNotification_MOBEmitterID,
+    "ManOverboardNotification.ActivationTime": &fieldSpec_ManOverboardNotification_ActivationTime,
+    "ManOverboardNotification.PositionDate": &fieldSpec_ManOverboardNotification_PositionDate,
+    "ManOverboardNotification.PositionTime": &fieldSpec_ManOverboardNotification_PositionTime,
+    "ManOverboardNotification.Latitude": &fieldSpec_ManOverboardNotification_Latitude,
+    "ManOverboardNotification.Longitude": &fieldSpec_ManOverboardNotification_Longitude,
+    "ManOverboardNotification.COG": &fieldSpec_ManOverboardNotification_COG,
+    "ManOverboardNotification.SOG": &fieldSpec_ManOverboardNotification_SOG,
+    "ManOverboardNotification.MMSIOfVesselOfOrigin": &fieldSpec_ManOverboardNotification_MMSIOfVesselOfOrigin,
+    "HeadingTrackControl.CommandedRudderAngle": &fieldSpec_HeadingTrackControl_CommandedRudderAngle,
+    "HeadingTrackControl.HeadingToSteerCourse": &fieldSpec_HeadingTrackControl_HeadingToSteerCourse,
+    "HeadingTrackControl.Track": &fieldSpec_HeadingTrackControl_Track,
+    "HeadingTrackControl.RudderLimit": &fieldSpec_HeadingTrackControl_RudderLimit,
+    "HeadingTrackControl.OffHeadingLimit": &fieldSpec_HeadingTrackControl_OffHeadingLimit,
+    "HeadingTrackControl.RadiusOfTurnOrder": &fieldSpec_HeadingTrackControl_RadiusOfTurnOrder,
+    "HeadingTrackControl.RateOfTurnOrder": &fieldSpec_HeadingTrackControl_RateOfTurnOrder,
+    "HeadingTrackControl.OffTrackLimit": &fieldSpec_HeadingTrackControl_OffTrackLimit,
+    "HeadingTrackControl.VesselHeading": &fieldSpec_HeadingTrackControl_VesselHeading,
+    "Rudder.Instance": &fieldSpec_Rudder_Instance,
+    "Rudder.AngleOrder": &fieldSpec_Rudder_AngleOrder,
+    "Rudder.Position": &fieldSpec_Rudder_Position,
+    "VesselHeading.SID": &fieldSpec_VesselHeading_SID,
+    "VesselHeading.Heading": &fieldSpec_VesselHeading_Heading,
+    "VesselHeading.Deviation": &fieldSpec_VesselHeading_Deviation,
+    "VesselHeading.Variation": &fieldSpec_VesselHeading_Variation,
+    "RateOfTurn.SID": &fieldSpec_RateOfTurn_SID,
+    "RateOfTurn.Rate": &fieldSpec_RateOfTurn_Rate,
+    "Heave.SID": &fieldSpec_Heave_SID,
+    "Heave.Heave": &fieldSpec_Heave_Heave,
+    "Attitude.SID": &fieldSpec_Attitude_SID,
+    "Attitude.Yaw": &fieldSpec_Attitude_Yaw,
+    "Attitude.Pitch": &fieldSpec_Attitude_Pitch,
+    "Attitude.Roll": &fieldSpec_Attitude_Roll,
+    "MagneticVariation.SID": &fieldSpec_MagneticVariation_SID,
+    "MagneticVariation.AgeOfService": &fieldSpec_MagneticVariation_AgeOfService,
+    "MagneticVariation.Variation": &fieldSpec_MagneticVariation_Variation,
+    "EngineParametersRapidUpdate.Speed": &fieldSpec_EngineParametersRapidUpdate_Speed,
+    "EngineParametersRapidUpdate.BoostPressure": &fieldSpec_EngineParametersRapidUpdate_BoostPressure,
+    "EngineParametersRapidUpdate.TiltTrim": &fieldSpec_EngineParametersRapidUpdate_TiltTrim,
+    "EngineParametersDynamic.OilPressure": &fieldSpec_EngineParametersDynamic_OilPressure,
+    "EngineParametersDynamic.OilTemperature": &fieldSpec_EngineParametersDynamic_OilTemperature,
+    "EngineParametersDynamic.Temperature": &fieldSpec_EngineParametersDynamic_Temperature,
+    "EngineParametersDynamic.AlternatorPotential": &fieldSpec_EngineParametersDynamic_AlternatorPotential,
+    "EngineParametersDynamic.FuelRate": &fieldSpec_EngineParametersDynamic_FuelRate,
+    "EngineParametersDynamic.TotalEngineHours": &fieldSpec_EngineParametersDynamic_TotalEngineHours,
+    "EngineParametersDynamic.CoolantPressure": &fieldSpec_EngineParametersDynamic_CoolantPressure,
+    "EngineParametersDynamic.FuelPressure": &fieldSpec_EngineParametersDynamic_FuelPressure,
+    "EngineParametersDynamic.EngineLoad": &fieldSpec_EngineParametersDynamic_EngineLoad,
+    "EngineParametersDynamic.EngineTorque": &fieldSpec_EngineParametersDynamic_EngineTorque,
+    "ElectricDriveStatusDynamic.InverterMotorIdentifier": &fieldSpec_ElectricDriveStatusDynamic_InverterMotorIdentifier,
+    "ElectricDriveStatusDynamic.OperatingMode": &fieldSpec_ElectricDriveStatusDynamic_OperatingMode,
+    "ElectricDriveStatusDynamic.MotorTemperature": &fieldSpec_ElectricDriveStatusDynamic_MotorTemperature,
+    "ElectricDriveStatusDynamic.InverterTemperature": &fieldSpec_ElectricDriveStatusDynamic_InverterTemperature,
+    "ElectricDriveStatusDynamic.CoolantTemperature": &fieldSpec_ElectricDriveStatusDynamic_CoolantTemperature,
+    "ElectricDriveStatusDynamic.GearTemperature": &fieldSpec_ElectricDriveStatusDynamic_GearTemperature,
+    "ElectricDriveStatusDynamic.ShaftTorque": &fieldSpec_ElectricDriveStatusDynamic_ShaftTorque,
+    "ElectricEnergyStorageStatusDynamic.EnergyStorageIdentifier": &fieldSpec_ElectricEnergyStorageStatusDynamic_EnergyStorageIdentifier,
+    "ElectricEnergyStorageStatusDynamic.StateOfCharge": &fieldSpec_ElectricEnergyStorageStatusDynamic_StateOfCharge,
+    "ElectricEnergyStorageStatusDynamic.TimeRemaining": &fieldSpec_ElectricEnergyStorageStatusDynamic_TimeRemaining,
+    "ElectricEnergyStorageStatusDynamic.HighestCellTemperature": &fieldSpec_ElectricEnergyStorageStatusDynamic_HighestCellTemperature,
+    "ElectricEnergyStorageStatusDynamic.LowestCellTemperature": &fieldSpec_ElectricEnergyStorageStatusDynamic_LowestCellTemperature,
+    "ElectricEnergyStorageStatusDynamic.AverageCellTemperature": &fieldSpec_ElectricEnergyStorageStatusDynamic_AverageCellTemperature,
+    "ElectricEnergyStorageStatusDynamic.MaxDischargeCurrent": &fieldSpec_ElectricEnergyStorageStatusDynamic_MaxDischargeCurrent,
+    "ElectricEnergyStorageStatusDynamic.MaxChargeCurrent": &fieldSpec_ElectricEnergyStorageStatusDynamic_MaxChargeCurrent,
+    "ElectricEnergyStorageStatusDynamic.CoolingSystemStatus": &fieldSpec_ElectricEnergyStorageStatusDynamic_CoolingSystemStatus,
+    "ElectricEnergyStorageStatusDynamic.HeatingSystemStatus": &fieldSpec_ElectricEnergyStorageStatusDynamic_HeatingSystemStatus,
+    "TransmissionParametersDynamic.OilPressure": &fieldSpec_TransmissionParametersDynamic_OilPressure,
+    "TransmissionParametersDynamic.OilTemperature": &fieldSpec_TransmissionParametersDynamic_OilTemperature,
+    "ElectricDriveInformation.InverterMotorIdentifier": &fieldSpec_ElectricDriveInformation_InverterMotorIdentifier,
+    "ElectricDriveInformation.MotorType": &fieldSpec_ElectricDriveInformation_MotorType,
+    "ElectricDriveInformation.MotorVoltageRating": &fieldSpec_ElectricDriveInformation_MotorVoltageRating,
+    "ElectricDriveInformation.MaximumContinuousMotorPower": &fieldSpec_ElectricDriveInformation_MaximumContinuousMotorPower,
+    "ElectricDriveInformation.MaximumBoostMotorPower": &fieldSpec_ElectricDriveInformation_MaximumBoostMotorPower,
+    "ElectricDriveInformation.MaximumMotorTemperatureRating": &fieldSpec_ElectricDriveInformation_MaximumMotorTemperatureRating,
+    "ElectricDriveInformation.RatedMotorSpeed": &fieldSpec_ElectricDriveInformation_RatedMotorSpeed,
+    "ElectricDriveInformation.MaximumControllerTemperatureRating": &fieldSpec_ElectricDriveInformation_MaximumControllerTemperatureRating,
+    "ElectricDriveInformation.MotorShaftTorqueRating": &fieldSpec_ElectricDriveInformation_MotorShaftTorqueRating,
+    "ElectricDriveInformation.MotorDCVoltageDeratingThreshold": &fieldSpec_ElectricDriveInformation_MotorDCVoltageDeratingThreshold,
+    "ElectricDriveInformation.MotorDCVoltageCutOffThreshold": &fieldSpec_ElectricDriveInformation_MotorDCVoltageCutOffThreshold,
+    "ElectricDriveInformation.DriveMotorHours": &fieldSpec_ElectricDriveInformation_DriveMotorHours,
+    "ElectricEnergyStorageInformation.EnergyStorageIdentifier": &fieldSpec_ElectricEnergyStorageInformation_EnergyStorageIdentifier,
+    "ElectricEnergyStorageInformation.MotorType": &fieldSpec_ElectricEnergyStorageInformation_MotorType,
+    "ElectricEnergyStorageInformation.StorageChemistryConversion": &fieldSpec_ElectricEnergyStorageInformation_StorageChemistryConversion,
+    "ElectricEnergyStorageInformation.MaximumTemperatureDerating": &fieldSpec_ElectricEnergyStorageInformation_MaximumTemperatureDerating,
+    "ElectricEnergyStorageInformation.MaximumTemperatureShutOff": &fieldSpec_ElectricEnergyStorageInformation_MaximumTemperatureShutOff,
+    "ElectricEnergyStorageInformation.MinimumTemperatureDerating": &fieldSpec_ElectricEnergyStorageInformation_MinimumTemperatureDerating,
+    "ElectricEnergyStorageInformation.MinimumTemperatureShutOff": &fieldSpec_ElectricEnergyStorageInformation_MinimumTemperatureShutOff,
+    "ElectricEnergyStorageInformation.UsableBatteryEnergy": &fieldSpec_ElectricEnergyStorageInformation_UsableBatteryEnergy,
+    "ElectricEnergyStorageInformation.StateOfHealth": &fieldSpec_ElectricEnergyStorageInformation_StateOfHealth,
+    "ElectricEnergyStorageInformation.BatteryCycleCounter": &fieldSpec_ElectricEnergyStorageInformation_BatteryCycleCounter,
+    "ElectricEnergyStorageInformation.BatteryFullStatus": &fieldSpec_ElectricEnergyStorageInformation_BatteryFullStatus,
+    "ElectricEnergyStorageInformation.BatteryEmptyStatus": &fieldSpec_ElectricEnergyStorageInformation_BatteryEmptyStatus,
+    "ElectricEnergyStorageInformation.MaximumChargeSoc": &fieldSpec_ElectricEnergyStorageInformation_MaximumChargeSoc,
+    "ElectricEnergyStorageInformation.MinimumChargeSoc": &fieldSpec_ElectricEnergyStorageInformation_MinimumChargeSoc,
+    "TripParametersVessel.TimeToEmpty": &fieldSpec_TripParametersVessel_TimeToEmpty,
+    "TripParametersVessel.DistanceToEmpty": &fieldSpec_TripParametersVessel_DistanceToEmpty,
+    "TripParametersVessel.EstimatedFuelRemaining": &fieldSpec_TripParametersVessel_EstimatedFuelRemaining,
+    "TripParametersVessel.TripRunTime": &fieldSpec_TripParametersVessel_TripRunTime,
+    "TripParametersEngine.TripFuelUsed": &fieldSpec_TripParametersEngine_TripFuelUsed,
+    "TripParametersEngine.FuelRateAverage": &fieldSpec_TripParametersEngine_FuelRateAverage,
+    "TripParametersEngine.FuelRateEconomy": &fieldSpec_TripParametersEngine_FuelRateEconomy,
+    "TripParametersEngine.InstantaneousFuelEconomy": &fieldSpec_TripParametersEngine_InstantaneousFuelEconomy,
+    "EngineParametersStatic.RatedEngineSpeed": &fieldSpec_EngineParametersStatic_RatedEngineSpeed,
+    "LoadControllerConnectionStateControl.SequenceID": &fieldSpec_LoadControllerConnectionStateControl_SequenceID,
+    "LoadControllerConnectionStateControl.ConnectionID": &fieldSpec_LoadControllerConnectionStateControl_ConnectionID,
+    "LoadControllerConnectionStateControl.State": &fieldSpec_LoadControllerConnectionStateControl_State,
+    "LoadControllerConnectionStateControl.Status": &fieldSpec_LoadControllerConnectionStateControl_Status,
+    "LoadControllerConnectionStateControl.OperationalStatusControl": &fieldSpec_LoadControllerConnectionStateControl_OperationalStatusControl,
+    "LoadControllerConnectionStateControl.PwmDutyCycle": &fieldSpec_LoadControllerConnectionStateControl_PwmDutyCycle,
+    "LoadControllerConnectionStateControl.Timeon": &fieldSpec_LoadControllerConnectionStateControl_Timeon,
+    "LoadControllerConnectionStateControl.Timeoff": &fieldSpec_LoadControllerConnectionStateControl_Timeoff,
+    "BinarySwitchBankStatus.Instance": &fieldSpec_BinarySwitchBankStatus_Instance,
+    "SwitchBankControl.Instance": &fieldSpec_SwitchBankControl_Instance,
+    "ACInputStatus.Instance": &fieldSpec_ACInputStatus_Instance,
+    "ACInputStatus.NumberOfLines": &fieldSpec_ACInputStatus_NumberOfLines,
+    "ACInputStatus.Voltage": &fieldSpec_ACInputStatus_Voltage,
+    "ACInputStatus.Current": &fieldSpec_ACInputStatus_Current,
+    "ACInputStatus.Frequency": &fieldSpec_ACInputStatus_Frequency,
+    "ACInputStatus.BreakerSize": &fieldSpec_ACInputStatus_BreakerSize,
+    "ACInputStatus.RealPower": &fieldSpec_ACInputStatus_RealPower,
+    "ACInputStatus.ReactivePower": &fieldSpec_ACInputStatus_ReactivePower,
+    "ACInputStatus.PowerFactor": &fieldSpec_ACInputStatus_PowerFactor,
+    "ACOutputStatus.Instance": &fieldSpec_ACOutputStatus_Instance,
+    "ACOutputStatus.NumberOfLines": &fieldSpec_ACOutputStatus_NumberOfLines,
+    "ACOutputStatus.Voltage": &fieldSpec_ACOutputStatus_Voltage,
+    "ACOutputStatus.Current": &fieldSpec_ACOutputStatus_Current,
+    "ACOutputStatus.Frequency": &fieldSpec_ACOutputStatus_Frequency,
+    "ACOutputStatus.BreakerSize": &fieldSpec_ACOutputStatus_BreakerSize,
+    "ACOutputStatus.RealPower": &fieldSpec_ACOutputStatus_RealPower,
+    "ACOutputStatus.ReactivePower": &fieldSpec_ACOutputStatus_ReactivePower,
+    "ACOutputStatus.PowerFactor": &fieldSpec_ACOutputStatus_PowerFactor,
+    "FluidLevel.Instance": &fieldSpec_FluidLevel_Instance,
+    "FluidLevel.Level": &fieldSpec_FluidLevel_Level,
+    "FluidLevel.Capacity": &fieldSpec_FluidLevel_Capacity,
+    "DCDetailedStatus.SID": &fieldSpec_DCDetailedStatus_SID,
+    "DCDetailedStatus.Instance": &fieldSpec_DCDetailedStatus_Instance,
+    "DCDetailedStatus.StateOfCharge": &fieldSpec_DCDetailedStatus_StateOfCharge,
+    "DCDetailedStatus.StateOfHealth": &fieldSpec_DCDetailedStatus_StateOfHealth,
+    "DCDetailedStatus.TimeRemaining": &fieldSpec_DCDetailedStatus_TimeRemaining,
+    "DCDetailedStatus.RippleVoltage": &fieldSpec_DCDetailedStatus_RippleVoltage,
+    "DCDetailedStatus.RemainingCapacity": &fieldSpec_DCDetailedStatus_RemainingCapacity,
+    "ChargerStatus.Instance": &fieldSpec_ChargerStatus_Instance,
+    "ChargerStatus.BatteryInstance": &fieldSpec_ChargerStatus_BatteryInstance,
+    "ChargerStatus.EqualizationTimeRemaining": &fieldSpec_ChargerStatus_EqualizationTimeRemaining,
+    "BatteryStatus.Instance": &fieldSpec_BatteryStatus_Instance,
+    "BatteryStatus.Voltage": &fieldSpec_BatteryStatus_Voltage,
+    "BatteryStatus.Current": &fieldSpec_BatteryStatus_Current,
+    "BatteryStatus.Temperature": &fieldSpec_BatteryStatus_Temperature,
+    "BatteryStatus.SID": &fieldSpec_BatteryStatus_SID,
+    "InverterStatus.Instance": &fieldSpec_InverterStatus_Instance,
+    "InverterStatus.ACInstance": &fieldSpec_InverterStatus_ACInstance,
+    "InverterStatus.DCInstance": &fieldSpec_InverterStatus_DCInstance,
+    "ChargerConfigurationStatus.Instance": &fieldSpec_ChargerConfigurationStatus_Instance,
+    "ChargerConfigurationStatus.BatteryInstance": &fieldSpec_ChargerConfigurationStatus_BatteryInstance,
+    "ChargerConfigurationStatus.ChargeCurrentLimit": &fieldSpec_ChargerConfigurationStatus_ChargeCurrentLimit,
+    "ChargerConfigurationStatus.EqualizeTime": &fieldSpec_ChargerConfigurationStatus_EqualizeTime,
+    "InverterConfigurationStatus.Instance": &fieldSpec_InverterConfigurationStatus_Instance,
+    "InverterConfigurationStatus.ACInstance": &fieldSpec_InverterConfigurationStatus_ACInstance,
+    "InverterConfigurationStatus.DCInstance": &fieldSpec_InverterConfigurationStatus_DCInstance,
+    "InverterConfigurationStatus.LoadSensePowerThreshold": &fieldSpec_InverterConfigurationStatus_LoadSensePowerThreshold,
+    "InverterConfigurationStatus.LoadSenseInterval": &fieldSpec_InverterConfigurationStatus_LoadSenseInterval,
+    "AgsConfigurationStatus.Instance": &fieldSpec_AgsConfigurationStatus_Instance,
+    "AgsConfigurationStatus.GeneratorInstance": &fieldSpec_AgsConfigurationStatus_GeneratorInstance,
+    "BatteryConfigurationStatus.Instance": &fieldSpec_BatteryConfigurationStatus_Instance,
+    "BatteryConfigurationStatus.Capacity": &fieldSpec_BatteryConfigurationStatus_Capacity,
+    "BatteryConfigurationStatus.TemperatureCoefficient": &fieldSpec_BatteryConfigurationStatus_TemperatureCoefficient,
+    "BatteryConfigurationStatus.PeukertExponent": &fieldSpec_BatteryConfigurationStatus_PeukertExponent,
+    "BatteryConfigurationStatus.ChargeEfficiencyFactor": &fieldSpec_BatteryConfigurationStatus_ChargeEfficiencyFactor,
+    "AgsStatus.Instance": &fieldSpec_AgsStatus_Instance,
+    "AgsStatus.GeneratorInstance": &fieldSpec_AgsStatus_GeneratorInstance,
+    "ACPowerCurrentPhaseA.SID": &fieldSpec_ACPowerCurrentPhaseA_SID,
+    "ACPowerCurrentPhaseA.ConnectionNumber": &fieldSpec_ACPowerCurrentPhaseA_ConnectionNumber,
+    "ACPowerCurrentPhaseA.ACRMSCurrent": &fieldSpec_ACPowerCurrentPhaseA_ACRMSCurrent,
+    "ACPowerCurrentPhaseA.Power": &fieldSpec_ACPowerCurrentPhaseA_Power,
+    "ACPowerCurrentPhaseB.SID": &fieldSpec_ACPowerCurrentPhaseB_SID,
+    "ACPowerCurrentPhaseB.ConnectionNumber": &fieldSpec_ACPowerCurrentPhaseB_ConnectionNumber,
+    "ACPowerCurrentPhaseB.ACRMSCurrent": &fieldSpec_ACPowerCurrentPhaseB_ACRMSCurrent,
+    "ACPowerCurrentPhaseB.Power": &fieldSpec_ACPowerCurrentPhaseB_Power,
+    "ACPowerCurrentPhaseC.SID": &fieldSpec_ACPowerCurrentPhaseC_SID,
+    "ACPowerCurrentPhaseC.ConnectionNumber": &fieldSpec_ACPowerCurrentPhaseC_ConnectionNumber,
+    "ACPowerCurrentPhaseC.ACRMSCurrent": &fieldSpec_ACPowerCurrentPhaseC_ACRMSCurrent,
+    "ACPowerCurrentPhaseC.Power": &fieldSpec_ACPowerCurrentPhaseC_Power,
+    "ACVoltageFrequencyPhaseA.SID": &fieldSpec_ACVoltageFrequencyPhaseA_SID,
+    "ACVoltageFrequencyPhaseA.ConnectionNumber": &fieldSpec_ACVoltageFrequencyPhaseA_ConnectionNumber,
+    "ACVoltageFrequencyPhaseA.ACVoltageLineToNeutral": &fieldSpec_ACVoltageFrequencyPhaseA_ACVoltageLineToNeutral,
+    "ACVoltageFrequencyPhaseA.ACVoltageLineToLine": &fieldSpec_ACVoltageFrequencyPhaseA_ACVoltageLineToLine,
+    "ACVoltageFrequencyPhaseA.Frequency": &fieldSpec_ACVoltageFrequencyPhaseA_Frequency,
+    "ACVoltageFrequencyPhaseB.SID": &fieldSpec_ACVoltageFrequencyPhaseB_SID,
+    "ACVoltageFrequencyPhaseB.ConnectionNumber": &fieldSpec_ACVoltageFrequencyPhaseB_ConnectionNumber,
+    "ACVoltageFrequencyPhaseB.ACVoltageLineToNeutral": &fieldSpec_ACVoltageFrequencyPhaseB_ACVoltageLineToNeutral,
+    "ACVoltageFrequencyPhaseB.ACVoltageLineToLine": &fieldSpec_ACVoltageFrequencyPhaseB_ACVoltageLineToLine,
+    "ACVoltageFrequencyPhaseB.Frequency": &fieldSpec_ACVoltageFrequencyPhaseB_Frequency,
+    "ACVoltageFrequencyPhaseC.SID": &fieldSpec_ACVoltageFrequencyPhaseC_SID,
+    "ACVoltageFrequencyPhaseC.ConnectionNumber": &fieldSpec_ACVoltageFrequencyPhaseC_ConnectionNumber,
+    "ACVoltageFrequencyPhaseC.ACVoltageLineToNeutral": &fieldSpec_ACVoltageFrequencyPhaseC_ACVoltageLineToNeutral,
+    "ACVoltageFrequencyPhaseC.ACVoltageLineToLine": &fieldSpec_ACVoltageFrequencyPhaseC_ACVoltageLineToLine,
+    "ACVoltageFrequencyPhaseC.Frequency": &fieldSpec_ACVoltageFrequencyPhaseC_Frequency,
+    "ConverterStatus.SID": &fieldSpec_ConverterStatus_SID,
+    "ConverterStatus.ConnectionNumber": &fieldSpec_ConverterStatus_ConnectionNumber,
+    "DCVoltageCurrent.SID": &fieldSpec_DCVoltageCurrent_SID,
+    "DCVoltageCurrent.ConnectionNumber": &fieldSpec_DCVoltageCurrent_ConnectionNumber,
+    "DCVoltageCurrent.DCVoltage": &fieldSpec_DCVoltageCurrent_DCVoltage,
+    "DCVoltageCurrent.DCCurrent": &fieldSpec_DCVoltageCurrent_DCCurrent,
+    "LeewayAngle.SID": &fieldSpec_LeewayAngle_SID,
+    "LeewayAngle.LeewayAngle": &fieldSpec_LeewayAngle_LeewayAngle,
+    "VesselAcceleration.SID": &fieldSpec_VesselAcceleration_SID,
+    "VesselAcceleration.LongitudinalAcceleration": &fieldSpec_VesselAcceleration_LongitudinalAcceleration,
+    "VesselAcceleration.TransverseAcceleration": &fieldSpec_VesselAcceleration_TransverseAcceleration,
+    "VesselAcceleration.VerticalAcceleration": &fieldSpec_VesselAcceleration_VerticalAcceleration,
+    "ElectricDriveStatusRapidUpdate.InverterMotorController": &fieldSpec_ElectricDriveStatusRapidUpdate_InverterMotorController,
+    "ElectricDriveStatusRapidUpdate.ActiveMotorMode": &fieldSpec_ElectricDriveStatusRapidUpdate_ActiveMotorMode,
+    "ElectricDriveStatusRapidUpdate.BrakeMode": &fieldSpec_ElectricDriveStatusRapidUpdate_BrakeMode,
+    "ElectricDriveStatusRapidUpdate.RotationalShaftSpeed": &fieldSpec_ElectricDriveStatusRapidUpdate_RotationalShaftSpeed,
+    "ElectricDriveStatusRapidUpdate.MotorDCVoltage": &fieldSpec_ElectricDriveStatusRapidUpdate_MotorDCVoltage,
+    "ElectricDriveStatusRapidUpdate.MotorDCCurrent": &fieldSpec_ElectricDriveStatusRapidUpdate_MotorDCCurrent,
+    "ElectricEnergyStorageStatusRapidUpdate.EnergyStorageIdentifier": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_EnergyStorageIdentifier,
+    "ElectricEnergyStorageStatusRapidUpdate.BatteryStatus": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_BatteryStatus,
+    "ElectricEnergyStorageStatusRapidUpdate.IsolationStatus": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_IsolationStatus,
+    "ElectricEnergyStorageStatusRapidUpdate.BatteryError": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_BatteryError,
+    "ElectricEnergyStorageStatusRapidUpdate.BatteryVoltage": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_BatteryVoltage,
+    "ElectricEnergyStorageStatusRapidUpdate.BatteryCurrent": &fieldSpec_ElectricEnergyStorageStatusRapidUpdate_BatteryCurrent,
+    "ThrusterControlStatus.SID": &fieldSpec_ThrusterControlStatus_SID,
+    "ThrusterControlStatus.Identifier": &fieldSpec_ThrusterControlStatus_Identifier,
+    "ThrusterControlStatus.SpeedControl": &fieldSpec_ThrusterControlStatus_SpeedControl,
+    "ThrusterControlStatus.CommandTimeout": &fieldSpec_ThrusterControlStatus_CommandTimeout,
+    "ThrusterControlStatus.AzimuthControl": &fieldSpec_ThrusterControlStatus_AzimuthControl,
+    "ThrusterInformation.Identifier": &fieldSpec_ThrusterInformation_Identifier,
+    "ThrusterInformation.PowerRating": &fieldSpec_ThrusterInformation_PowerRating,
+    "ThrusterInformation.MaximumTemperatureRating": &fieldSpec_ThrusterInformation_MaximumTemperatureRating,
+    "ThrusterInformation.MaximumRotationalSpeed": &fieldSpec_ThrusterInformation_MaximumRotationalSpeed,
+    "ThrusterMotorStatus.SID": &fieldSpec_ThrusterMotorStatus_SID,
+    "ThrusterMotorStatus.Identifier": &fieldSpec_ThrusterMotorStatus_Identifier,
+    "ThrusterMotorStatus.Current": &fieldSpec_ThrusterMotorStatus_Current,
+    "ThrusterMotorStatus.Temperature": &fieldSpec_ThrusterMotorStatus_Temperature,
+    "ThrusterMotorStatus.OperatingTime": &fieldSpec_ThrusterMotorStatus_OperatingTime,
+    "Speed.SID": &fieldSpec_Speed_SID,
+    "Speed.SpeedWaterReferenced": &fieldSpec_Speed_SpeedWaterReferenced,
+    "Speed.SpeedGroundReferenced": &fieldSpec_Speed_SpeedGroundReferenced,
+    "Speed.SpeedDirection": &fieldSpec_Speed_SpeedDirection,
+    "WaterDepth.SID": &fieldSpec_WaterDepth_SID,
+    "WaterDepth.Depth": &fieldSpec_WaterDepth_Depth,
+    "WaterDepth.Offset": &fieldSpec_WaterDepth_Offset,
+    "WaterDepth.Range": &fieldSpec_WaterDepth_Range,
+    "DistanceLog.Date": &fieldSpec_DistanceLog_Date,
+    "DistanceLog.Time": &fieldSpec_DistanceLog_Time,
+    "DistanceLog.Log": &fieldSpec_DistanceLog_Log,
+    "DistanceLog.TripLog": &fieldSpec_DistanceLog_TripLog,
+    "TrackedTargetData.SID": &fieldSpec_TrackedTargetData_SID,
+    "TrackedTargetData.TargetID": &fieldSpec_TrackedTargetData_TargetID,
+    "TrackedTargetData.Bearing": &fieldSpec_TrackedTargetData_Bearing,
+    "TrackedTargetData.Distance": &fieldSpec_TrackedTargetData_Distance,
+    "TrackedTargetData.Course": &fieldSpec_TrackedTargetData_Course,
+    "TrackedTargetData.Speed": &fieldSpec_TrackedTargetData_Speed,
+    "TrackedTargetData.Cpa": &fieldSpec_TrackedTargetData_Cpa,
+    "TrackedTargetData.Tcpa": &fieldSpec_TrackedTargetData_Tcpa,
+    "TrackedTargetData.UTCOfFix": &fieldSpec_TrackedTargetData_UTCOfFix,
+    "ElevatorCarStatus.SID": &fieldSpec_ElevatorCarStatus_SID,
+    "ElevatorCarStatus.ElevatorCarID": &fieldSpec_ElevatorCarStatus_ElevatorCarID,
+    "ElevatorCarStatus.ElevatorCarUsage": &fieldSpec_ElevatorCarStatus_ElevatorCarUsage,
+    "ElevatorCarStatus.SmokeSensorStatus": &fieldSpec_ElevatorCarStatus_SmokeSensorStatus,
+    "ElevatorCarStatus.LimitSwitchSensorStatus": &fieldSpec_ElevatorCarStatus_LimitSwitchSensorStatus,
+    "ElevatorCarStatus.ProximitySwitchSensorStatus": &fieldSpec_ElevatorCarStatus_ProximitySwitchSensorStatus,
+    "ElevatorCarStatus.InertialMeasurementUnitImuSensorStatus": &fieldSpec_ElevatorCarStatus_InertialMeasurementUnitImuSensorStatus,
+    "ElevatorCarStatus.ElevatorLoadLimitStatus": &fieldSpec_ElevatorCarStatus_ElevatorLoadLimitStatus,
+    "ElevatorCarStatus.ElevatorLoadBalanceStatus": &fieldSpec_ElevatorCarStatus_ElevatorLoadBalanceStatus,
+    "ElevatorCarStatus.ElevatorLoadSensor1Status": &fieldSpec_ElevatorCarStatus_ElevatorLoadSensor1Status,
+    "ElevatorCarStatus.ElevatorLoadSensor2Status": &fieldSpec_ElevatorCarStatus_ElevatorLoadSensor2Status,
+    "ElevatorCarStatus.ElevatorLoadSensor3Status": &fieldSpec_ElevatorCarStatus_ElevatorLoadSensor3Status,
+    "ElevatorCarStatus.ElevatorLoadSensor4Status": &fieldSpec_ElevatorCarStatus_ElevatorLoadSensor4Status,
+    "ElevatorCarStatus.ElevatorCarMotionStatus": &fieldSpec_ElevatorCarStatus_ElevatorCarMotionStatus,
+    "ElevatorCarStatus.ElevatorCarDoorStatus": &fieldSpec_ElevatorCarStatus_ElevatorCarDoorStatus,
+    "ElevatorCarStatus.ElevatorCarEmergencyButtonStatus": &fieldSpec_ElevatorCarStatus_ElevatorCarEmergencyButtonStatus,
+    "ElevatorCarStatus.ElevatorCarBuzzerStatus": &fieldSpec_ElevatorCarStatus_ElevatorCarBuzzerStatus,
+    "ElevatorCarStatus.OpenDoorButtonStatus": &fieldSpec_ElevatorCarStatus_OpenDoorButtonStatus,
+    "ElevatorCarStatus.CloseDoorButtonStatus": &fieldSpec_ElevatorCarStatus_CloseDoorButtonStatus,
+    "ElevatorCarStatus.CurrentDeck": &fieldSpec_ElevatorCarStatus_CurrentDeck,
+    "ElevatorCarStatus.DestinationDeck": &fieldSpec_ElevatorCarStatus_DestinationDeck,
+    "ElevatorCarStatus.TotalNumberOfDecks": &fieldSpec_ElevatorCarStatus_TotalNumberOfDecks,
+    "ElevatorCarStatus.WeightOfLoadCell1": &fieldSpec_ElevatorCarStatus_WeightOfLoadCell1,
+    "ElevatorCarStatus.WeightOfLoadCell2": &fieldSpec_ElevatorCarStatus_WeightOfLoadCell2,
+    "ElevatorCarStatus.WeightOfLoadCell3": &fieldSpec_ElevatorCarStatus_WeightOfLoadCell3,
+    "ElevatorCarStatus.WeightOfLoadCell4": &fieldSpec_ElevatorCarStatus_WeightOfLoadCell4,
+    "ElevatorCarStatus.SpeedOfElevatorCar": &fieldSpec_ElevatorCarStatus_SpeedOfElevatorCar,
+    "ElevatorCarStatus.ElevatorBrakeStatus": &fieldSpec_ElevatorCarStatus_ElevatorBrakeStatus,
+    "ElevatorCarStatus.ElevatorMotorRotationControlStatus": &fieldSpec_ElevatorCarStatus_ElevatorMotorRotationControlStatus,
+    "ElevatorMotorControl.SID": &fieldSpec_ElevatorMotorControl_SID,
+    "ElevatorMotorControl.ElevatorCarID": &fieldSpec_ElevatorMotorControl_ElevatorCarID,
+    "ElevatorMotorControl.ElevatorCarUsage": &fieldSpec_ElevatorMotorControl_ElevatorCarUsage,
+    "ElevatorMotorControl.MotorAccelerationDecelerationProfileSelection": &fieldSpec_ElevatorMotorControl_MotorAccelerationDecelerationProfileSelection,
+    "ElevatorMotorControl.MotorRotationalControlStatus": &fieldSpec_ElevatorMotorControl_MotorRotationalControlStatus,
+    "ElevatorDeckPushButton.SID": &fieldSpec_ElevatorDeckPushButton_SID,
+    "ElevatorDeckPushButton.ElevatorCallButtonID": &fieldSpec_ElevatorDeckPushButton_ElevatorCallButtonID,
+    "ElevatorDeckPushButton.DeckButtonID": &fieldSpec_ElevatorDeckPushButton_DeckButtonID,
+    "ElevatorDeckPushButton.ElevatorCarUsage": &fieldSpec_ElevatorDeckPushButton_ElevatorCarUsage,
+    "ElevatorDeckPushButton.ElevatorCarButtonSelection": &fieldSpec_ElevatorDeckPushButton_ElevatorCarButtonSelection,
+    "WindlassControlStatus.SID": &fieldSpec_WindlassControlStatus_SID,
+    "WindlassControlStatus.WindlassID": &fieldSpec_WindlassControlStatus_WindlassID,
+    "WindlassControlStatus.CommandTimeout": &fieldSpec_WindlassControlStatus_CommandTimeout,
+    "AnchorWindlassOperatingStatus.SID": &fieldSpec_AnchorWindlassOperatingStatus_SID,
+    "AnchorWindlassOperatingStatus.WindlassID": &fieldSpec_AnchorWindlassOperatingStatus_WindlassID,
+    "AnchorWindlassOperatingStatus.RodeCounterValue": &fieldSpec_AnchorWindlassOperatingStatus_RodeCounterValue,
+    "AnchorWindlassOperatingStatus.WindlassLineSpeed": &fieldSpec_AnchorWindlassOperatingStatus_WindlassLineSpeed,
+    "AnchorWindlassMonitoringStatus.SID": &fieldSpec_AnchorWindlassMonitoringStatus_SID,
+    "AnchorWindlassMonitoringStatus.WindlassID": &fieldSpec_AnchorWindlassMonitoringStatus_WindlassID,
+    "AnchorWindlassMonitoringStatus.ControllerVoltage": &fieldSpec_AnchorWindlassMonitoringStatus_ControllerVoltage,
+    "AnchorWindlassMonitoringStatus.MotorCurrent": &fieldSpec_AnchorWindlassMonitoringStatus_MotorCurrent,
+    "AnchorWindlassMonitoringStatus.TotalMotorTime": &fieldSpec_AnchorWindlassMonitoringStatus_TotalMotorTime,
+    "LinearActuatorControlStatus.ActuatorIdentifier": &fieldSpec_LinearActuatorControlStatus_ActuatorIdentifier,
+    "LinearActuatorControlStatus.CommandedDevicePosition": &fieldSpec_LinearActuatorControlStatus_CommandedDevicePosition,
+    "LinearActuatorControlStatus.DevicePosition": &fieldSpec_LinearActuatorControlStatus_DevicePosition,
+    "LinearActuatorControlStatus.MaximumDeviceTravel": &fieldSpec_LinearActuatorControlStatus_MaximumDeviceTravel,
+    "LinearActuatorControlStatus.DirectionOfTravel": &fieldSpec_LinearActuatorControlStatus_DirectionOfTravel,
+    "PositionRapidUpdate.Latitude": &fieldSpec_PositionRapidUpdate_Latitude,
+    "PositionRapidUpdate.Longitude": &fieldSpec_PositionRapidUpdate_Longitude,
+    "COGSOGRapidUpdate.SID": &fieldSpec_COGSOGRapidUpdate_SID,
+    "COGSOGRapidUpdate.COG": &fieldSpec_COGSOGRapidUpdate_COG,
+    "COGSOGRapidUpdate.SOG": &fieldSpec_COGSOGRapidUpdate_SOG,
+    "PositionDeltaRapidUpdate.SID": &fieldSpec_PositionDeltaRapidUpdate_SID,
+    "PositionDeltaRapidUpdate.TimeDelta": &fieldSpec_PositionDeltaRapidUpdate_TimeDelta,
+    "PositionDeltaRapidUpdate.LatitudeDelta": &fieldSpec_PositionDeltaRapidUpdate_LatitudeDelta,
+    "PositionDeltaRapidUpdate.LongitudeDelta": &fieldSpec_PositionDeltaRapidUpdate_LongitudeDelta,
+    "AltitudeDeltaRapidUpdate.SID": &fieldSpec_AltitudeDeltaRapidUpdate_SID,
+    "AltitudeDeltaRapidUpdate.TimeDelta": &fieldSpec_AltitudeDeltaRapidUpdate_TimeDelta,
+    "AltitudeDeltaRapidUpdate.COG": &fieldSpec_AltitudeDeltaRapidUpdate_COG,
+    "AltitudeDeltaRapidUpdate.AltitudeDelta": &fieldSpec_AltitudeDeltaRapidUpdate_AltitudeDelta,
+    "GNSSPositionData.SID": &fieldSpec_GNSSPositionData_SID,
+    "GNSSPositionData.Date": &fieldSpec_GNSSPositionData_Date,
+    "GNSSPositionData.Time": &fieldSpec_GNSSPositionData_Time,
+    "GNSSPositionData.Latitude": &fieldSpec_GNSSPositionData_Latitude,
+    "GNSSPositionData.Longitude": &fieldSpec_GNSSPositionData_Longitude,
+    "GNSSPositionData.Altitude": &fieldSpec_GNSSPositionData_Altitude,
+    "GNSSPositionData.NumberOfSvs": &fieldSpec_GNSSPositionData_NumberOfSvs,
+    "GNSSPositionData.Hdop": &fieldSpec_GNSSPositionData_Hdop,
+    "GNSSPositionData.Pdop": &fieldSpec_GNSSPositionData_Pdop,
+    "GNSSPositionData.GeoidalSeparation": &fieldSpec_GNSSPositionData_GeoidalSeparation,
+    "GNSSPositionData.ReferenceStations": &fieldSpec_GNSSPositionData_ReferenceStations,
+    "GNSSPositionData.ReferenceStationID": &fieldSpec_GNSSPositionData_ReferenceStationID,
+    "GNSSPositionData.AgeOfDGNSSCorrections": &fieldSpec_GNSSPositionData_AgeOfDGNSSCorrections,
+    "TimeDate.Date": &fieldSpec_TimeDate_Date,
+    "TimeDate.Time": &fieldSpec_TimeDate_Time,
+    "TimeDate.LocalOffset": &fieldSpec_TimeDate_LocalOffset,
+    "AISClassAPositionReport.UserID": &fieldSpec_AISClassAPositionReport_UserID,
+    "AISClassAPositionReport.Longitude": &fieldSpec_AISClassAPositionReport_Longitude,
+    "AISClassAPositionReport.Latitude": &fieldSpec_AISClassAPositionReport_Latitude,
+    "AISClassAPositionReport.COG": &fieldSpec_AISClassAPositionReport_COG,
+    "AISClassAPositionReport.SOG": &fieldSpec_AISClassAPositionReport_SOG,
+    "AISClassAPositionReport.Heading": &fieldSpec_AISClassAPositionReport_Heading,
+    "AISClassAPositionReport.RateOfTurn": &fieldSpec_AISClassAPositionReport_RateOfTurn,
+    "AISClassAPositionReport.SequenceID": &fieldSpec_AISClassAPositionReport_SequenceID,
+    "AISClassBPositionReport.UserID": &fieldSpec_AISClassBPositionReport_UserID,
+    "AISClassBPositionReport.Longitude": &fieldSpec_AISClassBPositionReport_Longitude,
+    "AISClassBPositionReport.Latitude": &fieldSpec_AISClassBPositionReport_Latitude,
+    "AISClassBPositionReport.COG": &fieldSpec_AISClassBPositionReport_COG,
+    "AISClassBPositionReport.SOG": &fieldSpec_AISClassBPositionReport_SOG,
+    "AISClassBPositionReport.Heading": &fieldSpec_AISClassBPositionReport_Heading,
+    "AISClassBExtendedPositionReport.UserID": &fieldSpec_AISClassBExtendedPositionReport_UserID,
+    "AISClassBExtendedPositionReport.Longitude": &fieldSpec_AISClassBExtendedPositionReport_Longitude,
+    "AISClassBExtendedPositionReport.Latitude": &fieldSpec_AISClassBExtendedPositionReport_Latitude,
+    "AISClassBExtendedPositionReport.COG": &fieldSpec_AISClassBExtendedPositionReport_COG,
+    "AISClassBExtendedPositionReport.SOG": &fieldSpec_AISClassBExtendedPositionReport_SOG,
+    "AISClassBExtendedPositionReport.TrueHeading": &fieldSpec_AISClassBExtendedPositionReport_TrueHeading,
+    "AISClassBExtendedPositionReport.Length": &fieldSpec_AISClassBExtendedPositionReport_Length,
+    "AISClassBExtendedPositionReport.Beam": &fieldSpec_AISClassBExtendedPositionReport_Beam,
+    "AISClassBExtendedPositionReport.PositionReferenceFromStarboard": &fieldSpec_AISClassBExtendedPositionReport_PositionReferenceFromStarboard,
+    "AISClassBExtendedPositionReport.PositionReferenceFromBow": &fieldSpec_AISClassBExtendedPositionReport_PositionReferenceFromBow,
+    "AISAidsToNavigationATONReport.UserID": &fieldSpec_AISAidsToNavigationATONReport_UserID,
+    "AISAidsToNavigationATONReport.Longitude": &fieldSpec_AISAidsToNavigationATONReport_Longitude,
+    "AISAidsToNavigationATONReport.Latitude": &fieldSpec_AISAidsToNavigationATONReport_Latitude,
+    "AISAidsToNavigationATONReport.LengthDiameter": &fieldSpec_AISAidsToNavigationATONReport_LengthDiameter,
+    "AISAidsToNavigationATONReport.BeamDiameter": &fieldSpec_AISAidsToNavigationATONReport_BeamDiameter,
+    "AISAidsToNavigationATONReport.PositionReferenceFromStarboardEdge": &fieldSpec_AISAidsToNavigationATONReport_PositionReferenceFromStarboardEdge,
+    "AISAidsToNavigationATONReport.PositionReferenceFromTrueNorthFacingEdge": &fieldSpec_AISAidsToNavigationATONReport_PositionReferenceFromTrueNorthFacingEdge,
+    "Datum.DeltaLatitude": &fieldSpec_Datum_DeltaLatitude,
+    "Datum.DeltaLongitude": &fieldSpec_Datum_DeltaLongitude,
+    "Datum.DeltaAltitude": &fieldSpec_Datum_DeltaAltitude,
+    "UserDatum.DeltaX": &fieldSpec_UserDatum_DeltaX,
+    "UserDatum.DeltaY": &fieldSpec_UserDatum_DeltaY,
+    "UserDatum.DeltaZ": &fieldSpec_UserDatum_DeltaZ,
+    "UserDatum.EllipsoidSemiMajorAxis": &fieldSpec_UserDatum_EllipsoidSemiMajorAxis,
+    "CrossTrackError.SID": &fieldSpec_CrossTrackError_SID,
+    "CrossTrackError.XTE": &fieldSpec_CrossTrackError_XTE,
+    "NavigationData.SID": &fieldSpec_NavigationData_SID,
+    "NavigationData.DistanceToWaypoint": &fieldSpec_NavigationData_DistanceToWaypoint,
+    "NavigationData.EtaTime": &fieldSpec_NavigationData_EtaTime,
+    "NavigationData.EtaDate": &fieldSpec_NavigationData_EtaDate,
+    "NavigationData.BearingOriginToDestinationWaypoint": &fieldSpec_NavigationData_BearingOriginToDestinationWaypoint,
+    "NavigationData.BearingPositionToDestinationWaypoint": &fieldSpec_NavigationData_BearingPositionToDestinationWaypoint,
+    "NavigationData.OriginWaypointNumber": &fieldSpec_NavigationData_OriginWaypointNumber,
+    "NavigationData.DestinationWaypointNumber": &fieldSpec_NavigationData_DestinationWaypointNumber,
+    "NavigationData.DestinationLatitude": &fieldSpec_NavigationData_DestinationLatitude,
+    "NavigationData.DestinationLongitude": &fieldSpec_NavigationData_DestinationLongitude,
+    "NavigationData.WaypointClosingVelocity": &fieldSpec_NavigationData_WaypointClosingVelocity,
+    "NavigationRouteWPInformation.StartRps": &fieldSpec_NavigationRouteWPInformation_StartRps,
+    "NavigationRouteWPInformation.Nitems": &fieldSpec_NavigationRouteWPInformation_Nitems,
+    "NavigationRouteWPInformation.DatabaseID": &fieldSpec_NavigationRouteWPInformation_DatabaseID,
+    "NavigationRouteWPInformation.RouteID": &fieldSpec_NavigationRouteWPInformation_RouteID,
+    "NavigationRouteWPInformation.WPID": &fieldSpec_NavigationRouteWPInformation_WPID,
+    "NavigationRouteWPInformation.WPLatitude": &fieldSpec_NavigationRouteWPInformation_WPLatitude,
+    "NavigationRouteWPInformation.WPLongitude": &fieldSpec_NavigationRouteWPInformation_WPLongitude,
+    "SetDriftRapidUpdate.SID": &fieldSpec_SetDriftRapidUpdate_SID,
+    "SetDriftRapidUpdate.Set": &fieldSpec_SetDriftRapidUpdate_Set,
+    "SetDriftRapidUpdate.Drift": &fieldSpec_SetDriftRapidUpdate_Drift,
+    "NavigationRouteTimeToFromMark.SID": &fieldSpec_NavigationRouteTimeToFromMark_SID,
+    "NavigationRouteTimeToFromMark.TimeToMark": &fieldSpec_NavigationRouteTimeToFromMark_TimeToMark,
+    "NavigationRouteTimeToFromMark.MarkID": &fieldSpec_NavigationRouteTimeToFromMark_MarkID,
+    "BearingAndDistanceBetweenTwoMarks.SID": &fieldSpec_BearingAndDistanceBetweenTwoMarks_SID,
+    "BearingAndDistanceBetweenTwoMarks.BearingOriginToDestination": &fieldSpec_BearingAndDistanceBetweenTwoMarks_BearingOriginToDestination,
+    "BearingAndDistanceBetweenTwoMarks.Distance": &fieldSpec_BearingAndDistanceBetweenTwoMarks_Distance,
+    "BearingAndDistanceBetweenTwoMarks.OriginMarkID": &fieldSpec_BearingAndDistanceBetweenTwoMarks_OriginMarkID,
+    "BearingAndDistanceBetweenTwoMarks.DestinationMarkID": &fieldSpec_BearingAndDistanceBetweenTwoMarks_DestinationMarkID,
+    "GNSSControlStatus.SvElevationMask": &fieldSpec_GNSSControlStatus_SvElevationMask,
+    "GNSSControlStatus.PdopMask": &fieldSpec_GNSSControlStatus_PdopMask,
+    "GNSSControlStatus.PdopSwitch": &fieldSpec_GNSSControlStatus_PdopSwitch,
+    "GNSSControlStatus.SnrMask": &fieldSpec_GNSSControlStatus_SnrMask,
+    "GNSSControlStatus.MaxCorrectionAge": &fieldSpec_GNSSControlStatus_MaxCorrectionAge,
+    "GNSSControlStatus.AntennaAltitudeFor2DMode": &fieldSpec_GNSSControlStatus_AntennaAltitudeFor2DMode,
+    "GNSSDOPs.SID": &fieldSpec_GNSSDOPs_SID,
+    "GNSSDOPs.Hdop": &fieldSpec_GNSSDOPs_Hdop,
+    "GNSSDOPs.Vdop": &fieldSpec_GNSSDOPs_Vdop,
+    "GNSSDOPs.Tdop": &fieldSpec_GNSSDOPs_Tdop,
+    "GNSSSatsInView.SID": &fieldSpec_GNSSSatsInView_SID,
+    "GNSSSatsInView.SatsInView": &fieldSpec_GNSSSatsInView_SatsInView,
+    "GNSSSatsInView.Prn": &fieldSpec_GNSSSatsInView_Prn,
+    "GNSSSatsInView.Elevation": &fieldSpec_GNSSSatsInView_Elevation,
+    "GNSSSatsInView.Azimuth": &fieldSpec_GNSSSatsInView_Azimuth,
+    "GNSSSatsInView.Snr": &fieldSpec_GNSSSatsInView_Snr,
+    "GNSSSatsInView.RangeResiduals": &fieldSpec_GNSSSatsInView_RangeResiduals,
+    "GPSAlmanacData.Prn": &fieldSpec_GPSAlmanacData_Prn,
+    "GPSAlmanacData.GPSWeekNumber": &fieldSpec_GPSAlmanacData_GPSWeekNumber,
+    "GPSAlmanacData.Eccentricity": &fieldSpec_GPSAlmanacData_Eccentricity,
+    "GPSAlmanacData.AlmanacReferenceTime": &fieldSpec_GPSAlmanacData_AlmanacReferenceTime,
+    "GPSAlmanacData.InclinationAngle": &fieldSpec_GPSAlmanacData_InclinationAngle,
+    "GPSAlmanacData.RateOfRightAscension": &fieldSpec_GPSAlmanacData_RateOfRightAscension,
+    "GPSAlmanacData.RootOfSemiMajorAxis": &fieldSpec_GPSAlmanacData_RootOfSemiMajorAxis,
+    "GPSAlmanacData.ArgumentOfPerigee": &fieldSpec_GPSAlmanacData_ArgumentOfPerigee,
+    "GPSAlmanacData.LongitudeOfAscensionNode": &fieldSpec_GPSAlmanacData_LongitudeOfAscensionNode,
+    "GPSAlmanacData.MeanAnomaly": &fieldSpec_GPSAlmanacData_MeanAnomaly,
+    "GPSAlmanacData.ClockParameter1": &fieldSpec_GPSAlmanacData_ClockParameter1,
+    "GPSAlmanacData.ClockParameter2": &fieldSpec_GPSAlmanacData_ClockParameter2,
+    "GNSSPseudorangeNoiseStatistics.SID": &fieldSpec_GNSSPseudorangeNoiseStatistics_SID,
+    "GNSSPseudorangeNoiseStatistics.RMSOfPositionUncertainty": &fieldSpec_GNSSPseudorangeNoiseStatistics_RMSOfPositionUncertainty,
+    "GNSSPseudorangeNoiseStatistics.StdOfMajorAxis": &fieldSpec_GNSSPseudorangeNoiseStatistics_StdOfMajorAxis,
+    "GNSSPseudorangeNoiseStatistics.StdOfMinorAxis": &fieldSpec_GNSSPseudorangeNoiseStatistics_StdOfMinorAxis,
+    "GNSSPseudorangeNoiseStatistics.OrientationOfMajorAxis": &fieldSpec_GNSSPseudorangeNoiseStatistics_OrientationOfMajorAxis,
+    "GNSSPseudorangeNoiseStatistics.StdOfLatError": &fieldSpec_GNSSPseudorangeNoiseStatistics_StdOfLatError,
+    "GNSSPseudorangeNoiseStatistics.StdOfLonError": &fieldSpec_GNSSPseudorangeNoiseStatistics_StdOfLonError,
+    "GNSSPseudorangeNoiseStatistics.StdOfAltError": &fieldSpec_GNSSPseudorangeNoiseStatistics_StdOfAltError,
+    "GNSSRAIMOutput.SID": &fieldSpec_GNSSRAIMOutput_SID,
+    "GNSSRAIMOutput.LatitudeExpectedError": &fieldSpec_GNSSRAIMOutput_LatitudeExpectedError,
+    "GNSSRAIMOutput.LongitudeExpectedError": &fieldSpec_GNSSRAIMOutput_LongitudeExpectedError,
+    "GNSSRAIMOutput.AltitudeExpectedError": &fieldSpec_GNSSRAIMOutput_AltitudeExpectedError,
+    "GNSSRAIMOutput.SvIDOfMostLikelyFailedSat": &fieldSpec_GNSSRAIMOutput_SvIDOfMostLikelyFailedSat,
+    "GNSSRAIMOutput.ProbabilityOfMissedDetection": &fieldSpec_GNSSRAIMOutput_ProbabilityOfMissedDetection,
+    "GNSSRAIMOutput.EstimateOfPseudorangeBias": &fieldSpec_GNSSRAIMOutput_EstimateOfPseudorangeBias,
+    "GNSSRAIMOutput.StdDeviationOfBias": &fieldSpec_GNSSRAIMOutput_StdDeviationOfBias,
+    "GNSSRAIMSettings.RadialPositionErrorMaximumThreshold": &fieldSpec_GNSSRAIMSettings_RadialPositionErrorMaximumThreshold,
+    "GNSSRAIMSettings.ProbabilityOfFalseAlarm": &fieldSpec_GNSSRAIMSettings_ProbabilityOfFalseAlarm,
+    "GNSSRAIMSettings.ProbabilityOfMissedDetection": &fieldSpec_GNSSRAIMSettings_ProbabilityOfMissedDetection,
+    "GNSSRAIMSettings.PseudorangeResidualFilteringTimeConstant": &fieldSpec_GNSSRAIMSettings_PseudorangeResidualFilteringTimeConstant,
+    "GNSSPseudorangeErrorStatistics.SID": &fieldSpec_GNSSPseudorangeErrorStatistics_SID,
+    "GNSSPseudorangeErrorStatistics.RMSStdDevOfRangeInputs": &fieldSpec_GNSSPseudorangeErrorStatistics_RMSStdDevOfRangeInputs,
+    "GNSSPseudorangeErrorStatistics.StdDevOfMajorErrorEllipse": &fieldSpec_GNSSPseudorangeErrorStatistics_StdDevOfMajorErrorEllipse,
+    "GNSSPseudorangeErrorStatistics.StdDevOfMinorErrorEllipse": &fieldSpec_GNSSPseudorangeErrorStatistics_StdDevOfMinorErrorEllipse,
+    "GNSSPseudorangeErrorStatistics.OrientationOfErrorEllipse": &fieldSpec_GNSSPseudorangeErrorStatistics_OrientationOfErrorEllipse,
+    "GNSSPseudorangeErrorStatistics.StdDevLatError": &fieldSpec_GNSSPseudorangeErrorStatistics_StdDevLatError,
+    "GNSSPseudorangeErrorStatistics.StdDevLonError": &fieldSpec_GNSSPseudorangeErrorStatistics_StdDevLonError,
+    "GNSSPseudorangeErrorStatistics.StdDevAltError": &fieldSpec_GNSSPseudorangeErrorStatistics_StdDevAltError,
+    "DGNSSCorrections.SID": &fieldSpec_DGNSSCorrections_SID,
+    "DGNSSCorrections.ReferenceStationID": &fieldSpec_DGNSSCorrections_ReferenceStationID,
+    "DGNSSCorrections.TimeOfCorrections": &fieldSpec_DGNSSCorrections_TimeOfCorrections,
+    "DGNSSCorrections.SatelliteID": &fieldSpec_DGNSSCorrections_SatelliteID,
+    "DGNSSCorrections.Prc": &fieldSpec_DGNSSCorrections_Prc,
+    "DGNSSCorrections.Rrc": &fieldSpec_DGNSSCorrections_Rrc,
+    "DGNSSCorrections.Udre": &fieldSpec_DGNSSCorrections_Udre,
+    "DGNSSCorrections.Iod": &fieldSpec_DGNSSCorrections_Iod,
+    "GNSSDifferentialCorrectionReceiverInterface.Channel": &fieldSpec_GNSSDifferentialCorrectionReceiverInterface_Channel,
+    "GNSSDifferentialCorrectionReceiverInterface.Frequency": &fieldSpec_GNSSDifferentialCorrectionReceiverInterface_Frequency,
+    "GNSSDifferentialCorrectionReceiverSignal.SID": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_SID,
+    "GNSSDifferentialCorrectionReceiverSignal.Channel": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_Channel,
+    "GNSSDifferentialCorrectionReceiverSignal.SignalStrength": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_SignalStrength,
+    "GNSSDifferentialCorrectionReceiverSignal.SignalSnr": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_SignalSnr,
+    "GNSSDifferentialCorrectionReceiverSignal.Frequency": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_Frequency,
+    "GNSSDifferentialCorrectionReceiverSignal.ReferenceStationID": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_ReferenceStationID,
+    "GNSSDifferentialCorrectionReceiverSignal.TimeSinceLastSatDifferentialSync": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_TimeSinceLastSatDifferentialSync,
+    "GNSSDifferentialCorrectionReceiverSignal.SatelliteServiceIDNo": &fieldSpec_GNSSDifferentialCorrectionReceiverSignal_SatelliteServiceIDNo,
+    "GLONASSAlmanacData.Prn": &fieldSpec_GLONASSAlmanacData_Prn,
+    "GLONASSAlmanacData.Na": &fieldSpec_GLONASSAlmanacData_Na,
+    "GLONASSAlmanacData.Cna": &fieldSpec_GLONASSAlmanacData_Cna,
+    "GLONASSAlmanacData.Hna": &fieldSpec_GLONASSAlmanacData_Hna,
+    "GLONASSAlmanacData.EpsilonNa": &fieldSpec_GLONASSAlmanacData_EpsilonNa,
+    "GLONASSAlmanacData.DeltatnaDot": &fieldSpec_GLONASSAlmanacData_DeltatnaDot,
+    "GLONASSAlmanacData.OmegaNa": &fieldSpec_GLONASSAlmanacData_OmegaNa,
+    "GLONASSAlmanacData.DeltaTna": &fieldSpec_GLONASSAlmanacData_DeltaTna,
+    "GLONASSAlmanacData.Tna": &fieldSpec_GLONASSAlmanacData_Tna,
+    "GLONASSAlmanacData.LambdaNa": &fieldSpec_GLONASSAlmanacData_LambdaNa,
+    "GLONASSAlmanacData.DeltaIna": &fieldSpec_GLONASSAlmanacData_DeltaIna,
+    "GLONASSAlmanacData.TauCa": &fieldSpec_GLONASSAlmanacData_TauCa,
+    "GLONASSAlmanacData.TauNa": &fieldSpec_GLONASSAlmanacData_TauNa,
+    "AISDGNSSBroadcastBinaryMessage.RepeatIndicator": &fieldSpec_AISDGNSSBroadcastBinaryMessage_RepeatIndicator,
+    "AISDGNSSBroadcastBinaryMessage.SourceID": &fieldSpec_AISDGNSSBroadcastBinaryMessage_SourceID,
+    "AISDGNSSBroadcastBinaryMessage.Longitude": &fieldSpec_AISDGNSSBroadcastBinaryMessage_Longitude,
+    "AISDGNSSBroadcastBinaryMessage.Latitude": &fieldSpec_AISDGNSSBroadcastBinaryMessage_Latitude,
+    "AISDGNSSBroadcastBinaryMessage.NumberOfBitsInBinaryDataField": &fieldSpec_AISDGNSSBroadcastBinaryMessage_NumberOfBitsInBinaryDataField,
+    "AISUTCAndDateReport.UserID": &fieldSpec_AISUTCAndDateReport_UserID,
+    "AISUTCAndDateReport.Longitude": &fieldSpec_AISUTCAndDateReport_Longitude,
+    "AISUTCAndDateReport.Latitude": &fieldSpec_AISUTCAndDateReport_Latitude,
+    "AISUTCAndDateReport.PositionTime": &fieldSpec_AISUTCAndDateReport_PositionTime,
+    "AISUTCAndDateReport.PositionDate": &fieldSpec_AISUTCAndDateReport_PositionDate,
+    "AISClassAStaticAndVoyageRelatedData.UserID": &fieldSpec_AISClassAStaticAndVoyageRelatedData_UserID,
+    "AISClassAStaticAndVoyageRelatedData.ImoNumber": &fieldSpec_AISClassAStaticAndVoyageRelatedData_ImoNumber,
+    "AISClassAStaticAndVoyageRelatedData.Length": &fieldSpec_AISClassAStaticAndVoyageRelatedData_Length,
+    "AISClassAStaticAndVoyageRelatedData.Beam": &fieldSpec_AISClassAStaticAndVoyageRelatedData_Beam,
+    "AISClassAStaticAndVoyageRelatedData.PositionReferenceFromStarboard": &fieldSpec_AISClassAStaticAndVoyageRelatedData_PositionReferenceFromStarboard,
+    "AISClassAStaticAndVoyageRelatedData.PositionReferenceFromBow": &fieldSpec_AISClassAStaticAndVoyageRelatedData_PositionReferenceFromBow,
+    "AISClassAStaticAndVoyageRelatedData.EtaDate": &fieldSpec_AISClassAStaticAndVoyageRelatedData_EtaDate,
+    "AISClassAStaticAndVoyageRelatedData.EtaTime": &fieldSpec_AISClassAStaticAndVoyageRelatedData_EtaTime,
+    "AISClassAStaticAndVoyageRelatedData.Draft": &fieldSpec_AISClassAStaticAndVoyageRelatedData_Draft,
+    "AISAddressedBinaryMessage.SourceID": &fieldSpec_AISAddressedBinaryMessage_SourceID,
+    "AISAddressedBinaryMessage.SequenceNumber": &fieldSpec_AISAddressedBinaryMessage_SequenceNumber,
+    "AISAddressedBinaryMessage.DestinationID": &fieldSpec_AISAddressedBinaryMessage_DestinationID,
+    "AISAddressedBinaryMessage.NumberOfBitsInBinaryDataField": &fieldSpec_AISAddressedBinaryMessage_NumberOfBitsInBinaryDataField,
+    "AISAcknowledge.SourceID": &fieldSpec_AISAcknowledge_SourceID,
+    "AISAcknowledge.DestinationID": &fieldSpec_AISAcknowledge_DestinationID,
+    "AISAcknowledge.SequenceNumber": &fieldSpec_AISAcknowledge_SequenceNumber,
+    "AISBinaryBroadcastMessage.SourceID": &fieldSpec_AISBinaryBroadcastMessage_SourceID,
+    "AISBinaryBroadcastMessage.NumberOfBitsInBinaryDataField": &fieldSpec_AISBinaryBroadcastMessage_NumberOfBitsInBinaryDataField,
+    "AISSARAircraftPositionReport.UserID": &fieldSpec_AISSARAircraftPositionReport_UserID,
+    "AISSARAircraftPositionReport.Longitude": &fieldSpec_AISSARAircraftPositionReport_Longitude,
+    "AISSARAircraftPositionReport.Latitude": &fieldSpec_AISSARAircraftPositionReport_Latitude,
+    "AISSARAircraftPositionReport.COG": &fieldSpec_AISSARAircraftPositionReport_COG,
+    "AISSARAircraftPositionReport.SOG": &fieldSpec_AISSARAircraftPositionReport_SOG,
+    "AISSARAircraftPositionReport.Altitude": &fieldSpec_AISSARAircraftPositionReport_Altitude,
+    "RadioFrequencyModePower.RxFrequency": &fieldSpec_RadioFrequencyModePower_RxFrequency,
+    "RadioFrequencyModePower.TxFrequency": &fieldSpec_RadioFrequencyModePower_TxFrequency,
+    "RadioFrequencyModePower.TxPower": &fieldSpec_RadioFrequencyModePower_TxPower,
+    "RadioFrequencyModePower.ChannelBandwidth": &fieldSpec_RadioFrequencyModePower_ChannelBandwidth,
+    "AISUTCDateInquiry.SourceID": &fieldSpec_AISUTCDateInquiry_SourceID,
+    "AISUTCDateInquiry.DestinationID": &fieldSpec_AISUTCDateInquiry_DestinationID,
+    "AISAddressedSafetyRelatedMessage.SourceID": &fieldSpec_AISAddressedSafetyRelatedMessage_SourceID,
+    "AISAddressedSafetyRelatedMessage.SequenceNumber": &fieldSpec_AISAddressedSafetyRelatedMessage_SequenceNumber,
+    "AISAddressedSafetyRelatedMessage.DestinationID": &fieldSpec_AISAddressedSafetyRelatedMessage_DestinationID,
+    "AISSafetyRelatedBroadcastMessage.SourceID": &fieldSpec_AISSafetyRelatedBroadcastMessage_SourceID,
+    "AISInterrogation.SourceID": &fieldSpec_AISInterrogation_SourceID,
+    "AISInterrogation.DestinationID1": &fieldSpec_AISInterrogation_DestinationID1,
+    "AISInterrogation.SlotOffset11": &fieldSpec_AISInterrogation_SlotOffset11,
+    "AISInterrogation.SlotOffset12": &fieldSpec_AISInterrogation_SlotOffset12,
+    "AISInterrogation.DestinationID2": &fieldSpec_AISInterrogation_DestinationID2,
+    "AISInterrogation.SlotOffset21": &fieldSpec_AISInterrogation_SlotOffset21,
+    "AISInterrogation.SID": &fieldSpec_AISInterrogation_SID,
+    "AISAssignmentModeCommand.SourceID": &fieldSpec_AISAssignmentModeCommand_SourceID,
+    "AISAssignmentModeCommand.DestinationIDA": &fieldSpec_AISAssignmentModeCommand_DestinationIDA,
+    "AISAssignmentModeCommand.OffsetA": &fieldSpec_AISAssignmentModeCommand_OffsetA,
+    "AISAssignmentModeCommand.IncrementA": &fieldSpec_AISAssignmentModeCommand_IncrementA,
+    "AISAssignmentModeCommand.DestinationIDB": &fieldSpec_AISAssignmentModeCommand_DestinationIDB,
+    "AISAssignmentModeCommand.OffsetB": &fieldSpec_AISAssignmentModeCommand_OffsetB,
+    "AISAssignmentModeCommand.IncrementB": &fieldSpec_AISAssignmentModeCommand_IncrementB,
+    "AISDataLinkManagementMessage.SourceID": &fieldSpec_AISDataLinkManagementMessage_SourceID,
+    "AISDataLinkManagementMessage.Offset": &fieldSpec_AISDataLinkManagementMessage_Offset,
+    "AISDataLinkManagementMessage.NumberOfSlots": &fieldSpec_AISDataLinkManagementMessage_NumberOfSlots,
+    "AISDataLinkManagementMessage.Timeout": &fieldSpec_AISDataLinkManagementMessage_Timeout,
+    "AISDataLinkManagementMessage.Increment": &fieldSpec_AISDataLinkManagementMessage_Increment,
+    "AISChannelManagement.SourceID": &fieldSpec_AISChannelManagement_SourceID,
+    "AISChannelManagement.ChannelA": &fieldSpec_AISChannelManagement_ChannelA,
+    "AISChannelManagement.ChannelB": &fieldSpec_AISChannelManagement_ChannelB,
+    "AISChannelManagement.NorthEastLongitudeCorner1": &fieldSpec_AISChannelManagement_NorthEastLongitudeCorner1,
+    "AISChannelManagement.NorthEastLatitudeCorner1": &fieldSpec_AISChannelManagement_NorthEastLatitudeCorner1,
+    "AISChannelManagement.SouthWestLongitudeCorner2": &fieldSpec_AISChannelManagement_SouthWestLongitudeCorner2,
+    "AISChannelManagement.SouthWestLatitudeCorner2": &fieldSpec_AISChannelManagement_SouthWestLatitudeCorner2,
+    "AISClassBGroupAssignment.SourceID": &fieldSpec_AISClassBGroupAssignment_SourceID,
+    "AISClassBGroupAssignment.NorthEastLongitudeCorner1": &fieldSpec_AISClassBGroupAssignment_NorthEastLongitudeCorner1,
+    "AISClassBGroupAssignment.NorthEastLatitudeCorner1": &fieldSpec_AISClassBGroupAssignment_NorthEastLatitudeCorner1,
+    "AISClassBGroupAssignment.SouthWestLongitudeCorner2": &fieldSpec_AISClassBGroupAssignment_SouthWestLongitudeCorner2,
+    "AISClassBGroupAssignment.SouthWestLatitudeCorner2": &fieldSpec_AISClassBGroupAssignment_SouthWestLatitudeCorner2,
+    "AISClassBGroupAssignment.QuietTime": &fieldSpec_AISClassBGroupAssignment_QuietTime,
+    "DSCDistressCallInformation.DSCCategory": &fieldSpec_DSCDistressCallInformation_DSCCategory,
+    "DSCDistressCallInformation.LatitudeOfVesselReported": &fieldSpec_DSCDistressCallInformation_LatitudeOfVesselReported,
+    "DSCDistressCallInformation.LongitudeOfVesselReported": &fieldSpec_DSCDistressCallInformation_LongitudeOfVesselReported,
+    "DSCDistressCallInformation.TimeOfPosition": &fieldSpec_DSCDistressCallInformation_TimeOfPosition,
+    "DSCDistressCallInformation.DSCEosSymbol": &fieldSpec_DSCDistressCallInformation_DSCEosSymbol,
+    "DSCDistressCallInformation.TimeOfReceipt": &fieldSpec_DSCDistressCallInformation_TimeOfReceipt,
+    "DSCDistressCallInformation.DateOfReceipt": &fieldSpec_DSCDistressCallInformation_DateOfReceipt,
+    "DSCDistressCallInformation.DSCEquipmentAssignedMessageID": &fieldSpec_DSCDistressCallInformation_DSCEquipmentAssignedMessageID,
+    "DSCCallInformation.LatitudeOfVesselReported": &fieldSpec_DSCCallInformation_LatitudeOfVesselReported,
+    "DSCCallInformation.LongitudeOfVesselReported": &fieldSpec_DSCCallInformation_LongitudeOfVesselReported,
+    "DSCCallInformation.TimeOfPosition": &fieldSpec_DSCCallInformation_TimeOfPosition,
+    "DSCCallInformation.DSCEosSymbol": &fieldSpec_DSCCallInformation_DSCEosSymbol,
+    "DSCCallInformation.TimeOfReceipt": &fieldSpec_DSCCallInformation_TimeOfReceipt,
+    "DSCCallInformation.DateOfReceipt": &fieldSpec_DSCCallInformation_DateOfReceipt,
+    "DSCCallInformation.DSCEquipmentAssignedMessageID": &fieldSpec_DSCCallInformation_DSCEquipmentAssignedMessageID,
+    "AISClassBStaticDataMsg24PartA.UserID": &fieldSpec_AISClassBStaticDataMsg24PartA_UserID,
+    "AISClassBStaticDataMsg24PartA.SequenceID": &fieldSpec_AISClassBStaticDataMsg24PartA_SequenceID,
+    "AISClassBStaticDataMsg24PartB.UserID": &fieldSpec_AISClassBStaticDataMsg24PartB_UserID,
+    "AISClassBStaticDataMsg24PartB.Length": &fieldSpec_AISClassBStaticDataMsg24PartB_Length,
+    "AISClassBStaticDataMsg24PartB.Beam": &fieldSpec_AISClassBStaticDataMsg24PartB_Beam,
+    "AISClassBStaticDataMsg24PartB.PositionReferenceFromStarboard": &fieldSpec_AISClassBStaticDataMsg24PartB_PositionReferenceFromStarboard,
+    "AISClassBStaticDataMsg24PartB.PositionReferenceFromBow": &fieldSpec_AISClassBStaticDataMsg24PartB_PositionReferenceFromBow,
+    "AISClassBStaticDataMsg24PartB.MothershipUserID": &fieldSpec_AISClassBStaticDataMsg24PartB_MothershipUserID,
+    "AISClassBStaticDataMsg24PartB.SequenceID": &fieldSpec_AISClassBStaticDataMsg24PartB_SequenceID,
+    "AISSingleSlotBinaryMessageDeprecated.SequenceID": &fieldSpec_AISSingleSlotBinaryMessageDeprecated_SequenceID,
+    "AISSingleSlotBinaryMessageDeprecated.SourceID": &fieldSpec_AISSingleSlotBinaryMessageDeprecated_SourceID,
+    "AISSingleSlotBinaryMessageDeprecated.BinaryDataFlag": &fieldSpec_AISSingleSlotBinaryMessageDeprecated_BinaryDataFlag,
+    "AISSingleSlotBinaryMessageDeprecated.DestinationID": &fieldSpec_AISSingleSlotBinaryMessageDeprecated_DestinationID,
+    "AISSingleSlotBinaryMessageDeprecated.NumberOfBitsInBinaryDataField": &fieldSpec_AISSingleSlotBinaryMessageDeprecated_NumberOfBitsInBinaryDataField,
+    "AISMultiSlotBinaryMessageDeprecated.SequenceID": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_SequenceID,
+    "AISMultiSlotBinaryMessageDeprecated.SourceID": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_SourceID,
+    "AISMultiSlotBinaryMessageDeprecated.BinaryDataFlag": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_BinaryDataFlag,
+    "AISMultiSlotBinaryMessageDeprecated.DestinationID": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_DestinationID,
+    "AISMultiSlotBinaryMessageDeprecated.CommunicationStateSelectorFlag": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_CommunicationStateSelectorFlag,
+    "AISMultiSlotBinaryMessageDeprecated.NumberOfBitsInBinaryDataField": &fieldSpec_AISMultiSlotBinaryMessageDeprecated_NumberOfBitsInBinaryDataField,
+    "AISLongRangeBroadcastMessage.SequenceID": &fieldSpec_AISLongRangeBroadcastMessage_SequenceID,
+    "AISLongRangeBroadcastMessage.UserID": &fieldSpec_AISLongRangeBroadcastMessage_UserID,
+    "AISLongRangeBroadcastMessage.Longitude": &fieldSpec_AISLongRangeBroadcastMessage_Longitude,
+    "AISLongRangeBroadcastMessage.Latitude": &fieldSpec_AISLongRangeBroadcastMessage_Latitude,
+    "AISLongRangeBroadcastMessage.PositionLatency": &fieldSpec_AISLongRangeBroadcastMessage_PositionLatency,
+    "AISLongRangeBroadcastMessage.SOG": &fieldSpec_AISLongRangeBroadcastMessage_SOG,
+    "AISLongRangeBroadcastMessage.COG": &fieldSpec_AISLongRangeBroadcastMessage_COG,
+    "AISSingleSlotBinaryMessage.SequenceID": &fieldSpec_AISSingleSlotBinaryMessage_SequenceID,
+    "AISSingleSlotBinaryMessage.SourceID": &fieldSpec_AISSingleSlotBinaryMessage_SourceID,
+    "AISSingleSlotBinaryMessage.BinaryDataFlag": &fieldSpec_AISSingleSlotBinaryMessage_BinaryDataFlag,
+    "AISSingleSlotBinaryMessage.DestinationID": &fieldSpec_AISSingleSlotBinaryMessage_DestinationID,
+    "AISSingleSlotBinaryMessage.NumberOfBitsInBinaryDataField": &fieldSpec_AISSingleSlotBinaryMessage_NumberOfBitsInBinaryDataField,
+    "AISMultiSlotBinaryMessage.SequenceID": &fieldSpec_AISMultiSlotBinaryMessage_SequenceID,
+    "AISMultiSlotBinaryMessage.SourceID": &fieldSpec_AISMultiSlotBinaryMessage_SourceID,
+    "AISMultiSlotBinaryMessage.BinaryDataFlag": &fieldSpec_AISMultiSlotBinaryMessage_BinaryDataFlag,
+    "AISMultiSlotBinaryMessage.DestinationID": &fieldSpec_AISMultiSlotBinaryMessage_DestinationID,
+    "AISMultiSlotBinaryMessage.CommunicationStateSelectorFlag": &fieldSpec_AISMultiSlotBinaryMessage_CommunicationStateSelectorFlag,
+    "AISMultiSlotBinaryMessage.NumberOfBitsInBinaryDataField": &fieldSpec_AISMultiSlotBinaryMessage_NumberOfBitsInBinaryDataField,
+    "AISAcknowledgeBinary.SequenceID": &fieldSpec_AISAcknowledgeBinary_SequenceID,
+    "AISAcknowledgeBinary.SourceID": &fieldSpec_AISAcknowledgeBinary_SourceID,
+    "AISAcknowledgeBinary.NumberOfAcknowledgments": &fieldSpec_AISAcknowledgeBinary_NumberOfAcknowledgments,
+    "AISAcknowledgeBinary.DestinationID": &fieldSpec_AISAcknowledgeBinary_DestinationID,
+    "AISAcknowledgeBinary.SequenceNumber": &fieldSpec_AISAcknowledgeBinary_SequenceNumber,
+    "LoranCTdData.GroupRepetitionIntervalGri": &fieldSpec_LoranCTdData_GroupRepetitionIntervalGri,
+    "LoranCTdData.MasterRange": &fieldSpec_LoranCTdData_MasterRange,
+    "LoranCTdData.VSecondaryTd": &fieldSpec_LoranCTdData_VSecondaryTd,
+    "LoranCTdData.WSecondaryTd": &fieldSpec_LoranCTdData_WSecondaryTd,
+    "LoranCTdData.XSecondaryTd": &fieldSpec_LoranCTdData_XSecondaryTd,
+    "LoranCTdData.YSecondaryTd": &fieldSpec_LoranCTdData_YSecondaryTd,
+    "LoranCTdData.ZSecondaryTd": &fieldSpec_LoranCTdData_ZSecondaryTd,
+    "LoranCRangeData.GroupRepetitionIntervalGri": &fieldSpec_LoranCRangeData_GroupRepetitionIntervalGri,
+    "LoranCRangeData.MasterRange": &fieldSpec_LoranCRangeData_MasterRange,
+    "LoranCRangeData.VSecondaryRange": &fieldSpec_LoranCRangeData_VSecondaryRange,
+    "LoranCRangeData.WSecondaryRange": &fieldSpec_LoranCRangeData_WSecondaryRange,
+    "LoranCRangeData.XSecondaryRange": &fieldSpec_LoranCRangeData_XSecondaryRange,
+    "LoranCRangeData.YSecondaryRange": &fieldSpec_LoranCRangeData_YSecondaryRange,
+    "LoranCRangeData.ZSecondaryRange": &fieldSpec_LoranCRangeData_ZSecondaryRange,
+    "LoranCSignalData.GroupRepetitionIntervalGri": &fieldSpec_LoranCSignalData_GroupRepetitionIntervalGri,
+    "LoranCSignalData.StationSnr": &fieldSpec_LoranCSignalData_StationSnr,
+    "LoranCSignalData.StationEcd": &fieldSpec_LoranCSignalData_StationEcd,
+    "LoranCSignalData.StationAsf": &fieldSpec_LoranCSignalData_StationAsf,
+    "Label.HardwareChannelID": &fieldSpec_Label_HardwareChannelID,
+    "Label.PGN": &fieldSpec_Label_PGN,
+    "Label.DataSourceInstanceFieldNumber": &fieldSpec_Label_DataSourceInstanceFieldNumber,
+    "Label.DataSourceInstanceValue": &fieldSpec_Label_DataSourceInstanceValue,
+    "Label.SecondaryEnumerationFieldNumber": &fieldSpec_Label_SecondaryEnumerationFieldNumber,
+    "Label.SecondaryEnumerationFieldValue": &fieldSpec_Label_SecondaryEnumerationFieldValue,
+    "Label.ParameterFieldNumber": &fieldSpec_Label_ParameterFieldNumber,
+    "ChannelSourceConfiguration.DataSourceChannelID": &fieldSpec_ChannelSourceConfiguration_DataSourceChannelID,
+    "ChannelSourceConfiguration.SourceSelectionStatus": &fieldSpec_ChannelSourceConfiguration_SourceSelectionStatus,
+    "ChannelSourceConfiguration.SourceName": &fieldSpec_ChannelSourceConfiguration_SourceName,
+    "ChannelSourceConfiguration.PGN": &fieldSpec_ChannelSourceConfiguration_PGN,
+    "ChannelSourceConfiguration.DataSourceInstanceFieldNumber": &fieldSpec_ChannelSourceConfiguration_DataSourceInstanceFieldNumber,
+    "ChannelSourceConfiguration.DataSourceInstanceValue": &fieldSpec_ChannelSourceConfiguration_DataSourceInstanceValue,
+    "ChannelSourceConfiguration.SecondaryEnumerationFieldNumber": &fieldSpec_ChannelSourceConfiguration_SecondaryEnumerationFieldNumber,
+    "ChannelSourceConfiguration.SecondaryEnumerationFieldValue": &fieldSpec_ChannelSourceConfiguration_SecondaryEnumerationFieldValue,
+    "ChannelSourceConfiguration.ParameterFieldNumber": &fieldSpec_ChannelSourceConfiguration_ParameterFieldNumber,
+    "RouteAndWPServiceDatabaseList.StartDatabaseID": &fieldSpec_RouteAndWPServiceDatabaseList_StartDatabaseID,
+    "RouteAndWPServiceDatabaseList.Nitems": &fieldSpec_RouteAndWPServiceDatabaseList_Nitems,
+    "RouteAndWPServiceDatabaseList.NumberOfDatabasesAvailable": &fieldSpec_RouteAndWPServiceDatabaseList_NumberOfDatabasesAvailable,
+    "RouteAndWPServiceDatabaseList.DatabaseID": &fieldSpec_RouteAndWPServiceDatabaseList_DatabaseID,
+    "RouteAndWPServiceDatabaseList.DatabaseTimestamp": &fieldSpec_RouteAndWPServiceDatabaseList_DatabaseTimestamp,
+    "RouteAndWPServiceDatabaseList.DatabaseDatestamp": &fieldSpec_RouteAndWPServiceDatabaseList_DatabaseDatestamp,
+    "RouteAndWPServiceDatabaseList.NumberOfRoutesInDatabase": &fieldSpec_RouteAndWPServiceDatabaseList_NumberOfRoutesInDatabase,
+    "RouteAndWPServiceDatabaseList.NumberOfWPsInDatabase": &fieldSpec_RouteAndWPServiceDatabaseList_NumberOfWPsInDatabase,
+    "RouteAndWPServiceDatabaseList.NumberOfBytesInDatabase": &fieldSpec_RouteAndWPServiceDatabaseList_NumberOfBytesInDatabase,
+    "RouteAndWPServiceRouteList.StartRouteID": &fieldSpec_RouteAndWPServiceRouteList_StartRouteID,
+    "RouteAndWPServiceRouteList.Nitems": &fieldSpec_RouteAndWPServiceRouteList_Nitems,
+    "RouteAndWPServiceRouteList.NumberOfRoutesInDatabase": &fieldSpec_RouteAndWPServiceRouteList_NumberOfRoutesInDatabase,
+    "RouteAndWPServiceRouteList.DatabaseID": &fieldSpec_RouteAndWPServiceRouteList_DatabaseID,
+    "RouteAndWPServiceRouteList.RouteID": &fieldSpec_RouteAndWPServiceRouteList_RouteID,
+    "RouteAndWPServiceRouteWPListAttributes.DatabaseID": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_DatabaseID,
+    "RouteAndWPServiceRouteWPListAttributes.RouteID": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_RouteID,
+    "RouteAndWPServiceRouteWPListAttributes.RouteWPListTimestamp": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_RouteWPListTimestamp,
+    "RouteAndWPServiceRouteWPListAttributes.RouteWPListDatestamp": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_RouteWPListDatestamp,
+    "RouteAndWPServiceRouteWPListAttributes.NumberOfWPsInTheRouteWPList": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_NumberOfWPsInTheRouteWPList,
+    "RouteAndWPServiceRouteWPListAttributes.XTELimitForTheRoute": &fieldSpec_RouteAndWPServiceRouteWPListAttributes_XTELimitForTheRoute,
+    "RouteAndWPServiceRouteWPNamePosition.StartRps": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_StartRps,
+    "RouteAndWPServiceRouteWPNamePosition.Nitems": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_Nitems,
+    "RouteAndWPServiceRouteWPNamePosition.NumberOfWPsInTheRouteWPList": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_NumberOfWPsInTheRouteWPList,
+    "RouteAndWPServiceRouteWPNamePosition.DatabaseID": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_DatabaseID,
+    "RouteAndWPServiceRouteWPNamePosition.RouteID": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_RouteID,
+    "RouteAndWPServiceRouteWPNamePosition.WPID": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_WPID,
+    "RouteAndWPServiceRouteWPNamePosition.WPLatitude": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_WPLatitude,
+    "RouteAndWPServiceRouteWPNamePosition.WPLongitude": &fieldSpec_RouteAndWPServiceRouteWPNamePosition_WPLongitude,
+    "RouteAndWPServiceRouteWPName.StartRps": &fieldSpec_RouteAndWPServiceRouteWPName_StartRps,
+    "RouteAndWPServiceRouteWPName.Nitems": &fieldSpec_RouteAndWPServiceRouteWPName_Nitems,
+    "RouteAndWPServiceRouteWPName.NumberOfWPsInTheRouteWPList": &fieldSpec_RouteAndWPServiceRouteWPName_NumberOfWPsInTheRouteWPList,
+    "RouteAndWPServiceRouteWPName.DatabaseID": &fieldSpec_RouteAndWPServiceRouteWPName_DatabaseID,
+    "RouteAndWPServiceRouteWPName.RouteID": &fieldSpec_RouteAndWPServiceRouteWPName_RouteID,
+    "RouteAndWPServiceRouteWPName.WPID": &fieldSpec_RouteAndWPServiceRouteWPName_WPID,
+    "RouteAndWPServiceXTELimitNavigationMethod.StartRps": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_StartRps,
+    "RouteAndWPServiceXTELimitNavigationMethod.Nitems": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_Nitems,
+    "RouteAndWPServiceXTELimitNavigationMethod.NumberOfWPsWithASpecificXTELimitOrNavMethod": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_NumberOfWPsWithASpecificXTELimitOrNavMethod,
+    "RouteAndWPServiceXTELimitNavigationMethod.DatabaseID": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_DatabaseID,
+    "RouteAndWPServiceXTELimitNavigationMethod.RouteID": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_RouteID,
+    "RouteAndWPServiceXTELimitNavigationMethod.Rps": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_Rps,
+    "RouteAndWPServiceXTELimitNavigationMethod.XTELimitInTheLegAfterWP": &fieldSpec_RouteAndWPServiceXTELimitNavigationMethod_XTELimitInTheLegAfterWP,
+    "RouteAndWPServiceWPComment.StartID": &fieldSpec_RouteAndWPServiceWPComment_StartID,
+    "RouteAndWPServiceWPComment.Nitems": &fieldSpec_RouteAndWPServiceWPComment_Nitems,
+    "RouteAndWPServiceWPComment.NumberOfWPsWithComments": &fieldSpec_RouteAndWPServiceWPComment_NumberOfWPsWithComments,
+    "RouteAndWPServiceWPComment.DatabaseID": &fieldSpec_RouteAndWPServiceWPComment_DatabaseID,
+    "RouteAndWPServiceWPComment.RouteID": &fieldSpec_RouteAndWPServiceWPComment_RouteID,
+    "RouteAndWPServiceWPComment.WPIDRps": &fieldSpec_RouteAndWPServiceWPComment_WPIDRps,
+    "RouteAndWPServiceRouteComment.StartRouteID": &fieldSpec_RouteAndWPServiceRouteComment_StartRouteID,
+    "RouteAndWPServiceRouteComment.Nitems": &fieldSpec_RouteAndWPServiceRouteComment_Nitems,
+    "RouteAndWPServiceRouteComment.NumberOfRoutesWithComments": &fieldSpec_RouteAndWPServiceRouteComment_NumberOfRoutesWithComments,
+    "RouteAndWPServiceRouteComment.DatabaseID": &fieldSpec_RouteAndWPServiceRouteComment_DatabaseID,
+    "RouteAndWPServiceRouteComment.RouteID": &fieldSpec_RouteAndWPServiceRouteComment_RouteID,
+    "RouteAndWPServiceDatabaseComment.StartDatabaseID": &fieldSpec_RouteAndWPServiceDatabaseComment_StartDatabaseID,
+    "RouteAndWPServiceDatabaseComment.Nitems": &fieldSpec_RouteAndWPServiceDatabaseComment_Nitems,
+    "RouteAndWPServiceDatabaseComment.NumberOfDatabasesWithComments": &fieldSpec_RouteAndWPServiceDatabaseComment_NumberOfDatabasesWithComments,
+    "RouteAndWPServiceDatabaseComment.DatabaseID": &fieldSpec_RouteAndWPServiceDatabaseComment_DatabaseID,
+    "RouteAndWPServiceRadiusOfTurn.StartRps": &fieldSpec_RouteAndWPServiceRadiusOfTurn_StartRps,
+    "RouteAndWPServiceRadiusOfTurn.Nitems": &fieldSpec_RouteAndWPServiceRadiusOfTurn_Nitems,
+    "RouteAndWPServiceRadiusOfTurn.NumberOfWPsWithASpecificRadiusOfTurn": &fieldSpec_RouteAndWPServiceRadiusOfTurn_NumberOfWPsWithASpecificRadiusOfTurn,
+    "RouteAndWPServiceRadiusOfTurn.DatabaseID": &fieldSpec_RouteAndWPServiceRadiusOfTurn_DatabaseID,
+    "RouteAndWPServiceRadiusOfTurn.RouteID": &fieldSpec_RouteAndWPServiceRadiusOfTurn_RouteID,
+    "RouteAndWPServiceRadiusOfTurn.Rps": &fieldSpec_RouteAndWPServiceRadiusOfTurn_Rps,
+    "RouteAndWPServiceRadiusOfTurn.RadiusOfTurn": &fieldSpec_RouteAndWPServiceRadiusOfTurn_RadiusOfTurn,
+    "RouteAndWPServiceWPListWPNamePosition.StartWPID": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_StartWPID,
+    "RouteAndWPServiceWPListWPNamePosition.Nitems": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_Nitems,
+    "RouteAndWPServiceWPListWPNamePosition.NumberOfValidWPsInTheWPList": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_NumberOfValidWPsInTheWPList,
+    "RouteAndWPServiceWPListWPNamePosition.DatabaseID": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_DatabaseID,
+    "RouteAndWPServiceWPListWPNamePosition.WPID": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_WPID,
+    "RouteAndWPServiceWPListWPNamePosition.WPLatitude": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_WPLatitude,
+    "RouteAndWPServiceWPListWPNamePosition.WPLongitude": &fieldSpec_RouteAndWPServiceWPListWPNamePosition_WPLongitude,
+    "WindData.SID": &fieldSpec_WindData_SID,
+    "WindData.WindSpeed": &fieldSpec_WindData_WindSpeed,
+    "WindData.WindAngle": &fieldSpec_WindData_WindAngle,
+    "EnvironmentalParametersObsolete.SID": &fieldSpec_EnvironmentalParametersObsolete_SID,
+    "EnvironmentalParametersObsolete.WaterTemperature": &fieldSpec_EnvironmentalParametersObsolete_WaterTemperature,
+    "EnvironmentalParametersObsolete.OutsideAmbientAirTemperature": &fieldSpec_EnvironmentalParametersObsolete_OutsideAmbientAirTemperature,
+    "EnvironmentalParametersObsolete.AtmosphericPressure": &fieldSpec_EnvironmentalParametersObsolete_AtmosphericPressure,
+    "EnvironmentalParameters.SID": &fieldSpec_EnvironmentalParameters_SID,
+    "EnvironmentalParameters.Temperature": &fieldSpec_EnvironmentalParameters_Temperature,
+    "EnvironmentalParameters.Humidity": &fieldSpec_EnvironmentalParameters_Humidity,
+    "EnvironmentalParameters.AtmosphericPressure": &fieldSpec_EnvironmentalParameters_AtmosphericPressure,
+    "Temperature.SID": &fieldSpec_Temperature_SID,
+    "Temperature.Instance": &fieldSpec_Temperature_Instance,
+    "Temperature.ActualTemperature": &fieldSpec_Temperature_ActualTemperature,
+    "Temperature.SetTemperature": &fieldSpec_Temperature_SetTemperature,
+    "Humidity.SID": &fieldSpec_Humidity_SID,
+    "Humidity.Instance": &fieldSpec_Humidity_Instance,
+    "Humidity.ActualHumidity": &fieldSpec_Humidity_ActualHumidity,
+    "Humidity.SetHumidity": &fieldSpec_Humidity_SetHumidity,
+    "ActualPressure.SID": &fieldSpec_ActualPressure_SID,
+    "ActualPressure.Instance": &fieldSpec_ActualPressure_Instance,
+    "ActualPressure.Pressure": &fieldSpec_ActualPressure_Pressure,
+    "SetPressure.SID": &fieldSpec_SetPressure_SID,
+    "SetPressure.Instance": &fieldSpec_SetPressure_Instance,
+    "SetPressure.Pressure": &fieldSpec_SetPressure_Pressure,
+    "TemperatureExtendedRange.SID": &fieldSpec_TemperatureExtendedRange_SID,
+    "TemperatureExtendedRange.Instance": &fieldSpec_TemperatureExtendedRange_Instance,
+    "TemperatureExtendedRange.Temperature": &fieldSpec_TemperatureExtendedRange_Temperature,
+    "TemperatureExtendedRange.SetTemperature": &fieldSpec_TemperatureExtendedRange_SetTemperature,
+    "TideStationData.MeasurementDate": &fieldSpec_TideStationData_MeasurementDate,
+    "TideStationData.MeasurementTime": &fieldSpec_TideStationData_MeasurementTime,
+    "TideStationData.StationLatitude": &fieldSpec_TideStationData_StationLatitude,
+    "TideStationData.StationLongitude": &fieldSpec_TideStationData_StationLongitude,
+    "TideStationData.TideLevel": &fieldSpec_TideStationData_TideLevel,
+    "TideStationData.TideLevelStandardDeviation": &fieldSpec_TideStationData_TideLevelStandardDeviation,
+    "SalinityStationData.MeasurementDate": &fieldSpec_SalinityStationData_MeasurementDate,
+    "SalinityStationData.MeasurementTime": &fieldSpec_SalinityStationData_MeasurementTime,
+    "SalinityStationData.StationLatitude": &fieldSpec_SalinityStationData_StationLatitude,
+    "SalinityStationData.StationLongitude": &fieldSpec_SalinityStationData_StationLongitude,
+    "SalinityStationData.WaterTemperature": &fieldSpec_SalinityStationData_WaterTemperature,
+    "CurrentStationData.MeasurementDate": &fieldSpec_CurrentStationData_MeasurementDate,
+    "CurrentStationData.MeasurementTime": &fieldSpec_CurrentStationData_MeasurementTime,
+    "CurrentStationData.StationLatitude": &fieldSpec_CurrentStationData_StationLatitude,
+    "CurrentStationData.StationLongitude": &fieldSpec_CurrentStationData_StationLongitude,
+    "CurrentStationData.MeasurementDepth": &fieldSpec_CurrentStationData_MeasurementDepth,
+    "CurrentStationData.CurrentSpeed": &fieldSpec_CurrentStationData_CurrentSpeed,
+    "CurrentStationData.CurrentFlowDirection": &fieldSpec_CurrentStationData_CurrentFlowDirection,
+    "CurrentStationData.WaterTemperature": &fieldSpec_CurrentStationData_WaterTemperature,
+    "MeteorologicalStationData.MeasurementDate": &fieldSpec_MeteorologicalStationData_MeasurementDate,
+    "MeteorologicalStationData.MeasurementTime": &fieldSpec_MeteorologicalStationData_MeasurementTime,
+    "MeteorologicalStationData.StationLatitude": &fieldSpec_MeteorologicalStationData_StationLatitude,
+    "MeteorologicalStationData.StationLongitude": &fieldSpec_MeteorologicalStationData_StationLongitude,
+    "MeteorologicalStationData.WindSpeed": &fieldSpec_MeteorologicalStationData_WindSpeed,
+    "MeteorologicalStationData.WindDirection": &fieldSpec_MeteorologicalStationData_WindDirection,
+    "MeteorologicalStationData.WindGusts": &fieldSpec_MeteorologicalStationData_WindGusts,
+    "MeteorologicalStationData.AtmosphericPressure": &fieldSpec_MeteorologicalStationData_AtmosphericPressure,
+    "MeteorologicalStationData.AmbientTemperature": &fieldSpec_MeteorologicalStationData_AmbientTemperature,
+    "MooredBuoyStationData.MeasurementDate": &fieldSpec_MooredBuoyStationData_MeasurementDate,
+    "MooredBuoyStationData.MeasurementTime": &fieldSpec_MooredBuoyStationData_MeasurementTime,
+    "MooredBuoyStationData.StationLatitude": &fieldSpec_MooredBuoyStationData_StationLatitude,
+    "MooredBuoyStationData.StationLongitude": &fieldSpec_MooredBuoyStationData_StationLongitude,
+    "MooredBuoyStationData.WindSpeed": &fieldSpec_MooredBuoyStationData_WindSpeed,
+    "MooredBuoyStationData.WindDirection": &fieldSpec_MooredBuoyStationData_WindDirection,
+    "MooredBuoyStationData.WindGusts": &fieldSpec_MooredBuoyStationData_WindGusts,
+    "MooredBuoyStationData.WaveHeight": &fieldSpec_MooredBuoyStationData_WaveHeight,
+    "MooredBuoyStationData.DominantWavePeriod": &fieldSpec_MooredBuoyStationData_DominantWavePeriod,
+    "MooredBuoyStationData.AtmosphericPressure": &fieldSpec_MooredBuoyStationData_AtmosphericPressure,
+    "MooredBuoyStationData.PressureTendencyRate": &fieldSpec_MooredBuoyStationData_PressureTendencyRate,
+    "MooredBuoyStationData.AirTemperature": &fieldSpec_MooredBuoyStationData_AirTemperature,
+    "MooredBuoyStationData.WaterTemperature": &fieldSpec_MooredBuoyStationData_WaterTemperature,
+    "HvacStatus.HvacIdentifier": &fieldSpec_HvacStatus_HvacIdentifier,
+    "HvacStatus.Location": &fieldSpec_HvacStatus_Location,
+    "HvacStatus.OperatingMode": &fieldSpec_HvacStatus_OperatingMode,
+    "HvacStatus.ControlOperatingState": &fieldSpec_HvacStatus_ControlOperatingState,
+    "HvacStatus.FanSpeedModeChangeable": &fieldSpec_HvacStatus_FanSpeedModeChangeable,
+    "HvacStatus.FanSpeedMode": &fieldSpec_HvacStatus_FanSpeedMode,
+    "HvacStatus.FanOperationMode": &fieldSpec_HvacStatus_FanOperationMode,
+    "HvacStatus.FanSpeedAvailable": &fieldSpec_HvacStatus_FanSpeedAvailable,
+    "HvacStatus.FanSpeed": &fieldSpec_HvacStatus_FanSpeed,
+    "HvacStatus.SetpointType": &fieldSpec_HvacStatus_SetpointType,
+    "HvacStatus.LowerTemperatureSetpoint": &fieldSpec_HvacStatus_LowerTemperatureSetpoint,
+    "HvacStatus.UpperTemperatureSetpoint": &fieldSpec_HvacStatus_UpperTemperatureSetpoint,
+    "HvacStatus.LowerHumiditySetpoint": &fieldSpec_HvacStatus_LowerHumiditySetpoint,
+    "HvacStatus.UpperHumiditySetpoint": &fieldSpec_HvacStatus_UpperHumiditySetpoint,
+    "HvacStatus.AuxiliaryHeatControlSupported": &fieldSpec_HvacStatus_AuxiliaryHeatControlSupported,
+    "HvacStatus.AuxiliaryHeatAutomaticMode": &fieldSpec_HvacStatus_AuxiliaryHeatAutomaticMode,
+    "HvacStatus.AuxiliaryHeatState": &fieldSpec_HvacStatus_AuxiliaryHeatState,
+    "HvacStatus.CurrentTemperature": &fieldSpec_HvacStatus_CurrentTemperature,
+    "HvacStatus.CurrentHumidity": &fieldSpec_HvacStatus_CurrentHumidity,
+    "HvacStatus.SupportedModes": &fieldSpec_HvacStatus_SupportedModes,
+    "HvacStatus.SeaWaterTemperature": &fieldSpec_HvacStatus_SeaWaterTemperature,
+    "HvacStatus.LoopTemperature": &fieldSpec_HvacStatus_LoopTemperature,
+    "HvacStatus.EvaporatorTemperature": &fieldSpec_HvacStatus_EvaporatorTemperature,
+    "HvacStatus.InletTemperature": &fieldSpec_HvacStatus_InletTemperature,
+    "HvacStatus.InletHumidity": &fieldSpec_HvacStatus_InletHumidity,
+    "LightingSystemSettings.GlobalEnable": &fieldSpec_LightingSystemSettings_GlobalEnable,
+    "LightingSystemSettings.MaxScenes": &fieldSpec_LightingSystemSettings_MaxScenes,
+    "LightingSystemSettings.MaxSceneConfigurationCount": &fieldSpec_LightingSystemSettings_MaxSceneConfigurationCount,
+    "LightingSystemSettings.MaxZones": &fieldSpec_LightingSystemSettings_MaxZones,
+    "LightingSystemSettings.MaxColorSequences": &fieldSpec_LightingSystemSettings_MaxColorSequences,
+    "LightingSystemSettings.MaxColorSequenceColorCount": &fieldSpec_LightingSystemSettings_MaxColorSequenceColorCount,
+    "LightingSystemSettings.NumberOfPrograms": &fieldSpec_LightingSystemSettings_NumberOfPrograms,
+    "LightingSystemSettings.ControllerCapabilities": &fieldSpec_LightingSystemSettings_ControllerCapabilities,
+    "LightingSystemSettings.IdentifyDevice": &fieldSpec_LightingSystemSettings_IdentifyDevice,
+    "PayloadMass.SID": &fieldSpec_PayloadMass_SID,
+    "PayloadMass.MeasurementStatus": &fieldSpec_PayloadMass_MeasurementStatus,
+    "PayloadMass.MeasurementID": &fieldSpec_PayloadMass_MeasurementID,
+    "PayloadMass.PayloadMass": &fieldSpec_PayloadMass_PayloadMass,
+    "LightingZone.ZoneIndex": &fieldSpec_LightingZone_ZoneIndex,
+    "LightingZone.RedComponent": &fieldSpec_LightingZone_RedComponent,
+    "LightingZone.GreenComponent": &fieldSpec_LightingZone_GreenComponent,
+    "LightingZone.BlueComponent": &fieldSpec_LightingZone_BlueComponent,
+    "LightingZone.ColorTemperature": &fieldSpec_LightingZone_ColorTemperature,
+    "LightingZone.Intensity": &fieldSpec_LightingZone_Intensity,
+    "LightingZone.ProgramID": &fieldSpec_LightingZone_ProgramID,
+    "LightingZone.ProgramColorSequenceIndex": &fieldSpec_LightingZone_ProgramColorSequenceIndex,
+    "LightingZone.ProgramIntensity": &fieldSpec_LightingZone_ProgramIntensity,
+    "LightingZone.ProgramRate": &fieldSpec_LightingZone_ProgramRate,
+    "LightingZone.ProgramColorSequence": &fieldSpec_LightingZone_ProgramColorSequence,
+    "LightingScene.SceneIndex": &fieldSpec_LightingScene_SceneIndex,
+    "LightingScene.Control": &fieldSpec_LightingScene_Control,
+    "LightingScene.ConfigurationCount": &fieldSpec_LightingScene_ConfigurationCount,
+    "LightingScene.ConfigurationIndex": &fieldSpec_LightingScene_ConfigurationIndex,
+    "LightingScene.ZoneIndex": &fieldSpec_LightingScene_ZoneIndex,
+    "LightingScene.DevicesID": &fieldSpec_LightingScene_DevicesID,
+    "LightingScene.ProgramIndex": &fieldSpec_LightingScene_ProgramIndex,
+    "LightingScene.ProgramColorSequenceIndex": &fieldSpec_LightingScene_ProgramColorSequenceIndex,
+    "LightingScene.ProgramIntensity": &fieldSpec_LightingScene_ProgramIntensity,
+    "LightingScene.ProgramRate": &fieldSpec_LightingScene_ProgramRate,
+    "LightingScene.ProgramColorSequenceRate": &fieldSpec_LightingScene_ProgramColorSequenceRate,
+    "LightingDevice.DeviceID": &fieldSpec_LightingDevice_DeviceID,
+    "LightingDevice.DeviceCapabilities": &fieldSpec_LightingDevice_DeviceCapabilities,
+    "LightingDevice.ColorCapabilities": &fieldSpec_LightingDevice_ColorCapabilities,
+    "LightingDevice.ZoneIndex": &fieldSpec_LightingDevice_ZoneIndex,
+    "LightingDevice.Status": &fieldSpec_LightingDevice_Status,
+    "LightingDevice.RedComponent": &fieldSpec_LightingDevice_RedComponent,
+    "LightingDevice.GreenComponent": &fieldSpec_LightingDevice_GreenComponent,
+    "LightingDevice.BlueComponent": &fieldSpec_LightingDevice_BlueComponent,
+    "LightingDevice.ColorTemperature": &fieldSpec_LightingDevice_ColorTemperature,
+    "LightingDevice.Intensity": &fieldSpec_LightingDevice_Intensity,
+    "LightingDevice.ProgramID": &fieldSpec_LightingDevice_ProgramID,
+    "LightingDevice.ProgramColorSequenceIndex": &fieldSpec_LightingDevice_ProgramColorSequenceIndex,
+    "LightingDevice.ProgramIntensity": &fieldSpec_LightingDevice_ProgramIntensity,
+    "LightingDevice.ProgramRate": &fieldSpec_LightingDevice_ProgramRate,
+    "LightingDevice.ProgramColorSequenceRate": &fieldSpec_LightingDevice_ProgramColorSequenceRate,
+    "LightingDeviceEnumeration.IndexOfFirstDevice": &fieldSpec_LightingDeviceEnumeration_IndexOfFirstDevice,
+    "LightingDeviceEnumeration.TotalNumberOfDevices": &fieldSpec_LightingDeviceEnumeration_TotalNumberOfDevices,
+    "LightingDeviceEnumeration.NumberOfDevices": &fieldSpec_LightingDeviceEnumeration_NumberOfDevices,
+    "LightingDeviceEnumeration.DeviceID": &fieldSpec_LightingDeviceEnumeration_DeviceID,
+    "LightingDeviceEnumeration.Status": &fieldSpec_LightingDeviceEnumeration_Status,
+    "LightingColorSequence.SequenceIndex": &fieldSpec_LightingColorSequence_SequenceIndex,
+    "LightingColorSequence.ColorCount": &fieldSpec_LightingColorSequence_ColorCount,
+    "LightingColorSequence.ColorIndex": &fieldSpec_LightingColorSequence_ColorIndex,
+    "LightingColorSequence.RedComponent": &fieldSpec_LightingColorSequence_RedComponent,
+    "LightingColorSequence.GreenComponent": &fieldSpec_LightingColorSequence_GreenComponent,
+    "LightingColorSequence.BlueComponent": &fieldSpec_LightingColorSequence_BlueComponent,
+    "LightingColorSequence.ColorTemperature": &fieldSpec_LightingColorSequence_ColorTemperature,
+    "LightingColorSequence.Intensity": &fieldSpec_LightingColorSequence_Intensity,
+    "LightingProgram.ProgramID": &fieldSpec_LightingProgram_ProgramID,
+    "LightingProgram.ProgramCapabilities": &fieldSpec_LightingProgram_ProgramCapabilities,
+    "WatermakerInputSettingAndStatus.Salinity": &fieldSpec_WatermakerInputSettingAndStatus_Salinity,
+    "WatermakerInputSettingAndStatus.ProductWaterTemperature": &fieldSpec_WatermakerInputSettingAndStatus_ProductWaterTemperature,
+    "WatermakerInputSettingAndStatus.PreFilterPressure": &fieldSpec_WatermakerInputSettingAndStatus_PreFilterPressure,
+    "WatermakerInputSettingAndStatus.PostFilterPressure": &fieldSpec_WatermakerInputSettingAndStatus_PostFilterPressure,
+    "WatermakerInputSettingAndStatus.FeedPressure": &fieldSpec_WatermakerInputSettingAndStatus_FeedPressure,
+    "WatermakerInputSettingAndStatus.SystemHighPressure": &fieldSpec_WatermakerInputSettingAndStatus_SystemHighPressure,
+    "WatermakerInputSettingAndStatus.ProductWaterFlow": &fieldSpec_WatermakerInputSettingAndStatus_ProductWaterFlow,
+    "WatermakerInputSettingAndStatus.BrineWaterFlow": &fieldSpec_WatermakerInputSettingAndStatus_BrineWaterFlow,
+    "WatermakerInputSettingAndStatus.RunTime": &fieldSpec_WatermakerInputSettingAndStatus_RunTime,
+    "EntertainmentDiagnosticStatus.Number": &fieldSpec_EntertainmentDiagnosticStatus_Number,
+    "EntertainmentDiagnosticStatus.DiagnosticMode": &fieldSpec_EntertainmentDiagnosticStatus_DiagnosticMode,
+    "CurrentStatusAndFile.Number": &fieldSpec_CurrentStatusAndFile_Number,
+    "CurrentStatusAndFile.ID": &fieldSpec_CurrentStatusAndFile_ID,
+    "CurrentStatusAndFile.ElapsedTrackTime": &fieldSpec_CurrentStatusAndFile_ElapsedTrackTime,
+    "CurrentStatusAndFile.TrackTime": &fieldSpec_CurrentStatusAndFile_TrackTime,
+    "CurrentStatusAndFile.SaveFavoriteNumber": &fieldSpec_CurrentStatusAndFile_SaveFavoriteNumber,
+    "CurrentStatusAndFile.PlayFavoriteNumber": &fieldSpec_CurrentStatusAndFile_PlayFavoriteNumber,
+    "CurrentStatusAndFile.SignalStrength": &fieldSpec_CurrentStatusAndFile_SignalStrength,
+    "CurrentStatusAndFile.RadioFrequency": &fieldSpec_CurrentStatusAndFile_RadioFrequency,
+    "CurrentStatusAndFile.HdFrequencyMulticast": &fieldSpec_CurrentStatusAndFile_HdFrequencyMulticast,
+    "CurrentStatusAndFile.DeleteFavoriteNumber": &fieldSpec_CurrentStatusAndFile_DeleteFavoriteNumber,
+    "CurrentStatusAndFile.TotalNumberOfTracks": &fieldSpec_CurrentStatusAndFile_TotalNumberOfTracks,
+    "LibraryDataFile.Number": &fieldSpec_LibraryDataFile_Number,
+    "LibraryDataFile.ID": &fieldSpec_LibraryDataFile_ID,
+    "LibraryDataFile.Track": &fieldSpec_LibraryDataFile_Track,
+    "LibraryDataFile.Station": &fieldSpec_LibraryDataFile_Station,
+    "LibraryDataFile.Favorite": &fieldSpec_LibraryDataFile_Favorite,
+    "LibraryDataFile.RadioFrequency": &fieldSpec_LibraryDataFile_RadioFrequency,
+    "LibraryDataFile.HdFrequency": &fieldSpec_LibraryDataFile_HdFrequency,
+    "LibraryDataGroup.Number": &fieldSpec_LibraryDataGroup_Number,
+    "LibraryDataGroup.GroupID": &fieldSpec_LibraryDataGroup_GroupID,
+    "LibraryDataGroup.IDOffset": &fieldSpec_LibraryDataGroup_IDOffset,
+    "LibraryDataGroup.IDCount": &fieldSpec_LibraryDataGroup_IDCount,
+    "LibraryDataGroup.TotalIDCount": &fieldSpec_LibraryDataGroup_TotalIDCount,
+    "LibraryDataGroup.ID": &fieldSpec_LibraryDataGroup_ID,
+    "LibraryDataSearch.Number": &fieldSpec_LibraryDataSearch_Number,
+    "LibraryDataSearch.GroupID": &fieldSpec_LibraryDataSearch_GroupID,
+    "SupportedSourceData.IDOffset": &fieldSpec_SupportedSourceData_IDOffset,
+    "SupportedSourceData.IDCount": &fieldSpec_SupportedSourceData_IDCount,
+    "SupportedSourceData.TotalIDCount": &fieldSpec_SupportedSourceData_TotalIDCount,
+    "SupportedSourceData.ID": &fieldSpec_SupportedSourceData_ID,
+    "SupportedSourceData.Number": &fieldSpec_SupportedSourceData_Number,
+    "SupportedZoneData.FirstZoneID": &fieldSpec_SupportedZoneData_FirstZoneID,
+    "SupportedZoneData.ZoneCount": &fieldSpec_SupportedZoneData_ZoneCount,
+    "SupportedZoneData.TotalZoneCount": &fieldSpec_SupportedZoneData_TotalZoneCount,
+    "EntertainmentParentalControlStatus.Number": &fieldSpec_EntertainmentParentalControlStatus_Number,
+    "EntertainmentParentalControlStatus.LockType": &fieldSpec_EntertainmentParentalControlStatus_LockType,
+    "EntertainmentParentalControlStatus.LockStatus": &fieldSpec_EntertainmentParentalControlStatus_LockStatus,
+    "EntertainmentParentalControlStatus.FileStationID": &fieldSpec_EntertainmentParentalControlStatus_FileStationID,
+    "SmallCraftStatus.PortTrimTab": &fieldSpec_SmallCraftStatus_PortTrimTab,
+    "SmallCraftStatus.StarboardTrimTab": &fieldSpec_SmallCraftStatus_StarboardTrimTab,
+    "DirectionData.SID": &fieldSpec_DirectionData_SID,
+    "DirectionData.COG": &fieldSpec_DirectionData_COG,
+    "DirectionData.SOG": &fieldSpec_DirectionData_SOG,
+    "DirectionData.Heading": &fieldSpec_DirectionData_Heading,
+    "DirectionData.SpeedThroughWater": &fieldSpec_DirectionData_SpeedThroughWater,
+    "DirectionData.Set": &fieldSpec_DirectionData_Set,
+    "DirectionData.Drift": &fieldSpec_DirectionData_Drift,
+    "VesselSpeedComponents.LongitudinalSpeedWaterReferenced": &fieldSpec_VesselSpeedComponents_LongitudinalSpeedWaterReferenced,
+    "VesselSpeedComponents.TransverseSpeedWaterReferenced": &fieldSpec_VesselSpeedComponents_TransverseSpeedWaterReferenced,
+    "VesselSpeedComponents.LongitudinalSpeedGroundReferenced": &fieldSpec_VesselSpeedComponents_LongitudinalSpeedGroundReferenced,
+    "VesselSpeedComponents.TransverseSpeedGroundReferenced": &fieldSpec_VesselSpeedComponents_TransverseSpeedGroundReferenced,
+    "VesselSpeedComponents.SternSpeedWaterReferenced": &fieldSpec_VesselSpeedComponents_SternSpeedWaterReferenced,
+    "VesselSpeedComponents.SternSpeedGroundReferenced": &fieldSpec_VesselSpeedComponents_SternSpeedGroundReferenced,
+    "SystemConfiguration.MaxFavorites": &fieldSpec_SystemConfiguration_MaxFavorites,
+    "SystemConfigurationDeprecated.MaxFavorites": &fieldSpec_SystemConfigurationDeprecated_MaxFavorites,
+    "ZoneConfigurationDeprecated.FirstZoneID": &fieldSpec_ZoneConfigurationDeprecated_FirstZoneID,
+    "ZoneConfigurationDeprecated.ZoneCount": &fieldSpec_ZoneConfigurationDeprecated_ZoneCount,
+    "ZoneConfigurationDeprecated.TotalZoneCount": &fieldSpec_ZoneConfigurationDeprecated_TotalZoneCount,
+    "ZoneVolume.Volume": &fieldSpec_ZoneVolume_Volume,
+    "AvailableAudioEQPresets.FirstPreset": &fieldSpec_AvailableAudioEQPresets_FirstPreset,
+    "AvailableAudioEQPresets.PresetCount": &fieldSpec_AvailableAudioEQPresets_PresetCount,
+    "AvailableAudioEQPresets.TotalPresetCount": &fieldSpec_AvailableAudioEQPresets_TotalPresetCount,
+    "AvailableBluetoothAddresses.FirstAddress": &fieldSpec_AvailableBluetoothAddresses_FirstAddress,
+    "AvailableBluetoothAddresses.AddressCount": &fieldSpec_AvailableBluetoothAddresses_AddressCount,
+    "AvailableBluetoothAddresses.TotalAddressCount": &fieldSpec_AvailableBluetoothAddresses_TotalAddressCount,
+    "AvailableBluetoothAddresses.SignalStrength": &fieldSpec_AvailableBluetoothAddresses_SignalStrength,
+    "BluetoothSourceStatus.SourceNumber": &fieldSpec_BluetoothSourceStatus_SourceNumber,
+    "ZoneConfiguration.VolumeLimit": &fieldSpec_ZoneConfiguration_VolumeLimit,
+    "ZoneConfiguration.Fade": &fieldSpec_ZoneConfiguration_Fade,
+    "ZoneConfiguration.Balance": &fieldSpec_ZoneConfiguration_Balance,
+    "ZoneConfiguration.SubVolume": &fieldSpec_ZoneConfiguration_SubVolume,
+    "ZoneConfiguration.EQTreble": &fieldSpec_ZoneConfiguration_EQTreble,
+    "ZoneConfiguration.EQMidRange": &fieldSpec_ZoneConfiguration_EQMidRange,
+    "ZoneConfiguration.EQBass": &fieldSpec_ZoneConfiguration_EQBass,
+    "ZoneConfiguration.HighPassFilterFrequency": &fieldSpec_ZoneConfiguration_HighPassFilterFrequency,
+    "ZoneConfiguration.LowPassFilterFrequency": &fieldSpec_ZoneConfiguration_LowPassFilterFrequency,
+    "SonichubInit2.ManufacturerCode": &fieldSpec_SonichubInit2_ManufacturerCode,
+    "SonichubInit2.IndustryCode": &fieldSpec_SonichubInit2_IndustryCode,
+    "SonichubInit2.ProprietaryID": &fieldSpec_SonichubInit2_ProprietaryID,
+    "SonichubInit2.A": &fieldSpec_SonichubInit2_A,
+    "SonichubInit2.B": &fieldSpec_SonichubInit2_B,
+    "SonichubAmRadio.ManufacturerCode": &fieldSpec_SonichubAmRadio_ManufacturerCode,
+    "SonichubAmRadio.IndustryCode": &fieldSpec_SonichubAmRadio_IndustryCode,
+    "SonichubAmRadio.ProprietaryID": &fieldSpec_SonichubAmRadio_ProprietaryID,
+    "SonichubAmRadio.Frequency": &fieldSpec_SonichubAmRadio_Frequency,
+    "SonichubAmRadio.NoiseLevel": &fieldSpec_SonichubAmRadio_NoiseLevel,
+    "SonichubAmRadio.SignalLevel": &fieldSpec_SonichubAmRadio_SignalLevel,
+    "SonichubZoneInfo.ManufacturerCode": &fieldSpec_SonichubZoneInfo_ManufacturerCode,
+    "SonichubZoneInfo.IndustryCode": &fieldSpec_SonichubZoneInfo_IndustryCode,
+    "SonichubZoneInfo.ProprietaryID": &fieldSpec_SonichubZoneInfo_ProprietaryID,
+    "SonichubZoneInfo.Zone": &fieldSpec_SonichubZoneInfo_Zone,
+    "SonichubSource.ManufacturerCode": &fieldSpec_SonichubSource_ManufacturerCode,
+    "SonichubSource.IndustryCode": &fieldSpec_SonichubSource_IndustryCode,
+    "SonichubSource.ProprietaryID": &fieldSpec_SonichubSource_ProprietaryID,
+    "SonichubSourceList.ManufacturerCode": &fieldSpec_SonichubSourceList_ManufacturerCode,
+    "SonichubSourceList.IndustryCode": &fieldSpec_SonichubSourceList_IndustryCode,
+    "SonichubSourceList.ProprietaryID": &fieldSpec_SonichubSourceList_ProprietaryID,
+    "SonichubSourceList.SourceID": &fieldSpec_SonichubSourceList_SourceID,
+    "SonichubSourceList.A": &fieldSpec_SonichubSourceList_A,
+    "SonichubControl.ManufacturerCode": &fieldSpec_SonichubControl_ManufacturerCode,
+    "SonichubControl.IndustryCode": &fieldSpec_SonichubControl_IndustryCode,
+    "SonichubControl.ProprietaryID": &fieldSpec_SonichubControl_ProprietaryID,
+    "SonichubFmRadio.ManufacturerCode": &fieldSpec_SonichubFmRadio_ManufacturerCode,
+    "SonichubFmRadio.IndustryCode": &fieldSpec_SonichubFmRadio_IndustryCode,
+    "SonichubFmRadio.ProprietaryID": &fieldSpec_SonichubFmRadio_ProprietaryID,
+    "SonichubFmRadio.Frequency": &fieldSpec_SonichubFmRadio_Frequency,
+    "SonichubFmRadio.NoiseLevel": &fieldSpec_SonichubFmRadio_NoiseLevel,
+    "SonichubFmRadio.SignalLevel": &fieldSpec_SonichubFmRadio_SignalLevel,
+    "SonichubPlaylist.ManufacturerCode": &fieldSpec_SonichubPlaylist_ManufacturerCode,
+    "SonichubPlaylist.IndustryCode": &fieldSpec_SonichubPlaylist_IndustryCode,
+    "SonichubPlaylist.ProprietaryID": &fieldSpec_SonichubPlaylist_ProprietaryID,
+    "SonichubPlaylist.A": &fieldSpec_SonichubPlaylist_A,
+    "SonichubPlaylist.CurrentTrack": &fieldSpec_SonichubPlaylist_CurrentTrack,
+    "SonichubPlaylist.Tracks": &fieldSpec_SonichubPlaylist_Tracks,
+    "SonichubPlaylist.Length": &fieldSpec_SonichubPlaylist_Length,
+    "SonichubPlaylist.PositionInTrack": &fieldSpec_SonichubPlaylist_PositionInTrack,
+    "SonichubTrack.ManufacturerCode": &fieldSpec_SonichubTrack_ManufacturerCode,
+    "SonichubTrack.IndustryCode": &fieldSpec_SonichubTrack_IndustryCode,
+    "SonichubTrack.ProprietaryID": &fieldSpec_SonichubTrack_ProprietaryID,
+    "SonichubTrack.Item": &fieldSpec_SonichubTrack_Item,
+    "SonichubArtist.ManufacturerCode": &fieldSpec_SonichubArtist_ManufacturerCode,
+    "SonichubArtist.IndustryCode": &fieldSpec_SonichubArtist_IndustryCode,
+    "SonichubArtist.ProprietaryID": &fieldSpec_SonichubArtist_ProprietaryID,
+    "SonichubArtist.Item": &fieldSpec_SonichubArtist_Item,
+    "SonichubAlbum.ManufacturerCode": &fieldSpec_SonichubAlbum_ManufacturerCode,
+    "SonichubAlbum.IndustryCode": &fieldSpec_SonichubAlbum_IndustryCode,
+    "SonichubAlbum.ProprietaryID": &fieldSpec_SonichubAlbum_ProprietaryID,
+    "SonichubAlbum.Item": &fieldSpec_SonichubAlbum_Item,
+    "SonichubMenuItem.ManufacturerCode": &fieldSpec_SonichubMenuItem_ManufacturerCode,
+    "SonichubMenuItem.IndustryCode": &fieldSpec_SonichubMenuItem_IndustryCode,
+    "SonichubMenuItem.ProprietaryID": &fieldSpec_SonichubMenuItem_ProprietaryID,
+    "SonichubMenuItem.Item": &fieldSpec_SonichubMenuItem_Item,
+    "SonichubMenuItem.C": &fieldSpec_SonichubMenuItem_C,
+    "SonichubMenuItem.D": &fieldSpec_SonichubMenuItem_D,
+    "SonichubMenuItem.E": &fieldSpec_SonichubMenuItem_E,
+    "SonichubZones.ManufacturerCode": &fieldSpec_SonichubZones_ManufacturerCode,
+    "SonichubZones.IndustryCode": &fieldSpec_SonichubZones_IndustryCode,
+    "SonichubZones.ProprietaryID": &fieldSpec_SonichubZones_ProprietaryID,
+    "SonichubZones.Zones": &fieldSpec_SonichubZones_Zones,
+    "SonichubMaxVolume.ManufacturerCode": &fieldSpec_SonichubMaxVolume_ManufacturerCode,
+    "SonichubMaxVolume.IndustryCode": &fieldSpec_SonichubMaxVolume_IndustryCode,
+    "SonichubMaxVolume.ProprietaryID": &fieldSpec_SonichubMaxVolume_ProprietaryID,
+    "SonichubMaxVolume.Zone": &fieldSpec_SonichubMaxVolume_Zone,
+    "SonichubMaxVolume.Level": &fieldSpec_SonichubMaxVolume_Level,
+    "SonichubVolume.ManufacturerCode": &fieldSpec_SonichubVolume_ManufacturerCode,
+    "SonichubVolume.IndustryCode": &fieldSpec_SonichubVolume_IndustryCode,
+    "SonichubVolume.ProprietaryID": &fieldSpec_SonichubVolume_ProprietaryID,
+    "SonichubVolume.Zone": &fieldSpec_SonichubVolume_Zone,
+    "SonichubVolume.Level": &fieldSpec_SonichubVolume_Level,
+    "SonichubInit1.ManufacturerCode": &fieldSpec_SonichubInit1_ManufacturerCode,
+    "SonichubInit1.IndustryCode": &fieldSpec_SonichubInit1_IndustryCode,
+    "SonichubInit1.ProprietaryID": &fieldSpec_SonichubInit1_ProprietaryID,
+    "SonichubPosition.ManufacturerCode": &fieldSpec_SonichubPosition_ManufacturerCode,
+    "SonichubPosition.IndustryCode": &fieldSpec_SonichubPosition_IndustryCode,
+    "SonichubPosition.ProprietaryID": &fieldSpec_SonichubPosition_ProprietaryID,
+    "SonichubPosition.Position": &fieldSpec_SonichubPosition_Position,
+    "SonichubInit3.ManufacturerCode": &fieldSpec_SonichubInit3_ManufacturerCode,
+    "SonichubInit3.IndustryCode": &fieldSpec_SonichubInit3_IndustryCode,
+    "SonichubInit3.ProprietaryID": &fieldSpec_SonichubInit3_ProprietaryID,
+    "SonichubInit3.A": &fieldSpec_SonichubInit3_A,
+    "SonichubInit3.B": &fieldSpec_SonichubInit3_B,
+    "FurunoStatusAndVersionReport.ManufacturerCode": &fieldSpec_FurunoStatusAndVersionReport_ManufacturerCode,
+    "FurunoStatusAndVersionReport.IndustryCode": &fieldSpec_FurunoStatusAndVersionReport_IndustryCode,
+    "FurunoStatusAndVersionReport.ProprietaryID": &fieldSpec_FurunoStatusAndVersionReport_ProprietaryID,
+    "FurunoStatusAndVersionReport.A": &fieldSpec_FurunoStatusAndVersionReport_A,
+    "SimradTextMessage.ManufacturerCode": &fieldSpec_SimradTextMessage_ManufacturerCode,
+    "SimradTextMessage.IndustryCode": &fieldSpec_SimradTextMessage_IndustryCode,
+    "SimradTextMessage.ProprietaryID": &fieldSpec_SimradTextMessage_ProprietaryID,
+    "SimradTextMessage.A": &fieldSpec_SimradTextMessage_A,
+    "SimradTextMessage.B": &fieldSpec_SimradTextMessage_B,
+    "SimradTextMessage.C": &fieldSpec_SimradTextMessage_C,
+    "SimradTextMessage.SID": &fieldSpec_SimradTextMessage_SID,
+    "SimradTextMessage.Prio": &fieldSpec_SimradTextMessage_Prio,
+    "BepMarineCzoneZcfBusDistribution.ManufacturerCode": &fieldSpec_BepMarineCzoneZcfBusDistribution_ManufacturerCode,
+    "BepMarineCzoneZcfBusDistribution.IndustryCode": &fieldSpec_BepMarineCzoneZcfBusDistribution_IndustryCode,
+    "BepMarineCzoneZcfBusDistribution.ChunkIndex": &fieldSpec_BepMarineCzoneZcfBusDistribution_ChunkIndex,
+    "BepMarineCzoneZcfBusDistribution.Flag": &fieldSpec_BepMarineCzoneZcfBusDistribution_Flag,
+    "HondaEngineStatus.ManufacturerCode": &fieldSpec_HondaEngineStatus_ManufacturerCode,
+    "HondaEngineStatus.IndustryCode": &fieldSpec_HondaEngineStatus_IndustryCode,
+    "SeaRecoveryWatermakerStatus.ManufacturerCode": &fieldSpec_SeaRecoveryWatermakerStatus_ManufacturerCode,
+    "SeaRecoveryWatermakerStatus.IndustryCode": &fieldSpec_SeaRecoveryWatermakerStatus_IndustryCode,
+    "SeaRecoveryWatermakerStatus.MessageID": &fieldSpec_SeaRecoveryWatermakerStatus_MessageID,
+    "SeaRecoveryWatermakerStatus.WatermakerInstance": &fieldSpec_SeaRecoveryWatermakerStatus_WatermakerInstance,
+    "SeaRecoveryWatermakerStatus.OperationState": &fieldSpec_SeaRecoveryWatermakerStatus_OperationState,
+    "SeaRecoveryWatermakerStatus.PrefilterPressure": &fieldSpec_SeaRecoveryWatermakerStatus_PrefilterPressure,
+    "SeaRecoveryWatermakerStatus.PostfilterPressure": &fieldSpec_SeaRecoveryWatermakerStatus_PostfilterPressure,
+    "SeaRecoveryWatermakerStatus.OperationPressure": &fieldSpec_SeaRecoveryWatermakerStatus_OperationPressure,
+    "SeaRecoveryWatermakerStatus.ProductFlow": &fieldSpec_SeaRecoveryWatermakerStatus_ProductFlow,
+    "SeaRecoveryWatermakerStatus.PrefilterPressureStatus": &fieldSpec_SeaRecoveryWatermakerStatus_PrefilterPressureStatus,
+    "SeaRecoveryWatermakerStatus.PostfilterPressureStatus": &fieldSpec_SeaRecoveryWatermakerStatus_PostfilterPressureStatus,
+    "SeaRecoveryWatermakerStatus.OperationPressureStatus": &fieldSpec_SeaRecoveryWatermakerStatus_OperationPressureStatus,
+    "SeaRecoveryWatermakerStatus.ProductSalinityStatus": &fieldSpec_SeaRecoveryWatermakerStatus_ProductSalinityStatus,
+    "SeaRecoveryWatermakerStatus.FilterStatus": &fieldSpec_SeaRecoveryWatermakerStatus_FilterStatus,
+    "SeaRecoveryWatermakerStatus.SystemStatus": &fieldSpec_SeaRecoveryWatermakerStatus_SystemStatus,
+    "NavicoFeatureUnlock.ManufacturerCode": &fieldSpec_NavicoFeatureUnlock_ManufacturerCode,
+    "NavicoFeatureUnlock.IndustryCode": &fieldSpec_NavicoFeatureUnlock_IndustryCode,
+    "NavicoFeatureUnlock.FeatureID": &fieldSpec_NavicoFeatureUnlock_FeatureID,
+    "NavicoFeatureUnlock.RecordCount": &fieldSpec_NavicoFeatureUnlock_RecordCount,
+    "NavicoFeatureUnlock.Data": &fieldSpec_NavicoFeatureUnlock_Data,
+    "LowranceProductInformation.ManufacturerCode": &fieldSpec_LowranceProductInformation_ManufacturerCode,
+    "LowranceProductInformation.IndustryCode": &fieldSpec_LowranceProductInformation_IndustryCode,
+    "LowranceProductInformation.ProductCode": &fieldSpec_LowranceProductInformation_ProductCode,
+    "LowranceProductInformation.A": &fieldSpec_LowranceProductInformation_A,
+    "LowranceProductInformation.B": &fieldSpec_LowranceProductInformation_B,
+    "LowranceProductInformation.C": &fieldSpec_LowranceProductInformation_C,
+    "FurunoSvControl.ManufacturerCode": &fieldSpec_FurunoSvControl_ManufacturerCode,
+    "FurunoSvControl.IndustryCode": &fieldSpec_FurunoSvControl_IndustryCode,
+    "MaretronAnnunciatorCapabilities.ManufacturerCode": &fieldSpec_MaretronAnnunciatorCapabilities_ManufacturerCode,
+    "MaretronAnnunciatorCapabilities.IndustryCode": &fieldSpec_MaretronAnnunciatorCapabilities_IndustryCode,
+    "MaretronAnnunciatorCapabilities.AnnunciatorInstance": &fieldSpec_MaretronAnnunciatorCapabilities_AnnunciatorInstance,
+    "MaretronAnnunciatorCapabilities.NumberOfTones": &fieldSpec_MaretronAnnunciatorCapabilities_NumberOfTones,
+    "MaretronAnnunciatorCapabilities.Tone": &fieldSpec_MaretronAnnunciatorCapabilities_Tone,
+    "BepMarineCzoneStatusExtended.ManufacturerCode": &fieldSpec_BepMarineCzoneStatusExtended_ManufacturerCode,
+    "BepMarineCzoneStatusExtended.IndustryCode": &fieldSpec_BepMarineCzoneStatusExtended_IndustryCode,
+    "BepMarineCzoneStatusExtended.Page": &fieldSpec_BepMarineCzoneStatusExtended_Page,
+    "BepMarineCzoneStatusExtended.Dipswitch": &fieldSpec_BepMarineCzoneStatusExtended_Dipswitch,
+    "SimnetReprogramData.ManufacturerCode": &fieldSpec_SimnetReprogramData_ManufacturerCode,
+    "SimnetReprogramData.IndustryCode": &fieldSpec_SimnetReprogramData_IndustryCode,
+    "SimnetReprogramData.Version": &fieldSpec_SimnetReprogramData_Version,
+    "SimnetReprogramData.Sequence": &fieldSpec_SimnetReprogramData_Sequence,
+    "FurunoSensorSetup.ManufacturerCode": &fieldSpec_FurunoSensorSetup_ManufacturerCode,
+    "FurunoSensorSetup.IndustryCode": &fieldSpec_FurunoSensorSetup_IndustryCode,
+    "FurunoSensorSetup.RotationSmoothing": &fieldSpec_FurunoSensorSetup_RotationSmoothing,
+    "FurunoSensorSetup.HeadingOffset": &fieldSpec_FurunoSensorSetup_HeadingOffset,
+    "FurunoSensorSetup.PitchOffset": &fieldSpec_FurunoSensorSetup_PitchOffset,
+    "FurunoSensorSetup.RollOffset": &fieldSpec_FurunoSensorSetup_RollOffset,
+    "FurunoSensorSetup.F8": &fieldSpec_FurunoSensorSetup_F8,
+    "FurunoSensorSetup.F9": &fieldSpec_FurunoSensorSetup_F9,
+    "FurunoSensorSetup.SOGAndCOGSmoothing": &fieldSpec_FurunoSensorSetup_SOGAndCOGSmoothing,
+    "FurunoSensorSetup.ThreeAxisSpeedSmoothing": &fieldSpec_FurunoSensorSetup_ThreeAxisSpeedSmoothing,
+    "FurunoSensorSetup.Fc": &fieldSpec_FurunoSensorSetup_Fc,
+    "FurunoSensorSetup.ThreeAxisOffset": &fieldSpec_FurunoSensorSetup_ThreeAxisOffset,
+    "FurunoSensorSetup.AirPressureOffset": &fieldSpec_FurunoSensorSetup_AirPressureOffset,
+    "FurunoSensorSetup.AirTemperatureOffset": &fieldSpec_FurunoSensorSetup_AirTemperatureOffset,
+    "MaretronLabel.ManufacturerCode": &fieldSpec_MaretronLabel_ManufacturerCode,
+    "MaretronLabel.IndustryCode": &fieldSpec_MaretronLabel_IndustryCode,
+    "MaretronLabel.Instance": &fieldSpec_MaretronLabel_Instance,
+    "MaretronLabel.DataSource": &fieldSpec_MaretronLabel_DataSource,
+    "MaretronLabel.DataIndicator": &fieldSpec_MaretronLabel_DataIndicator,
+    "MaretronLabel.HardwareChannel": &fieldSpec_MaretronLabel_HardwareChannel,
+    "BepMarineProprietaryPGN130818.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN130818_ManufacturerCode,
+    "BepMarineProprietaryPGN130818.IndustryCode": &fieldSpec_BepMarineProprietaryPGN130818_IndustryCode,
+    "WebastoStatus2.ManufacturerCode": &fieldSpec_WebastoStatus2_ManufacturerCode,
+    "WebastoStatus2.IndustryCode": &fieldSpec_WebastoStatus2_IndustryCode,
+    "WebastoStatus2.CANAddress": &fieldSpec_WebastoStatus2_CANAddress,
+    "WebastoStatus2.DeviceID": &fieldSpec_WebastoStatus2_DeviceID,
+    "WebastoStatus2.SystemError": &fieldSpec_WebastoStatus2_SystemError,
+    "WebastoStatus2.SystemStatus": &fieldSpec_WebastoStatus2_SystemStatus,
+    "WebastoStatus2.FreshAirBlowerSpeed": &fieldSpec_WebastoStatus2_FreshAirBlowerSpeed,
+    "WebastoStatus2.VSeriesOutput": &fieldSpec_WebastoStatus2_VSeriesOutput,
+    "SimnetRequestReprogram.ManufacturerCode": &fieldSpec_SimnetRequestReprogram_ManufacturerCode,
+    "SimnetRequestReprogram.IndustryCode": &fieldSpec_SimnetRequestReprogram_IndustryCode,
+    "MaretronAlertTransmission.ManufacturerCode": &fieldSpec_MaretronAlertTransmission_ManufacturerCode,
+    "MaretronAlertTransmission.IndustryCode": &fieldSpec_MaretronAlertTransmission_IndustryCode,
+    "MaretronAlertTransmission.AlertSystem": &fieldSpec_MaretronAlertTransmission_AlertSystem,
+    "MaretronAlertTransmission.AlertSubSystem": &fieldSpec_MaretronAlertTransmission_AlertSubSystem,
+    "MaretronAlertTransmission.AlertID": &fieldSpec_MaretronAlertTransmission_AlertID,
+    "MaretronAlertTransmission.DataSourceNetworkIDName": &fieldSpec_MaretronAlertTransmission_DataSourceNetworkIDName,
+    "MaretronAlertTransmission.DataSourceInstance": &fieldSpec_MaretronAlertTransmission_DataSourceInstance,
+    "MaretronAlertTransmission.DataSourceIndexSource": &fieldSpec_MaretronAlertTransmission_DataSourceIndexSource,
+    "MaretronAlertTransmission.AlertOccurrenceNumber": &fieldSpec_MaretronAlertTransmission_AlertOccurrenceNumber,
+    "WebastoHvacCommand.ManufacturerCode": &fieldSpec_WebastoHvacCommand_ManufacturerCode,
+    "WebastoHvacCommand.IndustryCode": &fieldSpec_WebastoHvacCommand_IndustryCode,
+    "WebastoHvacCommand.CANAddress": &fieldSpec_WebastoHvacCommand_CANAddress,
+    "WebastoHvacCommand.BlowerSpeed": &fieldSpec_WebastoHvacCommand_BlowerSpeed,
+    "WebastoHvacCommand.SetTemperature": &fieldSpec_WebastoHvacCommand_SetTemperature,
+    "WebastoHvacCommand.EcoMode": &fieldSpec_WebastoHvacCommand_EcoMode,
+    "WebastoHvacCommand.FunctionalMode": &fieldSpec_WebastoHvacCommand_FunctionalMode,
+    "WebastoHvacCommand.Compressor": &fieldSpec_WebastoHvacCommand_Compressor,
+    "WebastoHvacCommand.Mask": &fieldSpec_WebastoHvacCommand_Mask,
+    "FurunoDeadReckoningConfiguration.ManufacturerCode": &fieldSpec_FurunoDeadReckoningConfiguration_ManufacturerCode,
+    "FurunoDeadReckoningConfiguration.IndustryCode": &fieldSpec_FurunoDeadReckoningConfiguration_IndustryCode,
+    "FurunoDeadReckoningConfiguration.F4": &fieldSpec_FurunoDeadReckoningConfiguration_F4,
+    "FurunoDeadReckoningConfiguration.F5": &fieldSpec_FurunoDeadReckoningConfiguration_F5,
+    "FurunoDeadReckoningConfiguration.F6": &fieldSpec_FurunoDeadReckoningConfiguration_F6,
+    "FurunoDeadReckoningConfiguration.F7": &fieldSpec_FurunoDeadReckoningConfiguration_F7,
+    "FurunoDeadReckoningConfiguration.F8": &fieldSpec_FurunoDeadReckoningConfiguration_F8,
+    "FurunoDeadReckoningConfiguration.DeadReckoningTime": &fieldSpec_FurunoDeadReckoningConfiguration_DeadReckoningTime,
+    "FurunoDeadReckoningConfiguration.F10": &fieldSpec_FurunoDeadReckoningConfiguration_F10,
+    "FurunoDeadReckoningConfiguration.F11": &fieldSpec_FurunoDeadReckoningConfiguration_F11,
+    "BepMarineCzone130819.ManufacturerCode": &fieldSpec_BepMarineCzone130819_ManufacturerCode,
+    "BepMarineCzone130819.IndustryCode": &fieldSpec_BepMarineCzone130819_IndustryCode,
+    "BepMarineCzone130819.FieldA": &fieldSpec_BepMarineCzone130819_FieldA,
+    "BepMarineCzone130819.FieldB": &fieldSpec_BepMarineCzone130819_FieldB,
+    "BepMarineCzone130819.FieldC": &fieldSpec_BepMarineCzone130819_FieldC,
+    "BepMarineCzone130819.FieldD": &fieldSpec_BepMarineCzone130819_FieldD,
+    "BepMarineCzone130819.FieldE": &fieldSpec_BepMarineCzone130819_FieldE,
+    "BepMarineCzone130819.FieldF": &fieldSpec_BepMarineCzone130819_FieldF,
+    "BepMarineCzone130819.FieldG": &fieldSpec_BepMarineCzone130819_FieldG,
+    "BepMarineCzone130819.Flag": &fieldSpec_BepMarineCzone130819_Flag,
+    "BepMarineCzoneAlarmStringResponse.ManufacturerCode": &fieldSpec_BepMarineCzoneAlarmStringResponse_ManufacturerCode,
+    "BepMarineCzoneAlarmStringResponse.IndustryCode": &fieldSpec_BepMarineCzoneAlarmStringResponse_IndustryCode,
+    "BepMarineCzoneAlarmStringResponse.DeviceID": &fieldSpec_BepMarineCzoneAlarmStringResponse_DeviceID,
+    "BepMarineCzoneAlarmStringResponse.Channel": &fieldSpec_BepMarineCzoneAlarmStringResponse_Channel,
+    "SimnetReprogramStatus.ManufacturerCode": &fieldSpec_SimnetReprogramStatus_ManufacturerCode,
+    "SimnetReprogramStatus.IndustryCode": &fieldSpec_SimnetReprogramStatus_IndustryCode,
+    "SimnetReprogramStatus.Status": &fieldSpec_SimnetReprogramStatus_Status,
+    "FurunoUnknown130820.ManufacturerCode": &fieldSpec_FurunoUnknown130820_ManufacturerCode,
+    "FurunoUnknown130820.IndustryCode": &fieldSpec_FurunoUnknown130820_IndustryCode,
+    "FurunoUnknown130820.A": &fieldSpec_FurunoUnknown130820_A,
+    "FurunoUnknown130820.B": &fieldSpec_FurunoUnknown130820_B,
+    "FurunoUnknown130820.C": &fieldSpec_FurunoUnknown130820_C,
+    "FurunoUnknown130820.D": &fieldSpec_FurunoUnknown130820_D,
+    "FurunoUnknown130820.E": &fieldSpec_FurunoUnknown130820_E,
+    "FusionVersions.ManufacturerCode": &fieldSpec_FusionVersions_ManufacturerCode,
+    "FusionVersions.IndustryCode": &fieldSpec_FusionVersions_IndustryCode,
+    "FusionVersions.MessageID": &fieldSpec_FusionVersions_MessageID,
+    "FusionVersions.HwVersionMajor": &fieldSpec_FusionVersions_HwVersionMajor,
+    "FusionVersions.HwVersionMinor": &fieldSpec_FusionVersions_HwVersionMinor,
+    "FusionVersions.SwVersionMajor": &fieldSpec_FusionVersions_SwVersionMajor,
+    "FusionVersions.SwVersionMinor": &fieldSpec_FusionVersions_SwVersionMinor,
+    "FusionVersions.BuildNumber": &fieldSpec_FusionVersions_BuildNumber,
+    "FusionSource.ManufacturerCode": &fieldSpec_FusionSource_ManufacturerCode,
+    "FusionSource.IndustryCode": &fieldSpec_FusionSource_IndustryCode,
+    "FusionSource.MessageID": &fieldSpec_FusionSource_MessageID,
+    "FusionSource.SourceID": &fieldSpec_FusionSource_SourceID,
+    "FusionSource.CurrentSourceID": &fieldSpec_FusionSource_CurrentSourceID,
+    "FusionSource.Flags": &fieldSpec_FusionSource_Flags,
+    "FusionSourceCount.ManufacturerCode": &fieldSpec_FusionSourceCount_ManufacturerCode,
+    "FusionSourceCount.IndustryCode": &fieldSpec_FusionSourceCount_IndustryCode,
+    "FusionSourceCount.MessageID": &fieldSpec_FusionSourceCount_MessageID,
+    "FusionSourceCount.SourceCount": &fieldSpec_FusionSourceCount_SourceCount,
+    "FusionMedia.ManufacturerCode": &fieldSpec_FusionMedia_ManufacturerCode,
+    "FusionMedia.IndustryCode": &fieldSpec_FusionMedia_IndustryCode,
+    "FusionMedia.MessageID": &fieldSpec_FusionMedia_MessageID,
+    "FusionMedia.SourceID": &fieldSpec_FusionMedia_SourceID,
+    "FusionMedia.Track": &fieldSpec_FusionMedia_Track,
+    "FusionMedia.TrackCount": &fieldSpec_FusionMedia_TrackCount,
+    "FusionMedia.Length": &fieldSpec_FusionMedia_Length,
+    "FusionMedia.PositionInTrack": &fieldSpec_FusionMedia_PositionInTrack,
+    "FusionTrackName.ManufacturerCode": &fieldSpec_FusionTrackName_ManufacturerCode,
+    "FusionTrackName.IndustryCode": &fieldSpec_FusionTrackName_IndustryCode,
+    "FusionTrackName.MessageID": &fieldSpec_FusionTrackName_MessageID,
+    "FusionTrackName.SourceID": &fieldSpec_FusionTrackName_SourceID,
+    "FusionTrackName.Index": &fieldSpec_FusionTrackName_Index,
+    "FusionArtistName.ManufacturerCode": &fieldSpec_FusionArtistName_ManufacturerCode,
+    "FusionArtistName.IndustryCode": &fieldSpec_FusionArtistName_IndustryCode,
+    "FusionArtistName.MessageID": &fieldSpec_FusionArtistName_MessageID,
+    "FusionArtistName.SourceID": &fieldSpec_FusionArtistName_SourceID,
+    "FusionArtistName.Index": &fieldSpec_FusionArtistName_Index,
+    "FusionAlbumName.ManufacturerCode": &fieldSpec_FusionAlbumName_ManufacturerCode,
+    "FusionAlbumName.IndustryCode": &fieldSpec_FusionAlbumName_IndustryCode,
+    "FusionAlbumName.MessageID": &fieldSpec_FusionAlbumName_MessageID,
+    "FusionAlbumName.SourceID": &fieldSpec_FusionAlbumName_SourceID,
+    "FusionAlbumName.Index": &fieldSpec_FusionAlbumName_Index,
+    "FusionDeviceName.ManufacturerCode": &fieldSpec_FusionDeviceName_ManufacturerCode,
+    "FusionDeviceName.IndustryCode": &fieldSpec_FusionDeviceName_IndustryCode,
+    "FusionDeviceName.MessageID": &fieldSpec_FusionDeviceName_MessageID,
+    "FusionZoneName.ManufacturerCode": &fieldSpec_FusionZoneName_ManufacturerCode,
+    "FusionZoneName.IndustryCode": &fieldSpec_FusionZoneName_IndustryCode,
+    "FusionZoneName.MessageID": &fieldSpec_FusionZoneName_MessageID,
+    "FusionZoneName.Number": &fieldSpec_FusionZoneName_Number,
+    "FusionSpeedVolumeCurrentSpeed.ManufacturerCode": &fieldSpec_FusionSpeedVolumeCurrentSpeed_ManufacturerCode,
+    "FusionSpeedVolumeCurrentSpeed.IndustryCode": &fieldSpec_FusionSpeedVolumeCurrentSpeed_IndustryCode,
+    "FusionSpeedVolumeCurrentSpeed.MessageID": &fieldSpec_FusionSpeedVolumeCurrentSpeed_MessageID,
+    "FusionSpeedVolumeCurrentSpeed.SourceID": &fieldSpec_FusionSpeedVolumeCurrentSpeed_SourceID,
+    "FusionSpeedVolumeCurrentSpeed.Speed": &fieldSpec_FusionSpeedVolumeCurrentSpeed_Speed,
+    "FusionSpeedVolumeCurrentSpeed.Enabled": &fieldSpec_FusionSpeedVolumeCurrentSpeed_Enabled,
+    "FusionIgnitionSwitchState.ManufacturerCode": &fieldSpec_FusionIgnitionSwitchState_ManufacturerCode,
+    "FusionIgnitionSwitchState.IndustryCode": &fieldSpec_FusionIgnitionSwitchState_IndustryCode,
+    "FusionIgnitionSwitchState.MessageID": &fieldSpec_FusionIgnitionSwitchState_MessageID,
+    "FusionIgnitionSwitchState.State": &fieldSpec_FusionIgnitionSwitchState_State,
+    "FusionMenuLockID.ManufacturerCode": &fieldSpec_FusionMenuLockID_ManufacturerCode,
+    "FusionMenuLockID.IndustryCode": &fieldSpec_FusionMenuLockID_IndustryCode,
+    "FusionMenuLockID.MessageID": &fieldSpec_FusionMenuLockID_MessageID,
+    "FusionMenuLockID.LockID": &fieldSpec_FusionMenuLockID_LockID,
+    "FusionMenuLockID.Flags": &fieldSpec_FusionMenuLockID_Flags,
+    "FusionRDSData.ManufacturerCode": &fieldSpec_FusionRDSData_ManufacturerCode,
+    "FusionRDSData.IndustryCode": &fieldSpec_FusionRDSData_IndustryCode,
+    "FusionRDSData.MessageID": &fieldSpec_FusionRDSData_MessageID,
+    "FusionRDSData.SourceID": &fieldSpec_FusionRDSData_SourceID,
+    "FusionRDSData.RDSType": &fieldSpec_FusionRDSData_RDSType,
+    "FusionRDSData.ProgrammeType": &fieldSpec_FusionRDSData_ProgrammeType,
+    "FusionMultiroom.ManufacturerCode": &fieldSpec_FusionMultiroom_ManufacturerCode,
+    "FusionMultiroom.IndustryCode": &fieldSpec_FusionMultiroom_IndustryCode,
+    "FusionMultiroom.MessageID": &fieldSpec_FusionMultiroom_MessageID,
+    "FusionMultiroom.IpAddress1": &fieldSpec_FusionMultiroom_IpAddress1,
+    "FusionMultiroom.IpAddress2": &fieldSpec_FusionMultiroom_IpAddress2,
+    "FusionMultiroom.IpAddress3": &fieldSpec_FusionMultiroom_IpAddress3,
+    "FusionMultiroom.IpAddress4": &fieldSpec_FusionMultiroom_IpAddress4,
+    "FusionMultiroomStatus.ManufacturerCode": &fieldSpec_FusionMultiroomStatus_ManufacturerCode,
+    "FusionMultiroomStatus.IndustryCode": &fieldSpec_FusionMultiroomStatus_IndustryCode,
+    "FusionMultiroomStatus.MessageID": &fieldSpec_FusionMultiroomStatus_MessageID,
+    "FusionProcessingBypass.ManufacturerCode": &fieldSpec_FusionProcessingBypass_ManufacturerCode,
+    "FusionProcessingBypass.IndustryCode": &fieldSpec_FusionProcessingBypass_IndustryCode,
+    "FusionProcessingBypass.MessageID": &fieldSpec_FusionProcessingBypass_MessageID,
+    "FusionMono.ManufacturerCode": &fieldSpec_FusionMono_ManufacturerCode,
+    "FusionMono.IndustryCode": &fieldSpec_FusionMono_IndustryCode,
+    "FusionMono.MessageID": &fieldSpec_FusionMono_MessageID,
+    "FusionMono.Zone": &fieldSpec_FusionMono_Zone,
+    "FusionTrackPosition.ManufacturerCode": &fieldSpec_FusionTrackPosition_ManufacturerCode,
+    "FusionTrackPosition.IndustryCode": &fieldSpec_FusionTrackPosition_IndustryCode,
+    "FusionTrackPosition.MessageID": &fieldSpec_FusionTrackPosition_MessageID,
+    "FusionTrackPosition.SourceID": &fieldSpec_FusionTrackPosition_SourceID,
+    "FusionTrackPosition.Progress": &fieldSpec_FusionTrackPosition_Progress,
+    "FusionTuner.ManufacturerCode": &fieldSpec_FusionTuner_ManufacturerCode,
+    "FusionTuner.IndustryCode": &fieldSpec_FusionTuner_IndustryCode,
+    "FusionTuner.MessageID": &fieldSpec_FusionTuner_MessageID,
+    "FusionTuner.Scanning": &fieldSpec_FusionTuner_Scanning,
+    "FusionTuner.Frequency": &fieldSpec_FusionTuner_Frequency,
+    "FusionTuner.SignalStrength": &fieldSpec_FusionTuner_SignalStrength,
+    "FusionMarineTuner.ManufacturerCode": &fieldSpec_FusionMarineTuner_ManufacturerCode,
+    "FusionMarineTuner.IndustryCode": &fieldSpec_FusionMarineTuner_IndustryCode,
+    "FusionMarineTuner.MessageID": &fieldSpec_FusionMarineTuner_MessageID,
+    "FusionMarineTuner.SourceID": &fieldSpec_FusionMarineTuner_SourceID,
+    "FusionMarineTuner.Channel": &fieldSpec_FusionMarineTuner_Channel,
+    "FusionMarineTuner.SignalStrength": &fieldSpec_FusionMarineTuner_SignalStrength,
+    "FusionMarineSquelch.ManufacturerCode": &fieldSpec_FusionMarineSquelch_ManufacturerCode,
+    "FusionMarineSquelch.IndustryCode": &fieldSpec_FusionMarineSquelch_IndustryCode,
+    "FusionMarineSquelch.MessageID": &fieldSpec_FusionMarineSquelch_MessageID,
+    "FusionMarineSquelch.SourceID": &fieldSpec_FusionMarineSquelch_SourceID,
+    "FusionMarineSquelch.Squelch": &fieldSpec_FusionMarineSquelch_Squelch,
+    "FusionMarineScanMode.ManufacturerCode": &fieldSpec_FusionMarineScanMode_ManufacturerCode,
+    "FusionMarineScanMode.IndustryCode": &fieldSpec_FusionMarineScanMode_IndustryCode,
+    "FusionMarineScanMode.MessageID": &fieldSpec_FusionMarineScanMode_MessageID,
+    "FusionMarineScanMode.SourceID": &fieldSpec_FusionMarineScanMode_SourceID,
+    "FusionMenuItem.ManufacturerCode": &fieldSpec_FusionMenuItem_ManufacturerCode,
+    "FusionMenuItem.IndustryCode": &fieldSpec_FusionMenuItem_IndustryCode,
+    "FusionMenuItem.MessageID": &fieldSpec_FusionMenuItem_MessageID,
+    "FusionMenuItem.SourceID": &fieldSpec_FusionMenuItem_SourceID,
+    "FusionMenuItem.ItemIndex": &fieldSpec_FusionMenuItem_ItemIndex,
+    "FusionMenuItem.Flags": &fieldSpec_FusionMenuItem_Flags,
+    "FusionMenuItem.LockID": &fieldSpec_FusionMenuItem_LockID,
+    "FusionAuxGain.ManufacturerCode": &fieldSpec_FusionAuxGain_ManufacturerCode,
+    "FusionAuxGain.IndustryCode": &fieldSpec_FusionAuxGain_IndustryCode,
+    "FusionAuxGain.MessageID": &fieldSpec_FusionAuxGain_MessageID,
+    "FusionAuxGain.SourceID": &fieldSpec_FusionAuxGain_SourceID,
+    "FusionAuxGain.Gain": &fieldSpec_FusionAuxGain_Gain,
+    "FusionUSBRepeatStatus.ManufacturerCode": &fieldSpec_FusionUSBRepeatStatus_ManufacturerCode,
+    "FusionUSBRepeatStatus.IndustryCode": &fieldSpec_FusionUSBRepeatStatus_IndustryCode,
+    "FusionUSBRepeatStatus.MessageID": &fieldSpec_FusionUSBRepeatStatus_MessageID,
+    "FusionUSBRepeatStatus.ID": &fieldSpec_FusionUSBRepeatStatus_ID,
+    "FusionSetting.ManufacturerCode": &fieldSpec_FusionSetting_ManufacturerCode,
+    "FusionSetting.IndustryCode": &fieldSpec_FusionSetting_IndustryCode,
+    "FusionSetting.MessageID": &fieldSpec_FusionSetting_MessageID,
+    "FusionSetting.Value": &fieldSpec_FusionSetting_Value,
+    "FusionSettings.ManufacturerCode": &fieldSpec_FusionSettings_ManufacturerCode,
+    "FusionSettings.IndustryCode": &fieldSpec_FusionSettings_IndustryCode,
+    "FusionSettings.MessageID": &fieldSpec_FusionSettings_MessageID,
+    "FusionSettings.Count": &fieldSpec_FusionSettings_Count,
+    "FusionSettings.Value": &fieldSpec_FusionSettings_Value,
+    "FusionMute.ManufacturerCode": &fieldSpec_FusionMute_ManufacturerCode,
+    "FusionMute.IndustryCode": &fieldSpec_FusionMute_IndustryCode,
+    "FusionMute.MessageID": &fieldSpec_FusionMute_MessageID,
+    "FusionBalance.ManufacturerCode": &fieldSpec_FusionBalance_ManufacturerCode,
+    "FusionBalance.IndustryCode": &fieldSpec_FusionBalance_IndustryCode,
+    "FusionBalance.MessageID": &fieldSpec_FusionBalance_MessageID,
+    "FusionBalance.Zone": &fieldSpec_FusionBalance_Zone,
+    "FusionBalance.Value": &fieldSpec_FusionBalance_Value,
+    "FusionLowPassFilter.ManufacturerCode": &fieldSpec_FusionLowPassFilter_ManufacturerCode,
+    "FusionLowPassFilter.IndustryCode": &fieldSpec_FusionLowPassFilter_IndustryCode,
+    "FusionLowPassFilter.MessageID": &fieldSpec_FusionLowPassFilter_MessageID,
+    "FusionLowPassFilter.Zone": &fieldSpec_FusionLowPassFilter_Zone,
+    "FusionLowPassFilter.Filter": &fieldSpec_FusionLowPassFilter_Filter,
+    "FusionSublevels.ManufacturerCode": &fieldSpec_FusionSublevels_ManufacturerCode,
+    "FusionSublevels.IndustryCode": &fieldSpec_FusionSublevels_IndustryCode,
+    "FusionSublevels.MessageID": &fieldSpec_FusionSublevels_MessageID,
+    "FusionSublevels.Zone1": &fieldSpec_FusionSublevels_Zone1,
+    "FusionSublevels.Zone2": &fieldSpec_FusionSublevels_Zone2,
+    "FusionSublevels.Zone3": &fieldSpec_FusionSublevels_Zone3,
+    "FusionSublevels.Zone4": &fieldSpec_FusionSublevels_Zone4,
+    "FusionEQ.ManufacturerCode": &fieldSpec_FusionEQ_ManufacturerCode,
+    "FusionEQ.IndustryCode": &fieldSpec_FusionEQ_IndustryCode,
+    "FusionEQ.MessageID": &fieldSpec_FusionEQ_MessageID,
+    "FusionEQ.Zone": &fieldSpec_FusionEQ_Zone,
+    "FusionEQ.Bass": &fieldSpec_FusionEQ_Bass,
+    "FusionEQ.Mid": &fieldSpec_FusionEQ_Mid,
+    "FusionEQ.Treble": &fieldSpec_FusionEQ_Treble,
+    "FusionVolumeLimits.ManufacturerCode": &fieldSpec_FusionVolumeLimits_ManufacturerCode,
+    "FusionVolumeLimits.IndustryCode": &fieldSpec_FusionVolumeLimits_IndustryCode,
+    "FusionVolumeLimits.MessageID": &fieldSpec_FusionVolumeLimits_MessageID,
+    "FusionVolumeLimits.Zone1VolumeLimit": &fieldSpec_FusionVolumeLimits_Zone1VolumeLimit,
+    "FusionVolumeLimits.Zone2VolumeLimit": &fieldSpec_FusionVolumeLimits_Zone2VolumeLimit,
+    "FusionVolumeLimits.Zone3VolumeLimit": &fieldSpec_FusionVolumeLimits_Zone3VolumeLimit,
+    "FusionVolumeLimits.Zone4VolumeLimit": &fieldSpec_FusionVolumeLimits_Zone4VolumeLimit,
+    "FusionVolumes.ManufacturerCode": &fieldSpec_FusionVolumes_ManufacturerCode,
+    "FusionVolumes.IndustryCode": &fieldSpec_FusionVolumes_IndustryCode,
+    "FusionVolumes.MessageID": &fieldSpec_FusionVolumes_MessageID,
+    "FusionVolumes.Zone1": &fieldSpec_FusionVolumes_Zone1,
+    "FusionVolumes.Zone2": &fieldSpec_FusionVolumes_Zone2,
+    "FusionVolumes.Zone3": &fieldSpec_FusionVolumes_Zone3,
+    "FusionVolumes.Zone4": &fieldSpec_FusionVolumes_Zone4,
+    "FusionCapabilities.ManufacturerCode": &fieldSpec_FusionCapabilities_ManufacturerCode,
+    "FusionCapabilities.IndustryCode": &fieldSpec_FusionCapabilities_IndustryCode,
+    "FusionCapabilities.MessageID": &fieldSpec_FusionCapabilities_MessageID,
+    "FusionCapabilities.Zone1": &fieldSpec_FusionCapabilities_Zone1,
+    "FusionCapabilities.Zone2": &fieldSpec_FusionCapabilities_Zone2,
+    "FusionCapabilities.Zone3": &fieldSpec_FusionCapabilities_Zone3,
+    "FusionCapabilities.Zone4": &fieldSpec_FusionCapabilities_Zone4,
+    "FusionCapabilities.Global": &fieldSpec_FusionCapabilities_Global,
+    "FusionLineLevelControl.ManufacturerCode": &fieldSpec_FusionLineLevelControl_ManufacturerCode,
+    "FusionLineLevelControl.IndustryCode": &fieldSpec_FusionLineLevelControl_IndustryCode,
+    "FusionLineLevelControl.MessageID": &fieldSpec_FusionLineLevelControl_MessageID,
+    "FusionLineLevelControl.Zone": &fieldSpec_FusionLineLevelControl_Zone,
+    "FusionLineLevelControl.Control": &fieldSpec_FusionLineLevelControl_Control,
+    "FusionPowerState.ManufacturerCode": &fieldSpec_FusionPowerState_ManufacturerCode,
+    "FusionPowerState.IndustryCode": &fieldSpec_FusionPowerState_IndustryCode,
+    "FusionPowerState.MessageID": &fieldSpec_FusionPowerState_MessageID,
+    "FusionSiriusxm.ManufacturerCode": &fieldSpec_FusionSiriusxm_ManufacturerCode,
+    "FusionSiriusxm.IndustryCode": &fieldSpec_FusionSiriusxm_IndustryCode,
+    "FusionSiriusxm.MessageID": &fieldSpec_FusionSiriusxm_MessageID,
+    "FusionSiriusxm.SourceID": &fieldSpec_FusionSiriusxm_SourceID,
+    "FusionSiriusxm.AdvisoryChannel": &fieldSpec_FusionSiriusxm_AdvisoryChannel,
+    "FusionSiriusxmChannel.ManufacturerCode": &fieldSpec_FusionSiriusxmChannel_ManufacturerCode,
+    "FusionSiriusxmChannel.IndustryCode": &fieldSpec_FusionSiriusxmChannel_IndustryCode,
+    "FusionSiriusxmChannel.MessageID": &fieldSpec_FusionSiriusxmChannel_MessageID,
+    "FusionSiriusxmChannel.SourceID": &fieldSpec_FusionSiriusxmChannel_SourceID,
+    "FusionSiriusxmChannel.ChannelNumber": &fieldSpec_FusionSiriusxmChannel_ChannelNumber,
+    "FusionSiriusxmTitle.ManufacturerCode": &fieldSpec_FusionSiriusxmTitle_ManufacturerCode,
+    "FusionSiriusxmTitle.IndustryCode": &fieldSpec_FusionSiriusxmTitle_IndustryCode,
+    "FusionSiriusxmTitle.MessageID": &fieldSpec_FusionSiriusxmTitle_MessageID,
+    "FusionSiriusxmTitle.SourceID": &fieldSpec_FusionSiriusxmTitle_SourceID,
+    "FusionSiriusxmTitle.Channel": &fieldSpec_FusionSiriusxmTitle_Channel,
+    "FusionSiriusxmArtist.ManufacturerCode": &fieldSpec_FusionSiriusxmArtist_ManufacturerCode,
+    "FusionSiriusxmArtist.IndustryCode": &fieldSpec_FusionSiriusxmArtist_IndustryCode,
+    "FusionSiriusxmArtist.MessageID": &fieldSpec_FusionSiriusxmArtist_MessageID,
+    "FusionSiriusxmArtist.SourceID": &fieldSpec_FusionSiriusxmArtist_SourceID,
+    "FusionSiriusxmArtist.Channel": &fieldSpec_FusionSiriusxmArtist_Channel,
+    "FusionSiriusxmContentInfo.ManufacturerCode": &fieldSpec_FusionSiriusxmContentInfo_ManufacturerCode,
+    "FusionSiriusxmContentInfo.IndustryCode": &fieldSpec_FusionSiriusxmContentInfo_IndustryCode,
+    "FusionSiriusxmContentInfo.MessageID": &fieldSpec_FusionSiriusxmContentInfo_MessageID,
+    "FusionSiriusxmContentInfo.SourceID": &fieldSpec_FusionSiriusxmContentInfo_SourceID,
+    "FusionSiriusxmContentInfo.Channel": &fieldSpec_FusionSiriusxmContentInfo_Channel,
+    "FusionSiriusxmCategory.ManufacturerCode": &fieldSpec_FusionSiriusxmCategory_ManufacturerCode,
+    "FusionSiriusxmCategory.IndustryCode": &fieldSpec_FusionSiriusxmCategory_IndustryCode,
+    "FusionSiriusxmCategory.MessageID": &fieldSpec_FusionSiriusxmCategory_MessageID,
+    "FusionSiriusxmCategory.SourceID": &fieldSpec_FusionSiriusxmCategory_SourceID,
+    "FusionSiriusxmCategory.Channel": &fieldSpec_FusionSiriusxmCategory_Channel,
+    "FusionSiriusxmSignal.ManufacturerCode": &fieldSpec_FusionSiriusxmSignal_ManufacturerCode,
+    "FusionSiriusxmSignal.IndustryCode": &fieldSpec_FusionSiriusxmSignal_IndustryCode,
+    "FusionSiriusxmSignal.MessageID": &fieldSpec_FusionSiriusxmSignal_MessageID,
+    "FusionSiriusxmSignal.SourceID": &fieldSpec_FusionSiriusxmSignal_SourceID,
+    "FusionSiriusxmSignal.Signal": &fieldSpec_FusionSiriusxmSignal_Signal,
+    "FusionSiriusxmPresets.ManufacturerCode": &fieldSpec_FusionSiriusxmPresets_ManufacturerCode,
+    "FusionSiriusxmPresets.IndustryCode": &fieldSpec_FusionSiriusxmPresets_IndustryCode,
+    "FusionSiriusxmPresets.MessageID": &fieldSpec_FusionSiriusxmPresets_MessageID,
+    "FusionSiriusxmPresets.SourceID": &fieldSpec_FusionSiriusxmPresets_SourceID,
+    "FusionSiriusxmPresets.Count": &fieldSpec_FusionSiriusxmPresets_Count,
+    "MaretronAlertResponse.ManufacturerCode": &fieldSpec_MaretronAlertResponse_ManufacturerCode,
+    "MaretronAlertResponse.IndustryCode": &fieldSpec_MaretronAlertResponse_IndustryCode,
+    "MaretronAlertResponse.AlertSystem": &fieldSpec_MaretronAlertResponse_AlertSystem,
+    "MaretronAlertResponse.AlertSubSystem": &fieldSpec_MaretronAlertResponse_AlertSubSystem,
+    "MaretronAlertResponse.AlertID": &fieldSpec_MaretronAlertResponse_AlertID,
+    "MaretronAlertResponse.DataSourceNetworkIDName": &fieldSpec_MaretronAlertResponse_DataSourceNetworkIDName,
+    "MaretronAlertResponse.DataSourceInstance": &fieldSpec_MaretronAlertResponse_DataSourceInstance,
+    "MaretronAlertResponse.DataSourceIndexSource": &fieldSpec_MaretronAlertResponse_DataSourceIndexSource,
+    "MaretronAlertResponse.AlertOccurrenceNumber": &fieldSpec_MaretronAlertResponse_AlertOccurrenceNumber,
+    "MaretronAlertResponse.AcknowledgeSourceNetworkIDName": &fieldSpec_MaretronAlertResponse_AcknowledgeSourceNetworkIDName,
+    "NavicoAsciiData.ManufacturerCode": &fieldSpec_NavicoAsciiData_ManufacturerCode,
+    "NavicoAsciiData.IndustryCode": &fieldSpec_NavicoAsciiData_IndustryCode,
+    "NavicoAsciiData.A": &fieldSpec_NavicoAsciiData_A,
+    "FurunoUnknown130821.ManufacturerCode": &fieldSpec_FurunoUnknown130821_ManufacturerCode,
+    "FurunoUnknown130821.IndustryCode": &fieldSpec_FurunoUnknown130821_IndustryCode,
+    "FurunoUnknown130821.SID": &fieldSpec_FurunoUnknown130821_SID,
+    "FurunoUnknown130821.A": &fieldSpec_FurunoUnknown130821_A,
+    "FurunoUnknown130821.B": &fieldSpec_FurunoUnknown130821_B,
+    "FurunoUnknown130821.C": &fieldSpec_FurunoUnknown130821_C,
+    "FurunoUnknown130821.D": &fieldSpec_FurunoUnknown130821_D,
+    "FurunoUnknown130821.E": &fieldSpec_FurunoUnknown130821_E,
+    "FurunoUnknown130821.F": &fieldSpec_FurunoUnknown130821_F,
+    "FurunoUnknown130821.G": &fieldSpec_FurunoUnknown130821_G,
+    "FurunoUnknown130821.H": &fieldSpec_FurunoUnknown130821_H,
+    "FurunoUnknown130821.I": &fieldSpec_FurunoUnknown130821_I,
+    "MaretronAlertText.ManufacturerCode": &fieldSpec_MaretronAlertText_ManufacturerCode,
+    "MaretronAlertText.IndustryCode": &fieldSpec_MaretronAlertText_IndustryCode,
+    "MaretronAlertText.AlertSystem": &fieldSpec_MaretronAlertText_AlertSystem,
+    "MaretronAlertText.AlertSubSystem": &fieldSpec_MaretronAlertText_AlertSubSystem,
+    "MaretronAlertText.AlertID": &fieldSpec_MaretronAlertText_AlertID,
+    "MaretronAlertText.DataSourceNetworkIDName": &fieldSpec_MaretronAlertText_DataSourceNetworkIDName,
+    "MaretronAlertText.DataSourceInstance": &fieldSpec_MaretronAlertText_DataSourceInstance,
+    "MaretronAlertText.DataSourceIndexSource": &fieldSpec_MaretronAlertText_DataSourceIndexSource,
+    "MaretronAlertText.AlertOccurrenceNumber": &fieldSpec_MaretronAlertText_AlertOccurrenceNumber,
+    "BepMarineProprietaryPGN130821.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN130821_ManufacturerCode,
+    "BepMarineProprietaryPGN130821.IndustryCode": &fieldSpec_BepMarineProprietaryPGN130821_IndustryCode,
+    "NavicoUdbDatabaseObjectPing.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseObjectPing_ManufacturerCode,
+    "NavicoUdbDatabaseObjectPing.IndustryCode": &fieldSpec_NavicoUdbDatabaseObjectPing_IndustryCode,
+    "NavicoUdbDatabaseObjectPing.Marker": &fieldSpec_NavicoUdbDatabaseObjectPing_Marker,
+    "NavicoUdbDatabaseObjectPing.Command": &fieldSpec_NavicoUdbDatabaseObjectPing_Command,
+    "NavicoUdbDatabaseObjectPing.Address": &fieldSpec_NavicoUdbDatabaseObjectPing_Address,
+    "NavicoUdbDatabaseObjectPing.Section": &fieldSpec_NavicoUdbDatabaseObjectPing_Section,
+    "NavicoUdbDatabaseObjectPing.Item": &fieldSpec_NavicoUdbDatabaseObjectPing_Item,
+    "NavicoUdbDatabaseSourceReport.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseSourceReport_ManufacturerCode,
+    "NavicoUdbDatabaseSourceReport.IndustryCode": &fieldSpec_NavicoUdbDatabaseSourceReport_IndustryCode,
+    "NavicoUdbDatabaseSourceReport.Marker": &fieldSpec_NavicoUdbDatabaseSourceReport_Marker,
+    "NavicoUdbDatabaseSourceReport.Command": &fieldSpec_NavicoUdbDatabaseSourceReport_Command,
+    "NavicoUdbDatabaseSourceReport.Address": &fieldSpec_NavicoUdbDatabaseSourceReport_Address,
+    "NavicoUdbDatabaseSourceReport.SourceSettingID": &fieldSpec_NavicoUdbDatabaseSourceReport_SourceSettingID,
+    "NavicoUdbDatabaseSourceReport.Item": &fieldSpec_NavicoUdbDatabaseSourceReport_Item,
+    "NavicoUdbDatabaseSourceReport.ObjectValue": &fieldSpec_NavicoUdbDatabaseSourceReport_ObjectValue,
+    "NavicoUdbDatabaseSourceReport.Instance": &fieldSpec_NavicoUdbDatabaseSourceReport_Instance,
+    "NavicoUdbDatabaseSourceReport.SourceSelectionMaster": &fieldSpec_NavicoUdbDatabaseSourceReport_SourceSelectionMaster,
+    "NavicoUdbDatabaseSourceReport.Token": &fieldSpec_NavicoUdbDatabaseSourceReport_Token,
+    "NavicoUdbDatabaseBulkReport2.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseBulkReport2_ManufacturerCode,
+    "NavicoUdbDatabaseBulkReport2.IndustryCode": &fieldSpec_NavicoUdbDatabaseBulkReport2_IndustryCode,
+    "NavicoUdbDatabaseBulkReport2.Marker": &fieldSpec_NavicoUdbDatabaseBulkReport2_Marker,
+    "NavicoUdbDatabaseBulkReport2.Command": &fieldSpec_NavicoUdbDatabaseBulkReport2_Command,
+    "NavicoUdbDatabaseBulkReport2.Address": &fieldSpec_NavicoUdbDatabaseBulkReport2_Address,
+    "NavicoUdbDatabaseBulkReport2.Section": &fieldSpec_NavicoUdbDatabaseBulkReport2_Section,
+    "NavicoUdbDatabaseBulkReport2.Item": &fieldSpec_NavicoUdbDatabaseBulkReport2_Item,
+    "NavicoConfigurationSet.ManufacturerCode": &fieldSpec_NavicoConfigurationSet_ManufacturerCode,
+    "NavicoConfigurationSet.IndustryCode": &fieldSpec_NavicoConfigurationSet_IndustryCode,
+    "NavicoConfigurationSet.Marker": &fieldSpec_NavicoConfigurationSet_Marker,
+    "NavicoConfigurationSet.Command": &fieldSpec_NavicoConfigurationSet_Command,
+    "NavicoConfigurationSet.Address": &fieldSpec_NavicoConfigurationSet_Address,
+    "NavicoConfigurationSet.Section": &fieldSpec_NavicoConfigurationSet_Section,
+    "NavicoConfigurationSet.Item": &fieldSpec_NavicoConfigurationSet_Item,
+    "NavicoConfigurationSet.SourceSettingID": &fieldSpec_NavicoConfigurationSet_SourceSettingID,
+    "NavicoConfigurationSet.Token": &fieldSpec_NavicoConfigurationSet_Token,
+    "NavicoConfigurationSet.Length": &fieldSpec_NavicoConfigurationSet_Length,
+    "NavicoUdbDatabaseBulkReport4.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseBulkReport4_ManufacturerCode,
+    "NavicoUdbDatabaseBulkReport4.IndustryCode": &fieldSpec_NavicoUdbDatabaseBulkReport4_IndustryCode,
+    "NavicoUdbDatabaseBulkReport4.Marker": &fieldSpec_NavicoUdbDatabaseBulkReport4_Marker,
+    "NavicoUdbDatabaseBulkReport4.Command": &fieldSpec_NavicoUdbDatabaseBulkReport4_Command,
+    "NavicoUdbDatabaseBulkReport4.Address": &fieldSpec_NavicoUdbDatabaseBulkReport4_Address,
+    "NavicoUdbDatabaseBulkReport4.Section": &fieldSpec_NavicoUdbDatabaseBulkReport4_Section,
+    "NavicoUdbDatabaseBulkReport4.Item": &fieldSpec_NavicoUdbDatabaseBulkReport4_Item,
+    "NavicoUdbDatabaseShortReport5.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseShortReport5_ManufacturerCode,
+    "NavicoUdbDatabaseShortReport5.IndustryCode": &fieldSpec_NavicoUdbDatabaseShortReport5_IndustryCode,
+    "NavicoUdbDatabaseShortReport5.Marker": &fieldSpec_NavicoUdbDatabaseShortReport5_Marker,
+    "NavicoUdbDatabaseShortReport5.Command": &fieldSpec_NavicoUdbDatabaseShortReport5_Command,
+    "NavicoUdbDatabaseShortReport5.Address": &fieldSpec_NavicoUdbDatabaseShortReport5_Address,
+    "NavicoUdbDatabaseShortReport5.Section": &fieldSpec_NavicoUdbDatabaseShortReport5_Section,
+    "NavicoUdbDatabaseShortReport5.Item": &fieldSpec_NavicoUdbDatabaseShortReport5_Item,
+    "NavicoUdbDatabaseObjectDump.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseObjectDump_ManufacturerCode,
+    "NavicoUdbDatabaseObjectDump.IndustryCode": &fieldSpec_NavicoUdbDatabaseObjectDump_IndustryCode,
+    "NavicoUdbDatabaseObjectDump.Marker": &fieldSpec_NavicoUdbDatabaseObjectDump_Marker,
+    "NavicoUdbDatabaseObjectDump.Command": &fieldSpec_NavicoUdbDatabaseObjectDump_Command,
+    "NavicoUdbDatabaseObjectDump.Address": &fieldSpec_NavicoUdbDatabaseObjectDump_Address,
+    "NavicoUdbDatabaseObjectDump.Section": &fieldSpec_NavicoUdbDatabaseObjectDump_Section,
+    "NavicoUdbDatabaseObjectDump.Item": &fieldSpec_NavicoUdbDatabaseObjectDump_Item,
+    "NavicoUdbDatabaseObjectDump.ObjectValue": &fieldSpec_NavicoUdbDatabaseObjectDump_ObjectValue,
+    "NavicoUdbDatabaseObjectDump.Token": &fieldSpec_NavicoUdbDatabaseObjectDump_Token,
+    "NavicoUdbDatabaseObjectDump.Length": &fieldSpec_NavicoUdbDatabaseObjectDump_Length,
+    "NavicoUdbDatabaseObjectDump.Class": &fieldSpec_NavicoUdbDatabaseObjectDump_Class,
+    "NavicoUdbDatabaseShortReport7.ManufacturerCode": &fieldSpec_NavicoUdbDatabaseShortReport7_ManufacturerCode,
+    "NavicoUdbDatabaseShortReport7.IndustryCode": &fieldSpec_NavicoUdbDatabaseShortReport7_IndustryCode,
+    "NavicoUdbDatabaseShortReport7.Marker": &fieldSpec_NavicoUdbDatabaseShortReport7_Marker,
+    "NavicoUdbDatabaseShortReport7.Command": &fieldSpec_NavicoUdbDatabaseShortReport7_Command,
+    "NavicoUdbDatabaseShortReport7.Address": &fieldSpec_NavicoUdbDatabaseShortReport7_Address,
+    "NavicoUdbDatabaseShortReport7.Section": &fieldSpec_NavicoUdbDatabaseShortReport7_Section,
+    "NavicoUdbDatabaseShortReport7.Item": &fieldSpec_NavicoUdbDatabaseShortReport7_Item,
+    "MaretronAlertControl.ManufacturerCode": &fieldSpec_MaretronAlertControl_ManufacturerCode,
+    "MaretronAlertControl.IndustryCode": &fieldSpec_MaretronAlertControl_IndustryCode,
+    "MaretronAlertControl.AlertSystem": &fieldSpec_MaretronAlertControl_AlertSystem,
+    "MaretronAlertControl.AlertSubSystem": &fieldSpec_MaretronAlertControl_AlertSubSystem,
+    "MaretronAlertControl.AlertID": &fieldSpec_MaretronAlertControl_AlertID,
+    "MaretronAlertControl.DataSourceNetworkIDName": &fieldSpec_MaretronAlertControl_DataSourceNetworkIDName,
+    "MaretronAlertControl.DataSourceInstance": &fieldSpec_MaretronAlertControl_DataSourceInstance,
+    "MaretronAlertControl.DataSourceIndexSource": &fieldSpec_MaretronAlertControl_DataSourceIndexSource,
+    "MaretronAlertControl.AlertOccurrenceNumber": &fieldSpec_MaretronAlertControl_AlertOccurrenceNumber,
+    "BepMarineProprietaryPGN130822.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN130822_ManufacturerCode,
+    "BepMarineProprietaryPGN130822.IndustryCode": &fieldSpec_BepMarineProprietaryPGN130822_IndustryCode,
+    "MercuryEngineTelemetryLowSpeed.ManufacturerCode": &fieldSpec_MercuryEngineTelemetryLowSpeed_ManufacturerCode,
+    "MercuryEngineTelemetryLowSpeed.IndustryCode": &fieldSpec_MercuryEngineTelemetryLowSpeed_IndustryCode,
+    "MercuryEngineTelemetryLowSpeed.EngineInstance": &fieldSpec_MercuryEngineTelemetryLowSpeed_EngineInstance,
+    "MercuryEngineTelemetryLowSpeed.MalfunctionIndicator": &fieldSpec_MercuryEngineTelemetryLowSpeed_MalfunctionIndicator,
+    "MercuryEngineTelemetryLowSpeed.IntakeAirTemperature": &fieldSpec_MercuryEngineTelemetryLowSpeed_IntakeAirTemperature,
+    "MercuryEngineTelemetryLowSpeed.ExhaustGasTemperature": &fieldSpec_MercuryEngineTelemetryLowSpeed_ExhaustGasTemperature,
+    "MercuryEngineTelemetryLowSpeed.Gpl": &fieldSpec_MercuryEngineTelemetryLowSpeed_Gpl,
+    "MercuryEngineTelemetryLowSpeed.EngineState": &fieldSpec_MercuryEngineTelemetryLowSpeed_EngineState,
+    "MaretronProprietaryTemperatureHighRange.ManufacturerCode": &fieldSpec_MaretronProprietaryTemperatureHighRange_ManufacturerCode,
+    "MaretronProprietaryTemperatureHighRange.IndustryCode": &fieldSpec_MaretronProprietaryTemperatureHighRange_IndustryCode,
+    "MaretronProprietaryTemperatureHighRange.SID": &fieldSpec_MaretronProprietaryTemperatureHighRange_SID,
+    "MaretronProprietaryTemperatureHighRange.Instance": &fieldSpec_MaretronProprietaryTemperatureHighRange_Instance,
+    "MaretronProprietaryTemperatureHighRange.ActualTemperature": &fieldSpec_MaretronProprietaryTemperatureHighRange_ActualTemperature,
+    "MaretronProprietaryTemperatureHighRange.SetTemperature": &fieldSpec_MaretronProprietaryTemperatureHighRange_SetTemperature,
+    "NavicoDataTypeSourceDirectory.ManufacturerCode": &fieldSpec_NavicoDataTypeSourceDirectory_ManufacturerCode,
+    "NavicoDataTypeSourceDirectory.IndustryCode": &fieldSpec_NavicoDataTypeSourceDirectory_IndustryCode,
+    "NavicoDataTypeSourceDirectory.ReportType": &fieldSpec_NavicoDataTypeSourceDirectory_ReportType,
+    "NavicoDataTypeSourceDirectory.Part": &fieldSpec_NavicoDataTypeSourceDirectory_Part,
+    "NavicoDataTypeSourceDirectory.Length": &fieldSpec_NavicoDataTypeSourceDirectory_Length,
+    "NavicoDataTypeSourceDirectory.Type": &fieldSpec_NavicoDataTypeSourceDirectory_Type,
+    "NavicoDataTypeSourceDirectoryFullReport.ManufacturerCode": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_ManufacturerCode,
+    "NavicoDataTypeSourceDirectoryFullReport.IndustryCode": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_IndustryCode,
+    "NavicoDataTypeSourceDirectoryFullReport.ReportType": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_ReportType,
+    "NavicoDataTypeSourceDirectoryFullReport.Part": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_Part,
+    "NavicoDataTypeSourceDirectoryFullReport.Length": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_Length,
+    "NavicoDataTypeSourceDirectoryFullReport.Type": &fieldSpec_NavicoDataTypeSourceDirectoryFullReport_Type,
+    "NavicoBoatSpeedPolarTable.ManufacturerCode": &fieldSpec_NavicoBoatSpeedPolarTable_ManufacturerCode,
+    "NavicoBoatSpeedPolarTable.IndustryCode": &fieldSpec_NavicoBoatSpeedPolarTable_IndustryCode,
+    "NavicoBoatSpeedPolarTable.ReportType": &fieldSpec_NavicoBoatSpeedPolarTable_ReportType,
+    "NavicoBoatSpeedPolarTable.Part": &fieldSpec_NavicoBoatSpeedPolarTable_Part,
+    "BGKeyValueData.ManufacturerCode": &fieldSpec_BGKeyValueData_ManufacturerCode,
+    "BGKeyValueData.IndustryCode": &fieldSpec_BGKeyValueData_IndustryCode,
+    "BGKeyValueData.Key": &fieldSpec_BGKeyValueData_Key,
+    "BGKeyValueData.Length": &fieldSpec_BGKeyValueData_Length,
+    "MaretronAnnunciator.ManufacturerCode": &fieldSpec_MaretronAnnunciator_ManufacturerCode,
+    "MaretronAnnunciator.IndustryCode": &fieldSpec_MaretronAnnunciator_IndustryCode,
+    "MaretronAnnunciator.Field4": &fieldSpec_MaretronAnnunciator_Field4,
+    "MaretronAnnunciator.Field5": &fieldSpec_MaretronAnnunciator_Field5,
+    "MaretronAnnunciator.Field6": &fieldSpec_MaretronAnnunciator_Field6,
+    "MaretronAnnunciator.Field7": &fieldSpec_MaretronAnnunciator_Field7,
+    "MaretronAnnunciator.Field8": &fieldSpec_MaretronAnnunciator_Field8,
+    "MercuryEngineKeyValueData.ManufacturerCode": &fieldSpec_MercuryEngineKeyValueData_ManufacturerCode,
+    "MercuryEngineKeyValueData.IndustryCode": &fieldSpec_MercuryEngineKeyValueData_IndustryCode,
+    "MercuryEngineKeyValueData.Key": &fieldSpec_MercuryEngineKeyValueData_Key,
+    "MercuryEngineKeyValueData.Length": &fieldSpec_MercuryEngineKeyValueData_Length,
+    "MaretronDataInstanceChannelCorrelation.ManufacturerCode": &fieldSpec_MaretronDataInstanceChannelCorrelation_ManufacturerCode,
+    "MaretronDataInstanceChannelCorrelation.IndustryCode": &fieldSpec_MaretronDataInstanceChannelCorrelation_IndustryCode,
+    "MaretronDataInstanceChannelCorrelation.PGN": &fieldSpec_MaretronDataInstanceChannelCorrelation_PGN,
+    "MaretronDataInstanceChannelCorrelation.HardwareChannel": &fieldSpec_MaretronDataInstanceChannelCorrelation_HardwareChannel,
+    "MaretronDataInstanceChannelCorrelation.Instance": &fieldSpec_MaretronDataInstanceChannelCorrelation_Instance,
+    "MaretronDataInstanceChannelCorrelation.DataSource": &fieldSpec_MaretronDataInstanceChannelCorrelation_DataSource,
+    "MaretronDataInstanceChannelCorrelation.DataIndicator": &fieldSpec_MaretronDataInstanceChannelCorrelation_DataIndicator,
+    "NavicoAlarm.ManufacturerCode": &fieldSpec_NavicoAlarm_ManufacturerCode,
+    "NavicoAlarm.IndustryCode": &fieldSpec_NavicoAlarm_IndustryCode,
+    "NavicoAlarm.Instance": &fieldSpec_NavicoAlarm_Instance,
+    "NavicoAlarm.RecordID": &fieldSpec_NavicoAlarm_RecordID,
+    "NavicoAlarm.AlarmType": &fieldSpec_NavicoAlarm_AlarmType,
+    "NavicoAlarm.ActionFlag": &fieldSpec_NavicoAlarm_ActionFlag,
+    "NavicoAlarm.Value": &fieldSpec_NavicoAlarm_Value,
+    "BepMarineProprietaryPGN130825.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN130825_ManufacturerCode,
+    "BepMarineProprietaryPGN130825.IndustryCode": &fieldSpec_BepMarineProprietaryPGN130825_IndustryCode,
+    "MercuryCruiseControlData.ManufacturerCode": &fieldSpec_MercuryCruiseControlData_ManufacturerCode,
+    "MercuryCruiseControlData.IndustryCode": &fieldSpec_MercuryCruiseControlData_IndustryCode,
+    "MercuryCruiseControlData.Opcode": &fieldSpec_MercuryCruiseControlData_Opcode,
+    "MercuryCruiseControlData.EngineInstance": &fieldSpec_MercuryCruiseControlData_EngineInstance,
+    "MercuryCruiseControlData.CruiseState": &fieldSpec_MercuryCruiseControlData_CruiseState,
+    "MercuryCruiseControlData.CruiseRPMSetpoint": &fieldSpec_MercuryCruiseControlData_CruiseRPMSetpoint,
+    "MercuryCruiseControlData.CruiseSpeedSetpoint": &fieldSpec_MercuryCruiseControlData_CruiseSpeedSetpoint,
+    "MercuryCommandResponse.ManufacturerCode": &fieldSpec_MercuryCommandResponse_ManufacturerCode,
+    "MercuryCommandResponse.IndustryCode": &fieldSpec_MercuryCommandResponse_IndustryCode,
+    "MaretronSwitchIndicatorStatus.ManufacturerCode": &fieldSpec_MaretronSwitchIndicatorStatus_ManufacturerCode,
+    "MaretronSwitchIndicatorStatus.IndustryCode": &fieldSpec_MaretronSwitchIndicatorStatus_IndustryCode,
+    "MaretronSwitchIndicatorStatus.IndicatorBankInstance": &fieldSpec_MaretronSwitchIndicatorStatus_IndicatorBankInstance,
+    "MaretronSwitchIndicatorStatus.NumberOfStatusFields": &fieldSpec_MaretronSwitchIndicatorStatus_NumberOfStatusFields,
+    "MaretronSwitchIndicatorStatus.IndicatorStatus": &fieldSpec_MaretronSwitchIndicatorStatus_IndicatorStatus,
+    "BepMarineProprietaryPGN130826.ManufacturerCode": &fieldSpec_BepMarineProprietaryPGN130826_ManufacturerCode,
+    "BepMarineProprietaryPGN130826.IndustryCode": &fieldSpec_BepMarineProprietaryPGN130826_IndustryCode,
+    "MercuryBamDigitalDataProxy.ManufacturerCode": &fieldSpec_MercuryBamDigitalDataProxy_ManufacturerCode,
+    "MercuryBamDigitalDataProxy.IndustryCode": &fieldSpec_MercuryBamDigitalDataProxy_IndustryCode,
+    "MercuryBamDigitalDataProxy.Type": &fieldSpec_MercuryBamDigitalDataProxy_Type,
+    "MercuryBamDigitalDataProxy.Instance": &fieldSpec_MercuryBamDigitalDataProxy_Instance,
+    "MercuryBamDigitalDataProxy.Field4": &fieldSpec_MercuryBamDigitalDataProxy_Field4,
+    "MercuryBamDigitalDataProxy.Flag": &fieldSpec_MercuryBamDigitalDataProxy_Flag,
+    "LowranceUnknown.ManufacturerCode": &fieldSpec_LowranceUnknown_ManufacturerCode,
+    "LowranceUnknown.IndustryCode": &fieldSpec_LowranceUnknown_IndustryCode,
+    "LowranceUnknown.A": &fieldSpec_LowranceUnknown_A,
+    "LowranceUnknown.B": &fieldSpec_LowranceUnknown_B,
+    "LowranceUnknown.C": &fieldSpec_LowranceUnknown_C,
+    "LowranceUnknown.D": &fieldSpec_LowranceUnknown_D,
+    "LowranceUnknown.E": &fieldSpec_LowranceUnknown_E,
+    "LowranceUnknown.F": &fieldSpec_LowranceUnknown_F,
+    "FurunoNavpilotStatus.ManufacturerCode": &fieldSpec_FurunoNavpilotStatus_ManufacturerCode,
+    "FurunoNavpilotStatus.IndustryCode": &fieldSpec_FurunoNavpilotStatus_IndustryCode,
+    "FurunoNavpilotStatus.MessageID": &fieldSpec_FurunoNavpilotStatus_MessageID,
+    "FurunoNavpilotStatus.RudderAngle": &fieldSpec_FurunoNavpilotStatus_RudderAngle,
+    "FurunoNavpilotStatus.A": &fieldSpec_FurunoNavpilotStatus_A,
+    "FurunoNavpilotStatus.B": &fieldSpec_FurunoNavpilotStatus_B,
+    "FurunoNavpilotStatus.CommandedCourse": &fieldSpec_FurunoNavpilotStatus_CommandedCourse,
+    "FurunoNavpilotStatus.C": &fieldSpec_FurunoNavpilotStatus_C,
+    "FurunoNavpilotStatus.D": &fieldSpec_FurunoNavpilotStatus_D,
+    "SimnetSetSerialNumber.ManufacturerCode": &fieldSpec_SimnetSetSerialNumber_ManufacturerCode,
+    "SimnetSetSerialNumber.IndustryCode": &fieldSpec_SimnetSetSerialNumber_IndustryCode,
+    "MaretronDometicHvacControlStatus.ManufacturerCode": &fieldSpec_MaretronDometicHvacControlStatus_ManufacturerCode,
+    "MaretronDometicHvacControlStatus.IndustryCode": &fieldSpec_MaretronDometicHvacControlStatus_IndustryCode,
+    "MaretronDometicHvacControlStatus.CANID": &fieldSpec_MaretronDometicHvacControlStatus_CANID,
+    "MaretronDometicHvacControlStatus.ConfigurationMode": &fieldSpec_MaretronDometicHvacControlStatus_ConfigurationMode,
+    "MaretronDometicHvacControlStatus.Status": &fieldSpec_MaretronDometicHvacControlStatus_Status,
+    "MaretronDometicHvacControlStatus.FanModeSpeed": &fieldSpec_MaretronDometicHvacControlStatus_FanModeSpeed,
+    "MaretronDometicHvacControlStatus.SetpointTemperature": &fieldSpec_MaretronDometicHvacControlStatus_SetpointTemperature,
+    "MaretronDometicHvacControlStatus.AmbientTemperature": &fieldSpec_MaretronDometicHvacControlStatus_AmbientTemperature,
+    "MaretronDometicHvacControlStatus.OutdoorTemperature": &fieldSpec_MaretronDometicHvacControlStatus_OutdoorTemperature,
+    "MaretronDometicHvacControlStatus.FaultStatus": &fieldSpec_MaretronDometicHvacControlStatus_FaultStatus,
+    "MaretronDometicHvacControlStatus.AdditionalSensorTemperature": &fieldSpec_MaretronDometicHvacControlStatus_AdditionalSensorTemperature,
+    "MercuryEngineStatus.ManufacturerCode": &fieldSpec_MercuryEngineStatus_ManufacturerCode,
+    "MercuryEngineStatus.IndustryCode": &fieldSpec_MercuryEngineStatus_IndustryCode,
+    "MaretronDometicHvacStatus.ManufacturerCode": &fieldSpec_MaretronDometicHvacStatus_ManufacturerCode,
+    "MaretronDometicHvacStatus.IndustryCode": &fieldSpec_MaretronDometicHvacStatus_IndustryCode,
+    "MaretronDometicHvacStatus.AdditionalSensorTemperature": &fieldSpec_MaretronDometicHvacStatus_AdditionalSensorTemperature,
+    "MaretronDometicHvacStatus.CANID": &fieldSpec_MaretronDometicHvacStatus_CANID,
+    "MaretronDometicHvacStatus.State": &fieldSpec_MaretronDometicHvacStatus_State,
+    "MaretronDometicHvacStatus.HardwareStatus": &fieldSpec_MaretronDometicHvacStatus_HardwareStatus,
+    "MaretronDometicHvacStatus.Faults": &fieldSpec_MaretronDometicHvacStatus_Faults,
+    "MaretronDometicHvacStatus.LineVoltage": &fieldSpec_MaretronDometicHvacStatus_LineVoltage,
+    "MaretronDometicHvacStatus.CompressorCurrent": &fieldSpec_MaretronDometicHvacStatus_CompressorCurrent,
+    "SuzukiEngineData.ManufacturerCode": &fieldSpec_SuzukiEngineData_ManufacturerCode,
+    "SuzukiEngineData.IndustryCode": &fieldSpec_SuzukiEngineData_IndustryCode,
+    "MaretronUniversalConfigurationFp.ManufacturerCode": &fieldSpec_MaretronUniversalConfigurationFp_ManufacturerCode,
+    "MaretronUniversalConfigurationFp.IndustryCode": &fieldSpec_MaretronUniversalConfigurationFp_IndustryCode,
+    "SuzukiEngineAndStorageDeviceConfig.ManufacturerCode": &fieldSpec_SuzukiEngineAndStorageDeviceConfig_ManufacturerCode,
+    "SuzukiEngineAndStorageDeviceConfig.IndustryCode": &fieldSpec_SuzukiEngineAndStorageDeviceConfig_IndustryCode,
+    "MaretronVesselOperatingMode.ManufacturerCode": &fieldSpec_MaretronVesselOperatingMode_ManufacturerCode,
+    "MaretronVesselOperatingMode.IndustryCode": &fieldSpec_MaretronVesselOperatingMode_IndustryCode,
+    "MaretronVesselOperatingMode.AlertSystem": &fieldSpec_MaretronVesselOperatingMode_AlertSystem,
+    "MaretronVesselOperatingMode.AlertSubSystem": &fieldSpec_MaretronVesselOperatingMode_AlertSubSystem,
+    "MaretronVesselOperatingMode.AlertSystemInstance": &fieldSpec_MaretronVesselOperatingMode_AlertSystemInstance,
+    "MaretronVesselOperatingMode.OperatingMode": &fieldSpec_MaretronVesselOperatingMode_OperatingMode,
+    "MaretronVesselOperatingMode.GeneratingGlobalAlerts": &fieldSpec_MaretronVesselOperatingMode_GeneratingGlobalAlerts,
+    "MaretronVesselOperatingMode.UserChanged": &fieldSpec_MaretronVesselOperatingMode_UserChanged,
+    "MaretronVesselOperatingMode.InIndependentMode": &fieldSpec_MaretronVesselOperatingMode_InIndependentMode,
+    "SimnetFuelUsedHighResolution.ManufacturerCode": &fieldSpec_SimnetFuelUsedHighResolution_ManufacturerCode,
+    "SimnetFuelUsedHighResolution.IndustryCode": &fieldSpec_SimnetFuelUsedHighResolution_IndustryCode,
+    "MaretronVesselDataRecorderStatus.ManufacturerCode": &fieldSpec_MaretronVesselDataRecorderStatus_ManufacturerCode,
+    "MaretronVesselDataRecorderStatus.IndustryCode": &fieldSpec_MaretronVesselDataRecorderStatus_IndustryCode,
+    "MaretronVesselDataRecorderStatus.VdrRecordingStatus": &fieldSpec_MaretronVesselDataRecorderStatus_VdrRecordingStatus,
+    "MaretronVesselDataRecorderStatus.MemoryCapacity": &fieldSpec_MaretronVesselDataRecorderStatus_MemoryCapacity,
+    "MaretronVesselDataRecorderStatus.MemoryUsed": &fieldSpec_MaretronVesselDataRecorderStatus_MemoryUsed,
+    "BGUserAndRemoteRename.ManufacturerCode": &fieldSpec_BGUserAndRemoteRename_ManufacturerCode,
+    "BGUserAndRemoteRename.IndustryCode": &fieldSpec_BGUserAndRemoteRename_IndustryCode,
+    "BGUserAndRemoteRename.DataType": &fieldSpec_BGUserAndRemoteRename_DataType,
+    "BGUserAndRemoteRename.Length": &fieldSpec_BGUserAndRemoteRename_Length,
+    "FurunoShipParametersAndAntennaPosition.ManufacturerCode": &fieldSpec_FurunoShipParametersAndAntennaPosition_ManufacturerCode,
+    "FurunoShipParametersAndAntennaPosition.IndustryCode": &fieldSpec_FurunoShipParametersAndAntennaPosition_IndustryCode,
+    "FurunoShipParametersAndAntennaPosition.EquipmentIdentification": &fieldSpec_FurunoShipParametersAndAntennaPosition_EquipmentIdentification,
+    "FurunoShipParametersAndAntennaPosition.AntennaPositionX": &fieldSpec_FurunoShipParametersAndAntennaPosition_AntennaPositionX,
+    "FurunoShipParametersAndAntennaPosition.AntennaPositionY": &fieldSpec_FurunoShipParametersAndAntennaPosition_AntennaPositionY,
+    "FurunoShipParametersAndAntennaPosition.AntennaPositionZ": &fieldSpec_FurunoShipParametersAndAntennaPosition_AntennaPositionZ,
+    "FurunoShipParametersAndAntennaPosition.ShipSWidth": &fieldSpec_FurunoShipParametersAndAntennaPosition_ShipSWidth,
+    "FurunoShipParametersAndAntennaPosition.ShipSLength": &fieldSpec_FurunoShipParametersAndAntennaPosition_ShipSLength,
+    "FurunoShipParametersAndAntennaPosition.ShipSHeight": &fieldSpec_FurunoShipParametersAndAntennaPosition_ShipSHeight,
+    "MaretronSmsStatus.ManufacturerCode": &fieldSpec_MaretronSmsStatus_ManufacturerCode,
+    "MaretronSmsStatus.IndustryCode": &fieldSpec_MaretronSmsStatus_IndustryCode,
+    "MaretronSmsStatus.SimCardStatus": &fieldSpec_MaretronSmsStatus_SimCardStatus,
+    "MaretronSmsStatus.GsmBand": &fieldSpec_MaretronSmsStatus_GsmBand,
+    "MaretronSmsStatus.SignalStrength": &fieldSpec_MaretronSmsStatus_SignalStrength,
+    "MaretronSmsStatus.BitErrorRate": &fieldSpec_MaretronSmsStatus_BitErrorRate,
+    "SimnetEngineAndTankConfiguration.ManufacturerCode": &fieldSpec_SimnetEngineAndTankConfiguration_ManufacturerCode,
+    "SimnetEngineAndTankConfiguration.IndustryCode": &fieldSpec_SimnetEngineAndTankConfiguration_IndustryCode,
+    "FurunoSpeedCalculationPosition.ManufacturerCode": &fieldSpec_FurunoSpeedCalculationPosition_ManufacturerCode,
+    "FurunoSpeedCalculationPosition.IndustryCode": &fieldSpec_FurunoSpeedCalculationPosition_IndustryCode,
+    "FurunoSpeedCalculationPosition.PointIndex": &fieldSpec_FurunoSpeedCalculationPosition_PointIndex,
+    "FurunoSpeedCalculationPosition.PositionY": &fieldSpec_FurunoSpeedCalculationPosition_PositionY,
+    "FurunoSpeedCalculationPosition.PositionZ": &fieldSpec_FurunoSpeedCalculationPosition_PositionZ,
+    "MaretronSmsTextMessage.ManufacturerCode": &fieldSpec_MaretronSmsTextMessage_ManufacturerCode,
+    "MaretronSmsTextMessage.IndustryCode": &fieldSpec_MaretronSmsTextMessage_IndustryCode,
+    "MaretronSmsTextMessage.MessageType": &fieldSpec_MaretronSmsTextMessage_MessageType,
+    "SimnetSetEngineAndTankConfiguration.ManufacturerCode": &fieldSpec_SimnetSetEngineAndTankConfiguration_ManufacturerCode,
+    "SimnetSetEngineAndTankConfiguration.IndustryCode": &fieldSpec_SimnetSetEngineAndTankConfiguration_IndustryCode,
+    "SimnetFluidLevelSensorConfiguration.ManufacturerCode": &fieldSpec_SimnetFluidLevelSensorConfiguration_ManufacturerCode,
+    "SimnetFluidLevelSensorConfiguration.IndustryCode": &fieldSpec_SimnetFluidLevelSensorConfiguration_IndustryCode,
+    "SimnetFluidLevelSensorConfiguration.C": &fieldSpec_SimnetFluidLevelSensorConfiguration_C,
+    "SimnetFluidLevelSensorConfiguration.Device": &fieldSpec_SimnetFluidLevelSensorConfiguration_Device,
+    "SimnetFluidLevelSensorConfiguration.Instance": &fieldSpec_SimnetFluidLevelSensorConfiguration_Instance,
+    "SimnetFluidLevelSensorConfiguration.F": &fieldSpec_SimnetFluidLevelSensorConfiguration_F,
+    "SimnetFluidLevelSensorConfiguration.Capacity": &fieldSpec_SimnetFluidLevelSensorConfiguration_Capacity,
+    "SimnetFluidLevelSensorConfiguration.G": &fieldSpec_SimnetFluidLevelSensorConfiguration_G,
+    "SimnetFluidLevelSensorConfiguration.H": &fieldSpec_SimnetFluidLevelSensorConfiguration_H,
+    "SimnetFluidLevelSensorConfiguration.I": &fieldSpec_SimnetFluidLevelSensorConfiguration_I,
+    "MaretronSwitchStatusCounter.ManufacturerCode": &fieldSpec_MaretronSwitchStatusCounter_ManufacturerCode,
+    "MaretronSwitchStatusCounter.IndustryCode": &fieldSpec_MaretronSwitchStatusCounter_IndustryCode,
+    "MaretronSwitchStatusCounter.Instance": &fieldSpec_MaretronSwitchStatusCounter_Instance,
+    "MaretronSwitchStatusCounter.IndicatorNumber": &fieldSpec_MaretronSwitchStatusCounter_IndicatorNumber,
+    "MaretronSwitchStatusCounter.StartDate": &fieldSpec_MaretronSwitchStatusCounter_StartDate,
+    "MaretronSwitchStatusCounter.StartTime": &fieldSpec_MaretronSwitchStatusCounter_StartTime,
+    "MaretronSwitchStatusCounter.OffCounter": &fieldSpec_MaretronSwitchStatusCounter_OffCounter,
+    "MaretronSwitchStatusCounter.OnCounter": &fieldSpec_MaretronSwitchStatusCounter_OnCounter,
+    "MaretronSwitchStatusCounter.ErrorCounter": &fieldSpec_MaretronSwitchStatusCounter_ErrorCounter,
+    "SuzukiEngineSensorData.ManufacturerCode": &fieldSpec_SuzukiEngineSensorData_ManufacturerCode,
+    "SuzukiEngineSensorData.IndustryCode": &fieldSpec_SuzukiEngineSensorData_IndustryCode,
+    "SimnetFuelFlowTurbineConfiguration.ManufacturerCode": &fieldSpec_SimnetFuelFlowTurbineConfiguration_ManufacturerCode,
+    "SimnetFuelFlowTurbineConfiguration.IndustryCode": &fieldSpec_SimnetFuelFlowTurbineConfiguration_IndustryCode,
+    "MaretronSwitchStatusTimer.ManufacturerCode": &fieldSpec_MaretronSwitchStatusTimer_ManufacturerCode,
+    "MaretronSwitchStatusTimer.IndustryCode": &fieldSpec_MaretronSwitchStatusTimer_IndustryCode,
+    "MaretronSwitchStatusTimer.Instance": &fieldSpec_MaretronSwitchStatusTimer_Instance,
+    "MaretronSwitchStatusTimer.IndicatorNumber": &fieldSpec_MaretronSwitchStatusTimer_IndicatorNumber,
+    "MaretronSwitchStatusTimer.StartDate": &fieldSpec_MaretronSwitchStatusTimer_StartDate,
+    "MaretronSwitchStatusTimer.StartTime": &fieldSpec_MaretronSwitchStatusTimer_StartTime,
+    "MaretronSwitchStatusTimer.AccumulatedOffPeriod": &fieldSpec_MaretronSwitchStatusTimer_AccumulatedOffPeriod,
+    "MaretronSwitchStatusTimer.AccumulatedOnPeriod": &fieldSpec_MaretronSwitchStatusTimer_AccumulatedOnPeriod,
+    "MaretronSwitchStatusTimer.AccumulatedErrorPeriod": &fieldSpec_MaretronSwitchStatusTimer_AccumulatedErrorPeriod,
+    "MaretronBnwas.ManufacturerCode": &fieldSpec_MaretronBnwas_ManufacturerCode,
+    "MaretronBnwas.IndustryCode": &fieldSpec_MaretronBnwas_IndustryCode,
+    "SuzukiFuelManagement.ManufacturerCode": &fieldSpec_SuzukiFuelManagement_ManufacturerCode,
+    "SuzukiFuelManagement.IndustryCode": &fieldSpec_SuzukiFuelManagement_IndustryCode,
+    "SimnetFluidLevelWarning.ManufacturerCode": &fieldSpec_SimnetFluidLevelWarning_ManufacturerCode,
+    "SimnetFluidLevelWarning.IndustryCode": &fieldSpec_SimnetFluidLevelWarning_IndustryCode,
+    "SimnetPressureSensorConfiguration.ManufacturerCode": &fieldSpec_SimnetPressureSensorConfiguration_ManufacturerCode,
+    "SimnetPressureSensorConfiguration.IndustryCode": &fieldSpec_SimnetPressureSensorConfiguration_IndustryCode,
+    "MaretronGenericSensor.ManufacturerCode": &fieldSpec_MaretronGenericSensor_ManufacturerCode,
+    "MaretronGenericSensor.IndustryCode": &fieldSpec_MaretronGenericSensor_IndustryCode,
+    "MaretronGenericSensor.DataInstance": &fieldSpec_MaretronGenericSensor_DataInstance,
+    "MaretronGenericSensor.DataFormat": &fieldSpec_MaretronGenericSensor_DataFormat,
+    "SimnetDataSourceSelection.ManufacturerCode": &fieldSpec_SimnetDataSourceSelection_ManufacturerCode,
+    "SimnetDataSourceSelection.IndustryCode": &fieldSpec_SimnetDataSourceSelection_IndustryCode,
+    "SimnetDataSourceSelection.SourceClass": &fieldSpec_SimnetDataSourceSelection_SourceClass,
+    "SimnetDataSourceSelection.SourceAddress": &fieldSpec_SimnetDataSourceSelection_SourceAddress,
+    "SimnetDataSourceSelection.ChangeCounter": &fieldSpec_SimnetDataSourceSelection_ChangeCounter,
+    "SimnetDataSourceSelection.Source": &fieldSpec_SimnetDataSourceSelection_Source,
+    "MaretronCANFrameForwarding.ManufacturerCode": &fieldSpec_MaretronCANFrameForwarding_ManufacturerCode,
+    "MaretronCANFrameForwarding.IndustryCode": &fieldSpec_MaretronCANFrameForwarding_IndustryCode,
+    "MaretronWindlassOperatingStatus.ManufacturerCode": &fieldSpec_MaretronWindlassOperatingStatus_ManufacturerCode,
+    "MaretronWindlassOperatingStatus.IndustryCode": &fieldSpec_MaretronWindlassOperatingStatus_IndustryCode,
+    "MaretronWindlassOperatingStatus.WindlassOperatingEvents": &fieldSpec_MaretronWindlassOperatingStatus_WindlassOperatingEvents,
+    "MaretronWindlassOperatingStatus.WindlassInstance": &fieldSpec_MaretronWindlassOperatingStatus_WindlassInstance,
+    "MaretronWindlassOperatingStatus.WindlassDirectionControl": &fieldSpec_MaretronWindlassOperatingStatus_WindlassDirectionControl,
+    "MaretronWindlassOperatingStatus.SpeedControl": &fieldSpec_MaretronWindlassOperatingStatus_SpeedControl,
+    "SimnetAISClassBStaticDataMsg24PartA.ManufacturerCode": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_ManufacturerCode,
+    "SimnetAISClassBStaticDataMsg24PartA.IndustryCode": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_IndustryCode,
+    "SimnetAISClassBStaticDataMsg24PartA.MessageID": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_MessageID,
+    "SimnetAISClassBStaticDataMsg24PartA.D": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_D,
+    "SimnetAISClassBStaticDataMsg24PartA.E": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_E,
+    "SimnetAISClassBStaticDataMsg24PartA.UserID": &fieldSpec_SimnetAISClassBStaticDataMsg24PartA_UserID,
+    "FurunoSixDegreesOfFreedomMovement.ManufacturerCode": &fieldSpec_FurunoSixDegreesOfFreedomMovement_ManufacturerCode,
+    "FurunoSixDegreesOfFreedomMovement.IndustryCode": &fieldSpec_FurunoSixDegreesOfFreedomMovement_IndustryCode,
+    "FurunoSixDegreesOfFreedomMovement.A": &fieldSpec_FurunoSixDegreesOfFreedomMovement_A,
+    "FurunoSixDegreesOfFreedomMovement.B": &fieldSpec_FurunoSixDegreesOfFreedomMovement_B,
+    "FurunoSixDegreesOfFreedomMovement.C": &fieldSpec_FurunoSixDegreesOfFreedomMovement_C,
+    "FurunoSixDegreesOfFreedomMovement.D": &fieldSpec_FurunoSixDegreesOfFreedomMovement_D,
+    "FurunoSixDegreesOfFreedomMovement.E": &fieldSpec_FurunoSixDegreesOfFreedomMovement_E,
+    "FurunoSixDegreesOfFreedomMovement.F": &fieldSpec_FurunoSixDegreesOfFreedomMovement_F,
+    "FurunoSixDegreesOfFreedomMovement.G": &fieldSpec_FurunoSixDegreesOfFreedomMovement_G,
+    "FurunoSixDegreesOfFreedomMovement.H": &fieldSpec_FurunoSixDegreesOfFreedomMovement_H,
+    "FurunoSixDegreesOfFreedomMovement.I": &fieldSpec_FurunoSixDegreesOfFreedomMovement_I,
+    "SimnetAISClassBStaticDataMsg24PartB.ManufacturerCode": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_ManufacturerCode,
+    "SimnetAISClassBStaticDataMsg24PartB.IndustryCode": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_IndustryCode,
+    "SimnetAISClassBStaticDataMsg24PartB.MessageID": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_MessageID,
+    "SimnetAISClassBStaticDataMsg24PartB.D": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_D,
+    "SimnetAISClassBStaticDataMsg24PartB.E": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_E,
+    "SimnetAISClassBStaticDataMsg24PartB.UserID": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_UserID,
+    "SimnetAISClassBStaticDataMsg24PartB.Length": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_Length,
+    "SimnetAISClassBStaticDataMsg24PartB.Beam": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_Beam,
+    "SimnetAISClassBStaticDataMsg24PartB.PositionReferenceFromStarboard": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_PositionReferenceFromStarboard,
+    "SimnetAISClassBStaticDataMsg24PartB.PositionReferenceFromBow": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_PositionReferenceFromBow,
+    "SimnetAISClassBStaticDataMsg24PartB.MothershipUserID": &fieldSpec_SimnetAISClassBStaticDataMsg24PartB_MothershipUserID,
+    "SimnetAISSilentMode.ManufacturerCode": &fieldSpec_SimnetAISSilentMode_ManufacturerCode,
+    "SimnetAISSilentMode.IndustryCode": &fieldSpec_SimnetAISSilentMode_IndustryCode,
+    "SimnetAISSilentMode.MessageID": &fieldSpec_SimnetAISSilentMode_MessageID,
+    "SimnetAISSilentMode.D": &fieldSpec_SimnetAISSilentMode_D,
+    "SimnetAISSilentMode.E": &fieldSpec_SimnetAISSilentMode_E,
+    "MaretronWindlassControlCommand.ManufacturerCode": &fieldSpec_MaretronWindlassControlCommand_ManufacturerCode,
+    "MaretronWindlassControlCommand.IndustryCode": &fieldSpec_MaretronWindlassControlCommand_IndustryCode,
+    "FurunoHeelAngleRollInformation.ManufacturerCode": &fieldSpec_FurunoHeelAngleRollInformation_ManufacturerCode,
+    "FurunoHeelAngleRollInformation.IndustryCode": &fieldSpec_FurunoHeelAngleRollInformation_IndustryCode,
+    "FurunoHeelAngleRollInformation.Heel": &fieldSpec_FurunoHeelAngleRollInformation_Heel,
+    "FurunoHeelAngleRollInformation.Field4": &fieldSpec_FurunoHeelAngleRollInformation_Field4,
+    "FurunoHeelAngleRollInformation.Field6": &fieldSpec_FurunoHeelAngleRollInformation_Field6,
+    "FurunoHeelAngleRollInformation.Field8": &fieldSpec_FurunoHeelAngleRollInformation_Field8,
+    "SimnetSonarStatusFrequencyAndDspVoltage.ManufacturerCode": &fieldSpec_SimnetSonarStatusFrequencyAndDspVoltage_ManufacturerCode,
+    "SimnetSonarStatusFrequencyAndDspVoltage.IndustryCode": &fieldSpec_SimnetSonarStatusFrequencyAndDspVoltage_IndustryCode,
+    "CarlingProprietary.ManufacturerCode": &fieldSpec_CarlingProprietary_ManufacturerCode,
+    "CarlingProprietary.IndustryCode": &fieldSpec_CarlingProprietary_IndustryCode,
+    "MaretronDCEnergy.ManufacturerCode": &fieldSpec_MaretronDCEnergy_ManufacturerCode,
+    "MaretronDCEnergy.IndustryCode": &fieldSpec_MaretronDCEnergy_IndustryCode,
+    "FurunoMultiSatsInViewExtended.ManufacturerCode": &fieldSpec_FurunoMultiSatsInViewExtended_ManufacturerCode,
+    "FurunoMultiSatsInViewExtended.IndustryCode": &fieldSpec_FurunoMultiSatsInViewExtended_IndustryCode,
+    "FurunoMultiSatsInViewExtended.ReportType": &fieldSpec_FurunoMultiSatsInViewExtended_ReportType,
+    "FurunoMultiSatsInViewExtended.Antenna": &fieldSpec_FurunoMultiSatsInViewExtended_Antenna,
+    "FurunoMultiSatsInViewExtended.PageType": &fieldSpec_FurunoMultiSatsInViewExtended_PageType,
+    "FurunoMultiSatsInViewExtended.Page": &fieldSpec_FurunoMultiSatsInViewExtended_Page,
+    "FurunoMultiSatsInViewExtended.SatsInUse": &fieldSpec_FurunoMultiSatsInViewExtended_SatsInUse,
+    "FurunoMultiSatsInViewExtended.SatsInView": &fieldSpec_FurunoMultiSatsInViewExtended_SatsInView,
+    "FurunoMultiSatsInViewExtended.Prn": &fieldSpec_FurunoMultiSatsInViewExtended_Prn,
+    "FurunoMultiSatsInViewExtended.Elevation": &fieldSpec_FurunoMultiSatsInViewExtended_Elevation,
+    "FurunoMultiSatsInViewExtended.Azimuth": &fieldSpec_FurunoMultiSatsInViewExtended_Azimuth,
+    "FurunoMultiSatsInViewExtended.Snr": &fieldSpec_FurunoMultiSatsInViewExtended_Snr,
+    "FurunoMultiSatsInViewExtended.RangeResidual": &fieldSpec_FurunoMultiSatsInViewExtended_RangeResidual,
+    "SimnetKeyValue.ManufacturerCode": &fieldSpec_SimnetKeyValue_ManufacturerCode,
+    "SimnetKeyValue.IndustryCode": &fieldSpec_SimnetKeyValue_IndustryCode,
+    "SimnetKeyValue.Address": &fieldSpec_SimnetKeyValue_Address,
+    "SimnetKeyValue.Instance": &fieldSpec_SimnetKeyValue_Instance,
+    "SimnetKeyValue.Source": &fieldSpec_SimnetKeyValue_Source,
+    "SimnetKeyValue.Key": &fieldSpec_SimnetKeyValue_Key,
+    "SimnetParameterSet.ManufacturerCode": &fieldSpec_SimnetParameterSet_ManufacturerCode,
+    "SimnetParameterSet.IndustryCode": &fieldSpec_SimnetParameterSet_IndustryCode,
+    "SimnetParameterSet.Address": &fieldSpec_SimnetParameterSet_Address,
+    "SimnetParameterSet.Instance": &fieldSpec_SimnetParameterSet_Instance,
+    "SimnetParameterSet.Source": &fieldSpec_SimnetParameterSet_Source,
+    "SimnetParameterSet.Key": &fieldSpec_SimnetParameterSet_Key,
+    "SimnetParameterSet.Length": &fieldSpec_SimnetParameterSet_Length,
+    "MaretronBatteryAmpHourRecord.ManufacturerCode": &fieldSpec_MaretronBatteryAmpHourRecord_ManufacturerCode,
+    "MaretronBatteryAmpHourRecord.IndustryCode": &fieldSpec_MaretronBatteryAmpHourRecord_IndustryCode,
+    "FurunoMotionSensorStatusExtended.ManufacturerCode": &fieldSpec_FurunoMotionSensorStatusExtended_ManufacturerCode,
+    "FurunoMotionSensorStatusExtended.IndustryCode": &fieldSpec_FurunoMotionSensorStatusExtended_IndustryCode,
+    "FurunoMotionSensorStatusExtended.Status": &fieldSpec_FurunoMotionSensorStatusExtended_Status,
+    "NavicoAsciiIdentifier.ManufacturerCode": &fieldSpec_NavicoAsciiIdentifier_ManufacturerCode,
+    "NavicoAsciiIdentifier.IndustryCode": &fieldSpec_NavicoAsciiIdentifier_IndustryCode,
+    "SeatalkNodeStatistics.ManufacturerCode": &fieldSpec_SeatalkNodeStatistics_ManufacturerCode,
+    "SeatalkNodeStatistics.IndustryCode": &fieldSpec_SeatalkNodeStatistics_IndustryCode,
+    "SeatalkNodeStatistics.ProductCode": &fieldSpec_SeatalkNodeStatistics_ProductCode,
+    "SeatalkNodeStatistics.Year": &fieldSpec_SeatalkNodeStatistics_Year,
+    "SeatalkNodeStatistics.Month": &fieldSpec_SeatalkNodeStatistics_Month,
+    "SeatalkNodeStatistics.DeviceNumber": &fieldSpec_SeatalkNodeStatistics_DeviceNumber,
+    "SeatalkNodeStatistics.NodeVoltage": &fieldSpec_SeatalkNodeStatistics_NodeVoltage,
+    "SeatalkWaypointInformation.ManufacturerCode": &fieldSpec_SeatalkWaypointInformation_ManufacturerCode,
+    "SeatalkWaypointInformation.IndustryCode": &fieldSpec_SeatalkWaypointInformation_IndustryCode,
+    "SeatalkWaypointInformation.SID": &fieldSpec_SeatalkWaypointInformation_SID,
+    "SeatalkWaypointInformation.BearingToWaypointTrue": &fieldSpec_SeatalkWaypointInformation_BearingToWaypointTrue,
+    "SeatalkWaypointInformation.BearingToWaypointMagnetic": &fieldSpec_SeatalkWaypointInformation_BearingToWaypointMagnetic,
+    "SeatalkWaypointInformation.DistanceToWaypoint": &fieldSpec_SeatalkWaypointInformation_DistanceToWaypoint,
+    "NavicoProprietaryFp.ManufacturerCode": &fieldSpec_NavicoProprietaryFp_ManufacturerCode,
+    "NavicoProprietaryFp.IndustryCode": &fieldSpec_NavicoProprietaryFp_IndustryCode,
+    "SimnetCommandApStandby.ManufacturerCode": &fieldSpec_SimnetCommandApStandby_ManufacturerCode,
+    "SimnetCommandApStandby.IndustryCode": &fieldSpec_SimnetCommandApStandby_IndustryCode,
+    "SimnetCommandApStandby.Address": &fieldSpec_SimnetCommandApStandby_Address,
+    "SimnetCommandApStandby.CommandType": &fieldSpec_SimnetCommandApStandby_CommandType,
+    "SimnetCommandApStandby.Event": &fieldSpec_SimnetCommandApStandby_Event,
+    "SimnetCommandApNodrift.ManufacturerCode": &fieldSpec_SimnetCommandApNodrift_ManufacturerCode,
+    "SimnetCommandApNodrift.IndustryCode": &fieldSpec_SimnetCommandApNodrift_IndustryCode,
+    "SimnetCommandApNodrift.Address": &fieldSpec_SimnetCommandApNodrift_Address,
+    "SimnetCommandApNodrift.CommandType": &fieldSpec_SimnetCommandApNodrift_CommandType,
+    "SimnetCommandApNodrift.Event": &fieldSpec_SimnetCommandApNodrift_Event,
+    "SimnetCommandApWind.ManufacturerCode": &fieldSpec_SimnetCommandApWind_ManufacturerCode,
+    "SimnetCommandApWind.IndustryCode": &fieldSpec_SimnetCommandApWind_IndustryCode,
+    "SimnetCommandApWind.Address": &fieldSpec_SimnetCommandApWind_Address,
+    "SimnetCommandApWind.CommandType": &fieldSpec_SimnetCommandApWind_CommandType,
+    "SimnetCommandApWind.Event": &fieldSpec_SimnetCommandApWind_Event,
+    "SimnetCommandApNav.ManufacturerCode": &fieldSpec_SimnetCommandApNav_ManufacturerCode,
+    "SimnetCommandApNav.IndustryCode": &fieldSpec_SimnetCommandApNav_IndustryCode,
+    "SimnetCommandApNav.Address": &fieldSpec_SimnetCommandApNav_Address,
+    "SimnetCommandApNav.CommandType": &fieldSpec_SimnetCommandApNav_CommandType,
+    "SimnetCommandApNav.Event": &fieldSpec_SimnetCommandApNav_Event,
+    "SimnetCommandApHeading.ManufacturerCode": &fieldSpec_SimnetCommandApHeading_ManufacturerCode,
+    "SimnetCommandApHeading.IndustryCode": &fieldSpec_SimnetCommandApHeading_IndustryCode,
+    "SimnetCommandApHeading.Address": &fieldSpec_SimnetCommandApHeading_Address,
+    "SimnetCommandApHeading.CommandType": &fieldSpec_SimnetCommandApHeading_CommandType,
+    "SimnetCommandApHeading.Event": &fieldSpec_SimnetCommandApHeading_Event,
+    "SimnetCommandApTack.ManufacturerCode": &fieldSpec_SimnetCommandApTack_ManufacturerCode,
+    "SimnetCommandApTack.IndustryCode": &fieldSpec_SimnetCommandApTack_IndustryCode,
+    "SimnetCommandApTack.Address": &fieldSpec_SimnetCommandApTack_Address,
+    "SimnetCommandApTack.CommandType": &fieldSpec_SimnetCommandApTack_CommandType,
+    "SimnetCommandApTack.Event": &fieldSpec_SimnetCommandApTack_Event,
+    "SimnetCommandApTack.UnknownA": &fieldSpec_SimnetCommandApTack_UnknownA,
+    "SimnetCommandApTack.UnknownB": &fieldSpec_SimnetCommandApTack_UnknownB,
+    "SimnetCommandApFollowUp.ManufacturerCode": &fieldSpec_SimnetCommandApFollowUp_ManufacturerCode,
+    "SimnetCommandApFollowUp.IndustryCode": &fieldSpec_SimnetCommandApFollowUp_IndustryCode,
+    "SimnetCommandApFollowUp.Address": &fieldSpec_SimnetCommandApFollowUp_Address,
+    "SimnetCommandApFollowUp.CommandType": &fieldSpec_SimnetCommandApFollowUp_CommandType,
+    "SimnetCommandApFollowUp.Event": &fieldSpec_SimnetCommandApFollowUp_Event,
+    "SimnetCommandApChangeCourse.ManufacturerCode": &fieldSpec_SimnetCommandApChangeCourse_ManufacturerCode,
+    "SimnetCommandApChangeCourse.IndustryCode": &fieldSpec_SimnetCommandApChangeCourse_IndustryCode,
+    "SimnetCommandApChangeCourse.Address": &fieldSpec_SimnetCommandApChangeCourse_Address,
+    "SimnetCommandApChangeCourse.CommandType": &fieldSpec_SimnetCommandApChangeCourse_CommandType,
+    "SimnetCommandApChangeCourse.Event": &fieldSpec_SimnetCommandApChangeCourse_Event,
+    "SimnetCommandApChangeCourse.Angle": &fieldSpec_SimnetCommandApChangeCourse_Angle,
+    "SimnetEventCommandTimer.ManufacturerCode": &fieldSpec_SimnetEventCommandTimer_ManufacturerCode,
+    "SimnetEventCommandTimer.IndustryCode": &fieldSpec_SimnetEventCommandTimer_IndustryCode,
+    "SimnetEventCommandTimer.Address": &fieldSpec_SimnetEventCommandTimer_Address,
+    "SimnetEventCommandTimer.EventType": &fieldSpec_SimnetEventCommandTimer_EventType,
+    "SimnetEventCommandTimer.Parameter1": &fieldSpec_SimnetEventCommandTimer_Parameter1,
+    "SimnetEventCommandTimer.Parameter2": &fieldSpec_SimnetEventCommandTimer_Parameter2,
+    "SimnetEventCommandTimer.Parameter3": &fieldSpec_SimnetEventCommandTimer_Parameter3,
+    "SimnetAlarm.ManufacturerCode": &fieldSpec_SimnetAlarm_ManufacturerCode,
+    "SimnetAlarm.IndustryCode": &fieldSpec_SimnetAlarm_IndustryCode,
+    "SimnetAlarm.Address": &fieldSpec_SimnetAlarm_Address,
+    "SimnetAlarm.EventType": &fieldSpec_SimnetAlarm_EventType,
+    "SimnetAlarm.F": &fieldSpec_SimnetAlarm_F,
+    "SimnetAlarm.G": &fieldSpec_SimnetAlarm_G,
+    "SimnetApCommand.ManufacturerCode": &fieldSpec_SimnetApCommand_ManufacturerCode,
+    "SimnetApCommand.IndustryCode": &fieldSpec_SimnetApCommand_IndustryCode,
+    "SimnetApCommand.Address": &fieldSpec_SimnetApCommand_Address,
+    "SimnetApCommand.CommandType": &fieldSpec_SimnetApCommand_CommandType,
+    "SimnetEvent.ManufacturerCode": &fieldSpec_SimnetEvent_ManufacturerCode,
+    "SimnetEvent.IndustryCode": &fieldSpec_SimnetEvent_IndustryCode,
+    "SimnetEvent.Address": &fieldSpec_SimnetEvent_Address,
+    "SimnetApCommandReplyChangeCourse.ManufacturerCode": &fieldSpec_SimnetApCommandReplyChangeCourse_ManufacturerCode,
+    "SimnetApCommandReplyChangeCourse.IndustryCode": &fieldSpec_SimnetApCommandReplyChangeCourse_IndustryCode,
+    "SimnetApCommandReplyChangeCourse.Address": &fieldSpec_SimnetApCommandReplyChangeCourse_Address,
+    "SimnetApCommandReplyChangeCourse.CommandType": &fieldSpec_SimnetApCommandReplyChangeCourse_CommandType,
+    "SimnetApCommandReplyChangeCourse.Event": &fieldSpec_SimnetApCommandReplyChangeCourse_Event,
+    "SimnetApCommandReplyChangeCourse.Angle": &fieldSpec_SimnetApCommandReplyChangeCourse_Angle,
+    "SimnetApCommandReply.ManufacturerCode": &fieldSpec_SimnetApCommandReply_ManufacturerCode,
+    "SimnetApCommandReply.IndustryCode": &fieldSpec_SimnetApCommandReply_IndustryCode,
+    "SimnetApCommandReply.Address": &fieldSpec_SimnetApCommandReply_Address,
+    "SimnetApCommandReply.CommandType": &fieldSpec_SimnetApCommandReply_CommandType,
+    "SimnetApCommandReply.D": &fieldSpec_SimnetApCommandReply_D,
+    "SimnetApCommandReply.Value": &fieldSpec_SimnetApCommandReply_Value,
+    "NavicoDiagnosticData.ManufacturerCode": &fieldSpec_NavicoDiagnosticData_ManufacturerCode,
+    "NavicoDiagnosticData.IndustryCode": &fieldSpec_NavicoDiagnosticData_IndustryCode,
+    "NavicoDiagnosticData.Instance": &fieldSpec_NavicoDiagnosticData_Instance,
+    "NavicoDiagnosticData.FieldID": &fieldSpec_NavicoDiagnosticData_FieldID,
+    "NavicoDiagnosticData.Length": &fieldSpec_NavicoDiagnosticData_Length,
+    "SimnetAlarmMessage.ManufacturerCode": &fieldSpec_SimnetAlarmMessage_ManufacturerCode,
+    "SimnetAlarmMessage.IndustryCode": &fieldSpec_SimnetAlarmMessage_IndustryCode,
+    "SimnetAlarmMessage.B": &fieldSpec_SimnetAlarmMessage_B,
+    "SimnetAlarmMessage.C": &fieldSpec_SimnetAlarmMessage_C,
+    "SimnetApUnknown4.ManufacturerCode": &fieldSpec_SimnetApUnknown4_ManufacturerCode,
+    "SimnetApUnknown4.IndustryCode": &fieldSpec_SimnetApUnknown4_IndustryCode,
+    "SimnetApUnknown4.A": &fieldSpec_SimnetApUnknown4_A,
+    "SimnetApUnknown4.B": &fieldSpec_SimnetApUnknown4_B,
+    "SimnetApUnknown4.C": &fieldSpec_SimnetApUnknown4_C,
+    "SimnetApUnknown4.D": &fieldSpec_SimnetApUnknown4_D,
+    "SimnetApUnknown4.E": &fieldSpec_SimnetApUnknown4_E,
+    "SimnetApUnknown4.F": &fieldSpec_SimnetApUnknown4_F,
+    "AirmarAdditionalWeatherData.ManufacturerCode": &fieldSpec_AirmarAdditionalWeatherData_ManufacturerCode,
+    "AirmarAdditionalWeatherData.IndustryCode": &fieldSpec_AirmarAdditionalWeatherData_IndustryCode,
+    "AirmarAdditionalWeatherData.C": &fieldSpec_AirmarAdditionalWeatherData_C,
+    "AirmarAdditionalWeatherData.ApparentWindchillTemperature": &fieldSpec_AirmarAdditionalWeatherData_ApparentWindchillTemperature,
+    "AirmarAdditionalWeatherData.TrueWindchillTemperature": &fieldSpec_AirmarAdditionalWeatherData_TrueWindchillTemperature,
+    "AirmarAdditionalWeatherData.Dewpoint": &fieldSpec_AirmarAdditionalWeatherData_Dewpoint,
+    "AirmarHeaterControl.ManufacturerCode": &fieldSpec_AirmarHeaterControl_ManufacturerCode,
+    "AirmarHeaterControl.IndustryCode": &fieldSpec_AirmarHeaterControl_IndustryCode,
+    "AirmarHeaterControl.C": &fieldSpec_AirmarHeaterControl_C,
+    "AirmarHeaterControl.PlateTemperature": &fieldSpec_AirmarHeaterControl_PlateTemperature,
+    "AirmarHeaterControl.AirTemperature": &fieldSpec_AirmarHeaterControl_AirTemperature,
+    "AirmarHeaterControl.Dewpoint": &fieldSpec_AirmarHeaterControl_Dewpoint,
+    "XantrexACStatus.ManufacturerCode": &fieldSpec_XantrexACStatus_ManufacturerCode,
+    "XantrexACStatus.IndustryCode": &fieldSpec_XantrexACStatus_IndustryCode,
+    "XantrexACStatus.ACInstance": &fieldSpec_XantrexACStatus_ACInstance,
+    "XantrexACStatus.NumberOfLines": &fieldSpec_XantrexACStatus_NumberOfLines,
+    "XantrexACStatus.Line": &fieldSpec_XantrexACStatus_Line,
+    "XantrexACStatus.Acceptability": &fieldSpec_XantrexACStatus_Acceptability,
+    "XantrexACStatus.Waveform": &fieldSpec_XantrexACStatus_Waveform,
+    "XantrexACStatus.Voltage": &fieldSpec_XantrexACStatus_Voltage,
+    "XantrexACStatus.Current": &fieldSpec_XantrexACStatus_Current,
+    "XantrexACStatus.Frequency": &fieldSpec_XantrexACStatus_Frequency,
+    "XantrexACStatus.RealPower": &fieldSpec_XantrexACStatus_RealPower,
+    "XantrexACStatus.ReactivePower": &fieldSpec_XantrexACStatus_ReactivePower,
+    "XantrexACStatus.PowerFactor": &fieldSpec_XantrexACStatus_PowerFactor,
+    "XantrexDCSourceConfigurationStatus.ManufacturerCode": &fieldSpec_XantrexDCSourceConfigurationStatus_ManufacturerCode,
+    "XantrexDCSourceConfigurationStatus.IndustryCode": &fieldSpec_XantrexDCSourceConfigurationStatus_IndustryCode,
+    "XantrexDCSourceConfigurationStatus.DCSourceInstance": &fieldSpec_XantrexDCSourceConfigurationStatus_DCSourceInstance,
+    "XantrexDCSourceConfigurationStatus.DCUvShutdownLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCUvShutdownLevel,
+    "XantrexDCSourceConfigurationStatus.DCUvWarningLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCUvWarningLevel,
+    "XantrexDCSourceConfigurationStatus.DCUvShutdownDelay": &fieldSpec_XantrexDCSourceConfigurationStatus_DCUvShutdownDelay,
+    "XantrexDCSourceConfigurationStatus.DCUvRecoverLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCUvRecoverLevel,
+    "XantrexDCSourceConfigurationStatus.DCOvShutdownLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCOvShutdownLevel,
+    "XantrexDCSourceConfigurationStatus.DCOvWarningLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCOvWarningLevel,
+    "XantrexDCSourceConfigurationStatus.DCOvShutdownDelay": &fieldSpec_XantrexDCSourceConfigurationStatus_DCOvShutdownDelay,
+    "XantrexDCSourceConfigurationStatus.DCOvRecoverLevel": &fieldSpec_XantrexDCSourceConfigurationStatus_DCOvRecoverLevel,
+    "XantrexACOutputConfigurationStatus.ManufacturerCode": &fieldSpec_XantrexACOutputConfigurationStatus_ManufacturerCode,
+    "XantrexACOutputConfigurationStatus.IndustryCode": &fieldSpec_XantrexACOutputConfigurationStatus_IndustryCode,
+    "XantrexACOutputConfigurationStatus.ACSourceInstance": &fieldSpec_XantrexACOutputConfigurationStatus_ACSourceInstance,
+    "XantrexACOutputConfigurationStatus.NumberOfLines": &fieldSpec_XantrexACOutputConfigurationStatus_NumberOfLines,
+    "XantrexACOutputConfigurationStatus.Line": &fieldSpec_XantrexACOutputConfigurationStatus_Line,
+    "XantrexACOutputConfigurationStatus.Voltage": &fieldSpec_XantrexACOutputConfigurationStatus_Voltage,
+    "XantrexACOutputConfigurationStatus.Frequency": &fieldSpec_XantrexACOutputConfigurationStatus_Frequency,
+    "XantrexACOutputConfigurationStatus.PowerLimit": &fieldSpec_XantrexACOutputConfigurationStatus_PowerLimit,
+    "XantrexACOutputConfigurationStatus.OvFaultLevel": &fieldSpec_XantrexACOutputConfigurationStatus_OvFaultLevel,
+    "XantrexACOutputConfigurationStatus.UvFaultLevel": &fieldSpec_XantrexACOutputConfigurationStatus_UvFaultLevel,
+    "XantrexChargerConfigurationStatus.ManufacturerCode": &fieldSpec_XantrexChargerConfigurationStatus_ManufacturerCode,
+    "XantrexChargerConfigurationStatus.IndustryCode": &fieldSpec_XantrexChargerConfigurationStatus_IndustryCode,
+    "XantrexChargerConfigurationStatus.ChargeInstance": &fieldSpec_XantrexChargerConfigurationStatus_ChargeInstance,
+    "XantrexChargerConfigurationStatus.BatteryInstance": &fieldSpec_XantrexChargerConfigurationStatus_BatteryInstance,
+    "XantrexChargerConfigurationStatus.BulkVoltage": &fieldSpec_XantrexChargerConfigurationStatus_BulkVoltage,
+    "XantrexChargerConfigurationStatus.BulkTime": &fieldSpec_XantrexChargerConfigurationStatus_BulkTime,
+    "XantrexChargerConfigurationStatus.AbsorptionVoltage": &fieldSpec_XantrexChargerConfigurationStatus_AbsorptionVoltage,
+    "XantrexChargerConfigurationStatus.AbsorptionTime": &fieldSpec_XantrexChargerConfigurationStatus_AbsorptionTime,
+    "XantrexChargerConfigurationStatus.FloatVoltage": &fieldSpec_XantrexChargerConfigurationStatus_FloatVoltage,
+    "XantrexChargerConfigurationStatus.FloatTime": &fieldSpec_XantrexChargerConfigurationStatus_FloatTime,
+    "XantrexChargerConfigurationStatus.EqualizationVoltage": &fieldSpec_XantrexChargerConfigurationStatus_EqualizationVoltage,
+    "XantrexChargerConfigurationStatus.GenericChargeVoltage": &fieldSpec_XantrexChargerConfigurationStatus_GenericChargeVoltage,
+    "XantrexChargerConfigurationStatus.GenericChargeCurrent": &fieldSpec_XantrexChargerConfigurationStatus_GenericChargeCurrent,
+    "XantrexACInputConfigurationStatus.ManufacturerCode": &fieldSpec_XantrexACInputConfigurationStatus_ManufacturerCode,
+    "XantrexACInputConfigurationStatus.IndustryCode": &fieldSpec_XantrexACInputConfigurationStatus_IndustryCode,
+    "XantrexACInputConfigurationStatus.ACSourceInstance": &fieldSpec_XantrexACInputConfigurationStatus_ACSourceInstance,
+    "XantrexACInputConfigurationStatus.NumberOfLines": &fieldSpec_XantrexACInputConfigurationStatus_NumberOfLines,
+    "XantrexACInputConfigurationStatus.Line": &fieldSpec_XantrexACInputConfigurationStatus_Line,
+    "XantrexACInputConfigurationStatus.BreakerSize": &fieldSpec_XantrexACInputConfigurationStatus_BreakerSize,
+    "XantrexACInputConfigurationStatus.ACLostLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACLostLevel,
+    "XantrexACInputConfigurationStatus.ACUvLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACUvLevel,
+    "XantrexACInputConfigurationStatus.ACUvWarningLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACUvWarningLevel,
+    "XantrexACInputConfigurationStatus.ACUvDelay": &fieldSpec_XantrexACInputConfigurationStatus_ACUvDelay,
+    "XantrexACInputConfigurationStatus.ACOvLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACOvLevel,
+    "XantrexACInputConfigurationStatus.ACOvWarningLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACOvWarningLevel,
+    "XantrexACInputConfigurationStatus.ACOvDelay": &fieldSpec_XantrexACInputConfigurationStatus_ACOvDelay,
+    "XantrexACInputConfigurationStatus.ACUfLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACUfLevel,
+    "XantrexACInputConfigurationStatus.ACOfLevel": &fieldSpec_XantrexACInputConfigurationStatus_ACOfLevel,
+    "SeatalkRouteInformation.ManufacturerCode": &fieldSpec_SeatalkRouteInformation_ManufacturerCode,
+    "SeatalkRouteInformation.IndustryCode": &fieldSpec_SeatalkRouteInformation_IndustryCode,
+    "SeatalkRouteInformation.CurrentWaypointSequence": &fieldSpec_SeatalkRouteInformation_CurrentWaypointSequence,
+    "SeatalkRouteInformation.NextWaypointSequence": &fieldSpec_SeatalkRouteInformation_NextWaypointSequence,
+    "SeatalkRouteInformation.Unknown": &fieldSpec_SeatalkRouteInformation_Unknown,
+    "SeatalkRouteInformation.DistancePositionToNextWaypoint": &fieldSpec_SeatalkRouteInformation_DistancePositionToNextWaypoint,
+    "SeatalkRouteInformation.BearingPositionToNextWaypointTrue": &fieldSpec_SeatalkRouteInformation_BearingPositionToNextWaypointTrue,
+    "SeatalkRouteInformation.BearingCurrentWaypointToNextWaypointTrue": &fieldSpec_SeatalkRouteInformation_BearingCurrentWaypointToNextWaypointTrue,
+    "CarlingBreakerStatusAndConfiguration.ManufacturerCode": &fieldSpec_CarlingBreakerStatusAndConfiguration_ManufacturerCode,
+    "CarlingBreakerStatusAndConfiguration.IndustryCode": &fieldSpec_CarlingBreakerStatusAndConfiguration_IndustryCode,
+    "CarlingBreakerStatusAndConfiguration.MessageType": &fieldSpec_CarlingBreakerStatusAndConfiguration_MessageType,
+    "LumishoreLightControl.ManufacturerCode": &fieldSpec_LumishoreLightControl_ManufacturerCode,
+    "LumishoreLightControl.IndustryCode": &fieldSpec_LumishoreLightControl_IndustryCode,
+    "LumishoreLightControl.ZoneIndex": &fieldSpec_LumishoreLightControl_ZoneIndex,
+    "LumishoreLightControl.Red": &fieldSpec_LumishoreLightControl_Red,
+    "LumishoreLightControl.Green": &fieldSpec_LumishoreLightControl_Green,
+    "LumishoreLightControl.Blue": &fieldSpec_LumishoreLightControl_Blue,
+    "LumishoreLightControl.ColdWhite": &fieldSpec_LumishoreLightControl_ColdWhite,
+    "LumishoreLightControl.WarmWhite": &fieldSpec_LumishoreLightControl_WarmWhite,
+    "LumishoreLightControl.Intensity": &fieldSpec_LumishoreLightControl_Intensity,
+    "LumishoreLightControl.OnOff": &fieldSpec_LumishoreLightControl_OnOff,
+    "LumishoreLightControl.Status": &fieldSpec_LumishoreLightControl_Status,
+    "AirmarPost.ManufacturerCode": &fieldSpec_AirmarPost_ManufacturerCode,
+    "AirmarPost.IndustryCode": &fieldSpec_AirmarPost_IndustryCode,
+    "AirmarPost.NumberOfIDTestResultPairsToFollow": &fieldSpec_AirmarPost_NumberOfIDTestResultPairsToFollow,
+    "AirmarPost.TestResult": &fieldSpec_AirmarPost_TestResult,
+    "YamahaEngineData.ManufacturerCode": &fieldSpec_YamahaEngineData_ManufacturerCode,
+    "YamahaEngineData.IndustryCode": &fieldSpec_YamahaEngineData_IndustryCode,
+    "YamahaEngineData2.ManufacturerCode": &fieldSpec_YamahaEngineData2_ManufacturerCode,
+    "YamahaEngineData2.IndustryCode": &fieldSpec_YamahaEngineData2_IndustryCode,
+    "YamahaEngineData3.ManufacturerCode": &fieldSpec_YamahaEngineData3_ManufacturerCode,
+    "YamahaEngineData3.IndustryCode": &fieldSpec_YamahaEngineData3_IndustryCode,
+    "YamahaEngineData4.ManufacturerCode": &fieldSpec_YamahaEngineData4_ManufacturerCode,
+    "YamahaEngineData4.IndustryCode": &fieldSpec_YamahaEngineData4_IndustryCode,
+    "YamahaEngineData5.ManufacturerCode": &fieldSpec_YamahaEngineData5_ManufacturerCode,
+    "YamahaEngineData5.IndustryCode": &fieldSpec_YamahaEngineData5_IndustryCode,
+    "YamahaEngineData6.ManufacturerCode": &fieldSpec_YamahaEngineData6_ManufacturerCode,
+    "YamahaEngineData6.IndustryCode": &fieldSpec_YamahaEngineData6_IndustryCode,
+    "YamahaEngineData7.ManufacturerCode": &fieldSpec_YamahaEngineData7_ManufacturerCode,
+    "YamahaEngineData7.IndustryCode": &fieldSpec_YamahaEngineData7_IndustryCode,
+    "YanmarThrottleControl.ManufacturerCode": &fieldSpec_YanmarThrottleControl_ManufacturerCode,
+    "YanmarThrottleControl.IndustryCode": &fieldSpec_YanmarThrottleControl_IndustryCode,
+    "YanmarThrottleControl.UnknownControlFlag": &fieldSpec_YanmarThrottleControl_UnknownControlFlag,
+    "YanmarThrottleControl.ThrottlePosition": &fieldSpec_YanmarThrottleControl_ThrottlePosition,
+    "FusionMenuActionCommand.ManufacturerCode": &fieldSpec_FusionMenuActionCommand_ManufacturerCode,
+    "FusionMenuActionCommand.IndustryCode": &fieldSpec_FusionMenuActionCommand_IndustryCode,
+    "FusionMenuActionCommand.ProprietaryID": &fieldSpec_FusionMenuActionCommand_ProprietaryID,
+    "FusionMenuActionCommand.SourceID": &fieldSpec_FusionMenuActionCommand_SourceID,
+    "FusionMenuActionCommand.ItemIndex": &fieldSpec_FusionMenuActionCommand_ItemIndex,
+    "FusionMenuActionCommand.Action": &fieldSpec_FusionMenuActionCommand_Action,
+    "FusionMenuActionCommand.LockID": &fieldSpec_FusionMenuActionCommand_LockID,
+    "FusionRequestMenuCount.ManufacturerCode": &fieldSpec_FusionRequestMenuCount_ManufacturerCode,
+    "FusionRequestMenuCount.IndustryCode": &fieldSpec_FusionRequestMenuCount_IndustryCode,
+    "FusionRequestMenuCount.ProprietaryID": &fieldSpec_FusionRequestMenuCount_ProprietaryID,
+    "FusionRequestMenuCount.SourceID": &fieldSpec_FusionRequestMenuCount_SourceID,
+    "FusionRequestMenuCount.LockID": &fieldSpec_FusionRequestMenuCount_LockID,
+    "FusionRequestMenuItems.ManufacturerCode": &fieldSpec_FusionRequestMenuItems_ManufacturerCode,
+    "FusionRequestMenuItems.IndustryCode": &fieldSpec_FusionRequestMenuItems_IndustryCode,
+    "FusionRequestMenuItems.ProprietaryID": &fieldSpec_FusionRequestMenuItems_ProprietaryID,
+    "FusionRequestMenuItems.SourceID": &fieldSpec_FusionRequestMenuItems_SourceID,
+    "FusionRequestMenuItems.StartIndex": &fieldSpec_FusionRequestMenuItems_StartIndex,
+    "FusionRequestMenuItems.Count": &fieldSpec_FusionRequestMenuItems_Count,
+    "FusionRequestMenuItems.LockID": &fieldSpec_FusionRequestMenuItems_LockID,
+    "FusionMenuActionStatus.ManufacturerCode": &fieldSpec_FusionMenuActionStatus_ManufacturerCode,
+    "FusionMenuActionStatus.IndustryCode": &fieldSpec_FusionMenuActionStatus_IndustryCode,
+    "FusionMenuActionStatus.MessageID": &fieldSpec_FusionMenuActionStatus_MessageID,
+    "FusionMenuActionStatus.SourceID": &fieldSpec_FusionMenuActionStatus_SourceID,
+    "FusionMenuActionStatus.ItemIndex": &fieldSpec_FusionMenuActionStatus_ItemIndex,
+    "FusionMenuActionStatus.Action": &fieldSpec_FusionMenuActionStatus_Action,
+    "FusionMenuActionStatus.LockID": &fieldSpec_FusionMenuActionStatus_LockID,
+    "FusionMenuCount.ManufacturerCode": &fieldSpec_FusionMenuCount_ManufacturerCode,
+    "FusionMenuCount.IndustryCode": &fieldSpec_FusionMenuCount_IndustryCode,
+    "FusionMenuCount.MessageID": &fieldSpec_FusionMenuCount_MessageID,
+    "FusionMenuCount.SourceID": &fieldSpec_FusionMenuCount_SourceID,
+    "FusionMenuCount.Count": &fieldSpec_FusionMenuCount_Count,
+    "FusionMenuCount.LockID": &fieldSpec_FusionMenuCount_LockID,
+}
+
+// stringFieldMaxBytes maps "<PGN struct>.<field>" to the longest value a string field can carry.
+var stringFieldMaxBytes = map[string]int{
+    "AlertText.AlertTextDescription": 253,
+    "AlertText.AlertLocationTextDescription": 253,
+    "ProductInformation.ModelID": 32,
+    "ProductInformation.SoftwareVersionCode": 32,
+    "ProductInformation.ModelVersion": 32,
+    "ProductInformation.ModelSerialCode": 32,
+    "ConfigurationInformation.InstallationDescription1": 253,
+    "ConfigurationInformation.InstallationDescription2": 253,
+    "ConfigurationInformation.ManufacturerInformation": 253,
+    "EngineParametersStatic.Vin": 253,
+    "EngineParametersStatic.SoftwareID": 253,
+    "TrackedTargetData.Name": 253,
+    "AISClassBExtendedPositionReport.Name": 20,
+    "AISAidsToNavigationATONReport.ATONName": 253,
+    "Datum.LocalDatum": 4,
+    "Datum.ReferenceDatum": 4,
+    "UserDatum.DatumName": 4,
+    "NavigationRouteWPInformation.RouteName": 253,
+    "NavigationRouteWPInformation.WPName": 253,
+    "AISClassAStaticAndVoyageRelatedData.Callsign": 7,
+    "AISClassAStaticAndVoyageRelatedData.Name": 20,
+    "AISClassAStaticAndVoyageRelatedData.Destination": 20,
+    "RadioFrequencyModePower.RadioChannel": 6,
+    "AISAddressedSafetyRelatedMessage.SafetyRelatedText": 253,
+    "AISSafetyRelatedBroadcastMessage.SafetyRelatedText": 253,
+    "DSCDistressCallInformation.ProposedRxFrequencyChannel": 6,
+    "DSCDistressCallInformation.ProposedTxFrequencyChannel": 6,
+    "DSCDistressCallInformation.TelephoneNumber": 253,
+    "DSCDistressCallInformation.CallingRxFrequencyChannel": 6,
+    "DSCDistressCallInformation.CallingTxFrequencyChannel": 6,
+    "DSCDistressCallInformation.DSCExpansionFieldData": 253,
+    "DSCCallInformation.ProposedRxFrequencyChannel": 6,
+    "DSCCallInformation.ProposedTxFrequencyChannel": 6,
+    "DSCCallInformation.TelephoneNumber": 253,
+    "DSCCallInformation.CallingRxFrequencyChannel": 6,
+    "DSCCallInformation.CallingTxFrequencyChannel": 6,
+    "DSCCallInformation.DSCExpansionFieldData": 253,
+    "AISClassBStaticDataMsg24PartA.Name": 20,
+    "AISClassBStaticDataMsg24PartB.VendorID": 7,
+    "AISClassBStaticDataMsg24PartB.Callsign": 7,
+    "LoranCSignalData.StationIdentifier": 1,
+    "Label.Label": 253,
+    "RouteAndWPServiceDatabaseList.DatabaseName": 253,
+    "RouteAndWPServiceRouteList.RouteName": 253,
+    "RouteAndWPServiceRouteWPListAttributes.RouteWPListName": 253,
+    "RouteAndWPServiceRouteWPNamePosition.WPName": 253,
+    "RouteAndWPServiceRouteWPName.WPName": 253,
+    "RouteAndWPServiceWPComment.Comment": 253,
+    "RouteAndWPServiceRouteComment.Comment": 253,
+    "RouteAndWPServiceDatabaseComment.Comment": 253,
+    "RouteAndWPServiceWPListWPNamePosition.WPName": 253,
+    "TideStationData.StationID": 253,
+    "TideStationData.StationName": 253,
+    "SalinityStationData.StationID": 253,
+    "SalinityStationData.StationName": 253,
+    "CurrentStationData.StationID": 253,
+    "CurrentStationData.StationName": 253,
+    "MeteorologicalStationData.StationID": 253,
+    "MeteorologicalStationData.StationName": 253,
+    "MooredBuoyStationData.StationID": 253,
+    "LightingSystemSettings.NameOfTheLightingController": 253,
+    "LightingZone.ZoneName": 253,
+    "LightingScene.ZoneName": 253,
+    "LightingDevice.NameOfLightingDevice": 253,
+    "LightingProgram.NameOfProgram": 253,
+    "LightingProgram.Description": 253,
+    "EntertainmentDiagnosticStatus.DiagnosticData": 253,
+    "LibraryDataFile.Name": 253,
+    "LibraryDataFile.ArtistName": 253,
+    "LibraryDataFile.AlbumName": 253,
+    "LibraryDataFile.StationName": 253,
+    "LibraryDataGroup.Name": 253,
+    "LibraryDataSearch.GroupName1": 253,
+    "LibraryDataSearch.GroupName2": 253,
+    "LibraryDataSearch.GroupName3": 253,
+    "SupportedSourceData.Name": 253,
+    "SupportedZoneData.Name": 253,
+    "EntertainmentParentalControlStatus.CurrentPincode": 253,
+    "EntertainmentParentalControlStatus.NewPincode": 253,
+    "ZoneConfigurationDeprecated.ZoneName": 253,
+    "AvailableAudioEQPresets.PresetName": 253,
+    "AvailableBluetoothAddresses.DeviceName": 253,
+    "SonichubAmRadio.Text": 30,
+    "SonichubSourceList.Text": 30,
+    "SonichubFmRadio.Text": 30,
+    "SonichubTrack.Text": 30,
+    "SonichubArtist.Text": 30,
+    "SonichubAlbum.Text": 30,
+    "SonichubMenuItem.Text": 30,
+    "FurunoStatusAndVersionReport.Status": 253,
+    "SimradTextMessage.Text": 32,
+    "LowranceProductInformation.Model": 32,
+    "LowranceProductInformation.FirmwareVersion": 10,
+    "LowranceProductInformation.FirmwareDate": 32,
+    "LowranceProductInformation.FirmwareTime": 32,
+    "MaretronLabel.Label": 253,
+    "FusionSource.Source": 253,
+    "FusionTrackName.Track": 253,
+    "FusionArtistName.Artist": 253,
+    "FusionAlbumName.Album": 253,
+    "FusionDeviceName.Name": 253,
+    "FusionZoneName.Name": 253,
+    "FusionRDSData.RDS": 30,
+    "FusionTuner.Track": 253,
+    "FusionMarineTuner.Name": 253,
+    "FusionMenuItem.Text": 253,
+    "FusionSiriusxmChannel.Channel": 253,
+    "FusionSiriusxmTitle.Title": 253,
+    "FusionSiriusxmArtist.Artist": 253,
+    "FusionSiriusxmContentInfo.Genre": 253,
+    "FusionSiriusxmCategory.Name": 253,
+    "NavicoAsciiData.Message": 230,
+    "MaretronAlertText.AlertTextDescription": 253,
+    "MaretronAlertText.AlertLocationTextDescription": 253,
+    "BGUserAndRemoteRename.ShortName": 8,
+    "BGUserAndRemoteRename.LongName": 16,
+    "MaretronSmsStatus.SimCardPhoneNumber": 253,
+    "MaretronSmsStatus.NetworkOperatorName": 253,
+    "MaretronSmsTextMessage.PhoneNumber": 253,
+    "MaretronSmsTextMessage.Message": 253,
+    "SimnetAISClassBStaticDataMsg24PartA.Name": 20,
+    "SimnetAISClassBStaticDataMsg24PartB.VendorID": 7,
+    "SimnetAISClassBStaticDataMsg24PartB.Callsign": 7,
+    "NavicoAsciiIdentifier.Identifier": 253,
+    "SeatalkWaypointInformation.WaypointName": 16,
+    "SeatalkWaypointInformation.WaypointSequence": 4,
+    "SimnetAlarmMessage.Text": 223,
+    "SeatalkRouteInformation.CurrentWaypointName": 16,
+    "SeatalkRouteInformation.NextWaypointName": 16,
+}
+
+// fieldValueKinds maps PGN IDs to the encoding of each top-level field by one-based order.
+var fieldValueKinds = map[uint32]map[uint8]fieldValueKindEntry{
+    126208: {
+        1: {Kind: fieldValueBinary, BitLength: 1784},
+        2: {Kind: fieldValueNumber, BitLength: 24},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    126464: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+    },
+    126720: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueReserved, BitLength: 8},
+        10: {Kind: fieldValueBinary, BitLength: 0},
+        11: {Kind: fieldValueReserved, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 16},
+        17: {Kind: fieldValueNumber, BitLength: 4},
+        18: {Kind: fieldValueNumber, BitLength: 4},
+    },
+    126976: {
+        1: {Kind: fieldValueBinary, BitLength: 1784},
+    },
+    126983: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueLookup, BitLength: 1},
+        11: {Kind: fieldValueLookup, BitLength: 1},
+        12: {Kind: fieldValueLookup, BitLength: 1},
+        13: {Kind: fieldValueLookup, BitLength: 1},
+        14: {Kind: fieldValueLookup, BitLength: 1},
+        15: {Kind: fieldValueLookup, BitLength: 1},
+        16: {Kind: fieldValueReserved, BitLength: 2},
+        17: {Kind: fieldValueNumber, BitLength: 64},
+        18: {Kind: fieldValueLookup, BitLength: 4},
+        19: {Kind: fieldValueLookup, BitLength: 4},
+        20: {Kind: fieldValueNumber, BitLength: 8},
+        21: {Kind: fieldValueLookup, BitLength: 8},
+    },
+    126984: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 64},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    126985: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueLookup, BitLength: 8},
+        11: {Kind: fieldValueStringLAU, BitLength: 0},
+        12: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    126986: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 2},
+        11: {Kind: fieldValueNumber, BitLength: 2},
+        12: {Kind: fieldValueReserved, BitLength: 4},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueNumber, BitLength: 8},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    126987: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    126988: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    126992: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    126993: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 34},
+    },
+    126996: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueStringFix, BitLength: 256},
+        4: {Kind: fieldValueStringFix, BitLength: 256},
+        5: {Kind: fieldValueStringFix, BitLength: 256},
+        6: {Kind: fieldValueStringFix, BitLength: 256},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    126998: {
+        1: {Kind: fieldValueStringLAU, BitLength: 0},
+        2: {Kind: fieldValueStringLAU, BitLength: 0},
+        3: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    127233: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 5},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 3},
+        7: {Kind: fieldValueReserved, BitLength: 5},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 32},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueReserved, BitLength: 6},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 32},
+        17: {Kind: fieldValueLookup, BitLength: 3},
+        18: {Kind: fieldValueReserved, BitLength: 5},
+    },
+    127237: {
+        1: {Kind: fieldValueLookup, BitLength: 2},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 3},
+        6: {Kind: fieldValueLookup, BitLength: 3},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueReserved, BitLength: 5},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 16},
+        17: {Kind: fieldValueNumber, BitLength: 16},
+        18: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127245: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 3},
+        3: {Kind: fieldValueReserved, BitLength: 5},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    127250: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    127251: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    127252: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueReserved, BitLength: 40},
+    },
+    127257: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    127258: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    127488: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    127489: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueReserved, BitLength: 8},
+        11: {Kind: fieldValueLookup, BitLength: 16},
+        12: {Kind: fieldValueLookup, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127490: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127491: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 4},
+        10: {Kind: fieldValueNumber, BitLength: 4},
+    },
+    127493: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 6},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    127494: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    127495: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 2},
+        13: {Kind: fieldValueNumber, BitLength: 2},
+        14: {Kind: fieldValueReserved, BitLength: 4},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+        16: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127496: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    127497: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127498: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueStringLAU, BitLength: 0},
+        4: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    127500: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127501: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueLookup, BitLength: 2},
+        15: {Kind: fieldValueLookup, BitLength: 2},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueLookup, BitLength: 2},
+        18: {Kind: fieldValueLookup, BitLength: 2},
+        19: {Kind: fieldValueLookup, BitLength: 2},
+        20: {Kind: fieldValueLookup, BitLength: 2},
+        21: {Kind: fieldValueLookup, BitLength: 2},
+        22: {Kind: fieldValueLookup, BitLength: 2},
+        23: {Kind: fieldValueLookup, BitLength: 2},
+        24: {Kind: fieldValueLookup, BitLength: 2},
+        25: {Kind: fieldValueLookup, BitLength: 2},
+        26: {Kind: fieldValueLookup, BitLength: 2},
+        27: {Kind: fieldValueLookup, BitLength: 2},
+        28: {Kind: fieldValueLookup, BitLength: 2},
+        29: {Kind: fieldValueLookup, BitLength: 2},
+    },
+    127502: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueLookup, BitLength: 2},
+        15: {Kind: fieldValueLookup, BitLength: 2},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueLookup, BitLength: 2},
+        18: {Kind: fieldValueLookup, BitLength: 2},
+        19: {Kind: fieldValueLookup, BitLength: 2},
+        20: {Kind: fieldValueLookup, BitLength: 2},
+        21: {Kind: fieldValueLookup, BitLength: 2},
+        22: {Kind: fieldValueLookup, BitLength: 2},
+        23: {Kind: fieldValueLookup, BitLength: 2},
+        24: {Kind: fieldValueLookup, BitLength: 2},
+        25: {Kind: fieldValueLookup, BitLength: 2},
+        26: {Kind: fieldValueLookup, BitLength: 2},
+        27: {Kind: fieldValueLookup, BitLength: 2},
+        28: {Kind: fieldValueLookup, BitLength: 2},
+        29: {Kind: fieldValueLookup, BitLength: 2},
+    },
+    127503: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127504: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127505: {
+        1: {Kind: fieldValueNumber, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    127506: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127507: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueLookup, BitLength: 4},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 4},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127508: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127509: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueLookup, BitLength: 4},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    127510: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 6},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 4},
+        7: {Kind: fieldValueLookup, BitLength: 4},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127511: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127512: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    127513: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueLookup, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    127514: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueLookup, BitLength: 4},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+    },
+    127744: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    127745: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    127746: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    127747: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127748: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127749: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    127750: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueReserved, BitLength: 32},
+    },
+    127751: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    128000: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueReserved, BitLength: 40},
+    },
+    128001: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    128002: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 4},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    128003: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 4},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    128006: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    128007: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    128008: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    128259: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 4},
+        6: {Kind: fieldValueReserved, BitLength: 12},
+    },
+    128267: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    128275: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    128520: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 1},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 32},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueStringLAU, BitLength: 0},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    128538: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 2},
+        5: {Kind: fieldValueNumber, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 2},
+        9: {Kind: fieldValueNumber, BitLength: 2},
+        10: {Kind: fieldValueNumber, BitLength: 2},
+        11: {Kind: fieldValueNumber, BitLength: 2},
+        12: {Kind: fieldValueNumber, BitLength: 2},
+        13: {Kind: fieldValueNumber, BitLength: 2},
+        14: {Kind: fieldValueReserved, BitLength: 4},
+        15: {Kind: fieldValueNumber, BitLength: 2},
+        16: {Kind: fieldValueNumber, BitLength: 2},
+        17: {Kind: fieldValueNumber, BitLength: 2},
+        18: {Kind: fieldValueNumber, BitLength: 2},
+        19: {Kind: fieldValueNumber, BitLength: 2},
+        20: {Kind: fieldValueNumber, BitLength: 2},
+        21: {Kind: fieldValueReserved, BitLength: 4},
+        22: {Kind: fieldValueNumber, BitLength: 8},
+        23: {Kind: fieldValueNumber, BitLength: 8},
+        24: {Kind: fieldValueNumber, BitLength: 8},
+        25: {Kind: fieldValueNumber, BitLength: 16},
+        26: {Kind: fieldValueNumber, BitLength: 16},
+        27: {Kind: fieldValueNumber, BitLength: 16},
+        28: {Kind: fieldValueNumber, BitLength: 16},
+        29: {Kind: fieldValueNumber, BitLength: 16},
+        30: {Kind: fieldValueNumber, BitLength: 2},
+        31: {Kind: fieldValueNumber, BitLength: 2},
+        32: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    128768: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 4},
+        5: {Kind: fieldValueNumber, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 34},
+    },
+    128769: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    128776: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueBinary, BitLength: 8},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueLookup, BitLength: 4},
+        14: {Kind: fieldValueReserved, BitLength: 12},
+    },
+    128777: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 6},
+    },
+    128778: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    128780: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    129025: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    129026: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 6},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    129027: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 24},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    129028: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueReserved, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    129029: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 64},
+        5: {Kind: fieldValueNumber, BitLength: 64},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueLookup, BitLength: 4},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueReserved, BitLength: 6},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129033: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129038: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueBinary, BitLength: 19},
+        12: {Kind: fieldValueLookup, BitLength: 5},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueLookup, BitLength: 4},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueReserved, BitLength: 2},
+        18: {Kind: fieldValueReserved, BitLength: 3},
+        19: {Kind: fieldValueReserved, BitLength: 5},
+        20: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129039: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueBinary, BitLength: 19},
+        12: {Kind: fieldValueLookup, BitLength: 5},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueReserved, BitLength: 8},
+        15: {Kind: fieldValueReserved, BitLength: 2},
+        16: {Kind: fieldValueLookup, BitLength: 1},
+        17: {Kind: fieldValueLookup, BitLength: 1},
+        18: {Kind: fieldValueLookup, BitLength: 1},
+        19: {Kind: fieldValueLookup, BitLength: 1},
+        20: {Kind: fieldValueLookup, BitLength: 1},
+        21: {Kind: fieldValueLookup, BitLength: 1},
+        22: {Kind: fieldValueLookup, BitLength: 1},
+        23: {Kind: fieldValueReserved, BitLength: 15},
+    },
+    129040: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueReserved, BitLength: 8},
+        12: {Kind: fieldValueReserved, BitLength: 4},
+        13: {Kind: fieldValueReserved, BitLength: 4},
+        14: {Kind: fieldValueLookup, BitLength: 8},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueReserved, BitLength: 4},
+        17: {Kind: fieldValueLookup, BitLength: 4},
+        18: {Kind: fieldValueNumber, BitLength: 16},
+        19: {Kind: fieldValueNumber, BitLength: 16},
+        20: {Kind: fieldValueNumber, BitLength: 16},
+        21: {Kind: fieldValueNumber, BitLength: 16},
+        22: {Kind: fieldValueStringFix, BitLength: 160},
+        23: {Kind: fieldValueLookup, BitLength: 1},
+        24: {Kind: fieldValueLookup, BitLength: 1},
+        25: {Kind: fieldValueReserved, BitLength: 4},
+        26: {Kind: fieldValueLookup, BitLength: 5},
+        27: {Kind: fieldValueReserved, BitLength: 5},
+    },
+    129041: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueLookup, BitLength: 5},
+        14: {Kind: fieldValueLookup, BitLength: 1},
+        15: {Kind: fieldValueLookup, BitLength: 1},
+        16: {Kind: fieldValueLookup, BitLength: 1},
+        17: {Kind: fieldValueReserved, BitLength: 1},
+        18: {Kind: fieldValueLookup, BitLength: 4},
+        19: {Kind: fieldValueReserved, BitLength: 3},
+        20: {Kind: fieldValueBinary, BitLength: 8},
+        21: {Kind: fieldValueLookup, BitLength: 5},
+        22: {Kind: fieldValueReserved, BitLength: 3},
+        23: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    129044: {
+        1: {Kind: fieldValueStringFix, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueStringFix, BitLength: 32},
+    },
+    129045: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueBinary, BitLength: 0},
+        5: {Kind: fieldValueBinary, BitLength: 0},
+        6: {Kind: fieldValueBinary, BitLength: 0},
+        7: {Kind: fieldValueBinary, BitLength: 0},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueBinary, BitLength: 0},
+        10: {Kind: fieldValueStringFix, BitLength: 32},
+    },
+    129283: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueReserved, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    129284: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 32},
+        12: {Kind: fieldValueNumber, BitLength: 32},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129285: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 3},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 3},
+        8: {Kind: fieldValueStringLAU, BitLength: 0},
+        9: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    129291: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 6},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    129301: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueReserved, BitLength: 4},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    129302: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 4},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueLookup, BitLength: 4},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    129538: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 3},
+        6: {Kind: fieldValueLookup, BitLength: 3},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    129539: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 3},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 2},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129540: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 6},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129541: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueBinary, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 24},
+        9: {Kind: fieldValueNumber, BitLength: 24},
+        10: {Kind: fieldValueNumber, BitLength: 24},
+        11: {Kind: fieldValueNumber, BitLength: 24},
+        12: {Kind: fieldValueNumber, BitLength: 11},
+        13: {Kind: fieldValueNumber, BitLength: 11},
+        14: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    129542: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129545: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 6},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129546: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    129547: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129549: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 12},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueReserved, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129550: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueLookup, BitLength: 5},
+        4: {Kind: fieldValueLookup, BitLength: 3},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueLookup, BitLength: 4},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    129551: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 12},
+        8: {Kind: fieldValueLookup, BitLength: 5},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueReserved, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 4},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129556: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueReserved, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 1},
+        5: {Kind: fieldValueNumber, BitLength: 5},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 24},
+        10: {Kind: fieldValueNumber, BitLength: 24},
+        11: {Kind: fieldValueNumber, BitLength: 24},
+        12: {Kind: fieldValueNumber, BitLength: 24},
+        13: {Kind: fieldValueNumber, BitLength: 28},
+        14: {Kind: fieldValueNumber, BitLength: 12},
+    },
+    129792: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueNumber, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueReserved, BitLength: 3},
+        10: {Kind: fieldValueReserved, BitLength: 5},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129793: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueReserved, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueBinary, BitLength: 19},
+        11: {Kind: fieldValueLookup, BitLength: 5},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueReserved, BitLength: 4},
+        14: {Kind: fieldValueLookup, BitLength: 4},
+    },
+    129794: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueStringFix, BitLength: 56},
+        6: {Kind: fieldValueStringFix, BitLength: 160},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueStringFix, BitLength: 160},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueLookup, BitLength: 4},
+        18: {Kind: fieldValueLookup, BitLength: 1},
+        19: {Kind: fieldValueReserved, BitLength: 1},
+        20: {Kind: fieldValueLookup, BitLength: 5},
+        21: {Kind: fieldValueReserved, BitLength: 3},
+    },
+    129795: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueReserved, BitLength: 6},
+        9: {Kind: fieldValueLookup, BitLength: 1},
+        10: {Kind: fieldValueReserved, BitLength: 1},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129796: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    129797: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129798: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 6},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueBinary, BitLength: 19},
+        12: {Kind: fieldValueLookup, BitLength: 5},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueBinary, BitLength: 8},
+        15: {Kind: fieldValueLookup, BitLength: 1},
+        16: {Kind: fieldValueReserved, BitLength: 5},
+        17: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    129799: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueStringFix, BitLength: 48},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129800: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    129801: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueReserved, BitLength: 6},
+        9: {Kind: fieldValueLookup, BitLength: 1},
+        10: {Kind: fieldValueReserved, BitLength: 1},
+        11: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    129802: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    129803: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueReserved, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 6},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueReserved, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 6},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueReserved, BitLength: 6},
+        15: {Kind: fieldValueReserved, BitLength: 2},
+        16: {Kind: fieldValueNumber, BitLength: 32},
+        17: {Kind: fieldValueReserved, BitLength: 2},
+        18: {Kind: fieldValueLookup, BitLength: 6},
+        19: {Kind: fieldValueNumber, BitLength: 16},
+        20: {Kind: fieldValueReserved, BitLength: 2},
+        21: {Kind: fieldValueReserved, BitLength: 6},
+        22: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129804: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueReserved, BitLength: 4},
+        14: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    129805: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    129806: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 1},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueReserved, BitLength: 3},
+        10: {Kind: fieldValueLookup, BitLength: 1},
+        11: {Kind: fieldValueLookup, BitLength: 4},
+        12: {Kind: fieldValueNumber, BitLength: 32},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueNumber, BitLength: 32},
+        16: {Kind: fieldValueReserved, BitLength: 1},
+        17: {Kind: fieldValueLookup, BitLength: 1},
+        18: {Kind: fieldValueLookup, BitLength: 1},
+        19: {Kind: fieldValueLookup, BitLength: 1},
+        20: {Kind: fieldValueReserved, BitLength: 1},
+        21: {Kind: fieldValueLookup, BitLength: 3},
+        22: {Kind: fieldValueReserved, BitLength: 23},
+        23: {Kind: fieldValueReserved, BitLength: 1},
+    },
+    129807: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueReserved, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueLookup, BitLength: 4},
+        12: {Kind: fieldValueReserved, BitLength: 4},
+        13: {Kind: fieldValueLookup, BitLength: 8},
+        14: {Kind: fieldValueReserved, BitLength: 22},
+        15: {Kind: fieldValueReserved, BitLength: 2},
+        16: {Kind: fieldValueLookup, BitLength: 4},
+        17: {Kind: fieldValueNumber, BitLength: 4},
+        18: {Kind: fieldValueReserved, BitLength: 6},
+        19: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    129808: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 8},
+        3: {Kind: fieldValueBinary, BitLength: 40},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueStringFix, BitLength: 48},
+        7: {Kind: fieldValueStringFix, BitLength: 48},
+        8: {Kind: fieldValueStringLAU, BitLength: 0},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 32},
+        12: {Kind: fieldValueBinary, BitLength: 40},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueLookup, BitLength: 2},
+        15: {Kind: fieldValueReserved, BitLength: 6},
+        16: {Kind: fieldValueStringFix, BitLength: 48},
+        17: {Kind: fieldValueStringFix, BitLength: 48},
+        18: {Kind: fieldValueNumber, BitLength: 32},
+        19: {Kind: fieldValueNumber, BitLength: 16},
+        20: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    129809: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueStringFix, BitLength: 160},
+        5: {Kind: fieldValueLookup, BitLength: 5},
+        6: {Kind: fieldValueReserved, BitLength: 3},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129810: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueStringFix, BitLength: 56},
+        6: {Kind: fieldValueStringFix, BitLength: 56},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 32},
+        12: {Kind: fieldValueReserved, BitLength: 2},
+        13: {Kind: fieldValueReserved, BitLength: 2},
+        14: {Kind: fieldValueLookup, BitLength: 4},
+        15: {Kind: fieldValueLookup, BitLength: 5},
+        16: {Kind: fieldValueReserved, BitLength: 3},
+        17: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    129811: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueLookup, BitLength: 1},
+        6: {Kind: fieldValueNumber, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 5},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129812: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueLookup, BitLength: 1},
+        6: {Kind: fieldValueNumber, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 2},
+        8: {Kind: fieldValueLookup, BitLength: 5},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueReserved, BitLength: 3},
+        11: {Kind: fieldValueNumber, BitLength: 1},
+        12: {Kind: fieldValueBinary, BitLength: 19},
+        13: {Kind: fieldValueReserved, BitLength: 3},
+        14: {Kind: fieldValueReserved, BitLength: 5},
+    },
+    129813: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueLookup, BitLength: 1},
+        8: {Kind: fieldValueLookup, BitLength: 1},
+        9: {Kind: fieldValueLookup, BitLength: 4},
+        10: {Kind: fieldValueNumber, BitLength: 1},
+        11: {Kind: fieldValueReserved, BitLength: 1},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueLookup, BitLength: 5},
+        15: {Kind: fieldValueReserved, BitLength: 3},
+    },
+    129814: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueLookup, BitLength: 1},
+        6: {Kind: fieldValueNumber, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 1},
+        8: {Kind: fieldValueReserved, BitLength: 8},
+        9: {Kind: fieldValueLookup, BitLength: 5},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129815: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueLookup, BitLength: 1},
+        6: {Kind: fieldValueNumber, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueReserved, BitLength: 3},
+        10: {Kind: fieldValueNumber, BitLength: 1},
+        11: {Kind: fieldValueBinary, BitLength: 19},
+        12: {Kind: fieldValueReserved, BitLength: 3},
+        13: {Kind: fieldValueLookup, BitLength: 5},
+        14: {Kind: fieldValueReserved, BitLength: 5},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    129816: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueReserved, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 5},
+        7: {Kind: fieldValueReserved, BitLength: 1},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130052: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 4},
+        10: {Kind: fieldValueLookup, BitLength: 4},
+        11: {Kind: fieldValueLookup, BitLength: 4},
+        12: {Kind: fieldValueLookup, BitLength: 4},
+        13: {Kind: fieldValueLookup, BitLength: 4},
+        14: {Kind: fieldValueLookup, BitLength: 4},
+        15: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    130053: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 4},
+        10: {Kind: fieldValueLookup, BitLength: 4},
+        11: {Kind: fieldValueLookup, BitLength: 4},
+        12: {Kind: fieldValueLookup, BitLength: 4},
+        13: {Kind: fieldValueLookup, BitLength: 4},
+        14: {Kind: fieldValueLookup, BitLength: 4},
+        15: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    130054: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueStringFix, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130060: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 24},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130061: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 2},
+        4: {Kind: fieldValueBinary, BitLength: 12},
+        5: {Kind: fieldValueNumber, BitLength: 64},
+        6: {Kind: fieldValueNumber, BitLength: 24},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130064: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130065: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130066: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueStringLAU, BitLength: 0},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueLookup, BitLength: 8},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 4},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130067: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130068: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130069: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130070: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130071: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130072: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130073: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130074: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    130306: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueLookup, BitLength: 3},
+        5: {Kind: fieldValueReserved, BitLength: 21},
+    },
+    130310: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130311: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 6},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130312: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130313: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130314: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130315: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130316: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130320: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueStringLAU, BitLength: 0},
+        11: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130321: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueReserved, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueBinary, BitLength: 0},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueStringLAU, BitLength: 0},
+        10: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130322: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 3},
+        3: {Kind: fieldValueReserved, BitLength: 1},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueStringLAU, BitLength: 0},
+        13: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130323: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueReserved, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueReserved, BitLength: 5},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueStringLAU, BitLength: 0},
+        15: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130324: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueReserved, BitLength: 4},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueReserved, BitLength: 5},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 16},
+        17: {Kind: fieldValueNumber, BitLength: 16},
+        18: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130329: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 4},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 2},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 8},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+        16: {Kind: fieldValueNumber, BitLength: 4},
+        17: {Kind: fieldValueNumber, BitLength: 2},
+        18: {Kind: fieldValueNumber, BitLength: 2},
+        19: {Kind: fieldValueNumber, BitLength: 16},
+        20: {Kind: fieldValueNumber, BitLength: 8},
+        21: {Kind: fieldValueNumber, BitLength: 16},
+        22: {Kind: fieldValueNumber, BitLength: 16},
+        23: {Kind: fieldValueNumber, BitLength: 16},
+        24: {Kind: fieldValueNumber, BitLength: 16},
+        25: {Kind: fieldValueNumber, BitLength: 16},
+        26: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130330: {
+        1: {Kind: fieldValueNumber, BitLength: 2},
+        2: {Kind: fieldValueLookup, BitLength: 3},
+        3: {Kind: fieldValueReserved, BitLength: 3},
+        4: {Kind: fieldValueStringLAU, BitLength: 0},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130560: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 3},
+        3: {Kind: fieldValueReserved, BitLength: 5},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130561: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueStringLAU, BitLength: 0},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    130562: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueStringLAU, BitLength: 0},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130563: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueStringLAU, BitLength: 0},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueNumber, BitLength: 8},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+        16: {Kind: fieldValueNumber, BitLength: 8},
+        17: {Kind: fieldValueLookup, BitLength: 2},
+        18: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    130564: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130565: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130566: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueStringLAU, BitLength: 0},
+        3: {Kind: fieldValueStringLAU, BitLength: 0},
+        4: {Kind: fieldValueNumber, BitLength: 4},
+        5: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    130567: {
+        1: {Kind: fieldValueLookup, BitLength: 6},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueLookup, BitLength: 2},
+        6: {Kind: fieldValueLookup, BitLength: 2},
+        7: {Kind: fieldValueLookup, BitLength: 2},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueReserved, BitLength: 2},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 16},
+        17: {Kind: fieldValueNumber, BitLength: 16},
+        18: {Kind: fieldValueNumber, BitLength: 16},
+        19: {Kind: fieldValueNumber, BitLength: 16},
+        20: {Kind: fieldValueNumber, BitLength: 16},
+        21: {Kind: fieldValueNumber, BitLength: 16},
+        22: {Kind: fieldValueNumber, BitLength: 16},
+        23: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130568: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueReserved, BitLength: 8},
+        5: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130569: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 4},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueLookup, BitLength: 8},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+        16: {Kind: fieldValueNumber, BitLength: 8},
+        17: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130570: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueStringLAU, BitLength: 0},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueLookup, BitLength: 8},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueReserved, BitLength: 4},
+        15: {Kind: fieldValueStringLAU, BitLength: 0},
+        16: {Kind: fieldValueStringLAU, BitLength: 0},
+        17: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130571: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 8},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130572: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 32},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueStringLAU, BitLength: 0},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueStringLAU, BitLength: 0},
+        8: {Kind: fieldValueLookup, BitLength: 8},
+        9: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130573: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130574: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130575: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 2},
+        5: {Kind: fieldValueReserved, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueStringLAU, BitLength: 0},
+        8: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130576: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueReserved, BitLength: 48},
+    },
+    130577: {
+        1: {Kind: fieldValueLookup, BitLength: 4},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueReserved, BitLength: 2},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130578: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130579: {
+        1: {Kind: fieldValueLookup, BitLength: 2},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueReserved, BitLength: 44},
+    },
+    130580: {
+        1: {Kind: fieldValueLookup, BitLength: 2},
+        2: {Kind: fieldValueLookup, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 4},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130581: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130582: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueReserved, BitLength: 4},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 32},
+    },
+    130583: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130584: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130585: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueLookup, BitLength: 4},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueLookup, BitLength: 2},
+        5: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    130586: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueLookup, BitLength: 8},
+        10: {Kind: fieldValueLookup, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueLookup, BitLength: 8},
+    },
+    130816: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1784},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 2},
+        10: {Kind: fieldValueNumber, BitLength: 4},
+        11: {Kind: fieldValueReserved, BitLength: 2},
+        12: {Kind: fieldValueStringLZ, BitLength: 256},
+        13: {Kind: fieldValueNumber, BitLength: 2},
+        14: {Kind: fieldValueNumber, BitLength: 2},
+        15: {Kind: fieldValueNumber, BitLength: 2},
+        16: {Kind: fieldValueNumber, BitLength: 2},
+        17: {Kind: fieldValueReserved, BitLength: 4},
+    },
+    130817: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueStringFix, BitLength: 80},
+        10: {Kind: fieldValueStringFix, BitLength: 256},
+        11: {Kind: fieldValueStringFix, BitLength: 256},
+        12: {Kind: fieldValueLookup, BitLength: 24},
+    },
+    130818: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueBinary, BitLength: 1736},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 32},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130819: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 4},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 64},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueBinary, BitLength: 128},
+    },
+    130820: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueBinary, BitLength: 1736},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueNumber, BitLength: 64},
+        14: {Kind: fieldValueLookup, BitLength: 2},
+        15: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    130821: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueStringFix, BitLength: 1840},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+        13: {Kind: fieldValueNumber, BitLength: 8},
+        14: {Kind: fieldValueStringLAU, BitLength: 0},
+        15: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130822: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 6},
+        6: {Kind: fieldValueReserved, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 4},
+        12: {Kind: fieldValueNumber, BitLength: 1},
+        13: {Kind: fieldValueReserved, BitLength: 3},
+        14: {Kind: fieldValueBinary, BitLength: 24},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130823: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueBinary, BitLength: 1784},
+    },
+    130824: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130825: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 1},
+        10: {Kind: fieldValueLookup, BitLength: 4},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130826: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 6},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueBinary, BitLength: 256},
+    },
+    130827: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130828: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 32},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130829: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 4},
+        5: {Kind: fieldValueBinary, BitLength: 4},
+        6: {Kind: fieldValueBinary, BitLength: 4},
+        7: {Kind: fieldValueBinary, BitLength: 4},
+        8: {Kind: fieldValueBinary, BitLength: 8},
+    },
+    130830: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130831: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+    },
+    130832: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 1},
+        9: {Kind: fieldValueNumber, BitLength: 1},
+        10: {Kind: fieldValueNumber, BitLength: 1},
+        11: {Kind: fieldValueReserved, BitLength: 5},
+    },
+    130833: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 64},
+        6: {Kind: fieldValueNumber, BitLength: 64},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueStringFix, BitLength: 64},
+        9: {Kind: fieldValueStringFix, BitLength: 128},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130834: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueStringLAU, BitLength: 0},
+        9: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130835: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueStringLAU, BitLength: 0},
+        6: {Kind: fieldValueStringLAU, BitLength: 0},
+    },
+    130836: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 4},
+        8: {Kind: fieldValueLookup, BitLength: 4},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130837: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueReserved, BitLength: 6},
+    },
+    130838: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+    },
+    130839: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    130840: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueBinary, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueReserved, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 64},
+        11: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130841: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+    },
+    130842: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 6},
+        5: {Kind: fieldValueNumber, BitLength: 4},
+        6: {Kind: fieldValueNumber, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueLookup, BitLength: 2},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueLookup, BitLength: 2},
+        12: {Kind: fieldValueLookup, BitLength: 2},
+        13: {Kind: fieldValueLookup, BitLength: 2},
+        14: {Kind: fieldValueLookup, BitLength: 2},
+        15: {Kind: fieldValueLookup, BitLength: 2},
+        16: {Kind: fieldValueLookup, BitLength: 2},
+        17: {Kind: fieldValueReserved, BitLength: 6},
+        18: {Kind: fieldValueReserved, BitLength: 2},
+    },
+    130843: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130844: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+    },
+    130845: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 1768},
+        5: {Kind: fieldValueNumber, BitLength: 4},
+        6: {Kind: fieldValueNumber, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 4},
+        8: {Kind: fieldValueReserved, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130846: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 24},
+        9: {Kind: fieldValueLookup, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueBinary, BitLength: 0},
+    },
+    130847: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueStringLZ, BitLength: 0},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130848: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueStringFix, BitLength: 128},
+        6: {Kind: fieldValueStringFix, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130849: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    130850: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueLookup, BitLength: 8},
+        9: {Kind: fieldValueReserved, BitLength: 8},
+        10: {Kind: fieldValueReserved, BitLength: 24},
+        11: {Kind: fieldValueReserved, BitLength: 24},
+        12: {Kind: fieldValueReserved, BitLength: 8},
+        13: {Kind: fieldValueReserved, BitLength: 8},
+        14: {Kind: fieldValueReserved, BitLength: 8},
+        15: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130851: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueLookup, BitLength: 8},
+        9: {Kind: fieldValueReserved, BitLength: 8},
+        10: {Kind: fieldValueLookup, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    130852: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130856: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueStringFix, BitLength: 1784},
+    },
+    130860: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 32},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130880: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130881: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130900: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 3},
+        9: {Kind: fieldValueReserved, BitLength: 1},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 32},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+        15: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130910: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130911: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 6},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 32},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130912: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130913: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueReserved, BitLength: 6},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 16},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueNumber, BitLength: 16},
+        13: {Kind: fieldValueNumber, BitLength: 16},
+        14: {Kind: fieldValueNumber, BitLength: 16},
+        15: {Kind: fieldValueNumber, BitLength: 16},
+        16: {Kind: fieldValueNumber, BitLength: 16},
+        17: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    130918: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueStringFix, BitLength: 128},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueStringFix, BitLength: 128},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 32},
+        10: {Kind: fieldValueNumber, BitLength: 16},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    130921: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueBinary, BitLength: 1752},
+    },
+    130939: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+        12: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130944: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 1},
+        5: {Kind: fieldValueReserved, BitLength: 7},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    130945: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    130946: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    130947: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    130951: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    131008: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    131011: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    131012: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+    },
+    59392: {
+        1: {Kind: fieldValueBinary, BitLength: 64},
+        2: {Kind: fieldValueNumber, BitLength: 8},
+        3: {Kind: fieldValueReserved, BitLength: 24},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    59904: {
+        1: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    60160: {
+        1: {Kind: fieldValueNumber, BitLength: 8},
+        2: {Kind: fieldValueBinary, BitLength: 56},
+    },
+    60416: {
+        1: {Kind: fieldValueLookup, BitLength: 8},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 8},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    60928: {
+        1: {Kind: fieldValueNumber, BitLength: 21},
+        2: {Kind: fieldValueLookup, BitLength: 11},
+        3: {Kind: fieldValueNumber, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 5},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 7},
+        8: {Kind: fieldValueNumber, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueLookup, BitLength: 1},
+    },
+    61184: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueReserved, BitLength: 16},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    61440: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65001: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65002: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65003: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65004: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65005: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65006: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 30},
+    },
+    65007: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65008: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65009: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 30},
+    },
+    65010: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65011: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65012: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65013: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65014: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65015: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65016: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65017: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65018: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65019: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65020: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65021: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65022: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65023: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65024: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65025: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65026: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65027: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65028: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueLookup, BitLength: 2},
+        4: {Kind: fieldValueReserved, BitLength: 14},
+    },
+    65029: {
+        1: {Kind: fieldValueNumber, BitLength: 32},
+        2: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65030: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65240: {
+        1: {Kind: fieldValueBinary, BitLength: 21},
+        2: {Kind: fieldValueLookup, BitLength: 11},
+        3: {Kind: fieldValueNumber, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 5},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 1},
+        7: {Kind: fieldValueLookup, BitLength: 7},
+        8: {Kind: fieldValueNumber, BitLength: 4},
+        9: {Kind: fieldValueLookup, BitLength: 3},
+        10: {Kind: fieldValueReserved, BitLength: 1},
+        11: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    65280: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueReserved, BitLength: 16},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 10},
+        9: {Kind: fieldValueReserved, BitLength: 4},
+        10: {Kind: fieldValueLookup, BitLength: 2},
+        11: {Kind: fieldValueNumber, BitLength: 16},
+        12: {Kind: fieldValueBinary, BitLength: 8},
+        13: {Kind: fieldValueNumber, BitLength: 1},
+        14: {Kind: fieldValueReserved, BitLength: 3},
+    },
+    65281: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65282: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 24},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65283: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 2},
+        6: {Kind: fieldValueNumber, BitLength: 2},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 2},
+        9: {Kind: fieldValueNumber, BitLength: 2},
+        10: {Kind: fieldValueNumber, BitLength: 2},
+        11: {Kind: fieldValueNumber, BitLength: 4},
+        12: {Kind: fieldValueNumber, BitLength: 4},
+        13: {Kind: fieldValueNumber, BitLength: 4},
+        14: {Kind: fieldValueNumber, BitLength: 4},
+        15: {Kind: fieldValueNumber, BitLength: 4},
+        16: {Kind: fieldValueNumber, BitLength: 4},
+        17: {Kind: fieldValueNumber, BitLength: 1},
+        18: {Kind: fieldValueReserved, BitLength: 3},
+    },
+    65284: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 16},
+        8: {Kind: fieldValueLookup, BitLength: 2},
+        9: {Kind: fieldValueNumber, BitLength: 1},
+        10: {Kind: fieldValueReserved, BitLength: 5},
+        11: {Kind: fieldValueNumber, BitLength: 10},
+        12: {Kind: fieldValueReserved, BitLength: 6},
+        13: {Kind: fieldValueBinary, BitLength: 16},
+    },
+    65285: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 3},
+        5: {Kind: fieldValueReserved, BitLength: 45},
+        6: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65286: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    65287: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 3},
+        6: {Kind: fieldValueReserved, BitLength: 5},
+        7: {Kind: fieldValueNumber, BitLength: 32},
+        8: {Kind: fieldValueNumber, BitLength: 24},
+    },
+    65288: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueLookup, BitLength: 8},
+        8: {Kind: fieldValueBinary, BitLength: 16},
+    },
+    65289: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65290: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65291: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65292: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 48},
+    },
+    65293: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 4},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueReserved, BitLength: 2},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+        10: {Kind: fieldValueNumber, BitLength: 4},
+        11: {Kind: fieldValueNumber, BitLength: 1},
+        12: {Kind: fieldValueReserved, BitLength: 3},
+        13: {Kind: fieldValueNumber, BitLength: 4},
+        14: {Kind: fieldValueNumber, BitLength: 4},
+    },
+    65294: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65295: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 16},
+        7: {Kind: fieldValueNumber, BitLength: 4},
+        8: {Kind: fieldValueNumber, BitLength: 1},
+        9: {Kind: fieldValueNumber, BitLength: 1},
+        10: {Kind: fieldValueReserved, BitLength: 2},
+        11: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65296: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65297: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65298: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65299: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueBinary, BitLength: 24},
+    },
+    65300: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueBinary, BitLength: 40},
+    },
+    65301: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 5},
+        6: {Kind: fieldValueNumber, BitLength: 3},
+        7: {Kind: fieldValueBinary, BitLength: 32},
+    },
+    65302: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 32},
+        6: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65303: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 4},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65304: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueNumber, BitLength: 4},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65305: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueLookup, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65306: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65308: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65309: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65310: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65311: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65312: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65313: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 32},
+    },
+    65314: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+        5: {Kind: fieldValueReserved, BitLength: 1},
+        6: {Kind: fieldValueLookup, BitLength: 1},
+        7: {Kind: fieldValueReserved, BitLength: 6},
+        8: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65315: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65316: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65317: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65323: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65324: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 32},
+    },
+    65325: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65329: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65330: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65332: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65340: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueReserved, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    65341: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 16},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 16},
+    },
+    65344: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65345: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueReserved, BitLength: 16},
+    },
+    65346: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65348: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65349: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65350: {
+        1: {Kind: fieldValueNumber, BitLength: 16},
+        2: {Kind: fieldValueNumber, BitLength: 16},
+        3: {Kind: fieldValueNumber, BitLength: 16},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65359: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65360: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65361: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 8},
+        6: {Kind: fieldValueReserved, BitLength: 32},
+    },
+    65371: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 2},
+        8: {Kind: fieldValueNumber, BitLength: 2},
+        9: {Kind: fieldValueReserved, BitLength: 4},
+        10: {Kind: fieldValueNumber, BitLength: 8},
+        11: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65374: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 24},
+    },
+    65379: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueLookup, BitLength: 16},
+        5: {Kind: fieldValueBinary, BitLength: 16},
+        6: {Kind: fieldValueBinary, BitLength: 8},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65403: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 8},
+        8: {Kind: fieldValueNumber, BitLength: 8},
+        9: {Kind: fieldValueNumber, BitLength: 8},
+    },
+    65408: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueLookup, BitLength: 4},
+        6: {Kind: fieldValueReserved, BitLength: 36},
+    },
+    65409: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65410: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 8},
+        5: {Kind: fieldValueNumber, BitLength: 16},
+        6: {Kind: fieldValueNumber, BitLength: 16},
+        7: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65420: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueNumber, BitLength: 16},
+        5: {Kind: fieldValueNumber, BitLength: 8},
+        6: {Kind: fieldValueNumber, BitLength: 8},
+        7: {Kind: fieldValueNumber, BitLength: 4},
+        8: {Kind: fieldValueReserved, BitLength: 4},
+        9: {Kind: fieldValueReserved, BitLength: 8},
+    },
+    65424: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65440: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65441: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65472: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueBinary, BitLength: 48},
+    },
+    65480: {
+        1: {Kind: fieldValueLookup, BitLength: 11},
+        2: {Kind: fieldValueReserved, BitLength: 2},
+        3: {Kind: fieldValueLookup, BitLength: 3},
+        4: {Kind: fieldValueReserved, BitLength: 48},
+    },
+}
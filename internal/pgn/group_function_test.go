@@ -172,3 +172,78 @@ func TestDecodeNmeaAcknowledgeGroupFunctionMatchesActisenseConfigurationReply(t
 		t.Fatalf("parameter acknowledgements = %#v, want one acknowledgement", ack.Repeating1)
 	}
 }
+
+func TestGroupFunctionValueRoundTripsReferencedFieldTypes(t *testing.T) {
+	tests := []struct {
+		name  string
+		pgn   uint32
+		order uint8
+		value any
+		want  any
+	}{
+		{"scaled number", publicpgn.ProductInformationPGN, 1, 2.1, 2.1},
+		{"integer", publicpgn.ProductInformationPGN, 2, uint16(0x1234), uint64(0x1234)},
+		{"lookup", publicpgn.ProductInformationPGN, 7, publicpgn.CertificationLevelConst(1), uint64(1)},
+		{"fixed string", publicpgn.ProductInformationPGN, 3, "Model", "Model"},
+		{"STRING_LAU", publicpgn.ConfigurationInformationPGN, 1, "Helm", "Helm"},
+		{"missing number", publicpgn.ProductInformationPGN, 2, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := EncodeGroupFunctionValue(tt.pgn, tt.order, tt.value)
+			if err != nil {
+				t.Fatalf("EncodeGroupFunctionValue() error = %v", err)
+			}
+			decoded, err := DecodeGroupFunctionValue(tt.pgn, tt.order, encoded)
+			if err != nil {
+				t.Fatalf("DecodeGroupFunctionValue() error = %v", err)
+			}
+			if f, ok := decoded.(float64); ok {
+				if want := tt.want.(float64); f < want-0.001 || f > want+0.001 {
+					t.Fatalf("decoded = %v, want %v", f, want)
+				}
+				return
+			}
+			if decoded != tt.want {
+				t.Fatalf("decoded = %#v, want %#v", decoded, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupFunctionValueMatchesDecodedParameterBytes(t *testing.T) {
+	rawData := []byte{
+		2, 0x34, 0x12, // ProductInformation.ProductCode
+		7, 0x01, // ProductInformation.CertificationLevel (lookup)
+	}
+	decoded := decodeGroupFunctionPayload(t, groupFunctionRequestPayload(publicpgn.ProductInformationPGN, 2, rawData))
+	request := decoded.(publicpgn.NMEARequestGroupFunction)
+	if len(request.Repeating1) != 2 {
+		t.Fatalf("decoded repeating fields = %d, want 2", len(request.Repeating1))
+	}
+
+	code, err := DecodeGroupFunctionValue(*request.PGN, *request.Repeating1[0].Parameter, request.Repeating1[0].Value)
+	if err != nil || code != uint64(0x1234) {
+		t.Fatalf("ProductCode = %v, %v; want 0x1234", code, err)
+	}
+	level, err := DecodeGroupFunctionValue(*request.PGN, *request.Repeating1[1].Parameter, request.Repeating1[1].Value)
+	if err != nil || level != uint64(1) {
+		t.Fatalf("CertificationLevel = %v, %v; want 1", level, err)
+	}
+	encoded, err := EncodeGroupFunctionValue(*request.PGN, 2, 0x1234)
+	if err != nil || !bytes.Equal(encoded, request.Repeating1[0].Value) {
+		t.Fatalf("EncodeGroupFunctionValue() = % x, %v; want % x", encoded, err, request.Repeating1[0].Value)
+	}
+}
+
+func TestGroupFunctionValueRejectsUnknownAndMistypedFields(t *testing.T) {
+	if _, err := DecodeGroupFunctionValue(publicpgn.ProductInformationPGN, 99, nil); err == nil {
+		t.Fatalf("DecodeGroupFunctionValue() succeeded for unknown field")
+	}
+	if _, err := EncodeGroupFunctionValue(publicpgn.ProductInformationPGN, 3, 42); err == nil {
+		t.Fatalf("EncodeGroupFunctionValue() accepted an integer for a string field")
+	}
+	if _, err := EncodeGroupFunctionValue(publicpgn.ProductInformationPGN, 2, "x"); err == nil {
+		t.Fatalf("EncodeGroupFunctionValue() accepted a string for a numeric field")
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"fmt"
+	"reflect"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// fieldValueKind identifies how a top-level PGN field is encoded, for fields referenced
+// by group functions.
+type fieldValueKind uint8
+
+const (
+	fieldValueBinary fieldValueKind = iota
+	fieldValueReserved
+	fieldValueNumber
+	fieldValueLookup
+	fieldValueStringFix
+	fieldValueStringLZ
+	fieldValueStringLAU
+)
+
+// fieldValueKindEntry describes one generated fieldValueKinds entry.
+type fieldValueKindEntry struct {
+	Kind      fieldValueKind
+	BitLength uint16
+}
+
+func findFieldValueKind(referencedPGN uint32, fieldOrder uint8) (fieldValueKindEntry, error) {
+	entry, ok := fieldValueKinds[referencedPGN][fieldOrder]
+	if !ok {
+		return entry, fmt.Errorf("field %d not found in PGN %d", fieldOrder, referencedPGN)
+	}
+	if entry.Kind == fieldValueReserved {
+		return entry, fmt.Errorf("field %d of PGN %d is reserved", fieldOrder, referencedPGN)
+	}
+	return entry, nil
+}
+
+// DecodeGroupFunctionValue converts the wire bytes of a group-function parameter value
+// into the type of the referenced field: float64 for scaled numbers, int64 or uint64 for
+// integers, uint64 for lookups, string for strings, and []uint8 for binary fields.
+// A missing (not available) numeric value decodes to nil. For PGNs with several
+// proprietary variants the first variant's definition is used.
+func DecodeGroupFunctionValue(referencedPGN uint32, fieldOrder uint8, value []uint8) (any, error) {
+	entry, err := findFieldValueKind(referencedPGN, fieldOrder)
+	if err != nil {
+		return nil, err
+	}
+	stream := NewDataStream(value)
+
+	switch entry.Kind {
+	case fieldValueNumber:
+		spec, ok := FindFieldSpec(referencedPGN, fieldOrder)
+		if !ok {
+			return nil, fmt.Errorf("field %d of PGN %d has no field spec", fieldOrder, referencedPGN)
+		}
+		if spec.IsScaled() {
+			return derefOrNil(ReadScaled[float64](stream, spec))
+		}
+		if spec.IsSigned {
+			return derefOrNil(ReadRaw[int64](stream, spec))
+		}
+		return derefOrNil(ReadRaw[uint64](stream, spec))
+	case fieldValueLookup:
+		return stream.readLookupField(entry.BitLength)
+	case fieldValueStringFix:
+		return stream.readFixedString(entry.BitLength)
+	case fieldValueStringLZ:
+		return stream.readStringWithLength(entry.BitLength)
+	case fieldValueStringLAU:
+		return stream.readStringWithLengthAndControl()
+	default:
+		return append([]uint8(nil), value...), nil
+	}
+}
+
+// EncodeGroupFunctionValue converts a value into the wire bytes of a group-function
+// parameter that refers to the given PGN field. Numeric fields accept any Go integer or
+// float type (including lookup constants), or nil for a missing value; string fields
+// accept a string; binary fields accept []uint8.
+func EncodeGroupFunctionValue(referencedPGN uint32, fieldOrder uint8, value any) ([]uint8, error) {
+	entry, err := findFieldValueKind(referencedPGN, fieldOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entry.Kind {
+	case fieldValueNumber, fieldValueLookup:
+		stream := NewDataStream(make([]uint8, (entry.BitLength+7)/8))
+		if err := writeGroupFunctionNumber(stream, referencedPGN, fieldOrder, entry, value); err != nil {
+			return nil, err
+		}
+		if stream.bitOffset != 0 {
+			if err := stream.writeReserved(uint16(8-stream.bitOffset), 0); err != nil {
+				return nil, err
+			}
+		}
+		return stream.GetData(), nil
+	case fieldValueStringFix, fieldValueStringLZ, fieldValueStringLAU:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("field %d of PGN %d is a string, got %T", fieldOrder, referencedPGN, value)
+		}
+		return encodeGroupFunctionString(entry, str)
+	default:
+		data, ok := value.([]uint8)
+		if !ok {
+			return nil, fmt.Errorf("field %d of PGN %d is binary, got %T", fieldOrder, referencedPGN, value)
+		}
+		return append([]uint8(nil), data...), nil
+	}
+}
+
+func writeGroupFunctionNumber(stream *DataStream, referencedPGN uint32, fieldOrder uint8, entry fieldValueKindEntry, value any) error {
+	if entry.Kind == fieldValueLookup {
+		if value == nil {
+			return stream.writeReserved(entry.BitLength, 0)
+		}
+		v := reflect.ValueOf(value)
+		//nolint:exhaustive // Why: only integer kinds are lookup values.
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return stream.putNumberRaw(uint64(v.Int()), entry.BitLength, 0)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return stream.putNumberRaw(v.Uint(), entry.BitLength, 0)
+		default:
+			return fmt.Errorf("field %d of PGN %d is a lookup, got %T", fieldOrder, referencedPGN, value)
+		}
+	}
+
+	spec, ok := FindFieldSpec(referencedPGN, fieldOrder)
+	if !ok {
+		return fmt.Errorf("field %d of PGN %d has no field spec", fieldOrder, referencedPGN)
+	}
+	if value == nil {
+		return WriteRaw[uint64](stream, nil, spec)
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return WriteRaw[uint64](stream, nil, spec)
+		}
+		v = v.Elem()
+	}
+
+	//nolint:exhaustive // Why: only numeric kinds are accepted.
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if !spec.IsScaled() && f != float64(int64(f)) {
+			return fmt.Errorf("field %d of PGN %d is an integer, got %v", fieldOrder, referencedPGN, f)
+		}
+		return WriteScaled(stream, &f, spec)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if spec.IsScaled() {
+			f := float64(v.Int())
+			return WriteScaled(stream, &f, spec)
+		}
+		i := v.Int()
+		return WriteRaw(stream, &i, spec)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if spec.IsScaled() {
+			f := float64(v.Uint())
+			return WriteScaled(stream, &f, spec)
+		}
+		u := v.Uint()
+		return WriteRaw(stream, &u, spec)
+	default:
+		return fmt.Errorf("field %d of PGN %d is numeric, got %T", fieldOrder, referencedPGN, value)
+	}
+}
+
+func encodeGroupFunctionString(entry fieldValueKindEntry, value string) ([]uint8, error) {
+	switch entry.Kind {
+	case fieldValueStringFix:
+		byteCount := int(entry.BitLength / 8)
+		if len(value) > byteCount {
+			return nil, fmt.Errorf("string of %d bytes does not fit a %d byte field", len(value), byteCount)
+		}
+		stream := NewDataStream(make([]uint8, byteCount))
+		if err := stream.writeStringFix([]uint8(value), entry.BitLength, 0); err != nil {
+			return nil, err
+		}
+		return stream.GetData(), nil
+	case fieldValueStringLZ:
+		bitLength := entry.BitLength
+		if bitLength == 0 {
+			bitLength = uint16(len(value)+2) * 8
+		}
+		stream := NewDataStream(make([]uint8, bitLength/8))
+		if err := stream.writeStringWithLength(value, bitLength, 0); err != nil {
+			return nil, err
+		}
+		return stream.GetData(), nil
+	default:
+		return publicpgn.EncodeStringLAU(value)
+	}
+}
+
+func derefOrNil[T any](v *T, err error) (any, error) {
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return *v, nil
+}
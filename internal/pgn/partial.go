@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// PartialDecodeError is returned by generated decoders when a field cannot be read, most
+// often because the payload is shorter than the PGN definition. Partial holds the struct
+// with every field before Field decoded.
+type PartialDecodeError struct {
+	Partial any
+	Field   string
+	Err     error
+}
+
+// Error matches the message generated decoders have always returned for field failures.
+func (e *PartialDecodeError) Error() string {
+	return fmt.Sprintf("parse failed for %s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying read error.
+func (e *PartialDecodeError) Unwrap() error {
+	return e.Err
+}
+
+func partialDecodeError(val any, field string, err error) error {
+	return &PartialDecodeError{Partial: val, Field: field, Err: err}
+}
+
+// PartialStruct returns the partially decoded struct carried by a decoder error, with a
+// DecodeWarning for the failing field appended to its Info. It returns false for errors
+// that did not come from a field read, such as discriminator match failures.
+func PartialStruct(err error) (any, bool) {
+	var partialErr *PartialDecodeError
+	if !errors.As(err, &partialErr) || partialErr.Partial == nil {
+		return nil, false
+	}
+
+	v := reflect.New(reflect.TypeOf(partialErr.Partial)).Elem()
+	v.Set(reflect.ValueOf(partialErr.Partial))
+	field := v.FieldByName("Info")
+	if !field.IsValid() {
+		return nil, false
+	}
+	info, ok := field.Addr().Interface().(*publicpgn.MessageInfo)
+	if !ok {
+		return nil, false
+	}
+	// the details may be shared with the struct's other copies, so they are copied
+	var details publicpgn.DecodeDetails
+	if info.Details != nil {
+		details = *info.Details
+	}
+	details.Warnings = append(details.Warnings[:len(details.Warnings):len(details.Warnings)], publicpgn.DecodeWarning{
+		Field:  partialErr.Field,
+		Reason: partialErr.Err,
+	})
+	info.Details = &details
+	return v.Interface(), true
+}
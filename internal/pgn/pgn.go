@@ -15,6 +15,14 @@ const MaxPGNLength = 223
 
 // IsFast returns true if the specified PGN is a Fast packet
 func IsFast(pgn uint32) bool {
+	if fast, ok := registeredFast(pgn); ok {
+		return fast
+	}
+	return generatedIsFast(pgn)
+}
+
+// generatedIsFast consults only the generated fast-packet table.
+func generatedIsFast(pgn uint32) bool {
 	if pgn < 126208 {
 		return false // All PGNs < 126208 are single frame
 	}
@@ -55,3 +63,11 @@ func IsProprietaryPGN(pgn uint32) bool {
 		return false
 	}
 }
+
+// instanceValue is used by the generated StructInstance for numeric instance fields.
+func instanceValue(instance *uint8) (uint8, bool) {
+	if instance == nil {
+		return 0, false
+	}
+	return *instance, true
+}
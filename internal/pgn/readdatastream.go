@@ -8,15 +8,19 @@
 package pgn
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"strings"
 	"unicode/utf16"
 
-	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 	"golang.org/x/exp/constraints"
 )
 
+// errSkipPastEnd is returned by skipBits. Generated decoders skip trailing reserved bits
+// without checking the result, so it is allocated once rather than on every decode.
+var errSkipPastEnd = errors.New("skipping off end of pgn")
+
 // isEOF method returns true if the offsets exactly equal the data length
 func (s *DataStream) isEOF() bool {
 	// For now, only call an exact EOF -- not sure if we need to be more loosy-goosy or not
@@ -34,7 +38,7 @@ func (s *DataStream) skipBits(bitLength uint16) error {
 	}
 
 	if int(s.byteOffset) >= len(s.data) {
-		return fmt.Errorf("reading byte(%d) off end of pgn (len:%d)", s.byteOffset, len(s.data))
+		return errSkipPastEnd
 	}
 
 	return nil
@@ -311,48 +315,45 @@ func (s *DataStream) getNumberRaw(bitLength uint16) (uint64, error) {
 	return ret, nil
 }
 
-// getNullableNumberRaw method reads the specified length and returns a *uint64, or nil if missing or invalid.
-// It uses the pre-calculated MaxRawValue from FieldSpec to determine validity.
-func (s *DataStream) getNullableNumberRaw(spec *FieldSpec) (*uint64, error) {
-	v, err := s.getNumberRaw(spec.BitLength)
+// getNullableNumberRaw method reads the specified length and returns the value, with ok false if missing
+// or invalid. It uses the pre-calculated MaxRawValue from FieldSpec to determine validity.
+// Values are returned rather than pointers so that reading a field does not allocate.
+func (s *DataStream) getNullableNumberRaw(spec *FieldSpec) (v uint64, ok bool, err error) {
+	v, err = s.getNumberRaw(spec.BitLength)
 	if err != nil {
-		return nil, err
+		return 0, false, err
 	}
 
 	if spec.IsSigned {
 		mask := uint64(1 << (spec.BitLength - 1))
 		if (v & mask) > 0 { // negative signed number, so smaller than maxint, so just return
-			return &v, nil
+			return v, true, nil
 		}
 	}
 
 	// Use pre-calculated MaxRawValue instead of runtime calculation
-	if v > spec.MaxRawValue { // either missing or invalid. We'll return nil either way
-		return nil, nil
+	if v > spec.MaxRawValue { // either missing or invalid. We'll return not ok either way
+		return 0, false, nil
 	}
 
-	return &v, nil
+	return v, true, nil
 }
 
-// getSignedNullableNumber method returns a *int64 or nil if null
-func (s *DataStream) getSignedNullableNumber(spec *FieldSpec) (*int64, error) {
-	v, err := s.getNullableNumberRaw(spec)
-	if err != nil {
-		return nil, err
-	}
-	if v == nil {
-		return nil, nil
+// getSignedNullableNumber method returns the sign extended value, with ok false if null
+func (s *DataStream) getSignedNullableNumber(spec *FieldSpec) (int64, bool, error) {
+	v, ok, err := s.getNullableNumberRaw(spec)
+	if err != nil || !ok {
+		return 0, false, err
 	}
 
 	// Sign extend if negative
 	signBit := uint64(1) << (spec.BitLength - 1)
-	if (*v&signBit) != 0 && spec.BitLength < 64 {
+	if (v&signBit) != 0 && spec.BitLength < 64 {
 		mask := uint64(math.MaxUint64) << spec.BitLength
-		*v |= mask
+		v |= mask
 	}
 
-	vi := int64(*v)
-	return &vi, nil
+	return int64(v), true, nil
 }
 
 // readVariableDataWithSpec method reads and returns variable data using FieldSpec.
@@ -366,12 +367,19 @@ func (s *DataStream) readVariableDataWithSpec(spec *FieldSpec) ([]uint8, error)
 }
 
 // readGroupFunctionFieldValue returns the referenced field's wire representation.
-// STRING_LAU is self-delimiting; fixed-width fields continue to use FieldSpec.
+// STRING_LAU is self-delimiting; lookups and fixed strings use their generated bit
+// length, and other fixed-width fields continue to use FieldSpec.
 func (s *DataStream) readGroupFunctionFieldValue(referencedPGN *uint32, parameter *uint8) ([]uint8, error) {
 	if referencedPGN == nil || parameter == nil {
 		return nil, fmt.Errorf("missing referenced PGN or parameter")
 	}
-	if *referencedPGN == publicpgn.ConfigurationInformationPGN && *parameter >= 1 && *parameter <= 3 {
+	entry := fieldValueKinds[*referencedPGN][*parameter]
+	switch entry.Kind {
+	case fieldValueLookup, fieldValueStringFix, fieldValueStringLZ:
+		if entry.BitLength > 0 {
+			return s.readBinaryData((entry.BitLength + 7) &^ 0x7)
+		}
+	case fieldValueStringLAU:
 		header, err := s.readBinaryData(16)
 		if err != nil {
 			return nil, err
@@ -442,14 +450,11 @@ func ReadRaw[T constraints.Integer](s *DataStream, spec *FieldSpec) (*T, error)
 			}
 			signedVal = signExtendInt64(rawValue, spec.BitLength)
 		} else {
-			v, err := s.getSignedNullableNumber(spec)
-			if err != nil {
+			v, ok, err := s.getSignedNullableNumber(spec)
+			if err != nil || !ok {
 				return nil, err
 			}
-			if v == nil {
-				return nil, nil
-			}
-			signedVal = *v
+			signedVal = v
 		}
 		if spec.Offset != 0 {
 			var err error
@@ -471,14 +476,11 @@ func ReadRaw[T constraints.Integer](s *DataStream, spec *FieldSpec) (*T, error)
 			return nil, err
 		}
 	} else {
-		v, err := s.getNullableNumberRaw(spec)
-		if err != nil {
+		v, ok, err := s.getNullableNumberRaw(spec)
+		if err != nil || !ok {
 			return nil, err
 		}
-		if v == nil {
-			return nil, nil
-		}
-		rawValue = *v
+		rawValue = v
 	}
 
 	if spec.Offset != 0 {
@@ -524,23 +526,17 @@ func ReadScaled[T constraints.Float](s *DataStream, spec *FieldSpec) (*T, error)
 	} else {
 		// Has reserved values - use nullable logic
 		if spec.IsSigned {
-			rawValue, err := s.getSignedNullableNumber(spec)
-			if err != nil {
+			rawValue, ok, err := s.getSignedNullableNumber(spec)
+			if err != nil || !ok {
 				return nil, err
 			}
-			if rawValue == nil {
-				return nil, nil
-			}
-			val = float64(*rawValue)
+			val = float64(rawValue)
 		} else {
-			rawValue, err := s.getNullableNumberRaw(spec)
-			if err != nil {
+			rawValue, ok, err := s.getNullableNumberRaw(spec)
+			if err != nil || !ok {
 				return nil, err
 			}
-			if rawValue == nil {
-				return nil, nil
-			}
-			val = float64(*rawValue)
+			val = float64(rawValue)
 		}
 	}
 
@@ -0,0 +1,230 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// RegisteredPGN describes an application-owned PGN type that is decoded and encoded
+// alongside the generated PGN types.
+type RegisteredPGN struct {
+	// PGN is the parameter group number carried in the CAN ID.
+	PGN uint32
+
+	// Type is the struct type produced by Decode and accepted by Encode.
+	Type reflect.Type
+
+	// ManufacturerCode and IndustryCode, when set, restrict decoding to payloads whose
+	// proprietary header carries the same values. Proprietary PGNs require a manufacturer code.
+	ManufacturerCode *ManufacturerCodeConst
+	IndustryCode     *IndustryCodeConst
+
+	// Fast marks the PGN as a fast-packet PGN. It must agree with IsFast for PGNs
+	// that already have generated decoders.
+	Fast bool
+
+	// Decode converts a complete payload into a value of Type. The payload is its own
+	// copy, so the value may keep it.
+	Decode func(MessageInfo, []uint8) (any, error)
+
+	// Encode converts a value of Type into its message info and complete payload.
+	Encode func(any) (*MessageInfo, []uint8, error)
+}
+
+// pgnRegistry is an immutable snapshot of the registered PGNs. Registration replaces
+// the whole snapshot so the decode path never takes a lock.
+type pgnRegistry struct {
+	byPGN  map[uint32][]*RegisteredPGN
+	byType map[reflect.Type]*RegisteredPGN
+	// fast is the framing of each registered PGN. Frames are framed before their payload
+	// can be matched to a variant, so every variant of a PGN shares one framing.
+	fast map[uint32]bool
+}
+
+var (
+	registryMu sync.Mutex
+	registry   atomic.Pointer[pgnRegistry]
+)
+
+// RegisterPGN adds an application-owned PGN type. Registered decoders are consulted
+// before generated ones, so a registration for a proprietary PGN takes precedence over
+// the generic manufacturer-proprietary decoders for the same PGN.
+func RegisterPGN(def RegisteredPGN) error {
+	if err := validateRegisteredPGN(&def); err != nil {
+		return err
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	current := registry.Load()
+	next := &pgnRegistry{
+		byPGN:  map[uint32][]*RegisteredPGN{},
+		byType: map[reflect.Type]*RegisteredPGN{},
+		fast:   map[uint32]bool{},
+	}
+	if current != nil {
+		for pgn, defs := range current.byPGN {
+			next.byPGN[pgn] = append([]*RegisteredPGN(nil), defs...)
+		}
+		for t, d := range current.byType {
+			next.byType[t] = d
+		}
+		for pgn, fast := range current.fast {
+			next.fast[pgn] = fast
+		}
+	}
+
+	if _, exists := next.byType[def.Type]; exists {
+		return fmt.Errorf("type %s is already registered", def.Type)
+	}
+	if fast, exists := next.fast[def.PGN]; exists && fast != def.Fast {
+		return fmt.Errorf("PGN %d is already registered with a different fast-packet setting", def.PGN)
+	}
+	for _, existing := range next.byPGN[def.PGN] {
+		if sameDiscriminator(existing, &def) {
+			return fmt.Errorf("PGN %d is already registered for type %s with the same discriminators", def.PGN, existing.Type)
+		}
+	}
+
+	registered := def
+	next.byPGN[def.PGN] = append(next.byPGN[def.PGN], &registered)
+	next.byType[def.Type] = &registered
+	next.fast[def.PGN] = def.Fast
+	registry.Store(next)
+	return nil
+}
+
+func validateRegisteredPGN(def *RegisteredPGN) error {
+	switch {
+	case def.PGN == 0:
+		return errors.New("registered PGN must be non-zero")
+	case def.Type == nil || def.Type.Kind() != reflect.Struct:
+		return fmt.Errorf("registered PGN %d must use a struct type", def.PGN)
+	case def.Decode == nil:
+		return fmt.Errorf("registered PGN %d has no decoder", def.PGN)
+	case def.Encode == nil:
+		return fmt.Errorf("registered PGN %d has no encoder", def.PGN)
+	case IsProprietaryPGN(def.PGN) && def.ManufacturerCode == nil:
+		return fmt.Errorf("registered proprietary PGN %d requires a manufacturer code", def.PGN)
+	case isGeneratedPGN(def.PGN) && def.Fast != generatedIsFast(def.PGN):
+		return fmt.Errorf("registered PGN %d fast-packet setting does not match generated definitions", def.PGN)
+	}
+	return nil
+}
+
+func isGeneratedPGN(pgn uint32) bool {
+	_, exists := KnownPGNs[pgn]
+	return exists
+}
+
+func sameDiscriminator(a, b *RegisteredPGN) bool {
+	sameManufacturer := (a.ManufacturerCode == nil && b.ManufacturerCode == nil) ||
+		(a.ManufacturerCode != nil && b.ManufacturerCode != nil && *a.ManufacturerCode == *b.ManufacturerCode)
+	sameIndustry := (a.IndustryCode == nil && b.IndustryCode == nil) ||
+		(a.IndustryCode != nil && b.IndustryCode != nil && *a.IndustryCode == *b.IndustryCode)
+	return sameManufacturer && sameIndustry
+}
+
+// registeredFast reports the fast-packet setting of a registered PGN, which all of its
+// variants share.
+func registeredFast(pgn uint32) (fast, ok bool) {
+	current := registry.Load()
+	if current == nil {
+		return false, false
+	}
+	fast, ok = current.fast[pgn]
+	return fast, ok
+}
+
+// hasRegisteredDecoder reports whether an application registered a decoder for pgn.
+func hasRegisteredDecoder(pgn uint32) bool {
+	current := registry.Load()
+	return current != nil && len(current.byPGN[pgn]) > 0
+}
+
+// findRegisteredDecoder returns the decoder of the first registered type for pgn whose
+// manufacturer and industry codes match the payload.
+func findRegisteredDecoder(stream *DataStream, pgn uint32) (func(MessageInfo, *DataStream) (any, error), bool) {
+	current := registry.Load()
+	if current == nil {
+		return nil, false
+	}
+	for _, def := range current.byPGN[pgn] {
+		if !matchesRegistered(stream.data, def) {
+			continue
+		}
+		decode := def.Decode
+		return func(info MessageInfo, stream *DataStream) (any, error) {
+			// stream.data is a reassembly buffer or frame reused by the next message
+			return decode(info, slices.Clone(stream.data))
+		}, true
+	}
+	return nil, false
+}
+
+// matchesRegistered checks the proprietary header: an 11 bit manufacturer code,
+// 2 reserved bits, then a 3 bit industry code.
+func matchesRegistered(data []uint8, def *RegisteredPGN) bool {
+	if def.ManufacturerCode == nil && def.IndustryCode == nil {
+		return true
+	}
+	if len(data) < 2 {
+		return false
+	}
+	header := uint16(data[0]) | uint16(data[1])<<8
+	if def.ManufacturerCode != nil && ManufacturerCodeConst(header&0x7FF) != *def.ManufacturerCode {
+		return false
+	}
+	if def.IndustryCode != nil && IndustryCodeConst(header>>13) != *def.IndustryCode {
+		return false
+	}
+	return true
+}
+
+// encodeRegisteredStruct encodes a value of a registered type, or reports that s is not a PGN.
+func encodeRegisteredStruct(s any, stream *DataStream) (*MessageInfo, error) {
+	current := registry.Load()
+	t := reflect.TypeOf(s)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var def *RegisteredPGN
+	if current != nil && t != nil {
+		def = current.byType[t]
+	}
+	if def == nil {
+		return nil, fmt.Errorf("trying to encode a struct that isn't a PGN: %T", s)
+	}
+
+	info, data, err := def.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		info = &MessageInfo{}
+	}
+	if len(data) > MaxPGNLength {
+		return nil, fmt.Errorf("registered PGN %d encoded %d bytes; max is %d", def.PGN, len(data), MaxPGNLength)
+	}
+	if len(data) > 0 {
+		if err := stream.writeBinary(data, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+	if info.PGN == 0 {
+		info.PGN = def.PGN
+	}
+	return info, nil
+}
@@ -0,0 +1,289 @@
+package pgn
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+type registryTestPGN struct {
+	Info  publicpgn.MessageInfo
+	Value uint8
+}
+
+type registryTestFastPGN struct {
+	Info    publicpgn.MessageInfo
+	Payload []uint8
+}
+
+const registryTestManufacturer = publicpgn.ManufacturerCodeConst(2000)
+
+func restoreRegistry(t *testing.T) {
+	t.Helper()
+	previous := registry.Load()
+	t.Cleanup(func() {
+		registry.Store(previous)
+	})
+}
+
+func registryTestHeader(manufacturer publicpgn.ManufacturerCodeConst, industry publicpgn.IndustryCodeConst) []uint8 {
+	header := uint16(manufacturer) | 0x3<<11 | uint16(industry)<<13
+	return []uint8{uint8(header), uint8(header >> 8)}
+}
+
+func registerTestPGN(t *testing.T) {
+	t.Helper()
+	manufacturer := registryTestManufacturer
+	industry := publicpgn.MarineIndustry
+	err := RegisterPGN(RegisteredPGN{
+		PGN:              65300,
+		Type:             reflect.TypeFor[registryTestPGN](),
+		ManufacturerCode: &manufacturer,
+		IndustryCode:     &industry,
+		Decode: func(info MessageInfo, data []uint8) (any, error) {
+			if len(data) < 3 {
+				return nil, errors.New("short payload")
+			}
+			return registryTestPGN{Info: info, Value: data[2]}, nil
+		},
+		Encode: func(v any) (*MessageInfo, []uint8, error) {
+			var msg registryTestPGN
+			switch m := v.(type) {
+			case registryTestPGN:
+				msg = m
+			case *registryTestPGN:
+				msg = *m
+			}
+			data := append(registryTestHeader(manufacturer, industry), msg.Value, 0xff, 0xff, 0xff, 0xff, 0xff)
+			return &msg.Info, data, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("RegisterPGN() error = %v", err)
+	}
+}
+
+func TestRegisteredPGNTakesPrecedenceForMatchingManufacturer(t *testing.T) {
+	restoreRegistry(t)
+	registerTestPGN(t)
+
+	data := append(registryTestHeader(registryTestManufacturer, publicpgn.MarineIndustry), 42, 0xff, 0xff, 0xff, 0xff, 0xff)
+	stream := NewDataStream(data)
+	decoder, err := FindDecoder(stream, 65300)
+	if err != nil {
+		t.Fatalf("FindDecoder() error = %v", err)
+	}
+	decoded, err := decoder(MessageInfo{PGN: 65300, SourceId: 9}, stream)
+	if err != nil {
+		t.Fatalf("decoder() error = %v", err)
+	}
+	msg, ok := decoded.(registryTestPGN)
+	if !ok {
+		t.Fatalf("decoded type = %T, want registryTestPGN", decoded)
+	}
+	if msg.Value != 42 || msg.Info.SourceId != 9 {
+		t.Fatalf("decoded = %+v", msg)
+	}
+}
+
+func TestRegisteredPGNLeavesOtherManufacturersToGeneratedDecoders(t *testing.T) {
+	restoreRegistry(t)
+	registerTestPGN(t)
+
+	data := append(registryTestHeader(publicpgn.Simrad, publicpgn.MarineIndustry), 42, 0xff, 0xff, 0xff, 0xff, 0xff)
+	stream := NewDataStream(data)
+	decoder, err := FindDecoder(stream, 65300)
+	if err != nil {
+		return
+	}
+	decoded, err := decoder(MessageInfo{PGN: 65300}, stream)
+	if err == nil {
+		if _, ok := decoded.(registryTestPGN); ok {
+			t.Fatalf("payload from another manufacturer decoded as registered type")
+		}
+	}
+}
+
+func TestEncodeStructRegisteredPGN(t *testing.T) {
+	restoreRegistry(t)
+	registerTestPGN(t)
+
+	for _, value := range []any{registryTestPGN{Value: 7}, &registryTestPGN{Value: 7}} {
+		stream := NewDataStream(make([]uint8, MaxPGNLength))
+		info, err := EncodeStruct(value, stream)
+		if err != nil {
+			t.Fatalf("EncodeStruct(%T) error = %v", value, err)
+		}
+		if info.PGN != 65300 {
+			t.Fatalf("encoded PGN = %d, want 65300", info.PGN)
+		}
+		want := append(registryTestHeader(registryTestManufacturer, publicpgn.MarineIndustry), 7, 0xff, 0xff, 0xff, 0xff, 0xff)
+		if !bytes.Equal(stream.GetData(), want) {
+			t.Fatalf("encoded data = % x, want % x", stream.GetData(), want)
+		}
+	}
+}
+
+func TestEncodeStructUnregisteredTypeFails(t *testing.T) {
+	restoreRegistry(t)
+
+	stream := NewDataStream(make([]uint8, MaxPGNLength))
+	if _, err := EncodeStruct(registryTestPGN{}, stream); err == nil {
+		t.Fatalf("EncodeStruct() succeeded for unregistered type")
+	}
+}
+
+func TestRegisteredPGNFastPacketSetting(t *testing.T) {
+	restoreRegistry(t)
+
+	const customPGN = 130900
+	wasFast := IsFast(customPGN)
+	manufacturer := registryTestManufacturer
+	err := RegisterPGN(RegisteredPGN{
+		PGN:              customPGN,
+		Type:             reflect.TypeFor[registryTestFastPGN](),
+		ManufacturerCode: &manufacturer,
+		Fast:             !wasFast,
+		Decode:           func(MessageInfo, []uint8) (any, error) { return registryTestFastPGN{}, nil },
+		Encode:           func(any) (*MessageInfo, []uint8, error) { return nil, nil, nil },
+	})
+	if _, generated := KnownPGNs[customPGN]; generated {
+		if err == nil {
+			t.Fatalf("RegisterPGN() accepted a fast-packet setting that conflicts with generated data")
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("RegisterPGN() error = %v", err)
+	}
+	if IsFast(customPGN) == wasFast {
+		t.Fatalf("IsFast(%d) did not follow the registered setting", customPGN)
+	}
+	if !HasDecoder(customPGN) {
+		t.Fatalf("HasDecoder(%d) = false after registration", customPGN)
+	}
+}
+
+func TestRegisteredDecoderMayKeepPayload(t *testing.T) {
+	restoreRegistry(t)
+	manufacturer := registryTestManufacturer
+	err := RegisterPGN(RegisteredPGN{
+		PGN:              130901,
+		Type:             reflect.TypeFor[registryTestFastPGN](),
+		ManufacturerCode: &manufacturer,
+		Fast:             true,
+		Decode: func(info MessageInfo, data []uint8) (any, error) {
+			return registryTestFastPGN{Info: info, Payload: data}, nil
+		},
+		Encode: func(any) (*MessageInfo, []uint8, error) { return nil, nil, nil },
+	})
+	if err != nil {
+		t.Fatalf("RegisterPGN() error = %v", err)
+	}
+
+	// The payload buffer is reused for the next message, as reassembly does
+	buffer := append(registryTestHeader(manufacturer, publicpgn.MarineIndustry), 1, 2, 3)
+	stream := NewDataStream(buffer)
+	decoder, err := FindDecoder(stream, 130901)
+	if err != nil {
+		t.Fatalf("FindDecoder() error = %v", err)
+	}
+	decoded, err := decoder(MessageInfo{PGN: 130901}, stream)
+	if err != nil {
+		t.Fatalf("decoder() error = %v", err)
+	}
+	kept := decoded.(registryTestFastPGN).Payload
+	want := bytes.Clone(buffer)
+	copy(buffer[2:], []uint8{9, 9, 9})
+	if !bytes.Equal(kept, want) {
+		t.Fatalf("kept payload = %v, want %v", kept, want)
+	}
+}
+
+func TestRegisteredVariantsShareFraming(t *testing.T) {
+	restoreRegistry(t)
+	register := func(typ reflect.Type, manufacturer publicpgn.ManufacturerCodeConst, fast bool) error {
+		return RegisterPGN(RegisteredPGN{
+			PGN:              130902,
+			Type:             typ,
+			ManufacturerCode: &manufacturer,
+			Fast:             fast,
+			Decode:           func(MessageInfo, []uint8) (any, error) { return nil, nil },
+			Encode:           func(any) (*MessageInfo, []uint8, error) { return nil, nil, nil },
+		})
+	}
+	if err := register(reflect.TypeFor[registryTestFastPGN](), registryTestManufacturer, true); err != nil {
+		t.Fatalf("RegisterPGN() error = %v", err)
+	}
+	if err := register(reflect.TypeFor[registryTestPGN](), registryTestManufacturer+1, false); err == nil {
+		t.Fatalf("RegisterPGN() accepted a variant framed differently from the PGN's other variants")
+	}
+	if err := register(reflect.TypeFor[registryTestPGN](), registryTestManufacturer+1, true); err != nil {
+		t.Fatalf("RegisterPGN() error = %v", err)
+	}
+	if !IsFast(130902) {
+		t.Fatalf("IsFast(130902) = false for fast-packet variants")
+	}
+}
+
+func TestPublisherTimestampsRegisteredPGNsWithItsClock(t *testing.T) {
+	restoreRegistry(t)
+	registerTestPGN(t)
+	recorder := &infoRecorder{}
+	publisher := NewPublisher(recorder)
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	publisher.SetClock(clock.NewFake(now))
+
+	if err := publisher.Write(registryTestPGN{Value: 1}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if !recorder.info.Timestamp.Equal(now) {
+		t.Fatalf("Timestamp = %v, want the publisher's clock %v", recorder.info.Timestamp, now)
+	}
+}
+
+// infoRecorder is a PgnWriter that keeps the info of the last PGN written.
+type infoRecorder struct {
+	info MessageInfo
+}
+
+func (r *infoRecorder) WritePgn(info MessageInfo, _ []uint8) error {
+	r.info = info
+	return nil
+}
+
+func TestRegisterPGNRejectsInvalidDefinitions(t *testing.T) {
+	restoreRegistry(t)
+	registerTestPGN(t)
+
+	decode := func(MessageInfo, []uint8) (any, error) { return nil, nil }
+	encode := func(any) (*MessageInfo, []uint8, error) { return nil, nil, nil }
+	manufacturer := registryTestManufacturer
+	industry := publicpgn.MarineIndustry
+
+	tests := []struct {
+		name string
+		def  RegisteredPGN
+	}{
+		{"zero PGN", RegisteredPGN{Type: reflect.TypeFor[registryTestFastPGN](), Decode: decode, Encode: encode}},
+		{"non-struct type", RegisteredPGN{PGN: 65300, Type: reflect.TypeFor[int](), ManufacturerCode: &manufacturer, Decode: decode, Encode: encode}},
+		{"missing manufacturer", RegisteredPGN{PGN: 65301, Type: reflect.TypeFor[registryTestFastPGN](), Decode: decode, Encode: encode}},
+		{"duplicate type", RegisteredPGN{PGN: 65301, Type: reflect.TypeFor[registryTestPGN](), ManufacturerCode: &manufacturer, Decode: decode, Encode: encode}},
+		{"duplicate discriminator", RegisteredPGN{
+			PGN: 65300, Type: reflect.TypeFor[registryTestFastPGN](),
+			ManufacturerCode: &manufacturer, IndustryCode: &industry, Decode: decode, Encode: encode,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterPGN(tt.def); err == nil {
+				t.Fatalf("RegisterPGN() succeeded, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"reflect"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// restoreOriginalPayload merges info.Payload() into the freshly encoded stream. The original
+// payload is decoded and re-encoded; every bit where that encoding matches the new one
+// belongs to an unchanged field, a reserved or spare field, or padding, and is copied from
+// the original, and bytes past the encoded fields are appended. A struct whose fields all
+// match the original payload is written as that payload verbatim. If the original cannot be
+// decoded into the same type, or field changes alter the payload length, the field-only
+// encoding is kept.
+func restoreOriginalPayload(s any, info *publicpgn.MessageInfo, stream *DataStream) {
+	original := info.Payload()
+	encoded := stream.GetData()
+
+	decodeInfo := *info
+	decodeInfo.Details = nil
+	originalStream := NewDataStream(original)
+	decoder, err := FindDecoder(originalStream, info.PGN)
+	if err != nil {
+		return
+	}
+	decoded, err := decoder(decodeInfo, originalStream)
+	if err != nil || reflect.TypeOf(decoded) != reflect.Indirect(reflect.ValueOf(s)).Type() {
+		return
+	}
+
+	if len(original) <= len(stream.data) && sameFieldValues(s, decoded) {
+		copy(stream.data, original)
+		stream.byteOffset = uint16(len(original))
+		stream.bitOffset = 0
+		return
+	}
+
+	reencodedStream := NewDataStream(make([]uint8, len(stream.data)))
+	if _, err := encodeStructFields(decoded, reencodedStream); err != nil {
+		return
+	}
+	reencoded := reencodedStream.GetData()
+	if len(reencoded) != len(encoded) || len(original) < len(encoded) || len(original) > len(stream.data) {
+		return
+	}
+
+	for i := range encoded {
+		changed := encoded[i] ^ reencoded[i]
+		encoded[i] = original[i]&^changed | encoded[i]&changed
+	}
+	copy(stream.data[len(encoded):], original[len(encoded):])
+	stream.byteOffset = uint16(len(original))
+	stream.bitOffset = 0
+}
+
+// sameFieldValues reports whether s (a struct or pointer to one) and decoded hold the same
+// field values, ignoring Info.
+func sameFieldValues(s, decoded any) bool {
+	a := reflect.New(reflect.TypeOf(decoded)).Elem()
+	a.Set(reflect.Indirect(reflect.ValueOf(s)))
+	b := reflect.New(reflect.TypeOf(decoded)).Elem()
+	b.Set(reflect.ValueOf(decoded))
+	if info := a.FieldByName("Info"); info.IsValid() {
+		info.SetZero()
+		b.FieldByName("Info").SetZero()
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
@@ -0,0 +1,139 @@
+package pgn
+
+import (
+	"bytes"
+	"testing"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// headingPayload has zero reserved bits after Reference and a trailing byte past the
+// defined fields, neither of which the field encoder reproduces.
+var headingPayload = []uint8{7, 0x10, 0x27, 0x00, 0x00, 0x00, 0x00, 0x01, 0xAA}
+
+func decodeHeadingPayload(t *testing.T, retain bool) publicpgn.VesselHeading {
+	t.Helper()
+	info := MessageInfo{PGN: publicpgn.VesselHeadingPGN}
+	if retain {
+		info.Details = &publicpgn.DecodeDetails{Payload: bytes.Clone(headingPayload)}
+	}
+	stream := NewDataStream(headingPayload)
+	decoder, err := FindDecoder(stream, info.PGN)
+	if err != nil {
+		t.Fatalf("FindDecoder() error = %v", err)
+	}
+	decoded, err := decoder(info, stream)
+	if err != nil {
+		t.Fatalf("decoder() error = %v", err)
+	}
+	return decoded.(publicpgn.VesselHeading)
+}
+
+func encodeForTest(t *testing.T, s any) []uint8 {
+	t.Helper()
+	stream := NewDataStream(make([]uint8, MaxPGNLength))
+	if _, err := EncodeStruct(s, stream); err != nil {
+		t.Fatalf("EncodeStruct() error = %v", err)
+	}
+	return stream.GetData()
+}
+
+func TestEncodeStructReproducesRetainedPayload(t *testing.T) {
+	heading := decodeHeadingPayload(t, true)
+	if got := encodeForTest(t, heading); !bytes.Equal(got, headingPayload) {
+		t.Fatalf("encoded = % x, want % x", got, headingPayload)
+	}
+
+	withoutPayload := decodeHeadingPayload(t, false)
+	if got := encodeForTest(t, withoutPayload); bytes.Equal(got, headingPayload) {
+		t.Fatalf("field-only encoding unexpectedly reproduced reserved bits and trailing bytes")
+	}
+}
+
+func TestEncodeStructRetainedPayloadKeepsFieldChanges(t *testing.T) {
+	heading := decodeHeadingPayload(t, true)
+	value := float32(2)
+	heading.Heading = &value
+
+	got := encodeForTest(t, &heading)
+	want := bytes.Clone(headingPayload)
+	want[1], want[2] = 0x20, 0x4E
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoded = % x, want % x", got, want)
+	}
+}
+
+// roundTripFixtures are captured payloads whose bytes the field encoder alone does not
+// reproduce, so they only survive a round trip through the retained payload.
+var roundTripFixtures = []struct {
+	name    string
+	pgn     uint32
+	payload []uint8
+}{
+	{
+		name:    "heading with cleared reserved bits and a trailing byte",
+		pgn:     publicpgn.VesselHeadingPGN,
+		payload: headingPayload,
+	},
+	{
+		// Reference 6 is not in WindReference, and the reserved bits after it are mixed
+		name:    "wind with an unknown reference and set reserved bits",
+		pgn:     publicpgn.WindDataPGN,
+		payload: []uint8{0x01, 0xE8, 0x03, 0x10, 0x27, 0x56, 0x12, 0x34},
+	},
+	{
+		name:    "wind with cleared reserved bits",
+		pgn:     publicpgn.WindDataPGN,
+		payload: []uint8{0x02, 0xE8, 0x03, 0x10, 0x27, 0x02, 0x00, 0x00},
+	},
+}
+
+func decodeFixture(t *testing.T, pgn uint32, payload []uint8, retain bool) any {
+	t.Helper()
+	info := MessageInfo{PGN: pgn}
+	if retain {
+		info.Details = &publicpgn.DecodeDetails{Payload: bytes.Clone(payload)}
+	}
+	stream := NewDataStream(payload)
+	decoder, err := FindDecoder(stream, pgn)
+	if err != nil {
+		t.Fatalf("FindDecoder() error = %v", err)
+	}
+	decoded, err := decoder(info, stream)
+	if err != nil {
+		t.Fatalf("decoder() error = %v", err)
+	}
+	return decoded
+}
+
+func TestEncodeStructRoundTripsFixtures(t *testing.T) {
+	for _, fixture := range roundTripFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			retained := decodeFixture(t, fixture.pgn, fixture.payload, true)
+			if got := encodeForTest(t, retained); !bytes.Equal(got, fixture.payload) {
+				t.Fatalf("encoded = % x, want % x", got, fixture.payload)
+			}
+
+			fieldsOnly := decodeFixture(t, fixture.pgn, fixture.payload, false)
+			if got := encodeForTest(t, fieldsOnly); bytes.Equal(got, fixture.payload) {
+				t.Fatalf("fixture does not exercise the retained payload: field-only encoding = % x", got)
+			}
+		})
+	}
+}
+
+func TestEncodeStructRetainedPayloadKeepsUnknownLookupValues(t *testing.T) {
+	wind := decodeFixture(t, publicpgn.WindDataPGN, roundTripFixtures[1].payload, true).(publicpgn.WindData)
+	if wind.Reference != publicpgn.WindReferenceConst(6) {
+		t.Fatalf("Reference = %d, want 6", wind.Reference)
+	}
+	angle := float32(2)
+	wind.WindAngle = &angle
+
+	got := encodeForTest(t, wind)
+	want := bytes.Clone(roundTripFixtures[1].payload)
+	want[3], want[4] = 0x20, 0x4E
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoded = % x, want % x", got, want)
+	}
+}
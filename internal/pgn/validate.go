@@ -0,0 +1,315 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// FieldViolation is an alias for publicpgn.FieldViolation.
+type FieldViolation = publicpgn.FieldViolation
+
+var publicPGNPkgPath = reflect.TypeFor[publicpgn.MessageInfo]().PkgPath()
+
+// overflowProbeLength is the scratch buffer size used to measure payloads that do not
+// fit in MaxPGNLength.
+const overflowProbeLength = 8 * MaxPGNLength
+
+// Validate checks a PGN struct against the generated field specs and reports every field
+// that the encoder would clamp, reject, or write as a reserved value. Registered
+// application types have no field specs and always validate. Values that are not PGN
+// structs return an error.
+func Validate(s any) ([]FieldViolation, error) {
+	v := reflect.ValueOf(s)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("cannot validate nil %T", s)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot validate non-struct %T", s)
+	}
+	t := v.Type()
+	if t.PkgPath() != publicPGNPkgPath {
+		current := registry.Load()
+		if current != nil && current.byType[t] != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot validate a struct that isn't a PGN: %T", s)
+	}
+
+	var violations []FieldViolation
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fv := v.Field(i)
+		if field.Name == "Info" {
+			continue
+		}
+		if strings.HasPrefix(field.Name, "Repeating") && fv.Kind() == reflect.Slice {
+			for index := 0; index < fv.Len(); index++ {
+				elem := fv.Index(index)
+				for j := 0; j < elem.NumField(); j++ {
+					name := elem.Type().Field(j).Name
+					path := fmt.Sprintf("%s[%d].%s", field.Name, index, name)
+					violations = appendFieldViolations(violations, path, t.Name()+"."+name, elem.Field(j))
+				}
+			}
+			continue
+		}
+		violations = appendFieldViolations(violations, field.Name, t.Name()+"."+field.Name, fv)
+	}
+
+	return append(violations, repeatingViolations(v)...), nil
+}
+
+// appendFieldViolations checks one field value against its generated spec or string limit.
+func appendFieldViolations(violations []FieldViolation, path, key string, fv reflect.Value) []FieldViolation {
+	if fv.Kind() == reflect.String {
+		if limit, ok := stringFieldMaxBytes[key]; ok && fv.Len() > limit {
+			violations = append(violations, FieldViolation{
+				Field: path,
+				Kind:  publicpgn.ViolationStringTooLong,
+				Value: fv.Len(),
+				Max:   float64(limit),
+			})
+		}
+		return violations
+	}
+
+	spec, ok := fieldSpecIndex[key]
+	if !ok {
+		return violations
+	}
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return violations
+		}
+		if fv.Elem().Kind() == reflect.Struct {
+			value, err := canboatUnitValue(fv.Interface())
+			if err != nil {
+				return violations
+			}
+			return appendScaledViolation(violations, path, fv.Interface(), float64(value), spec)
+		}
+		fv = fv.Elem()
+	}
+
+	//nolint:exhaustive // Why: only numeric kinds carry field specs.
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if spec.IsScaled() {
+			return appendScaledViolation(violations, path, fv.Interface(), float64(fv.Int()), spec)
+		}
+		return appendRawViolation(violations, path, fv.Interface(), fv.Int(), 0, true, spec)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if spec.IsScaled() {
+			return appendScaledViolation(violations, path, fv.Interface(), float64(fv.Uint()), spec)
+		}
+		return appendRawViolation(violations, path, fv.Interface(), 0, fv.Uint(), false, spec)
+	case reflect.Float32, reflect.Float64:
+		return appendScaledViolation(violations, path, fv.Interface(), fv.Float(), spec)
+	default:
+		return violations
+	}
+}
+
+// appendRawViolation checks an unscaled integer the way WriteRaw encodes it.
+func appendRawViolation(
+	violations []FieldViolation, path string, value any, signedValue int64, unsignedValue uint64, isSigned bool, spec *FieldSpec,
+) []FieldViolation {
+	if spec.IsSigned {
+		if !isSigned {
+			if unsignedValue > math.MaxInt64 {
+				return append(violations, rangeViolation(path, value, spec))
+			}
+			signedValue = int64(unsignedValue)
+		}
+		return appendSignedRawViolation(violations, path, value, signedValue-spec.Offset, spec)
+	}
+
+	var raw uint64
+	switch {
+	case isSigned:
+		wire := signedValue - spec.Offset
+		if wire < 0 {
+			return append(violations, rangeViolation(path, value, spec))
+		}
+		raw = uint64(wire)
+	case spec.Offset > 0:
+		if unsignedValue < uint64(spec.Offset) {
+			return append(violations, rangeViolation(path, value, spec))
+		}
+		raw = unsignedValue - uint64(spec.Offset)
+	default:
+		raw = unsignedValue + uint64(-spec.Offset)
+	}
+	return appendUnsignedRawViolation(violations, path, value, raw, spec)
+}
+
+func appendUnsignedRawViolation(violations []FieldViolation, path string, value any, raw uint64, spec *FieldSpec) []FieldViolation {
+	if raw > allOnes(spec.BitLength) {
+		return append(violations, rangeViolation(path, value, spec))
+	}
+	if spec.ReservedCount > 0 && raw > spec.MaxRawValue {
+		return append(violations, reservedViolation(path, value, spec))
+	}
+	return violations
+}
+
+func appendSignedRawViolation(violations []FieldViolation, path string, value any, raw int64, spec *FieldSpec) []FieldViolation {
+	minRaw := -int64(1) << (spec.BitLength - 1)
+	maxRaw := int64(allOnes(spec.BitLength - 1))
+	if raw < minRaw || raw > maxRaw {
+		return append(violations, rangeViolation(path, value, spec))
+	}
+	if spec.ReservedCount > 0 && raw > int64(spec.MaxRawValue) {
+		return append(violations, reservedViolation(path, value, spec))
+	}
+	return violations
+}
+
+// appendScaledViolation checks a physical value the way WriteScaled encodes it, except
+// that domain limits are reported instead of clamped.
+func appendScaledViolation(violations []FieldViolation, path string, value any, physical float64, spec *FieldSpec) []FieldViolation {
+	if math.IsNaN(physical) || math.IsInf(physical, 0) {
+		return append(violations, rangeViolation(path, value, spec))
+	}
+	if (spec.DomainMin != nil && physical < *spec.DomainMin) || (spec.DomainMax != nil && physical > *spec.DomainMax) {
+		violation := rangeViolation(path, value, spec)
+		if spec.DomainMin != nil {
+			violation.Min = *spec.DomainMin
+		}
+		if spec.DomainMax != nil {
+			violation.Max = *spec.DomainMax
+		}
+		return append(violations, violation)
+	}
+
+	raw := physical - float64(spec.Offset)
+	if spec.IsScaled() {
+		raw = roundFloat(raw/spec.Resolution, calcPrecision(spec.Resolution))
+	}
+	raw = math.Trunc(raw)
+	if spec.IsSigned {
+		if raw < -math.Ldexp(1, int(spec.BitLength)-1) || raw >= math.Ldexp(1, int(spec.BitLength)-1) {
+			return append(violations, rangeViolation(path, value, spec))
+		}
+		return appendSignedRawViolation(violations, path, value, int64(raw), spec)
+	}
+	if raw < 0 || raw >= math.Ldexp(1, int(spec.BitLength)) {
+		return append(violations, rangeViolation(path, value, spec))
+	}
+	return appendUnsignedRawViolation(violations, path, value, uint64(raw), spec)
+}
+
+// repeatingViolations reports repeating sets whose entries overflow the maximum payload.
+// The struct is encoded once, recording where each entry ends; a set's entries fit up to
+// the last one that ends within MaxPGNLength, with the sets before it kept whole.
+func repeatingViolations(v reflect.Value) []FieldViolation {
+	t := v.Type()
+	hasEntries := false
+	for i := 0; i < t.NumField(); i++ {
+		if strings.HasPrefix(t.Field(i).Name, "Repeating") && v.Field(i).Kind() == reflect.Slice && v.Field(i).Len() > 0 {
+			hasEntries = true
+			break
+		}
+	}
+	if !hasEntries {
+		return nil
+	}
+
+	// Encoding runs until the probe buffer is full; entries past it never end, so they
+	// are counted as not fitting.
+	var entryEnds [2][]uint32
+	stream := NewDataStream(make([]uint8, overflowProbeLength))
+	stream.entryEnds = &entryEnds
+	if _, err := EncodeStruct(v.Interface(), stream); err != nil && stream.byteOffset < MaxPGNLength {
+		return nil
+	}
+
+	var violations []FieldViolation
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		set := 0
+		switch field.Name {
+		case "Repeating1":
+			set = 1
+		case "Repeating2":
+			set = 2
+		}
+		if set == 0 || field.Type.Kind() != reflect.Slice {
+			continue
+		}
+		entries := v.Field(i).Len()
+		fits := 0
+		for _, end := range entryEnds[set-1] {
+			if (end+7)/8 > MaxPGNLength {
+				break
+			}
+			fits++
+		}
+		if fits < entries {
+			violations = append(violations, FieldViolation{
+				Field: field.Name,
+				Kind:  publicpgn.ViolationRepeatingCountTooLarge,
+				Value: entries,
+				Max:   float64(fits),
+			})
+		}
+	}
+	return violations
+}
+
+func rangeViolation(path string, value any, spec *FieldSpec) FieldViolation {
+	minRaw, maxRaw := 0.0, float64(allOnes(spec.BitLength))
+	if spec.ReservedCount > 0 || spec.MaxRawValue != 0 {
+		maxRaw = float64(spec.MaxRawValue)
+	}
+	if spec.IsSigned {
+		minRaw = -math.Ldexp(1, int(spec.BitLength)-1)
+		if spec.ReservedCount == 0 {
+			maxRaw = math.Ldexp(1, int(spec.BitLength)-1) - 1
+		}
+	}
+	return FieldViolation{
+		Field: path,
+		Kind:  publicpgn.ViolationOutOfRange,
+		Value: value,
+		Min:   physicalValue(minRaw, spec),
+		Max:   physicalValue(maxRaw, spec),
+	}
+}
+
+func reservedViolation(path string, value any, spec *FieldSpec) FieldViolation {
+	return FieldViolation{
+		Field: path,
+		Kind:  publicpgn.ViolationReservedValue,
+		Value: value,
+		Max:   physicalValue(float64(spec.MaxRawValue), spec),
+	}
+}
+
+func physicalValue(raw float64, spec *FieldSpec) float64 {
+	if spec.IsScaled() {
+		raw *= spec.Resolution
+	}
+	return raw + float64(spec.Offset)
+}
+
+func allOnes(bitLength uint16) uint64 {
+	if bitLength >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bitLength - 1
+}
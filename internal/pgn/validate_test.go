@@ -0,0 +1,112 @@
+package pgn
+
+import (
+	"testing"
+
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+func TestValidateAcceptsValidStruct(t *testing.T) {
+	sid := uint8(1)
+	heading := float32(1.5)
+	violations, err := Validate(publicpgn.VesselHeading{SID: &sid, Heading: &heading})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("Validate() violations = %v, want none", violations)
+	}
+}
+
+func TestValidateReportsFieldViolations(t *testing.T) {
+	sid := uint8(0xFE)
+	heading := float32(7)
+	deviation := float32(-4)
+	msg := &publicpgn.ProductInformation{ModelID: "a model identifier that is far too long for its field"}
+
+	violations, err := Validate(&publicpgn.VesselHeading{SID: &sid, Heading: &heading, Deviation: &deviation})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	want := map[string]publicpgn.ViolationKind{
+		"SID":       publicpgn.ViolationReservedValue,
+		"Heading":   publicpgn.ViolationOutOfRange,
+		"Deviation": publicpgn.ViolationOutOfRange,
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("Validate() violations = %v, want %d", violations, len(want))
+	}
+	for _, v := range violations {
+		if want[v.Field] != v.Kind {
+			t.Fatalf("violation %v, want kind %v for %s", v, want[v.Field], v.Field)
+		}
+		if v.Field == "Heading" && (v.Min != 0 || v.Max < 6.28 || v.Max > 6.29) {
+			t.Fatalf("Heading range = [%g, %g], want domain limits", v.Min, v.Max)
+		}
+	}
+
+	violations, err = Validate(msg)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(violations) != 1 || violations[0].Field != "ModelID" || violations[0].Kind != publicpgn.ViolationStringTooLong || violations[0].Max != 32 {
+		t.Fatalf("Validate() violations = %v, want ModelID too long", violations)
+	}
+}
+
+func TestValidateReportsRepeatingOverflow(t *testing.T) {
+	msg := publicpgn.PGNListTransmitAndReceive{FunctionCode: publicpgn.TransmitPGNList}
+	for i := 0; i < 100; i++ {
+		pgn := uint32(127250)
+		msg.Repeating1 = append(msg.Repeating1, publicpgn.PGNListTransmitAndReceiveRepeating1{PGN: &pgn})
+	}
+
+	violations, err := Validate(msg)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(violations) != 1 || violations[0].Kind != publicpgn.ViolationRepeatingCountTooLarge {
+		t.Fatalf("Validate() violations = %v, want repeating count too large", violations)
+	}
+	fits := int(violations[0].Max)
+	msg.Repeating1 = msg.Repeating1[:fits+1]
+	if violations, _ := Validate(msg); len(violations) != 1 {
+		t.Fatalf("Validate() with %d entries = %v, want repeating count too large", fits+1, violations)
+	}
+	msg.Repeating1 = msg.Repeating1[:fits]
+	if violations, _ := Validate(msg); len(violations) != 0 {
+		t.Fatalf("Validate() with %d entries = %v, want none", fits, violations)
+	}
+}
+
+func TestValidateRejectsNonPGN(t *testing.T) {
+	for _, value := range []any{42, struct{ A int }{}, (*publicpgn.VesselHeading)(nil)} {
+		if _, err := Validate(value); err == nil {
+			t.Fatalf("Validate(%T) succeeded, want error", value)
+		}
+	}
+}
+
+func TestValidateReportsOnlyTheOverflowingRepeatingSet(t *testing.T) {
+	parameter := uint8(1)
+	msg := publicpgn.NMEAReadFieldsGroupFunction{
+		FunctionCode: publicpgn.ReadFields,
+		Repeating1:   []publicpgn.NMEAReadFieldsGroupFunctionRepeating1{{SelectionParameter: &parameter}},
+	}
+	for i := 0; i < 300; i++ {
+		msg.Repeating2 = append(msg.Repeating2, publicpgn.NMEAReadFieldsGroupFunctionRepeating2{Parameter: &parameter})
+	}
+
+	violations, err := Validate(msg)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(violations) != 1 || violations[0].Field != "Repeating2" {
+		t.Fatalf("Validate() violations = %v, want Repeating2 count too large", violations)
+	}
+	fits := int(violations[0].Max)
+	msg.Repeating2 = msg.Repeating2[:fits]
+	if violations, _ := Validate(msg); len(violations) != 0 {
+		t.Fatalf("Validate() with %d entries = %v, want none", fits, violations)
+	}
+}
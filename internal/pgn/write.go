@@ -8,6 +8,10 @@
 package pgn
 
 import (
+	"context"
+	"sync"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
 	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 )
 
@@ -16,9 +20,21 @@ type PgnWriter interface {
 	WritePgn(publicpgn.MessageInfo, []uint8) error
 }
 
+// ContextPgnWriter is a PgnWriter that reports whether a PGN reached the network.
+type ContextPgnWriter interface {
+	WritePgnContext(context.Context, publicpgn.MessageInfo, []uint8) error
+}
+
+// WriteAuthorizer decides whether a struct, encoded with info, may be written by the
+// caller of ctx. ctx is context.Background() for Publisher.Write.
+type WriteAuthorizer func(ctx context.Context, s any, info publicpgn.MessageInfo) error
+
 // Publisher defines an object that can interact with a PgnWriter
 type Publisher struct {
-	handler PgnWriter
+	handler   PgnWriter
+	authorize WriteAuthorizer
+	// clock timestamps written messages; nil is the wall clock
+	clock clock.Clock
 }
 
 // NewPublisher returns a new Publisher instance with the specified PgnWriter
@@ -28,20 +44,97 @@ func NewPublisher(handler PgnWriter) Publisher {
 	}
 }
 
+// SetClock sets the clock that timestamps the messages written. A nil clock is the wall
+// clock.
+func (p *Publisher) SetClock(c clock.Clock) {
+	p.clock = c
+}
+
+// SetAuthorizer makes the publisher check each struct with authorize once it is encoded,
+// returning its error instead of writing the struct. nil writes every struct.
+func (p *Publisher) SetAuthorizer(authorize WriteAuthorizer) {
+	p.authorize = authorize
+}
+
 // Write writes a golang type describing a PGN to the n2k network.
 // If validates the type passed in and returns an error if invalid
 // The pgn is written to the network asynchronously, so errors are logged
 func (p *Publisher) Write(s any) error {
-	var info *publicpgn.MessageInfo
-	var err error
-	data := make([]uint8, 223)
-	stream := NewDataStream(data)
-	info, err = EncodeStruct(s, stream)
+	return p.WriteTagged(context.Background(), s)
+}
+
+// WriteTagged is Write for the caller of ctx: the struct is authorized with ctx, but the
+// write is neither bounded by it nor waited for.
+func (p *Publisher) WriteTagged(ctx context.Context, s any) error {
+	buffer := encodeBuffers.Get().(*encodeBuffer)
+	defer encodeBuffers.Put(buffer)
+	info, data, err := encode(s, buffer)
 	if err != nil {
 		return err
 	}
+	info.Timestamp = clock.OrReal(p.clock).Now()
+	if p.authorize != nil {
+		if err := p.authorize(ctx, s, *info); err != nil {
+			return err
+		}
+	}
 	if p.handler != nil {
-		err = p.handler.WritePgn(*info, data[0:stream.byteOffset:stream.byteOffset])
+		err = p.handler.WritePgn(*info, data)
 	}
 	return err
 }
+
+// WriteContext writes a golang type describing a PGN to the n2k network and waits until
+// it has been sent, returning the endpoint's error. Handlers that are not a
+// ContextPgnWriter are written as with Write.
+func (p *Publisher) WriteContext(ctx context.Context, s any) error {
+	buffer := encodeBuffers.Get().(*encodeBuffer)
+	defer encodeBuffers.Put(buffer)
+	info, data, err := encode(s, buffer)
+	if err != nil {
+		return err
+	}
+	info.Timestamp = clock.OrReal(p.clock).Now()
+	if p.authorize != nil {
+		if err := p.authorize(ctx, s, *info); err != nil {
+			return err
+		}
+	}
+	switch handler := p.handler.(type) {
+	case nil:
+		return nil
+	case ContextPgnWriter:
+		return handler.WritePgnContext(ctx, *info, data)
+	default:
+		return handler.WritePgn(*info, data)
+	}
+}
+
+// EncodedInfo encodes s and returns its MessageInfo as it would be written, with the PGN
+// set by the encoder.
+func EncodedInfo(s any) (publicpgn.MessageInfo, error) {
+	buffer := encodeBuffers.Get().(*encodeBuffer)
+	defer encodeBuffers.Put(buffer)
+	info, _, err := encode(s, buffer)
+	if err != nil {
+		return publicpgn.MessageInfo{}, err
+	}
+	return *info, nil
+}
+
+// encodeBuffer holds the payload of a PGN while it is written. Handlers must not keep
+// the data they are passed, as the buffer is reused once they return.
+type encodeBuffer [MaxPGNLength]uint8
+
+var encodeBuffers = sync.Pool{New: func() any { return new(encodeBuffer) }}
+
+func encode(s any, buffer *encodeBuffer) (*publicpgn.MessageInfo, []uint8, error) {
+	data := buffer[:]
+	clear(data)
+	stream := NewDataStream(data)
+	info, err := EncodeStruct(s, stream)
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, data[0:stream.byteOffset:stream.byteOffset], nil
+}
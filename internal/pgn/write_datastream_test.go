@@ -1,6 +1,7 @@
 package pgn
 
 import (
+	"context"
 	"math"
 	"testing"
 
@@ -117,6 +118,41 @@ func TestWritePgn(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// contextRecorder is a ContextPgnWriter that counts how it is written to.
+type contextRecorder struct {
+	writes, contextWrites int
+}
+
+func (r *contextRecorder) WritePgn(publicpgn.MessageInfo, []uint8) error {
+	r.writes++
+	return nil
+}
+
+func (r *contextRecorder) WritePgnContext(context.Context, publicpgn.MessageInfo, []uint8) error {
+	r.contextWrites++
+	return nil
+}
+
+type tagKey struct{}
+
+func TestWriteTaggedAuthorizesWithContextWithoutWaiting(t *testing.T) {
+	recorder := &contextRecorder{}
+	publisher := NewPublisher(recorder)
+	var tags []any
+	publisher.SetAuthorizer(func(ctx context.Context, _ any, _ publicpgn.MessageInfo) error {
+		tags = append(tags, ctx.Value(tagKey{}))
+		return nil
+	})
+
+	heading := float32(1)
+	msg := publicpgn.VesselHeading{Info: publicpgn.MessageInfo{SourceId: 3}, Heading: &heading}
+	assert.NoError(t, publisher.WriteTagged(context.WithValue(context.Background(), tagKey{}, "helm"), msg))
+	assert.NoError(t, publisher.Write(msg))
+	assert.Equal(t, []any{"helm", nil}, tags)
+	assert.Equal(t, 2, recorder.writes)
+	assert.Zero(t, recorder.contextWrites)
+}
+
 func TestWriteFloat32NilReturnsError(t *testing.T) {
 	stream := NewDataStream(make([]uint8, 32))
 	err := stream.writeFloat32(nil, 32, 0, 0)
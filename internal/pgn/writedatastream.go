@@ -143,27 +143,38 @@ func (s *DataStream) writeUnit(value any, spec *FieldSpec) error {
 		return WriteScaled[float32](s, nil, spec)
 	}
 
-	// Convert to canboat's default unit based on the type
-	var canboatValue float32
+	canboatValue, err := canboatUnitValue(value)
+	if err != nil {
+		return err
+	}
+	return WriteScaled(s, &canboatValue, spec)
+}
+
+// BaseUnitValue converts a pointer to a units package value, as found in PGN struct
+// fields, to the unit NMEA 2000 encodes it in.
+func BaseUnitValue(value any) (float32, error) {
+	return canboatUnitValue(value)
+}
+
+// canboatUnitValue converts a units package value to canboat's default unit for its type.
+func canboatUnitValue(value any) (float32, error) {
 	switch v := value.(type) {
 	case *units.Distance:
-		canboatValue = v.Convert(units.Meter).Value
+		return v.Convert(units.Meter).Value, nil
 	case *units.Velocity:
-		canboatValue = v.Convert(units.MetersPerSecond).Value
+		return v.Convert(units.MetersPerSecond).Value, nil
 	case *units.Volume:
-		canboatValue = v.Convert(units.Liter).Value
+		return v.Convert(units.Liter).Value, nil
 	case *units.Temperature:
-		canboatValue = v.Convert(units.Kelvin).Value
+		return v.Convert(units.Kelvin).Value, nil
 	case *units.Pressure:
-		canboatValue = v.Convert(units.Pa).Value
+		return v.Convert(units.Pa).Value, nil
 	case *units.Flow:
-		canboatValue = v.Convert(units.LitersPerHour).Value
+		return v.Convert(units.LitersPerHour).Value, nil
 	default:
 		// invalid unit, return error
-		return fmt.Errorf("invalid unit type: %T", value)
+		return 0, fmt.Errorf("invalid unit type: %T", value)
 	}
-
-	return WriteScaled(s, &canboatValue, spec)
 }
 
 // writeFloat32 writes the specified length of value at the specified offset.
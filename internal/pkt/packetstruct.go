@@ -9,8 +9,10 @@ package pkt
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/boatkit-io/n2k/internal/pgn"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
 )
 
 // StructHandler is an interface for a handler of the output of a PacketStruct
@@ -20,7 +22,9 @@ type StructHandler interface {
 
 // PacketStruct methods convert Packets to golang structs and sends them on.
 type PacketStruct struct {
-	handler StructHandler
+	handler        StructHandler
+	partialDecodes bool
+	retainPayloads bool
 }
 
 // NewPacketStruct initializes and returns a new PacketStruct instance.
@@ -33,12 +37,28 @@ func (ps *PacketStruct) SetOutput(sh StructHandler) {
 	ps.handler = sh
 }
 
+// SetPartialDecodes controls whether payloads that fail partway through decoding are
+// delivered as partially decoded structs, with Info.DecodeWarnings() set, instead of UnknownPGN.
+func (ps *PacketStruct) SetPartialDecodes(enabled bool) {
+	ps.partialDecodes = enabled
+}
+
+// SetRetainPayloads controls whether decoded structs keep a copy of their original
+// payload in Info.Details, so that re-encoding them reproduces it byte for byte.
+func (ps *PacketStruct) SetRetainPayloads(enabled bool) {
+	ps.retainPayloads = enabled
+}
+
 // HandlePacket is how you tell PacketStruct to start processing a new
 // packet into a PGN
 //
 //nolint:gocritic // Why: Breaking change to change.
 func (ps *PacketStruct) HandlePacket(pkt Packet) {
-	stream := pgn.NewDataStream(pkt.Data)
+	if ps.retainPayloads {
+		pkt.Info.Details = &publicpgn.DecodeDetails{Payload: slices.Clone(pkt.Data)}
+	}
+	stream := pgn.AcquireDataStream(pkt.Data)
+	defer pgn.ReleaseDataStream(stream)
 	decoder, err := pgn.FindDecoder(stream, pkt.Info.PGN)
 	if err != nil {
 		pkt.ParseErrors = append(pkt.ParseErrors, fmt.Errorf("no matching decoder for PGN %d: %w", pkt.Info.PGN, err))
@@ -48,6 +68,12 @@ func (ps *PacketStruct) HandlePacket(pkt Packet) {
 
 	ret, err := decoder(pkt.Info, stream)
 	if err != nil {
+		if ps.partialDecodes {
+			if partial, ok := pgn.PartialStruct(err); ok {
+				ps.pgnReady(partial)
+				return
+			}
+		}
 		pkt.ParseErrors = append(pkt.ParseErrors, err)
 		ps.pgnReady(pkt.UnknownPGN())
 		return
@@ -0,0 +1,59 @@
+package pkt
+
+import (
+	"testing"
+
+	"github.com/boatkit-io/n2k/internal/pgn"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/stretchr/testify/assert"
+)
+
+type capturingStructHandler struct {
+	structs []any
+}
+
+func (h *capturingStructHandler) HandleStruct(s any) {
+	h.structs = append(h.structs, s)
+}
+
+// truncatedHeadingPacket carries SID and Heading, then ends before Deviation.
+func truncatedHeadingPacket() Packet {
+	return *NewPacket(pgn.MessageInfo{PGN: publicpgn.VesselHeadingPGN, SourceId: 3}, []uint8{7, 0x10, 0x27})
+}
+
+func TestHandlePacketTruncatedPayloadIsUnknownByDefault(t *testing.T) {
+	ps := NewPacketStruct()
+	handler := &capturingStructHandler{}
+	ps.SetOutput(handler)
+
+	ps.HandlePacket(truncatedHeadingPacket())
+
+	assert.Len(t, handler.structs, 1)
+	unknown, ok := handler.structs[0].(pgn.UnknownPGN)
+	assert.True(t, ok)
+	assert.Contains(t, unknown.Reason.Error(), "parse failed for VesselHeading-Deviation")
+}
+
+func TestHandlePacketDeliversPartialStruct(t *testing.T) {
+	ps := NewPacketStruct()
+	ps.SetPartialDecodes(true)
+	handler := &capturingStructHandler{}
+	ps.SetOutput(handler)
+
+	ps.HandlePacket(truncatedHeadingPacket())
+
+	assert.Len(t, handler.structs, 1)
+	heading, ok := handler.structs[0].(publicpgn.VesselHeading)
+	if !assert.True(t, ok, "got %T", handler.structs[0]) {
+		return
+	}
+	assert.Equal(t, uint8(7), *heading.SID)
+	assert.InDelta(t, 1.0, *heading.Heading, 0.0001)
+	assert.Nil(t, heading.Deviation)
+	assert.Nil(t, heading.Variation)
+	assert.Equal(t, uint8(3), heading.Info.SourceId)
+	warnings := heading.Info.DecodeWarnings()
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, "VesselHeading-Deviation", warnings[0].Field)
+	assert.Error(t, warnings[0].Reason)
+}
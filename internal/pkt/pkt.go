@@ -54,13 +54,20 @@ type Packet struct {
 
 // NewPacket returns a pointer to an initialized new packet,
 func NewPacket(info pgn.MessageInfo, data []byte) *Packet {
+	p := MakePacket(info, data)
+	return &p
+}
+
+// MakePacket returns an initialized new packet by value, so that a caller that does
+// not keep it avoids an allocation.
+func MakePacket(info pgn.MessageInfo, data []byte) Packet {
 	p := Packet{}
 	p.Data = data
 	p.Info = info
 	if p.Valid() {
 		p.Proprietary = pgn.IsProprietaryPGN(p.Info.PGN)
 	}
-	return &p
+	return p
 }
 
 // Valid does light sanity checking on a packet.
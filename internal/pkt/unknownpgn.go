@@ -9,6 +9,7 @@ package pkt
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/boatkit-io/n2k/internal/pgn"
@@ -20,8 +21,9 @@ import (
 //nolint:errcheck // Why: needs a refactor
 func buildUnknownPGN(p *Packet) pgn.UnknownPGN {
 	ret := pgn.UnknownPGN{
-		Info:   p.Info,
-		Data:   p.Data,
+		Info: p.Info,
+		// the packet's data is reused once it has been handled
+		Data:   slices.Clone(p.Data),
 		Reason: fmt.Errorf("%s", mergeErrorStrings(p.ParseErrors)),
 	}
 	if pgn.IsProprietaryPGN(ret.Info.PGN) {
@@ -0,0 +1,185 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package subscribe
+
+import (
+	"sync"
+	"time"
+)
+
+// OverflowPolicy selects what an asynchronous subscription does with a new struct when
+// its queue is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest queued struct to make room for the new one.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the new struct and keeps the queue as it is.
+	DropNewest
+	// CoalesceLatest replaces a queued struct that has the same coalesce key with the new
+	// one, keeping its place in the queue. Structs with a new key drop the oldest struct
+	// when the queue is full.
+	CoalesceLatest
+)
+
+// Options configures a typed subscription.
+type Options struct {
+	// Filter, when set, is called on the dispatching goroutine and drops structs it rejects.
+	Filter func(any) bool
+	// QueueSize, when positive, makes the subscription asynchronous: structs are queued
+	// and the callback runs on a goroutine owned by the subscription.
+	QueueSize int
+	// Overflow selects what happens when the queue is full.
+	Overflow OverflowPolicy
+	// MinInterval, when positive, delivers at most one struct per key per interval.
+	MinInterval time.Duration
+	// KeepLatest delivers the latest struct held back by MinInterval when the interval
+	// ends, from a timer goroutine.
+	KeepLatest bool
+	// Changed, when set, reports whether next differs enough from the last struct
+	// delivered for its key to be delivered.
+	Changed func(last, next any) bool
+	// Key groups structs for CoalesceLatest, MinInterval and Changed. Without it every
+	// struct of the subscription shares one key.
+	Key func(any) uint64
+}
+
+// AsyncObserver receives delivery observations from asynchronous subscriptions. A
+// CallbackObserver that also implements AsyncObserver receives them.
+type AsyncObserver interface {
+	ObserveAsyncDelivery(structName, callbackName string, lag time.Duration)
+	ObserveAsyncDrop(structName, callbackName string)
+	// ObserveAsyncReplace is called when CoalesceLatest replaces a queued struct with a
+	// newer one of the same key, which is not a drop.
+	ObserveAsyncReplace(structName, callbackName string)
+}
+
+// pushResult tells what queueing a struct did to the queue.
+type pushResult int
+
+const (
+	pushQueued pushResult = iota
+	// pushDropped means a struct, either the new one or the oldest queued, was discarded.
+	pushDropped
+	// pushReplaced means the new struct took the place of a queued one with the same key.
+	pushReplaced
+)
+
+type asyncEntry struct {
+	value  any
+	key    uint64
+	queued time.Time
+}
+
+// asyncQueue is a bounded ring buffer feeding one subscription goroutine.
+type asyncQueue struct {
+	mu      sync.Mutex
+	ready   *sync.Cond
+	entries []asyncEntry
+	head    int
+	count   int
+	closed  bool
+
+	overflow OverflowPolicy
+	key      func(any) uint64
+}
+
+func newAsyncQueue(options Options) *asyncQueue {
+	q := &asyncQueue{
+		entries:  make([]asyncEntry, options.QueueSize),
+		overflow: options.Overflow,
+		key:      options.Key,
+	}
+	q.ready = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues a struct and reports whether a struct was dropped or replaced to do so.
+func (q *asyncQueue) push(value any, now time.Time) pushResult {
+	var key uint64
+	if q.overflow == CoalesceLatest && q.key != nil {
+		key = q.key(value)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return pushQueued
+	}
+
+	if q.overflow == CoalesceLatest {
+		for i := 0; i < q.count; i++ {
+			entry := &q.entries[(q.head+i)%len(q.entries)]
+			if entry.key == key {
+				entry.value = value
+				return pushReplaced
+			}
+		}
+	}
+
+	result := pushQueued
+	if q.count == len(q.entries) {
+		if q.overflow == DropNewest {
+			return pushDropped
+		}
+		q.entries[q.head] = asyncEntry{}
+		q.head = (q.head + 1) % len(q.entries)
+		q.count--
+		result = pushDropped
+	}
+	q.entries[(q.head+q.count)%len(q.entries)] = asyncEntry{value: value, key: key, queued: now}
+	q.count++
+	q.ready.Signal()
+	return result
+}
+
+// pop waits for the next struct. It returns false once the queue is closed.
+func (q *asyncQueue) pop() (asyncEntry, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.count == 0 && !q.closed {
+		q.ready.Wait()
+	}
+	if q.closed {
+		return asyncEntry{}, false
+	}
+	entry := q.entries[q.head]
+	q.entries[q.head] = asyncEntry{}
+	q.head = (q.head + 1) % len(q.entries)
+	q.count--
+	return entry, true
+}
+
+// close stops the subscription goroutine and discards queued structs.
+func (q *asyncQueue) close() {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	q.ready.Broadcast()
+}
+
+// runAsync delivers queued structs to an asynchronous subscription until it is closed.
+func (s *SubscribeManager) runAsync(sub *trackedSub) {
+	for {
+		entry, ok := sub.queue.pop()
+		if !ok {
+			return
+		}
+		callbackObserver := s.observer()
+		if observer, ok := callbackObserver.(AsyncObserver); ok {
+			observer.ObserveAsyncDelivery(sub.structName, sub.callbackName, sub.clock.Now().Sub(entry.queued))
+		}
+		callTyped(sub, entry.value, callbackObserver)
+	}
+}
+
+func (s *SubscribeManager) observer() CallbackObserver {
+	s.subMutex.Lock()
+	defer s.subMutex.Unlock()
+	return s.callbackObserver
+}
@@ -0,0 +1,180 @@
+package subscribe
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type asyncTestObserver struct {
+	mu         sync.Mutex
+	deliveries int
+	drops      int
+	replaced   int
+	callbacks  int
+}
+
+func (o *asyncTestObserver) CallbackStarted(_, _ string) uint64 { return 0 }
+func (o *asyncTestObserver) CallbackFinished(_ uint64)          {}
+func (o *asyncTestObserver) ObserveCallback(_, _ string, _ time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.callbacks++
+}
+func (o *asyncTestObserver) ObserveAsyncDelivery(_, _ string, _ time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.deliveries++
+}
+
+func (o *asyncTestObserver) ObserveAsyncDrop(_, _ string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.drops++
+}
+
+func (o *asyncTestObserver) ObserveAsyncReplace(_, _ string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.replaced++
+}
+
+func (o *asyncTestObserver) counts() (drops, replaced, callbacks int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.drops, o.replaced, o.callbacks
+}
+
+// blockedAsyncSubscription subscribes asynchronously, delivers field1 == 0 and waits
+// until the callback is blocked on it, so later structs stay queued until release.
+func blockedAsyncSubscription(t *testing.T, s *SubscribeManager, options Options) (got chan int, release func()) {
+	got = make(chan int, 16)
+	gate := make(chan struct{})
+	started := make(chan struct{})
+	_, err := SubscribeWithOptions(s, options, func(v test2) {
+		if v.field1 == 0 {
+			close(started)
+			<-gate
+		}
+		got <- v.field1
+	})
+	require.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 0})
+	<-started
+	return got, func() { close(gate) }
+}
+
+func receive(t *testing.T, got chan int, count int) []int {
+	values := make([]int, 0, count)
+	for range count {
+		select {
+		case v := <-got:
+			values = append(values, v)
+		case <-time.After(time.Second):
+			t.Fatalf("received %v, want %d values", values, count)
+		}
+	}
+	return values
+}
+
+func TestAsyncSubscriptionOverflowPolicies(t *testing.T) {
+	key := func(p any) uint64 { return uint64(len(p.(test2).field2)) }
+	tests := []struct {
+		name     string
+		policy   OverflowPolicy
+		sends    []test2
+		want     []int
+		drops    int
+		replaced int
+	}{
+		{
+			name:   "drop oldest",
+			policy: DropOldest,
+			sends:  []test2{{field1: 1}, {field1: 2}, {field1: 3}},
+			want:   []int{0, 2, 3},
+			drops:  1,
+		},
+		{
+			name:   "drop newest",
+			policy: DropNewest,
+			sends:  []test2{{field1: 1}, {field1: 2}, {field1: 3}},
+			want:   []int{0, 1, 2},
+			drops:  1,
+		},
+		{
+			name:     "coalesce latest",
+			policy:   CoalesceLatest,
+			sends:    []test2{{field1: 1, field2: "a"}, {field1: 2, field2: "bb"}, {field1: 3, field2: "a"}},
+			want:     []int{0, 3, 2},
+			replaced: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New()
+			s.SetTypeIndex(2, testTypeIndex)
+			observer := &asyncTestObserver{}
+			s.SetCallbackObserver(observer)
+
+			got, release := blockedAsyncSubscription(t, s, Options{QueueSize: 2, Overflow: tt.policy, Key: key})
+			for _, v := range tt.sends {
+				s.HandleStruct(v)
+			}
+			release()
+
+			assert.Equal(t, tt.want, receive(t, got, len(tt.want)))
+			// async callbacks are timed like synchronous ones, once each returns
+			assert.Eventually(t, func() bool {
+				_, _, callbacks := observer.counts()
+				return callbacks == len(tt.want)
+			}, time.Second, time.Millisecond)
+			drops, replaced, _ := observer.counts()
+			assert.Equal(t, tt.drops, drops)
+			assert.Equal(t, tt.replaced, replaced)
+		})
+	}
+}
+
+func TestAsyncSubscriptionDoesNotBlockOtherSubscribers(t *testing.T) {
+	s := New()
+	got, release := blockedAsyncSubscription(t, s, Options{QueueSize: 4})
+	defer release()
+
+	var delivered []int
+	_, err := Subscribe(s, func(v test2) { delivered = append(delivered, v.field1) })
+	assert.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 1})
+	assert.Equal(t, []int{1}, delivered)
+	assert.Empty(t, got)
+}
+
+func TestAsyncSubscriptionUnsubscribeStopsDelivery(t *testing.T) {
+	s := New()
+	got := make(chan int, 4)
+	subID, err := SubscribeWithOptions(s, Options{QueueSize: 4}, func(v test2) { got <- v.field1 })
+	require.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 1})
+	assert.Equal(t, []int{1}, receive(t, got, 1))
+
+	require.NoError(t, subID.Unsubscribe())
+	s.HandleStruct(test2{field1: 2})
+	select {
+	case v := <-got:
+		t.Fatalf("received %d after unsubscribe", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestAsyncSubscriptionErrors(t *testing.T) {
+	s := New()
+	_, err := SubscribeWithOptions(s, Options{QueueSize: -1}, func(_ test2) {})
+	assert.Error(t, err)
+	_, err = SubscribeWithOptions(s, Options{QueueSize: 1, Overflow: OverflowPolicy(7)}, func(_ test2) {})
+	assert.Error(t, err)
+}
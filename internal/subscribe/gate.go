@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package subscribe
+
+import (
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
+)
+
+// gateIdleTimeout is how long a key may go without structs before its gate state is
+// evicted. Keys come from source addresses and instances, which change as devices join
+// and leave the bus, so without eviction the states would only grow.
+const gateIdleTimeout = 10 * time.Minute
+
+// deliveryGate throttles a typed subscription per key and suppresses structs that have
+// not changed enough since the last one delivered.
+type deliveryGate struct {
+	mu     sync.Mutex
+	states map[uint64]*gateState
+	closed bool
+	clock  clock.Clock
+	// nextSweep is when admit next evicts idle keys
+	nextSweep time.Time
+
+	minInterval time.Duration
+	keepLatest  bool
+	changed     func(last, next any) bool
+	key         func(any) uint64
+	// deliver is called for held structs when their interval ends
+	deliver func(any)
+}
+
+type gateState struct {
+	lastSeen     time.Time
+	lastDelivery time.Time
+	last         any
+	pending      any
+	timer        clock.Timer
+}
+
+func newDeliveryGate(options Options, clk clock.Clock, deliver func(any)) *deliveryGate {
+	return &deliveryGate{
+		states:      make(map[uint64]*gateState),
+		clock:       clk,
+		minInterval: options.MinInterval,
+		keepLatest:  options.KeepLatest,
+		changed:     options.Changed,
+		key:         options.Key,
+		deliver:     deliver,
+	}
+}
+
+// admit reports whether p should be delivered now. A struct held back by KeepLatest is
+// delivered by the gate when its interval ends.
+func (g *deliveryGate) admit(p any, now time.Time) bool {
+	var key uint64
+	if g.key != nil {
+		key = g.key(p)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return false
+	}
+	g.sweep(now)
+	state := g.states[key]
+	if state == nil {
+		state = &gateState{}
+		g.states[key] = state
+	}
+	state.lastSeen = now
+
+	if g.changed != nil && state.last != nil && !g.changed(state.last, p) {
+		// The latest value is within the deadband, so a held value is stale too.
+		state.pending = nil
+		return false
+	}
+	if g.minInterval > 0 && state.last != nil {
+		if wait := state.lastDelivery.Add(g.minInterval).Sub(now); wait > 0 {
+			if g.keepLatest {
+				state.pending = p
+				if state.timer == nil {
+					state.timer = g.clock.AfterFunc(wait, func() { g.flush(key) })
+				}
+			}
+			return false
+		}
+	}
+
+	state.lastDelivery = now
+	state.last = p
+	state.pending = nil
+	return true
+}
+
+// sweep evicts keys that have seen no structs for the idle timeout, at most once per
+// timeout. A key that is evicted starts again as new, so its next struct is delivered.
+// The caller holds mu.
+func (g *deliveryGate) sweep(now time.Time) {
+	if now.Before(g.nextSweep) {
+		return
+	}
+	// the interval must end before a key is forgotten, or it would be delivered early
+	idle := max(gateIdleTimeout, g.minInterval)
+	g.nextSweep = now.Add(idle)
+	for key, state := range g.states {
+		if state.timer == nil && now.Sub(state.lastSeen) >= idle {
+			delete(g.states, key)
+		}
+	}
+}
+
+// flush delivers the struct held for key, if any.
+func (g *deliveryGate) flush(key uint64) {
+	g.mu.Lock()
+	state := g.states[key]
+	state.timer = nil
+	p := state.pending
+	if g.closed || p == nil {
+		g.mu.Unlock()
+		return
+	}
+	state.pending = nil
+	state.last = p
+	state.lastDelivery = g.clock.Now()
+	g.mu.Unlock()
+
+	g.deliver(p)
+}
+
+// close stops pending deliveries.
+func (g *deliveryGate) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+	for _, state := range g.states {
+		if state.timer != nil {
+			state.timer.Stop()
+		}
+	}
+}
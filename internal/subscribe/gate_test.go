@@ -0,0 +1,104 @@
+package subscribe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGateMinIntervalPerKey(t *testing.T) {
+	gate := newDeliveryGate(Options{
+		MinInterval: time.Second,
+		Key:         func(p any) uint64 { return uint64(len(p.(test2).field2)) },
+	}, clock.Real(), func(any) { t.Fatal("nothing should be held without KeepLatest") })
+	start := time.Unix(100, 0)
+
+	assert.True(t, gate.admit(test2{field1: 1, field2: "a"}, start))
+	assert.False(t, gate.admit(test2{field1: 2, field2: "a"}, start.Add(500*time.Millisecond)))
+	assert.True(t, gate.admit(test2{field1: 3, field2: "bb"}, start.Add(500*time.Millisecond)))
+	assert.True(t, gate.admit(test2{field1: 4, field2: "a"}, start.Add(time.Second)))
+}
+
+func TestGateEvictsIdleKeys(t *testing.T) {
+	gate := newDeliveryGate(Options{
+		MinInterval: time.Second,
+		Changed:     func(last, next any) bool { return last.(test2).field1 != next.(test2).field1 },
+		Key:         func(p any) uint64 { return uint64(len(p.(test2).field2)) },
+	}, clock.Real(), func(any) {})
+	start := time.Unix(100, 0)
+
+	assert.True(t, gate.admit(test2{field1: 1, field2: "a"}, start))
+	assert.True(t, gate.admit(test2{field1: 1, field2: "bb"}, start))
+	// "a" keeps arriving unchanged, so it is suppressed but not idle
+	for elapsed := time.Minute; elapsed < gateIdleTimeout; elapsed += time.Minute {
+		assert.False(t, gate.admit(test2{field1: 1, field2: "a"}, start.Add(elapsed)))
+	}
+	assert.False(t, gate.admit(test2{field1: 1, field2: "a"}, start.Add(gateIdleTimeout)))
+	assert.Len(t, gate.states, 1, "the idle key should have been evicted")
+
+	// an evicted key starts again, so its next struct is delivered even if unchanged
+	assert.True(t, gate.admit(test2{field1: 1, field2: "bb"}, start.Add(gateIdleTimeout)))
+	assert.Len(t, gate.states, 2)
+}
+
+func TestGateKeepLatestDeliversHeldStruct(t *testing.T) {
+	fake := clock.NewFake(time.Unix(100, 0))
+	s := New()
+	s.SetClock(fake)
+	var got []int
+	_, err := SubscribeWithOptions(s, Options{MinInterval: time.Second, KeepLatest: true}, func(v test2) {
+		got = append(got, v.field1)
+	})
+	require.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 1})
+	fake.Advance(400 * time.Millisecond)
+	s.HandleStruct(test2{field1: 2})
+	s.HandleStruct(test2{field1: 3})
+	assert.Equal(t, []int{1}, got)
+
+	// The held struct goes out as the interval ends, and starts the next one
+	fake.Advance(600 * time.Millisecond)
+	assert.Equal(t, []int{1, 3}, got)
+	s.HandleStruct(test2{field1: 4})
+	fake.Advance(10 * time.Second)
+	assert.Equal(t, []int{1, 3, 4}, got)
+}
+
+func TestGateChangedSuppressesSmallChanges(t *testing.T) {
+	gate := newDeliveryGate(Options{
+		Changed: func(last, next any) bool {
+			delta := next.(test2).field1 - last.(test2).field1
+			return delta >= 5 || delta <= -5
+		},
+	}, clock.Real(), nil)
+	now := time.Unix(100, 0)
+
+	assert.True(t, gate.admit(test2{field1: 10}, now))
+	assert.False(t, gate.admit(test2{field1: 14}, now))
+	assert.True(t, gate.admit(test2{field1: 15}, now))
+	assert.False(t, gate.admit(test2{field1: 11}, now))
+	assert.True(t, gate.admit(test2{field1: 9}, now))
+}
+
+func TestGateUnsubscribeCancelsHeldStruct(t *testing.T) {
+	s := New()
+	got := make(chan int, 8)
+	subID, err := SubscribeWithOptions(s, Options{MinInterval: 20 * time.Millisecond, KeepLatest: true}, func(v test2) {
+		got <- v.field1
+	})
+	require.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 1})
+	s.HandleStruct(test2{field1: 2})
+	require.NoError(t, subID.Unsubscribe())
+	assert.Equal(t, []int{1}, receive(t, got, 1))
+	select {
+	case v := <-got:
+		t.Fatalf("unexpected delivery of %d after unsubscribe", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -9,12 +9,15 @@
 package subscribe
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
 )
 
 // SubscribeManager maintains lists of subscribers to specific or all
@@ -31,13 +34,21 @@ type SubscribeManager struct {
 	all       []*trackedSub
 	lastSubId SubscriptionId
 
+	// typed subscriptions indexed by type ID; each slice is replaced, never modified
+	typeID      func(any) (int, bool)
+	typed       [][]*trackedSub
+	typedByType map[reflect.Type][]*trackedSub
+
 	callbackObserver CallbackObserver
+	clock            clock.Clock
 }
 
 // CallbackObserver receives aggregate callback timing observations from the synchronous subscriber path.
 type CallbackObserver interface {
-	CallbackStarted(structName, callbackName string)
-	CallbackFinished()
+	// CallbackStarted returns an ID for the invocation, which CallbackFinished takes back;
+	// callbacks on different decode workers run concurrently.
+	CallbackStarted(structName, callbackName string) uint64
+	CallbackFinished(id uint64)
 	ObserveCallback(structName, callbackName string, duration time.Duration)
 }
 
@@ -46,6 +57,22 @@ type CallbackObserver interface {
 //nolint:revive // Why: Breaking change to refactor.
 type SubscriptionId uint
 
+// Subscription is a handle to a typed subscription.
+type Subscription struct {
+	// ID identifies the subscription to SubscribeManager.Unsubscribe.
+	ID SubscriptionId
+
+	manager *SubscribeManager
+}
+
+// Unsubscribe cancels the subscription.
+func (sub Subscription) Unsubscribe() error {
+	if sub.manager == nil {
+		return errors.New("subscription has no manager")
+	}
+	return sub.manager.Unsubscribe(sub.ID)
+}
+
 // trackedSub connects a  subscriber with a function that fulfills a
 // specific subscription.
 //
@@ -56,6 +83,20 @@ type trackedSub struct {
 	// Will be either func(any) for global handler or func(specific struct) for a struct callback
 	callback     any
 	callbackName string
+	// set for typed subscriptions, which are dispatched without reflection
+	typedCallback func(any)
+	filter        func(any) bool
+	goType        reflect.Type
+	// set for asynchronous subscriptions
+	queue *asyncQueue
+	// set for throttled or change-only subscriptions, whose held structs are delivered
+	// from timers; callMu keeps those calls from overlapping
+	gate   *deliveryGate
+	callMu sync.Mutex
+	// index into typed, or -1 when tracked in typedByType
+	typeIndex int
+	// times callbacks, queued structs and the gate of typed subscriptions
+	clock clock.Clock
 }
 
 // New returns a pointer to a new SubscribeManager.
@@ -65,9 +106,31 @@ func New() *SubscribeManager {
 		subs:      make(map[SubscriptionId]*trackedSub),
 		all:       []*trackedSub{},
 		singles:   make(map[string][]*trackedSub),
+
+		typedByType: make(map[reflect.Type][]*trackedSub),
+		clock:       clock.Real(),
 	}
 }
 
+// SetTypeIndex installs a function mapping struct values to dense IDs below count.
+// Typed subscriptions to types with an ID are dispatched through a slice lookup; all
+// other types fall back to a map keyed by reflect.Type. It must be called before any
+// typed subscription is added.
+func (s *SubscribeManager) SetTypeIndex(count int, typeID func(any) (int, bool)) {
+	s.subMutex.Lock()
+	defer s.subMutex.Unlock()
+	s.typeID = typeID
+	s.typed = make([][]*trackedSub, count)
+}
+
+// SetClock sets the clock that callbacks and subscription rate limits are timed by. A
+// nil clock is the wall clock. It must be called before any subscription is added.
+func (s *SubscribeManager) SetClock(c clock.Clock) {
+	s.subMutex.Lock()
+	defer s.subMutex.Unlock()
+	s.clock = clock.OrReal(c)
+}
+
 // SetCallbackObserver sets a callback timing observer for diagnostics.
 func (s *SubscribeManager) SetCallbackObserver(observer CallbackObserver) {
 	s.subMutex.Lock()
@@ -116,6 +179,10 @@ func (s *SubscribeManager) Unsubscribe(subId SubscriptionId) error {
 		return fmt.Errorf("subscription %d not found", subId)
 	}
 
+	if ts.typedCallback != nil {
+		return s.removeTypedSubscription(ts)
+	}
+
 	if ts.structName == "" {
 		// global sub
 		found := false
@@ -158,8 +225,31 @@ func (s *SubscribeManager) Unsubscribe(subId SubscriptionId) error {
 }
 
 // HandleStruct calls registered subscriber callbacks for a struct.
-// It calls all specific subscribers and all subscribers.
+// It calls typed subscribers, then specific subscribers, then all subscribers.
 func (s *SubscribeManager) HandleStruct(p any) {
+	s.subMutex.Lock()
+	typed := s.typedSubscriptions(p)
+	reflective := len(s.singles) > 0 || len(s.all) > 0
+	callbackObserver := s.callbackObserver
+	clk := s.clock
+	s.subMutex.Unlock()
+
+	for _, sub := range typed {
+		if sub.filter != nil && !sub.filter(p) {
+			continue
+		}
+		if sub.gate != nil && !sub.gate.admit(p, clk.Now()) {
+			continue
+		}
+		deliverTyped(sub, p, callbackObserver)
+	}
+	if reflective {
+		s.handleReflective(p)
+	}
+}
+
+// handleReflective calls the specific and all subscribers registered with reflection.
+func (s *SubscribeManager) handleReflective(p any) {
 	pv := reflect.ValueOf(p)
 	sn := pv.Type().Name()
 
@@ -168,6 +258,7 @@ func (s *SubscribeManager) HandleStruct(p any) {
 
 	s.subMutex.Lock()
 	callbackObserver := s.callbackObserver
+	clk := s.clock
 
 	if single, exists := s.singles[sn]; exists {
 		// Copy the single slice in case it changes while we're iterating
@@ -233,18 +324,19 @@ func (s *SubscribeManager) HandleStruct(p any) {
 			callWith = []reflect.Value{pv}
 		}
 
-		start := time.Now()
+		start := clk.Now()
+		var callbackID uint64
 		if callbackObserver != nil {
-			callbackObserver.CallbackStarted(sn, call.name)
+			callbackID = callbackObserver.CallbackStarted(sn, call.name)
 		}
 		func() {
 			if callbackObserver != nil {
-				defer callbackObserver.CallbackFinished()
+				defer callbackObserver.CallbackFinished(callbackID)
 			}
 			t.Call(callWith)
 		}()
 		if callbackObserver != nil {
-			callbackObserver.ObserveCallback(sn, call.name, time.Since(start))
+			callbackObserver.ObserveCallback(sn, call.name, clk.Now().Sub(start))
 		}
 	}
 }
@@ -309,3 +401,197 @@ func (s *SubscribeManager) SubscribeToAllStructs(callback any) (SubscriptionId,
 
 	return s.addSubscription("", callback)
 }
+
+// Subscribe registers a type-safe subscription to struct type T. The callback is
+// dispatched without reflection, through the type index when T has an ID and through a
+// map keyed by reflect.Type otherwise.
+func Subscribe[T any](s *SubscribeManager, callback func(T)) (Subscription, error) {
+	return SubscribeWithFilter(s, nil, callback)
+}
+
+// SubscribeWithFilter registers a type-safe subscription to struct type T whose callback
+// is only called for structs the filter accepts. A nil filter accepts every struct. The
+// filter runs on the dispatching goroutine before the callback.
+func SubscribeWithFilter[T any](s *SubscribeManager, filter func(any) bool, callback func(T)) (Subscription, error) {
+	return SubscribeWithOptions(s, Options{Filter: filter}, callback)
+}
+
+// SubscribeWithOptions registers a type-safe subscription to struct type T configured
+// by options, which can make it filtered, asynchronous, or both.
+func SubscribeWithOptions[T any](s *SubscribeManager, options Options, callback func(T)) (Subscription, error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return Subscription{}, fmt.Errorf("subscribe called with non-struct type: %s", t)
+	}
+	if callback == nil {
+		return Subscription{}, fmt.Errorf("subscribe called with nil callback for %s", t.Name())
+	}
+	if options.QueueSize < 0 {
+		return Subscription{}, fmt.Errorf("subscribe called with negative queue size %d", options.QueueSize)
+	}
+	if options.Overflow < DropOldest || options.Overflow > CoalesceLatest {
+		return Subscription{}, fmt.Errorf("subscribe called with unknown overflow policy %d", options.Overflow)
+	}
+	if options.MinInterval < 0 {
+		return Subscription{}, fmt.Errorf("subscribe called with negative minimum interval %s", options.MinInterval)
+	}
+
+	var zero T
+	id, err := s.addTypedSubscription(zero, t, callback, options, func(p any) {
+		callback(p.(T))
+	})
+	if err != nil {
+		return Subscription{}, err
+	}
+	return Subscription{ID: id, manager: s}, nil
+}
+
+// addTypedSubscription adds a typed subscription. Subscription slices are copied on
+// write so HandleStruct can call them outside the mutex without copying.
+func (s *SubscribeManager) addTypedSubscription(
+	zero any, t reflect.Type, callback any, options Options, typedCallback func(any),
+) (SubscriptionId, error) {
+	s.subMutex.Lock()
+	defer s.subMutex.Unlock()
+
+	s.lastSubId++
+	ts := &trackedSub{
+		subId:         s.lastSubId,
+		structName:    t.Name(),
+		callback:      callback,
+		callbackName:  callbackDisplayName(callback),
+		typedCallback: typedCallback,
+		filter:        options.Filter,
+		goType:        t,
+		typeIndex:     -1,
+		clock:         s.clock,
+	}
+	s.subs[ts.subId] = ts
+
+	if id, ok := s.indexType(zero); ok {
+		ts.typeIndex = id
+		s.typed[id] = append(append([]*trackedSub(nil), s.typed[id]...), ts)
+	} else {
+		s.typedByType[t] = append(append([]*trackedSub(nil), s.typedByType[t]...), ts)
+	}
+
+	if options.QueueSize > 0 {
+		ts.queue = newAsyncQueue(options)
+		go s.runAsync(ts)
+	}
+	if options.MinInterval > 0 || options.Changed != nil {
+		ts.gate = newDeliveryGate(options, s.clock, func(p any) {
+			deliverTyped(ts, p, s.observer())
+		})
+	}
+
+	return ts.subId, nil
+}
+
+// removeTypedSubscription removes a typed subscription. The caller holds subMutex.
+func (s *SubscribeManager) removeTypedSubscription(ts *trackedSub) error {
+	delete(s.subs, ts.subId)
+	if ts.queue != nil {
+		ts.queue.close()
+	}
+	if ts.gate != nil {
+		ts.gate.close()
+	}
+
+	if ts.typeIndex >= 0 {
+		subs, found := withoutSub(s.typed[ts.typeIndex], ts)
+		if !found {
+			return fmt.Errorf("typed subscription %d not tracked somehow in %s", ts.subId, ts.structName)
+		}
+		s.typed[ts.typeIndex] = subs
+		return nil
+	}
+
+	subs, found := withoutSub(s.typedByType[ts.goType], ts)
+	if !found {
+		return fmt.Errorf("typed subscription %d not tracked somehow in %s", ts.subId, ts.structName)
+	}
+	if len(subs) == 0 {
+		delete(s.typedByType, ts.goType)
+	} else {
+		s.typedByType[ts.goType] = subs
+	}
+	return nil
+}
+
+// typedSubscriptions returns the typed subscriptions for p. The caller holds subMutex.
+func (s *SubscribeManager) typedSubscriptions(p any) []*trackedSub {
+	if id, ok := s.indexType(p); ok {
+		return s.typed[id]
+	}
+	if len(s.typedByType) == 0 {
+		return nil
+	}
+	return s.typedByType[reflect.TypeOf(p)]
+}
+
+func (s *SubscribeManager) indexType(p any) (int, bool) {
+	if s.typeID == nil {
+		return 0, false
+	}
+	id, ok := s.typeID(p)
+	if !ok || id < 0 || id >= len(s.typed) {
+		return 0, false
+	}
+	return id, true
+}
+
+func callTyped(sub *trackedSub, p any, callbackObserver CallbackObserver) {
+	if callbackObserver == nil {
+		sub.typedCallback(p)
+		return
+	}
+
+	start := sub.clock.Now()
+	callbackID := callbackObserver.CallbackStarted(sub.structName, sub.callbackName)
+	func() {
+		defer callbackObserver.CallbackFinished(callbackID)
+		sub.typedCallback(p)
+	}()
+	callbackObserver.ObserveCallback(sub.structName, sub.callbackName, sub.clock.Now().Sub(start))
+}
+
+// deliverTyped queues p for an asynchronous subscription or calls the callback.
+func deliverTyped(sub *trackedSub, p any, callbackObserver CallbackObserver) {
+	if sub.queue != nil {
+		queueTyped(sub, p, callbackObserver)
+		return
+	}
+	if sub.gate != nil {
+		sub.callMu.Lock()
+		defer sub.callMu.Unlock()
+	}
+	callTyped(sub, p, callbackObserver)
+}
+
+func queueTyped(sub *trackedSub, p any, callbackObserver CallbackObserver) {
+	result := sub.queue.push(p, sub.clock.Now())
+	observer, ok := callbackObserver.(AsyncObserver)
+	if !ok {
+		return
+	}
+	switch result {
+	case pushDropped:
+		observer.ObserveAsyncDrop(sub.structName, sub.callbackName)
+	case pushReplaced:
+		observer.ObserveAsyncReplace(sub.structName, sub.callbackName)
+	case pushQueued:
+	}
+}
+
+// withoutSub returns a copy of subs without ts, and whether ts was present.
+func withoutSub(subs []*trackedSub, ts *trackedSub) ([]*trackedSub, bool) {
+	for i, sub := range subs {
+		if sub == ts {
+			out := make([]*trackedSub, 0, len(subs)-1)
+			out = append(out, subs[:i]...)
+			return append(out, subs[i+1:]...), true
+		}
+	}
+	return subs, false
+}
@@ -117,3 +117,97 @@ func TestSubscriptionErrors(t *testing.T) {
 	// second time should break
 	assert.Error(t, s.Unsubscribe(subID))
 }
+
+func testTypeIndex(p any) (int, bool) {
+	switch p.(type) {
+	case test1:
+		return 0, true
+	case test2:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+func TestTypedSubscriptions(t *testing.T) {
+	for _, indexed := range []bool{true, false} {
+		s := New()
+		if indexed {
+			s.SetTypeIndex(2, testTypeIndex)
+		}
+
+		var typed, typed2 []test1
+		sub, err := Subscribe(s, func(v test1) { typed = append(typed, v) })
+		assert.NoError(t, err)
+		sub2, err := Subscribe(s, func(v test1) { typed2 = append(typed2, v) })
+		assert.NoError(t, err)
+
+		// Reflective subscriptions keep working alongside typed ones
+		var reflective []test1
+		reflectiveID, err := s.SubscribeToStruct(test1{}, func(v test1) { reflective = append(reflective, v) })
+		assert.NoError(t, err)
+
+		s.HandleStruct(test1{field1: makeFloat(1.0)})
+		s.HandleStruct(test2{field1: 2})
+		assert.Len(t, typed, 1)
+		assert.Len(t, typed2, 1)
+		assert.Len(t, reflective, 1)
+		assert.Equal(t, float32(1.0), *typed[0].field1)
+
+		assert.NoError(t, sub.Unsubscribe())
+		assert.Error(t, sub.Unsubscribe())
+		s.HandleStruct(test1{field1: makeFloat(2.0)})
+		assert.Len(t, typed, 1)
+		assert.Len(t, typed2, 2)
+		assert.Len(t, reflective, 2)
+
+		assert.NoError(t, s.Unsubscribe(sub2.ID))
+		assert.NoError(t, s.Unsubscribe(reflectiveID))
+		s.HandleStruct(test1{field1: makeFloat(3.0)})
+		assert.Len(t, typed2, 2)
+		assert.Len(t, reflective, 2)
+	}
+}
+
+func TestTypedSubscriptionErrors(t *testing.T) {
+	s := New()
+
+	_, err := Subscribe(s, func(_ int) {})
+	assert.Error(t, err)
+	_, err = Subscribe(s, func(_ *test1) {})
+	assert.Error(t, err)
+	_, err = Subscribe[test1](s, nil)
+	assert.Error(t, err)
+	assert.Error(t, Subscription{}.Unsubscribe())
+}
+
+func TestTypedSubscriptionDoesNotAllocate(t *testing.T) {
+	s := New()
+	s.SetTypeIndex(2, testTypeIndex)
+
+	count := 0
+	_, err := Subscribe(s, func(_ test2) { count++ })
+	assert.NoError(t, err)
+
+	var p any = test2{field1: 1}
+	allocs := testing.AllocsPerRun(100, func() {
+		s.HandleStruct(p)
+	})
+	assert.Zero(t, allocs)
+	assert.Positive(t, count)
+}
+
+func TestTypedSubscriptionFilter(t *testing.T) {
+	s := New()
+	s.SetTypeIndex(2, testTypeIndex)
+
+	var got []test2
+	_, err := SubscribeWithFilter(s, func(p any) bool { return p.(test2).field1 > 1 }, func(v test2) {
+		got = append(got, v)
+	})
+	assert.NoError(t, err)
+
+	s.HandleStruct(test2{field1: 1})
+	s.HandleStruct(test2{field1: 2})
+	assert.Equal(t, []test2{{field1: 2}}, got)
+}
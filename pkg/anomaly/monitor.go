@@ -0,0 +1,608 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+// Package anomaly watches NMEA 2000 traffic for signs of misbehaving or spoofed devices.
+//
+// NMEA 2000 has no authentication, so any device may send any PGN from any address. A
+// Monitor cannot prevent that, but it reports traffic that does not fit what the
+// network has shown so far: sources transmitting before claiming an address, two NAMEs
+// on one address, address claim storms, PGNs appearing from unexpected sources, control
+// PGNs from unknown devices and messages whose timing departs from the learned baseline.
+package anomaly
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/brutella/can"
+
+	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/n2k"
+	"github.com/boatkit-io/n2k/pkg/node"
+	publicpgn "github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+const (
+	// DefaultLearningPeriod is how long a monitor learns which sources send which PGNs
+	// before reporting unexpected ones.
+	DefaultLearningPeriod = 30 * time.Second
+	// DefaultClaimStormThreshold is the number of address claims within the storm window
+	// beyond which a claim storm is reported.
+	DefaultClaimStormThreshold = 20
+	// DefaultClaimStormWindow is the period over which address claims are counted.
+	DefaultClaimStormWindow = time.Second
+	// DefaultConflictWindow is how long two NAMEs may claim one address before it is
+	// reported, leaving the loser time to move to another address.
+	DefaultConflictWindow = 500 * time.Millisecond
+	// DefaultTimingSamples is the number of intervals learned before timing is checked.
+	DefaultTimingSamples = 8
+	// DefaultTimingTolerance is how many mean deviations an interval may stray from the
+	// learned mean.
+	DefaultTimingTolerance = 5.0
+)
+
+const (
+	// nullAddress is the source of a claim by a device that could not claim an address.
+	nullAddress = 254
+	// timingWeight is the weight of each new interval in the learned mean and deviation.
+	timingWeight = 0.125
+	// minTimingDeviation is the deviation assumed of a stream at least as regular as
+	// this fraction of its interval, so that jitter in a very regular stream is not
+	// reported.
+	minTimingDeviation = 0.1
+)
+
+// Kind identifies an anomaly.
+type Kind int
+
+const (
+	// TransmitBeforeClaim is a source address transmitting without having claimed it.
+	TransmitBeforeClaim Kind = iota + 1
+	// AddressConflict is two NAMEs claiming one address, neither moving away.
+	AddressConflict
+	// ClaimStorm is more address claims within the storm window than the threshold.
+	ClaimStorm
+	// UnexpectedPGN is a PGN from a source that did not send it while the monitor was
+	// learning and does not list it among the PGNs it transmits.
+	UnexpectedPGN
+	// UnknownControlSource is a control or safety-critical PGN from a source whose NAME
+	// is unknown.
+	UnknownControlSource
+	// TimingDeviation is a message arriving far from the learned interval of its PGN and
+	// source.
+	TimingDeviation
+)
+
+func (k Kind) String() string {
+	switch k {
+	case TransmitBeforeClaim:
+		return "transmit before claim"
+	case AddressConflict:
+		return "address conflict"
+	case ClaimStorm:
+		return "claim storm"
+	case UnexpectedPGN:
+		return "unexpected PGN"
+	case UnknownControlSource:
+		return "unknown control source"
+	case TimingDeviation:
+		return "timing deviation"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Event describes an anomaly. Fields that do not apply to its Kind are zero.
+type Event struct {
+	Kind Kind
+	Time time.Time
+	// Bus is the bus the traffic was received on, empty for an endpoint with one bus.
+	Bus    string
+	Source uint8
+	// Name is the NAME last claimed by Source, zero when unknown.
+	Name uint64
+	PGN  uint32
+	// Names are the NAMEs claiming Source, for AddressConflict.
+	Names []uint64
+	// Claims is the number of claims within the storm window, for ClaimStorm.
+	Claims int
+	// Class is the class of the PGN, for UnknownControlSource.
+	Class n2k.WriteClass
+	// Interval is the interval since the previous message, and Expected the learned
+	// interval, for TimingDeviation.
+	Interval time.Duration
+	Expected time.Duration
+}
+
+// Option configures a Monitor.
+type Option func(*options)
+
+type options struct {
+	learningPeriod      time.Duration
+	claimStormThreshold int
+	claimStormWindow    time.Duration
+	conflictWindow      time.Duration
+	timingSamples       int
+	timingTolerance     float64
+}
+
+// WithLearningPeriod sets how long the monitor learns which sources send which PGNs.
+// Sources transmitting during it are taken to have claimed their addresses.
+func WithLearningPeriod(period time.Duration) Option {
+	return func(options *options) {
+		options.learningPeriod = period
+	}
+}
+
+// WithClaimStorm reports a claim storm when more than threshold address claims are
+// received within window.
+func WithClaimStorm(threshold int, window time.Duration) Option {
+	return func(options *options) {
+		options.claimStormThreshold = threshold
+		options.claimStormWindow = window
+	}
+}
+
+// WithConflictWindow sets how long two NAMEs may claim one address before it is
+// reported.
+func WithConflictWindow(window time.Duration) Option {
+	return func(options *options) {
+		options.conflictWindow = window
+	}
+}
+
+// WithTiming checks the timing of each PGN and source once samples intervals have been
+// learned, reporting intervals more than tolerance mean deviations from the mean.
+func WithTiming(samples int, tolerance float64) Option {
+	return func(options *options) {
+		options.timingSamples = samples
+		options.timingTolerance = tolerance
+	}
+}
+
+// sourceKey identifies a source address on a bus.
+type sourceKey struct {
+	bus     string
+	address uint8
+}
+
+// sourceState is what the monitor knows of a source address.
+type sourceState struct {
+	name    uint64
+	claimed bool
+	// reportedUnclaimed is set once TransmitBeforeClaim has been reported
+	reportedUnclaimed bool
+	pgns              map[uint32]*pgnState
+}
+
+// pgnState is the learned timing of a PGN from one source.
+type pgnState struct {
+	last    time.Time
+	mean    float64
+	dev     float64
+	samples int
+	// outliers counts consecutive deviating intervals
+	outliers          int
+	reportedControl   bool
+	reportedDeviation bool
+}
+
+// conflict is an address claimed by two NAMEs, waiting for one to move.
+type conflict struct {
+	key   sourceKey
+	names []uint64
+	timer clock.Timer
+}
+
+// Monitor reports anomalies in the frames it is given, using a node's device tracking
+// to know which devices have claimed addresses and which PGNs they transmit.
+type Monitor struct {
+	clock   clock.Clock
+	options options
+
+	mu            sync.Mutex
+	started       bool
+	node          *node.Node
+	learningUntil time.Time
+	deviceSub     node.SubscriptionID
+	sources       map[sourceKey]*sourceState
+	deviceNames   map[uint8]uint64
+	transmitPGNs  map[uint64][]uint32
+	claimTimes    map[string][]time.Time
+	storming      map[string]bool
+	conflicts     map[sourceKey]*conflict
+	subscribers   map[uint]func(Event)
+	nextSubID     uint
+}
+
+// NewMonitor returns a monitor timed by clk. Give it the clock of the service it watches;
+// a nil clock is the wall clock.
+func NewMonitor(clk clock.Clock, opts ...Option) *Monitor {
+	o := options{
+		learningPeriod:      DefaultLearningPeriod,
+		claimStormThreshold: DefaultClaimStormThreshold,
+		claimStormWindow:    DefaultClaimStormWindow,
+		conflictWindow:      DefaultConflictWindow,
+		timingSamples:       DefaultTimingSamples,
+		timingTolerance:     DefaultTimingTolerance,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Monitor{
+		clock:        clock.OrReal(clk),
+		options:      o,
+		sources:      make(map[sourceKey]*sourceState),
+		deviceNames:  make(map[uint8]uint64),
+		transmitPGNs: make(map[uint64][]uint32),
+		claimTimes:   make(map[string][]time.Time),
+		storming:     make(map[string]bool),
+		conflicts:    make(map[sourceKey]*conflict),
+		subscribers:  make(map[uint]func(Event)),
+	}
+}
+
+// Subscribe calls callback with each anomaly, and returns an ID for Unsubscribe.
+func (m *Monitor) Subscribe(callback func(Event)) uint {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextSubID++
+	m.subscribers[m.nextSubID] = callback
+	return m.nextSubID
+}
+
+// Unsubscribe removes a subscription made with Subscribe.
+func (m *Monitor) Unsubscribe(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.subscribers[id]; !ok {
+		return fmt.Errorf("anomaly subscription %d not found", id)
+	}
+	delete(m.subscribers, id)
+	return nil
+}
+
+// Start begins the learning period and follows the known devices of n. Devices n
+// already knows are taken to have claimed their addresses. Frames given to the monitor
+// before it starts are ignored.
+func (m *Monitor) Start(n *node.Node) error {
+	m.mu.Lock()
+	if m.started {
+		m.mu.Unlock()
+		return fmt.Errorf("anomaly monitor already started")
+	}
+	m.started = true
+	m.node = n
+	m.learningUntil = m.clock.Now().Add(m.options.learningPeriod)
+	m.mu.Unlock()
+
+	for _, device := range n.KnownDevices() {
+		m.observeDevice(&device)
+	}
+	sub := n.SubscribeToDeviceChanges(m.handleDeviceChange)
+	m.mu.Lock()
+	m.deviceSub = sub
+	m.mu.Unlock()
+	return nil
+}
+
+// Stop stops following the node's devices and abandons pending address conflicts.
+func (m *Monitor) Stop() error {
+	m.mu.Lock()
+	if !m.started {
+		m.mu.Unlock()
+		return nil
+	}
+	m.started = false
+	sub := m.deviceSub
+	n := m.node
+	for key, c := range m.conflicts {
+		c.timer.Stop()
+		delete(m.conflicts, key)
+	}
+	m.mu.Unlock()
+	return n.UnsubscribeDeviceChanges(sub)
+}
+
+// FrameInterceptor returns an inbound frame interceptor feeding received frames to the
+// monitor, for n2k.WithFrameInterceptor. It passes every frame on unchanged.
+func (m *Monitor) FrameInterceptor() n2k.FrameInterceptor {
+	return func(ctx context.Context, dir n2k.Direction, frame can.Frame, next func(can.Frame) error) error {
+		if dir == n2k.Inbound {
+			bus := ""
+			if buses := endpoint.BusesFromContext(ctx); len(buses) > 0 {
+				bus = buses[0]
+			}
+			m.HandleFrame(bus, &frame)
+		}
+		return next(frame)
+	}
+}
+
+// HandleFrame checks a frame received on bus, empty for an endpoint with one bus.
+func (m *Monitor) HandleFrame(bus string, frame *can.Frame) {
+	id := converter.DecodeCanID(frame.ID)
+	now := m.clock.Now()
+	var events []Event
+
+	m.mu.Lock()
+	if !m.started {
+		m.mu.Unlock()
+		return
+	}
+	if id.PGN == publicpgn.ISOAddressClaimPGN {
+		if frame.Length >= 8 {
+			// The reserved bit is left out of NAMEs, as node does
+			name := binary.LittleEndian.Uint64(frame.Data[:]) &^ (1 << 48)
+			events = m.handleClaimLocked(bus, id.SourceID, name, now)
+		}
+		m.mu.Unlock()
+		m.publish(events)
+		return
+	}
+	if id.SourceID >= nullAddress {
+		m.mu.Unlock()
+		return
+	}
+	key := sourceKey{bus: bus, address: id.SourceID}
+	src := m.sourceLocked(key)
+	learning := now.Before(m.learningUntil)
+	event := Event{Time: now, Bus: bus, Source: id.SourceID, Name: src.name, PGN: id.PGN}
+
+	if !src.claimed {
+		if learning {
+			src.claimed = true
+		} else if !src.reportedUnclaimed && id.PGN != publicpgn.ISORequestPGN {
+			src.reportedUnclaimed = true
+			events = append(events, withKind(event, TransmitBeforeClaim))
+		}
+	}
+	// Only the first frame of a fast packet starts a message
+	if pgn.IsFast(id.PGN) && frame.Data[0]&0x1F != 0 {
+		m.mu.Unlock()
+		m.publish(events)
+		return
+	}
+
+	ps, seen := src.pgns[id.PGN]
+	if !seen {
+		ps = &pgnState{}
+		src.pgns[id.PGN] = ps
+		if !learning && !isNetworkPGN(id.PGN) && !m.advertisesLocked(src.name, id.PGN) {
+			events = append(events, withKind(event, UnexpectedPGN))
+		}
+	}
+	if class := n2k.ClassifyPGN(id.PGN); class != n2k.WriteInformational && src.name == 0 && !ps.reportedControl {
+		ps.reportedControl = true
+		controlEvent := withKind(event, UnknownControlSource)
+		controlEvent.Class = class
+		events = append(events, controlEvent)
+	}
+	if interval, expected, deviates := m.observeTimingLocked(ps, now); deviates {
+		timingEvent := withKind(event, TimingDeviation)
+		timingEvent.Interval = interval
+		timingEvent.Expected = expected
+		events = append(events, timingEvent)
+	}
+	m.mu.Unlock()
+	m.publish(events)
+}
+
+func withKind(event Event, kind Kind) Event {
+	event.Kind = kind
+	return event
+}
+
+func (m *Monitor) sourceLocked(key sourceKey) *sourceState {
+	src, ok := m.sources[key]
+	if !ok {
+		src = &sourceState{pgns: make(map[uint32]*pgnState)}
+		// The node does not know the bus of its devices, so an address it knows is taken
+		// to be claimed on every bus
+		if name, ok := m.deviceNames[key.address]; ok {
+			src.name = name
+			src.claimed = true
+		}
+		m.sources[key] = src
+	}
+	return src
+}
+
+// handleClaimLocked records a claim of address by name, counting it towards a storm and
+// tracking conflicts with other NAMEs.
+func (m *Monitor) handleClaimLocked(bus string, address uint8, name uint64, now time.Time) []Event {
+	var events []Event
+	claims := append(m.claimTimes[bus], now)
+	cutoff := now.Add(-m.options.claimStormWindow)
+	first := 0
+	for first < len(claims) && !claims[first].After(cutoff) {
+		first++
+	}
+	claims = claims[first:]
+	m.claimTimes[bus] = claims
+	switch {
+	case len(claims) > m.options.claimStormThreshold && !m.storming[bus]:
+		m.storming[bus] = true
+		events = append(events, Event{Kind: ClaimStorm, Time: now, Bus: bus, Source: address, Name: name,
+			PGN: publicpgn.ISOAddressClaimPGN, Claims: len(claims)})
+	case len(claims) <= m.options.claimStormThreshold/2:
+		m.storming[bus] = false
+	}
+
+	// A NAME claiming another address, or none, has left its previous one
+	for key, src := range m.sources {
+		if key.bus == bus && key.address != address && src.name == name {
+			src.name = 0
+			src.claimed = false
+		}
+	}
+	for key, c := range m.conflicts {
+		if key.bus == bus && key.address != address && slices.Contains(c.names, name) {
+			c.timer.Stop()
+			delete(m.conflicts, key)
+		}
+	}
+	if address >= nullAddress {
+		return events
+	}
+
+	key := sourceKey{bus: bus, address: address}
+	src := m.sourceLocked(key)
+	if src.name != 0 && src.name != name {
+		if c, ok := m.conflicts[key]; ok {
+			if !slices.Contains(c.names, name) {
+				c.names = append(c.names, name)
+			}
+		} else if m.started {
+			c := &conflict{key: key, names: []uint64{src.name, name}}
+			c.timer = m.clock.AfterFunc(m.options.conflictWindow, func() { m.conflictExpired(c) })
+			m.conflicts[key] = c
+		}
+	}
+	src.name = name
+	src.claimed = true
+	src.reportedUnclaimed = false
+	return events
+}
+
+// conflictExpired reports a conflict that no NAME resolved by moving away.
+func (m *Monitor) conflictExpired(c *conflict) {
+	m.mu.Lock()
+	if m.conflicts[c.key] != c {
+		m.mu.Unlock()
+		return
+	}
+	delete(m.conflicts, c.key)
+	event := Event{Kind: AddressConflict, Time: m.clock.Now(), Bus: c.key.bus, Source: c.key.address,
+		Name: m.sourceLocked(c.key).name, PGN: publicpgn.ISOAddressClaimPGN, Names: slices.Clone(c.names)}
+	m.mu.Unlock()
+	m.publish([]Event{event})
+}
+
+// observeTimingLocked learns the interval of a PGN from a source, and reports whether
+// the latest interval deviates from it. Deviating intervals are not learned, unless they
+// persist for as long as it took to learn the baseline, which is then learned afresh.
+func (m *Monitor) observeTimingLocked(ps *pgnState, now time.Time) (interval, expected time.Duration, deviates bool) {
+	last := ps.last
+	ps.last = now
+	if last.IsZero() || !now.After(last) {
+		return 0, 0, false
+	}
+	interval = now.Sub(last)
+	seconds := interval.Seconds()
+	if ps.samples >= m.options.timingSamples {
+		deviation := math.Max(ps.dev, ps.mean*minTimingDeviation)
+		if math.Abs(seconds-ps.mean) > m.options.timingTolerance*deviation {
+			expected = time.Duration(ps.mean * float64(time.Second))
+			deviates = !ps.reportedDeviation
+			ps.reportedDeviation = true
+			ps.outliers++
+			if ps.outliers >= m.options.timingSamples {
+				*ps = pgnState{last: now, reportedControl: ps.reportedControl}
+			}
+			return interval, expected, deviates
+		}
+		ps.reportedDeviation = false
+		ps.outliers = 0
+	}
+	if ps.samples == 0 {
+		ps.mean = seconds
+	} else {
+		ps.dev += timingWeight * (math.Abs(seconds-ps.mean) - ps.dev)
+		ps.mean += timingWeight * (seconds - ps.mean)
+	}
+	ps.samples++
+	return interval, 0, false
+}
+
+// advertisesLocked reports whether the device named name lists pgnNumber among the PGNs
+// it transmits.
+func (m *Monitor) advertisesLocked(name uint64, pgnNumber uint32) bool {
+	return name != 0 && slices.Contains(m.transmitPGNs[name], pgnNumber)
+}
+
+func (m *Monitor) handleDeviceChange(change node.DeviceChange) {
+	if change.Kind == node.DeviceChangeExpired {
+		m.mu.Lock()
+		delete(m.transmitPGNs, change.Device.Name)
+		if m.deviceNames[change.Device.Address] == change.Device.Name {
+			delete(m.deviceNames, change.Device.Address)
+		}
+		m.mu.Unlock()
+		return
+	}
+	if change.OldAddress != nil {
+		m.mu.Lock()
+		if m.deviceNames[*change.OldAddress] == change.Device.Name {
+			delete(m.deviceNames, *change.OldAddress)
+		}
+		m.mu.Unlock()
+	}
+	m.observeDevice(&change.Device)
+}
+
+// observeDevice takes what the node knows of a device: that it claimed its address, and
+// the PGNs it transmits.
+func (m *Monitor) observeDevice(device *node.KnownDevice) {
+	if device.Name == 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if device.TransmitPGNs != nil {
+		m.transmitPGNs[device.Name] = slices.Clone(device.TransmitPGNs)
+	}
+	if device.Address >= nullAddress {
+		return
+	}
+	m.deviceNames[device.Address] = device.Name
+	for key, src := range m.sources {
+		if key.address == device.Address && src.name == 0 {
+			src.name = device.Name
+			src.claimed = true
+		}
+	}
+}
+
+func (m *Monitor) publish(events []Event) {
+	if len(events) == 0 {
+		return
+	}
+	m.mu.Lock()
+	subscribers := make([]func(Event), 0, len(m.subscribers))
+	for _, subscriber := range m.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	m.mu.Unlock()
+	for i := range events {
+		for _, subscriber := range subscribers {
+			subscriber(events[i])
+		}
+	}
+}
+
+// isNetworkPGN reports whether a PGN is part of network management, sent by any device
+// on request.
+func isNetworkPGN(pgnNumber uint32) bool {
+	switch pgnNumber {
+	case publicpgn.ISOAcknowledgementPGN, publicpgn.ISORequestPGN, publicpgn.ISOAddressClaimPGN,
+		publicpgn.ISOCommandedAddressPGN, publicpgn.ISOTransportProtocolDataTransferPGN,
+		publicpgn.ISOTransportProtocolConnectionManagementRequestToSendPGN,
+		publicpgn.NMEARequestGroupFunctionPGN, publicpgn.PGNListTransmitAndReceivePGN,
+		publicpgn.HeartbeatPGN, publicpgn.ProductInformationPGN, publicpgn.ConfigurationInformationPGN:
+		return true
+	}
+	return false
+}
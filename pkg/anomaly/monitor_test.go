@@ -0,0 +1,261 @@
+package anomaly
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brutella/can"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/n2k"
+	"github.com/boatkit-io/n2k/pkg/node"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *eventRecorder) record(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *eventRecorder) take() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := r.events
+	r.events = nil
+	return events
+}
+
+func (r *eventRecorder) kinds() []Kind {
+	events := r.take()
+	kinds := make([]Kind, len(events))
+	for i, event := range events {
+		kinds[i] = event.Kind
+	}
+	return kinds
+}
+
+func newTestMonitor(t *testing.T, opts ...Option) (*Monitor, *clock.Fake, *eventRecorder) {
+	t.Helper()
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	monitor := NewMonitor(fake, append([]Option{WithLearningPeriod(time.Second)}, opts...)...)
+	recorder := &eventRecorder{}
+	monitor.Subscribe(recorder.record)
+	require.NoError(t, monitor.Start(node.NewNode(nil, nil, fake)))
+	t.Cleanup(func() { _ = monitor.Stop() })
+	return monitor, fake, recorder
+}
+
+func frame(pgnNumber uint32, source uint8, data ...uint8) *can.Frame {
+	f := &can.Frame{ID: converter.CanIDFromData(pgnNumber, source, 3, 255), Length: 8}
+	copy(f.Data[:], data)
+	return f
+}
+
+func claimFrame(source uint8, name uint64) *can.Frame {
+	f := frame(pgn.ISOAddressClaimPGN, source)
+	binary.LittleEndian.PutUint64(f.Data[:], name)
+	return f
+}
+
+func TestTransmitBeforeClaim(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t)
+
+	// Sources heard while learning are taken to have claimed before the monitor started
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 10))
+	fake.Advance(time.Second)
+	monitor.HandleFrame("", claimFrame(12, 0x1234))
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 10))
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 12))
+	monitor.HandleFrame("", frame(pgn.ISORequestPGN, 11))
+	assert.Equal(t, []Kind{UnexpectedPGN}, recorder.kinds())
+
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 11))
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 11))
+	events := recorder.take()
+	require.NotEmpty(t, events)
+	assert.Equal(t, TransmitBeforeClaim, events[0].Kind)
+	assert.Equal(t, uint8(11), events[0].Source)
+	assert.Equal(t, uint32(pgn.RudderPGN), events[0].PGN)
+	assert.Len(t, events, 2, "each source is reported once")
+}
+
+func TestAddressConflict(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t)
+
+	monitor.HandleFrame("can0", claimFrame(20, 0x1111))
+	monitor.HandleFrame("can0", claimFrame(20, 0x2222))
+	fake.Advance(DefaultConflictWindow - time.Millisecond)
+	assert.Empty(t, recorder.kinds())
+	fake.Advance(time.Millisecond)
+	events := recorder.take()
+	require.Len(t, events, 1)
+	assert.Equal(t, AddressConflict, events[0].Kind)
+	assert.Equal(t, "can0", events[0].Bus)
+	assert.Equal(t, uint8(20), events[0].Source)
+	assert.Equal(t, []uint64{0x1111, 0x2222}, events[0].Names)
+
+	// Losing arbitration and moving away is how claims are meant to go
+	monitor.HandleFrame("can0", claimFrame(30, 0x3333))
+	monitor.HandleFrame("can0", claimFrame(30, 0x4444))
+	monitor.HandleFrame("can0", claimFrame(30, 0x3333))
+	monitor.HandleFrame("can0", claimFrame(31, 0x4444))
+	fake.Advance(time.Second)
+	assert.Empty(t, recorder.kinds())
+
+	monitor.HandleFrame("can0", claimFrame(40, 0x5555))
+	monitor.HandleFrame("can0", claimFrame(40, 0x6666))
+	monitor.HandleFrame("can0", claimFrame(254, 0x6666))
+	fake.Advance(time.Second)
+	assert.Empty(t, recorder.kinds())
+}
+
+func TestClaimStorm(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t, WithClaimStorm(4, time.Second))
+
+	for i := 0; i < 10; i++ {
+		monitor.HandleFrame("", claimFrame(uint8(i), uint64(0x100+i)))
+		fake.Advance(10 * time.Millisecond)
+	}
+	events := recorder.take()
+	require.Len(t, events, 1, "a storm is reported once")
+	assert.Equal(t, ClaimStorm, events[0].Kind)
+	assert.Equal(t, 5, events[0].Claims)
+
+	// Once it has died down, another storm is reported again
+	fake.Advance(2 * time.Second)
+	for i := 0; i < 5; i++ {
+		monitor.HandleFrame("", claimFrame(uint8(i), uint64(0x100+i)))
+	}
+	assert.Equal(t, []Kind{ClaimStorm}, recorder.kinds())
+}
+
+func TestUnexpectedPGNHonoursAdvertisedPGNs(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t)
+
+	monitor.HandleFrame("", claimFrame(50, 0xABCD))
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 50))
+	fake.Advance(time.Second)
+	monitor.handleDeviceChange(node.DeviceChange{
+		Kind:   node.DeviceChangePGNListsChanged,
+		Device: node.KnownDevice{Address: 50, Name: 0xABCD, TransmitPGNs: []uint32{pgn.RudderPGN, pgn.WindDataPGN}},
+	})
+
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 50))
+	monitor.HandleFrame("", frame(pgn.WindDataPGN, 50))
+	monitor.HandleFrame("", frame(pgn.HeartbeatPGN, 50))
+	assert.Empty(t, recorder.kinds())
+
+	monitor.HandleFrame("", frame(pgn.AttitudePGN, 50))
+	events := recorder.take()
+	require.Len(t, events, 1)
+	assert.Equal(t, UnexpectedPGN, events[0].Kind)
+	assert.Equal(t, uint64(0xABCD), events[0].Name)
+	assert.Equal(t, uint32(pgn.AttitudePGN), events[0].PGN)
+}
+
+func TestKnownDevicesAreClaimedOnEveryBus(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t)
+	fake.Advance(time.Second)
+
+	monitor.handleDeviceChange(node.DeviceChange{
+		Kind:   node.DeviceChangeObserved,
+		Device: node.KnownDevice{Address: 60, Name: 0xBEEF, TransmitPGNs: []uint32{pgn.RudderPGN}},
+	})
+	monitor.HandleFrame("can1", frame(pgn.RudderPGN, 60))
+	assert.Empty(t, recorder.kinds())
+}
+
+func TestUnknownControlSource(t *testing.T) {
+	monitor, _, recorder := newTestMonitor(t)
+
+	// Only the first frame of a fast packet counts
+	monitor.HandleFrame("", frame(pgn.HeadingTrackControlPGN, 70, 0x20, 21))
+	monitor.HandleFrame("", frame(pgn.HeadingTrackControlPGN, 70, 0x21))
+	events := recorder.take()
+	require.Len(t, events, 1)
+	assert.Equal(t, UnknownControlSource, events[0].Kind)
+	assert.Equal(t, n2k.WriteSafetyCritical, events[0].Class)
+
+	monitor.HandleFrame("", claimFrame(71, 0xCAFE))
+	monitor.HandleFrame("", frame(pgn.HeadingTrackControlPGN, 71, 0x40, 21))
+	assert.Empty(t, recorder.kinds())
+}
+
+func TestTimingDeviation(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t, WithTiming(4, 5))
+
+	for i := 0; i < 6; i++ {
+		monitor.HandleFrame("", frame(pgn.RudderPGN, 80))
+		fake.Advance(100 * time.Millisecond)
+	}
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 80))
+	fake.Advance(110 * time.Millisecond)
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 80))
+	assert.Empty(t, recorder.kinds(), "jitter within the tolerance is not reported")
+
+	fake.Advance(10 * time.Millisecond)
+	monitor.HandleFrame("", frame(pgn.RudderPGN, 80))
+	events := recorder.take()
+	require.Len(t, events, 1)
+	assert.Equal(t, TimingDeviation, events[0].Kind)
+	assert.Equal(t, 10*time.Millisecond, events[0].Interval)
+	assert.InDelta(t, 100*time.Millisecond, events[0].Expected, float64(2*time.Millisecond))
+
+	// A stream that keeps a new rate is relearned rather than reported forever
+	for i := 0; i < 20; i++ {
+		fake.Advance(10 * time.Millisecond)
+		monitor.HandleFrame("", frame(pgn.RudderPGN, 80))
+	}
+	assert.Empty(t, recorder.kinds())
+}
+
+func TestFrameInterceptorFeedsInboundFrames(t *testing.T) {
+	monitor, fake, recorder := newTestMonitor(t)
+	fake.Advance(time.Second)
+	intercept := monitor.FrameInterceptor()
+
+	var passed []can.Frame
+	next := func(f can.Frame) error {
+		passed = append(passed, f)
+		return nil
+	}
+	ctx := endpoint.WithBuses(context.Background(), "can2")
+	require.NoError(t, intercept(ctx, n2k.Outbound, *frame(pgn.RudderPGN, 90), next))
+	assert.Empty(t, recorder.kinds())
+	require.NoError(t, intercept(ctx, n2k.Inbound, *frame(pgn.RudderPGN, 91), next))
+	assert.Len(t, passed, 2)
+
+	events := recorder.take()
+	require.NotEmpty(t, events)
+	assert.Equal(t, TransmitBeforeClaim, events[0].Kind)
+	assert.Equal(t, "can2", events[0].Bus)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	monitor, _, _ := newTestMonitor(t)
+	id := monitor.Subscribe(func(Event) {})
+	require.NoError(t, monitor.Unsubscribe(id))
+	assert.Error(t, monitor.Unsubscribe(id))
+	assert.Error(t, monitor.Start(node.NewNode(nil, nil, nil)))
+}
+
+func TestFramesBeforeStartAreIgnored(t *testing.T) {
+	monitor := NewMonitor(clock.NewFake(time.Now()), WithLearningPeriod(0))
+	recorder := &eventRecorder{}
+	monitor.Subscribe(recorder.record)
+	monitor.HandleFrame("", frame(pgn.HeadingTrackControlPGN, 70, 0x20, 21))
+	assert.Empty(t, recorder.kinds())
+}
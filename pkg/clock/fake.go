@@ -0,0 +1,266 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Fake is a Clock that only moves when Advance or Set is called. Timers and tickers that
+// come due fire in order of their deadlines, with the clock reading each deadline as it
+// fires, so everything timed by a Fake sees the same sequence of instants however fast
+// the clock is driven.
+//
+// Channels of timers and tickers hold one time, and ticks are dropped while it is
+// unread, as with the time package. Functions given to AfterFunc run in the goroutine
+// moving the clock, before it moves on to the next deadline.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+	seq     uint64
+	// changed is closed and replaced whenever waiters are added
+	changed chan struct{}
+}
+
+// fakeWaiter is a timer, ticker or function waiting on a Fake.
+type fakeWaiter struct {
+	clock  *Fake
+	c      chan time.Time
+	fn     func()
+	when   time.Time
+	period time.Duration
+	// seq orders waiters with the same deadline by when they were armed
+	seq    uint64
+	active bool
+}
+
+// NewFake returns a fake clock reading start.
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start, changed: make(chan struct{})}
+}
+
+// Now returns the time the clock was last moved to.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// NewTimer returns a timer firing once the clock has moved d. A timer of zero or less
+// fires immediately.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	w := &fakeWaiter{clock: f, c: make(chan time.Time, 1)}
+	w.arm(d)
+	return fakeTimer{w}
+}
+
+// NewTicker returns a ticker firing each time the clock moves past a multiple of d.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for clock.Fake.NewTicker")
+	}
+	w := &fakeWaiter{clock: f, c: make(chan time.Time, 1), period: d}
+	w.arm(d)
+	return fakeTicker{w}
+}
+
+// AfterFunc returns a timer calling fn once the clock has moved d. A function due
+// immediately runs in its own goroutine, so that callers holding locks may arm it.
+func (f *Fake) AfterFunc(d time.Duration, fn func()) Timer {
+	w := &fakeWaiter{clock: f, fn: fn}
+	w.arm(d)
+	return fakeTimer{w}
+}
+
+// Advance moves the clock forward by d, firing everything due on the way.
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the clock to t, firing everything due up to t. A t before the current time
+// leaves the clock where it is.
+func (f *Fake) Set(t time.Time) {
+	for {
+		f.mu.Lock()
+		w := f.nextLocked()
+		if w == nil || w.when.After(t) {
+			if t.After(f.now) {
+				f.now = t
+			}
+			f.mu.Unlock()
+			return
+		}
+		if w.when.After(f.now) {
+			f.now = w.when
+		}
+		now := f.now
+		if w.period > 0 {
+			w.when = w.when.Add(w.period)
+		} else {
+			f.removeLocked(w)
+		}
+		f.mu.Unlock()
+
+		if w.fn != nil {
+			w.fn()
+			continue
+		}
+		select {
+		case w.c <- now:
+		default:
+			// A ticker whose last tick is unread would drop every tick up to t, so it
+			// skips them rather than firing each
+			if w.period > 0 {
+				f.mu.Lock()
+				for w.active && !w.when.After(t) {
+					w.when = w.when.Add(w.period)
+				}
+				f.mu.Unlock()
+			}
+		}
+	}
+}
+
+// Next returns the deadline of the next timer or ticker to fire, and false when there is
+// none.
+func (f *Fake) Next() (time.Time, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w := f.nextLocked()
+	if w == nil {
+		return time.Time{}, false
+	}
+	return w.when, true
+}
+
+// Pending returns the number of timers and tickers waiting to fire.
+func (f *Fake) Pending() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.waiters)
+}
+
+// BlockUntil waits until at least n timers and tickers are waiting to fire, such as
+// until the code under test has armed the timer it will sleep on, or until ctx ends.
+func (f *Fake) BlockUntil(ctx context.Context, n int) error {
+	for {
+		f.mu.Lock()
+		pending, changed := len(f.waiters), f.changed
+		f.mu.Unlock()
+		if pending >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// nextLocked returns the waiter to fire next. The caller holds f.mu.
+func (f *Fake) nextLocked() *fakeWaiter {
+	var next *fakeWaiter
+	for _, w := range f.waiters {
+		if next == nil || w.when.Before(next.when) || (w.when.Equal(next.when) && w.seq < next.seq) {
+			next = w
+		}
+	}
+	return next
+}
+
+// removeLocked stops w and reports whether it was waiting. The caller holds f.mu.
+func (f *Fake) removeLocked(w *fakeWaiter) bool {
+	if !w.active {
+		return false
+	}
+	w.active = false
+	for i, other := range f.waiters {
+		if other == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// arm (re)starts w to fire after d and reports whether it was waiting.
+func (w *fakeWaiter) arm(d time.Duration) bool {
+	f := w.clock
+	f.mu.Lock()
+	wasActive := f.removeLocked(w)
+	now := f.now
+	if d <= 0 && w.period == 0 {
+		f.mu.Unlock()
+		if w.fn != nil {
+			go w.fn()
+		} else {
+			select {
+			case w.c <- now:
+			default:
+			}
+		}
+		return wasActive
+	}
+	if w.period > 0 {
+		w.period = d
+	}
+	f.seq++
+	w.seq = f.seq
+	w.when = now.Add(d)
+	w.active = true
+	f.waiters = append(f.waiters, w)
+	close(f.changed)
+	f.changed = make(chan struct{})
+	f.mu.Unlock()
+	return wasActive
+}
+
+func (w *fakeWaiter) stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+	return w.clock.removeLocked(w)
+}
+
+type fakeTimer struct {
+	w *fakeWaiter
+}
+
+func (t fakeTimer) C() <-chan time.Time {
+	return t.w.c
+}
+
+func (t fakeTimer) Stop() bool {
+	return t.w.stop()
+}
+
+func (t fakeTimer) Reset(d time.Duration) bool {
+	return t.w.arm(d)
+}
+
+type fakeTicker struct {
+	w *fakeWaiter
+}
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.w.c
+}
+
+func (t fakeTicker) Stop() {
+	t.w.stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for clock.Fake ticker Reset")
+	}
+	t.w.arm(d)
+}
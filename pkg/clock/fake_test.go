@@ -0,0 +1,93 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fakeStart = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestFakeFiresInDeadlineOrder(t *testing.T) {
+	fake := NewFake(fakeStart)
+	var fired []string
+	var at []time.Duration
+	record := func(name string) func() {
+		return func() {
+			fired = append(fired, name)
+			at = append(at, fake.Now().Sub(fakeStart))
+		}
+	}
+	fake.AfterFunc(3*time.Second, record("c"))
+	fake.AfterFunc(time.Second, record("a"))
+	fake.AfterFunc(2*time.Second, record("b1"))
+	fake.AfterFunc(2*time.Second, record("b2"))
+
+	fake.Advance(1500 * time.Millisecond)
+	assert.Equal(t, []string{"a"}, fired)
+	assert.Equal(t, 1500*time.Millisecond, fake.Now().Sub(fakeStart))
+
+	fake.Advance(time.Hour)
+	assert.Equal(t, []string{"a", "b1", "b2", "c"}, fired)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 2 * time.Second, 3 * time.Second}, at)
+	assert.Equal(t, 0, fake.Pending())
+}
+
+func TestFakeTimerStopAndReset(t *testing.T) {
+	fake := NewFake(fakeStart)
+	timer := fake.NewTimer(time.Second)
+	assert.True(t, timer.Stop())
+	assert.False(t, timer.Stop())
+	fake.Advance(time.Minute)
+	assert.Empty(t, timer.C())
+
+	assert.False(t, timer.Reset(time.Second))
+	fake.Advance(time.Second)
+	require.Len(t, timer.C(), 1)
+	assert.Equal(t, fakeStart.Add(time.Minute+time.Second), <-timer.C())
+
+	immediate := fake.NewTimer(0)
+	require.Len(t, immediate.C(), 1)
+}
+
+func TestFakeTickerSkipsUnreadTicks(t *testing.T) {
+	fake := NewFake(fakeStart)
+	ticker := fake.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	fake.Advance(time.Second)
+	assert.Equal(t, fakeStart.Add(time.Second), <-ticker.C())
+
+	// Unread ticks are dropped, and the ticker keeps its phase
+	fake.Advance(10 * time.Second)
+	assert.Equal(t, fakeStart.Add(2*time.Second), <-ticker.C())
+	next, ok := fake.Next()
+	require.True(t, ok)
+	assert.Equal(t, fakeStart.Add(12*time.Second), next)
+
+	ticker.Reset(5 * time.Second)
+	fake.Advance(5 * time.Second)
+	assert.Equal(t, fakeStart.Add(16*time.Second), <-ticker.C())
+}
+
+func TestFakeBlockUntil(t *testing.T) {
+	fake := NewFake(fakeStart)
+	done := make(chan time.Time)
+	go func() {
+		timer := fake.NewTimer(time.Minute)
+		done <- <-timer.C()
+	}()
+
+	require.NoError(t, fake.BlockUntil(context.Background(), 1))
+	next, ok := fake.Next()
+	require.True(t, ok)
+	fake.Set(next)
+	assert.Equal(t, fakeStart.Add(time.Minute), <-done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, fake.BlockUntil(ctx, 1), context.Canceled)
+}
@@ -11,8 +11,11 @@ package endpoint
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/brutella/can"
 )
 
@@ -32,13 +35,76 @@ type Endpoint interface {
 	WriteFrame(can.Frame)
 }
 
+// ContextFrameWriter is implemented by endpoints that report whether a frame was sent.
+// WriteFrameContext returns once the frame has been handed to the bus interface, or with
+// an error wrapping ErrTxBufferFull, ErrClosed, ErrWriteNotSupported, an interface error,
+// or ctx's error if ctx ended first. A frame whose ctx ended before it was sent is not
+// sent later.
+type ContextFrameWriter interface {
+	WriteFrameContext(ctx context.Context, frame can.Frame) error
+}
+
+var (
+	// ErrTxBufferFull reports a frame that could not be queued or sent because the
+	// endpoint's or the interface's transmit buffer stayed full.
+	ErrTxBufferFull = errors.New("transmit buffer full")
+	// ErrClosed reports a write to an endpoint that is closed or stopped writing.
+	ErrClosed = errors.New("endpoint closed")
+	// ErrWriteNotSupported reports a write to a read-only endpoint.
+	ErrWriteNotSupported = errors.New("endpoint does not support writing")
+)
+
+// PartialWriteError reports a multi-frame message of which only the first Sent of Total
+// frames were sent. Receivers discard the incomplete message.
+type PartialWriteError struct {
+	Sent  int
+	Total int
+	Err   error
+}
+
+func (e *PartialWriteError) Error() string {
+	return fmt.Sprintf("sent %d of %d frames: %v", e.Sent, e.Total, e.Err)
+}
+
+func (e *PartialWriteError) Unwrap() error {
+	return e.Err
+}
+
 // OutboundLagReporter is implemented by endpoints that can report outbound
 // queue/send latency.
 type OutboundLagReporter interface {
 	OutboundQueueLag() time.Duration
 }
 
+// ClockSetter is implemented by endpoints that pace, time out or timestamp frames. The
+// service gives such an endpoint its own clock before starting it, so that a fake clock
+// drives the endpoint too.
+type ClockSetter interface {
+	SetClock(c clock.Clock)
+}
+
 // MessageHandler is an interface for the handler of an Endpoint that takes a finished Message object
 type MessageHandler interface {
 	HandleMessage(message Message)
 }
+
+// BusMessage is a Message received on one of several named buses, as passed on by
+// endpoints that combine several buses.
+type BusMessage struct {
+	Bus     string
+	Message Message
+}
+
+type busesKey struct{}
+
+// WithBuses returns a context directing writes made with it to the named buses. Writes
+// go to every bus when no buses are named. Endpoints with a single bus ignore it.
+func WithBuses(ctx context.Context, buses ...string) context.Context {
+	return context.WithValue(ctx, busesKey{}, buses)
+}
+
+// BusesFromContext returns the buses named by WithBuses, or nil.
+func BusesFromContext(ctx context.Context) []string {
+	buses, _ := ctx.Value(busesKey{}).([]string)
+	return buses
+}
@@ -70,3 +70,8 @@ func (e *IdleEndpoint) SetOutput(handler endpoint.MessageHandler) {
 
 // WriteFrame drops the frame.
 func (e *IdleEndpoint) WriteFrame(_ can.Frame) {}
+
+// WriteFrameContext drops the frame and returns endpoint.ErrWriteNotSupported.
+func (e *IdleEndpoint) WriteFrameContext(_ context.Context, _ can.Frame) error {
+	return endpoint.ErrWriteNotSupported
+}
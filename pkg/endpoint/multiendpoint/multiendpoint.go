@@ -0,0 +1,205 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+// Package multiendpoint provides an endpoint that combines several named buses.
+package multiendpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	"github.com/brutella/can"
+)
+
+// Bus is an endpoint and the ID it is known by.
+type Bus struct {
+	ID       string
+	Endpoint endpoint.Endpoint
+}
+
+// MultiEndpoint reads from and writes to several buses. Received messages are passed on
+// as endpoint.BusMessage, so they can be told apart and reassembled separately. Frames
+// are written to every bus, or to the buses named with endpoint.WithBuses.
+type MultiEndpoint struct {
+	buses []Bus
+	byID  map[string]endpoint.Endpoint
+	log   *slog.Logger
+
+	mu      sync.RWMutex
+	handler endpoint.MessageHandler
+}
+
+// busOutput tags the messages of one bus with its ID.
+type busOutput struct {
+	bus   string
+	multi *MultiEndpoint
+}
+
+func (o *busOutput) HandleMessage(message endpoint.Message) {
+	o.multi.mu.RLock()
+	handler := o.multi.handler
+	o.multi.mu.RUnlock()
+	if handler != nil {
+		handler.HandleMessage(endpoint.BusMessage{Bus: o.bus, Message: message})
+	}
+}
+
+// NewMultiEndpoint combines buses. Bus IDs must be unique and not empty. A nil log uses
+// slog.Default().
+func NewMultiEndpoint(log *slog.Logger, buses ...Bus) (*MultiEndpoint, error) {
+	if len(buses) == 0 {
+		return nil, errors.New("no buses")
+	}
+	m := &MultiEndpoint{
+		buses: buses,
+		byID:  make(map[string]endpoint.Endpoint, len(buses)),
+		log:   logging.OrDefault(log).With(logging.Endpoint("multi")),
+	}
+	for _, bus := range buses {
+		if bus.ID == "" {
+			return nil, errors.New("bus ID is empty")
+		}
+		if bus.Endpoint == nil {
+			return nil, fmt.Errorf("bus %s has no endpoint", bus.ID)
+		}
+		if _, ok := m.byID[bus.ID]; ok {
+			return nil, fmt.Errorf("duplicate bus ID %s", bus.ID)
+		}
+		m.byID[bus.ID] = bus.Endpoint
+		bus.Endpoint.SetOutput(&busOutput{bus: bus.ID, multi: m})
+	}
+	return m, nil
+}
+
+// Buses returns the bus IDs in the order they were given.
+func (m *MultiEndpoint) Buses() []string {
+	ids := make([]string, len(m.buses))
+	for i, bus := range m.buses {
+		ids[i] = bus.ID
+	}
+	return ids
+}
+
+// Start starts every bus. If one fails, the buses already started are closed.
+func (m *MultiEndpoint) Start(ctx context.Context) error {
+	for i, bus := range m.buses {
+		if err := bus.Endpoint.Start(ctx); err != nil {
+			for _, started := range m.buses[:i] {
+				if closeErr := started.Endpoint.Close(); closeErr != nil {
+					m.log.Warn("Failed to close bus", logging.Bus(started.ID), logging.Error(closeErr))
+				}
+			}
+			return fmt.Errorf("bus %s: %w", bus.ID, err)
+		}
+	}
+	return nil
+}
+
+// Run runs every bus and returns once all have stopped. A bus that stops does not stop
+// the others.
+func (m *MultiEndpoint) Run(ctx context.Context) error {
+	errs := make([]error, len(m.buses))
+	var wg sync.WaitGroup
+	for i, bus := range m.buses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := bus.Endpoint.Run(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				m.log.Error("Bus stopped", logging.Bus(bus.ID), logging.Error(err))
+				errs[i] = fmt.Errorf("bus %s: %w", bus.ID, err)
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Close closes every bus.
+func (m *MultiEndpoint) Close() error {
+	var errs []error
+	for _, bus := range m.buses {
+		if err := bus.Endpoint.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("bus %s: %w", bus.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetOutput sets the handler receiving every bus's messages as endpoint.BusMessage.
+func (m *MultiEndpoint) SetOutput(handler endpoint.MessageHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handler = handler
+}
+
+// WriteFrame writes frame to every bus.
+func (m *MultiEndpoint) WriteFrame(frame can.Frame) {
+	for _, bus := range m.buses {
+		bus.Endpoint.WriteFrame(frame)
+	}
+}
+
+// WriteFrameContext writes frame to the buses named with endpoint.WithBuses, or to every
+// bus, and returns the errors of the buses that failed.
+func (m *MultiEndpoint) WriteFrameContext(ctx context.Context, frame can.Frame) error {
+	ids := endpoint.BusesFromContext(ctx)
+	if len(ids) == 0 {
+		ids = m.Buses()
+	}
+	for _, id := range ids {
+		if _, ok := m.byID[id]; !ok {
+			return fmt.Errorf("unknown bus %s", id)
+		}
+	}
+
+	var errs []error
+	for _, id := range ids {
+		if err := writeFrame(ctx, m.byID[id], frame); err != nil {
+			errs = append(errs, fmt.Errorf("bus %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func writeFrame(ctx context.Context, ep endpoint.Endpoint, frame can.Frame) error {
+	if writer, ok := ep.(endpoint.ContextFrameWriter); ok {
+		return writer.WriteFrameContext(ctx, frame)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	ep.WriteFrame(frame)
+	return nil
+}
+
+// OutboundQueueLag returns the largest outbound lag reported by the buses.
+func (m *MultiEndpoint) OutboundQueueLag() time.Duration {
+	var lag time.Duration
+	for _, bus := range m.buses {
+		if reporter, ok := bus.Endpoint.(endpoint.OutboundLagReporter); ok {
+			lag = max(lag, reporter.OutboundQueueLag())
+		}
+	}
+	return lag
+}
+
+// SetClock gives c to every bus that takes a clock.
+func (m *MultiEndpoint) SetClock(c clock.Clock) {
+	for _, bus := range m.buses {
+		if setter, ok := bus.Endpoint.(endpoint.ClockSetter); ok {
+			setter.SetClock(c)
+		}
+	}
+}
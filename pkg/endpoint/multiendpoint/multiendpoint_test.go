@@ -0,0 +1,170 @@
+package multiendpoint
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/brutella/can"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEndpoint struct {
+	startErr error
+	runErr   error
+	writeErr error
+
+	mu      sync.Mutex
+	output  endpoint.MessageHandler
+	frames  []can.Frame
+	started bool
+	closed  bool
+}
+
+func (e *testEndpoint) Start(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.started = e.startErr == nil
+	return e.startErr
+}
+
+func (e *testEndpoint) Run(ctx context.Context) error {
+	if e.runErr != nil {
+		return e.runErr
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (e *testEndpoint) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+	return nil
+}
+
+func (e *testEndpoint) SetOutput(output endpoint.MessageHandler) { e.output = output }
+
+func (e *testEndpoint) WriteFrame(frame can.Frame) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.frames = append(e.frames, frame)
+}
+
+func (e *testEndpoint) WriteFrameContext(_ context.Context, frame can.Frame) error {
+	if e.writeErr != nil {
+		return e.writeErr
+	}
+	e.WriteFrame(frame)
+	return nil
+}
+
+type recordingHandler struct {
+	messages []endpoint.Message
+}
+
+func (h *recordingHandler) HandleMessage(message endpoint.Message) {
+	h.messages = append(h.messages, message)
+}
+
+func TestMultiEndpointImplementsEndpoint(_ *testing.T) {
+	var _ endpoint.Endpoint = &MultiEndpoint{}
+	var _ endpoint.ContextFrameWriter = &MultiEndpoint{}
+	var _ endpoint.OutboundLagReporter = &MultiEndpoint{}
+}
+
+func TestNewMultiEndpointRejectsInvalidBuses(t *testing.T) {
+	_, err := NewMultiEndpoint(slog.Default())
+	assert.Error(t, err)
+	_, err = NewMultiEndpoint(slog.Default(), Bus{ID: "", Endpoint: &testEndpoint{}})
+	assert.Error(t, err)
+	_, err = NewMultiEndpoint(slog.Default(), Bus{ID: "a"})
+	assert.Error(t, err)
+	_, err = NewMultiEndpoint(slog.Default(), Bus{ID: "a", Endpoint: &testEndpoint{}}, Bus{ID: "a", Endpoint: &testEndpoint{}})
+	assert.ErrorContains(t, err, "duplicate bus ID a")
+}
+
+func TestMultiEndpointTagsMessagesWithBus(t *testing.T) {
+	a, b := &testEndpoint{}, &testEndpoint{}
+	m, err := NewMultiEndpoint(slog.Default(), Bus{ID: "a", Endpoint: a}, Bus{ID: "b", Endpoint: b})
+	require.NoError(t, err)
+	handler := &recordingHandler{}
+	m.SetOutput(handler)
+
+	frame := &can.Frame{ID: 1}
+	b.output.HandleMessage(frame)
+	a.output.HandleMessage(frame)
+	assert.Equal(t, []endpoint.Message{
+		endpoint.BusMessage{Bus: "b", Message: frame},
+		endpoint.BusMessage{Bus: "a", Message: frame},
+	}, handler.messages)
+	assert.Equal(t, []string{"a", "b"}, m.Buses())
+}
+
+func TestMultiEndpointStartClosesStartedBusesOnFailure(t *testing.T) {
+	a, b := &testEndpoint{}, &testEndpoint{startErr: errors.New("no interface")}
+	m, err := NewMultiEndpoint(slog.Default(), Bus{ID: "a", Endpoint: a}, Bus{ID: "b", Endpoint: b})
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, m.Start(context.Background()), "bus b: no interface")
+	assert.True(t, a.closed)
+	assert.False(t, b.closed)
+}
+
+func TestMultiEndpointRunKeepsOtherBusesRunning(t *testing.T) {
+	failed := errors.New("interface down")
+	a, b := &testEndpoint{runErr: failed}, &testEndpoint{}
+	m, err := NewMultiEndpoint(slog.Default(), Bus{ID: "a", Endpoint: a}, Bus{ID: "b", Endpoint: b})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- m.Run(ctx) }()
+
+	select {
+	case <-done:
+		t.Fatal("Run returned while bus b was running")
+	case <-time.After(20 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, failed)
+		assert.ErrorContains(t, err, "bus a")
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after its context ended")
+	}
+}
+
+func TestMultiEndpointWritesToNamedBuses(t *testing.T) {
+	a, b := &testEndpoint{}, &testEndpoint{}
+	m, err := NewMultiEndpoint(slog.Default(), Bus{ID: "a", Endpoint: a}, Bus{ID: "b", Endpoint: b})
+	require.NoError(t, err)
+	frame := can.Frame{ID: 1}
+
+	m.WriteFrame(frame)
+	assert.Len(t, a.frames, 1)
+	assert.Len(t, b.frames, 1)
+
+	require.NoError(t, m.WriteFrameContext(endpoint.WithBuses(context.Background(), "b"), frame))
+	assert.Len(t, a.frames, 1)
+	assert.Len(t, b.frames, 2)
+
+	require.NoError(t, m.WriteFrameContext(context.Background(), frame))
+	assert.Len(t, a.frames, 2)
+	assert.Len(t, b.frames, 3)
+
+	assert.ErrorContains(t, m.WriteFrameContext(endpoint.WithBuses(context.Background(), "a", "c"), frame), "unknown bus c")
+	assert.Len(t, a.frames, 2)
+
+	b.writeErr = endpoint.ErrTxBufferFull
+	err = m.WriteFrameContext(context.Background(), frame)
+	assert.ErrorIs(t, err, endpoint.ErrTxBufferFull)
+	assert.ErrorContains(t, err, "bus b")
+	assert.Len(t, a.frames, 3)
+}
@@ -13,21 +13,24 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"log/slog"
 	"math"
 	"os"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/brutella/can"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
 // N2kFileEndpoint reads an n2k log file and sends canbus frames to its output channel.
 type N2kFileEndpoint struct {
-	log        *logrus.Logger
+	log        *slog.Logger
+	clock      clock.Clock
 	inFilePath string
 
 	mu      sync.Mutex
@@ -37,14 +40,23 @@ type N2kFileEndpoint struct {
 	handler endpoint.MessageHandler
 }
 
-// NewN2kFileEndpoint creates a new n2k endpoint.
-func NewN2kFileEndpoint(file string, log *logrus.Logger) *N2kFileEndpoint {
+// NewN2kFileEndpoint creates a new n2k endpoint. A nil log uses slog.Default().
+func NewN2kFileEndpoint(file string, log *slog.Logger) *N2kFileEndpoint {
 	return &N2kFileEndpoint{
-		log:        log,
+		log:        logging.OrDefault(log).With(logging.Endpoint("n2kfile"), "file", file),
+		clock:      clock.Real(),
 		inFilePath: file,
 	}
 }
 
+// SetClock sets the clock that playback is paced by. Each frame is passed on once the
+// clock has moved on from the start of playback by the frame's offset in the log, so a
+// fake clock replays the log at whatever speed it is driven. A nil clock is the wall
+// clock. Call it before Run.
+func (n *N2kFileEndpoint) SetClock(c clock.Clock) {
+	n.clock = clock.OrReal(c)
+}
+
 // SetOutput sets the output struct for handling when a message is ready
 func (n *N2kFileEndpoint) SetOutput(mh endpoint.MessageHandler) {
 	n.handler = mh
@@ -94,12 +106,12 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 	defer func() {
 		if n.finishRun(file) {
 			if err := file.Close(); err != nil {
-				n.log.WithError(err).Warnf("failed to close n2k file %s", n.inFilePath)
+				n.log.Warn("failed to close n2k file", logging.Error(err))
 			}
 		}
 	}()
 
-	startTime := time.Now()
+	startTime := n.clock.Now()
 
 	n.log.Info("starting n2k file playback")
 
@@ -119,7 +131,7 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 		}
 		var frame can.Frame
 		var canDead string
-		var timeDelta float32
+		var timeDelta float64
 		_, err := fmt.Sscanf(line, " (%f)  %s  %8X   [%d]", &timeDelta, &canDead, &frame.ID, &frame.Length)
 		if err != nil {
 			return err
@@ -136,23 +148,16 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 				return err
 			}
 		}
-		// Pause until the timeDelta has expired, so this all replays in "real-time" (relative to start, obvs)
-		for {
-			curDelta := time.Since(startTime).Seconds()
-			nextTime := timeDelta - float32(curDelta)
-			wait := time.Duration(math.Min(500, float64(nextTime)*1000.0)) * time.Millisecond
-			if wait > 0 {
-				timer := time.NewTimer(wait)
-				select {
-				case <-timer.C:
-				case <-ctx.Done():
-					timer.Stop()
-					return nil
-				}
-			}
-
-			if time.Since(startTime) > time.Duration(timeDelta) {
-				break
+		// Pause until the frame's offset has passed, so this all replays in "real-time"
+		// (relative to start, obvs). Offsets are logged to the microsecond.
+		offset := time.Duration(math.Round(timeDelta*1e6)) * time.Microsecond
+		if wait := startTime.Add(offset).Sub(n.clock.Now()); wait > 0 {
+			timer := n.clock.NewTimer(wait)
+			select {
+			case <-timer.C():
+			case <-ctx.Done():
+				timer.Stop()
+				return nil
 			}
 		}
 
@@ -160,7 +165,7 @@ func (n *N2kFileEndpoint) Run(ctx context.Context) error {
 	}
 
 	if err := scanner.Err(); err != nil {
-		n.log.Warn(errors.Wrap(err, "error while scanning n2k replay file"))
+		n.log.Warn("error while scanning n2k replay file", logging.Error(err))
 	}
 
 	n.log.Info("n2k file playback complete")
@@ -187,6 +192,11 @@ func (n *N2kFileEndpoint) WriteFrame(_ can.Frame) {
 	// This is a read-only endpoint
 }
 
+// WriteFrameContext returns endpoint.ErrWriteNotSupported as this is a read-only endpoint.
+func (n *N2kFileEndpoint) WriteFrameContext(_ context.Context, _ can.Frame) error {
+	return endpoint.ErrWriteNotSupported
+}
+
 // frameReady is a helper to handle passing completed frames to the handler
 func (n *N2kFileEndpoint) frameReady(frame endpoint.Message) {
 	if n.handler != nil {
@@ -2,13 +2,15 @@ package n2kfileendpoint
 
 import (
 	"context"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
-	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,7 +28,7 @@ func TestRunRejectsConcurrentPlayback(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "replay.n2k")
 	require.NoError(t, os.WriteFile(path, []byte(" (0.000000)  can1  08FF0401   [1]  00\n"), 0o600))
 	handler := &blockingHandler{entered: make(chan struct{}), release: make(chan struct{})}
-	ep := NewN2kFileEndpoint(path, logrus.New())
+	ep := NewN2kFileEndpoint(path, slog.Default())
 	ep.SetOutput(handler)
 
 	runDone := make(chan error, 1)
@@ -46,9 +48,56 @@ func TestRunRejectsConcurrentPlayback(t *testing.T) {
 func TestClosePreventsRunFromReopeningFile(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "replay.n2k")
 	require.NoError(t, os.WriteFile(path, nil, 0o600))
-	ep := NewN2kFileEndpoint(path, logrus.New())
+	ep := NewN2kFileEndpoint(path, slog.Default())
 
 	require.NoError(t, ep.Start(context.Background()))
 	require.NoError(t, ep.Close())
 	require.ErrorContains(t, ep.Run(context.Background()), "closed")
 }
+
+type clockRecorder struct {
+	clock *clock.Fake
+	start time.Time
+	at    []time.Duration
+}
+
+func (h *clockRecorder) HandleMessage(endpoint.Message) {
+	h.at = append(h.at, h.clock.Now().Sub(h.start))
+}
+
+func TestPlaybackFollowsClock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "replay.n2k")
+	log := " (0.000000)  can1  08FF0401   [1]  00\n" +
+		" (1.500000)  can1  08FF0401   [1]  01\n" +
+		" (1.500000)  can1  08FF0401   [1]  02\n" +
+		" (3600.000250)  can1  08FF0401   [1]  03\n"
+	require.NoError(t, os.WriteFile(path, []byte(log), 0o600))
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := clock.NewFake(start)
+	handler := &clockRecorder{clock: fake, start: start}
+	ep := NewN2kFileEndpoint(path, slog.New(slog.DiscardHandler))
+	ep.SetClock(fake)
+	ep.SetOutput(handler)
+
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- ep.Run(context.Background())
+	}()
+	// Move the clock straight to each frame's time once playback waits for it
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for fake.BlockUntil(ctx, 1) == nil {
+			next, _ := fake.Next()
+			fake.Set(next)
+		}
+	}()
+
+	select {
+	case err := <-runDone:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("playback did not finish")
+	}
+	assert.Equal(t, []time.Duration{0, 1500 * time.Millisecond, 1500 * time.Millisecond, time.Hour + 250*time.Microsecond}, handler.at)
+}
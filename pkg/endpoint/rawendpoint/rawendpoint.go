@@ -12,22 +12,25 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"log/slog"
 	"math/rand"
 	"os"
 	"sync"
 	"time"
 
 	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/brutella/can"
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
 // RawEndpoint writes a raw log file from canbus frames sent through the write pipeline.
 // Initially through stdout
 type RawEndpoint struct {
-	log         *logrus.Logger
+	log         *slog.Logger
+	clock       clock.Clock
 	outFilePath string
 	mu          sync.Mutex
 	file        *os.File
@@ -40,7 +43,7 @@ type RawEndpoint struct {
 
 // RawFileEndpoint reads a raw log file and sends canbus frames to its output channel.
 type RawFileEndpoint struct {
-	log        *logrus.Logger
+	log        *slog.Logger
 	inFilePath string
 	mu         sync.Mutex
 	inFile     *os.File
@@ -50,19 +53,21 @@ type RawFileEndpoint struct {
 	rand       *rand.Rand
 }
 
-// NewRawEndpoint creates a new RAW endpoint
-func NewRawEndpoint(outFilePath string, log *logrus.Logger) *RawEndpoint {
+// NewRawEndpoint creates a new RAW endpoint. A nil log uses slog.Default().
+func NewRawEndpoint(outFilePath string, log *slog.Logger) *RawEndpoint {
 	return &RawEndpoint{
-		log:         log,
+		log:         logging.OrDefault(log).With(logging.Endpoint("raw")),
+		clock:       clock.Real(),
 		outFilePath: outFilePath,
 		done:        make(chan struct{}),
 	}
 }
 
-// NewRawFileEndpoint creates a new raw file endpoint for replaying raw log files
-func NewRawFileEndpoint(file string, log *logrus.Logger) *RawFileEndpoint {
+// NewRawFileEndpoint creates a new raw file endpoint for replaying raw log files. A nil
+// log uses slog.Default().
+func NewRawFileEndpoint(file string, log *slog.Logger) *RawFileEndpoint {
 	return &RawFileEndpoint{
-		log:        log,
+		log:        logging.OrDefault(log).With(logging.Endpoint("rawfile"), "file", file),
 		inFilePath: file,
 		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
@@ -117,6 +122,12 @@ func (r *RawEndpoint) Run(ctx context.Context) error {
 	}
 }
 
+// SetClock sets the clock timestamping written frames. A nil clock is the wall clock.
+// Call it before writing.
+func (r *RawEndpoint) SetClock(c clock.Clock) {
+	r.clock = clock.OrReal(c)
+}
+
 // SetOutput sets the output struct for handling when a message is ready
 func (r *RawEndpoint) SetOutput(mh endpoint.MessageHandler) {
 	r.handler = mh
@@ -124,7 +135,7 @@ func (r *RawEndpoint) SetOutput(mh endpoint.MessageHandler) {
 
 // WriteFrame is invoked by CanAdapter, converts the frame into a RAW string, and writes it to the file.
 func (r *RawEndpoint) WriteFrame(frame can.Frame) {
-	outStr := converter.RawFromCanFrame(frame)
+	outStr := converter.RawFromCanFrame(frame, r.clock.Now())
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	if r.closed {
@@ -132,13 +143,35 @@ func (r *RawEndpoint) WriteFrame(frame can.Frame) {
 	}
 	if r.file != nil {
 		if _, err := r.file.WriteString(outStr); err != nil {
-			r.log.WithError(err).Error("failed to write raw frame")
+			r.log.Error("failed to write raw frame", logging.Error(err))
 		}
 	} else {
 		r.log.Info(outStr)
 	}
 }
 
+// WriteFrameContext writes a CAN frame like WriteFrame and reports whether it was
+// written.
+func (r *RawEndpoint) WriteFrameContext(ctx context.Context, frame can.Frame) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	outStr := converter.RawFromCanFrame(frame, r.clock.Now())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return endpoint.ErrClosed
+	}
+	if r.file != nil {
+		if _, err := r.file.WriteString(outStr); err != nil {
+			return errors.Wrap(err, "failed to write raw frame")
+		}
+	} else {
+		r.log.Info(outStr)
+	}
+	return nil
+}
+
 // Close closes the endpoint
 func (r *RawEndpoint) Close() error {
 	r.mu.Lock()
@@ -205,7 +238,7 @@ func (r *RawFileEndpoint) Run(ctx context.Context) error {
 	defer func() {
 		if r.finishRun(file) {
 			if err := file.Close(); err != nil {
-				r.log.WithError(err).Errorf("failed to close raw input file %s", r.inFilePath)
+				r.log.Error("failed to close raw input file", logging.Error(err))
 			}
 		}
 	}()
@@ -227,7 +260,7 @@ func (r *RawFileEndpoint) Run(ctx context.Context) error {
 
 		frames, err := converter.CanFrameFromRaw(line)
 		if err != nil {
-			r.log.Warnf("Error parsing raw line: %v", err)
+			r.log.Warn("Error parsing raw line", logging.Error(err))
 			continue
 		}
 
@@ -245,7 +278,7 @@ func (r *RawFileEndpoint) Run(ctx context.Context) error {
 	}
 
 	if err := scanner.Err(); err != nil {
-		r.log.Warn(errors.Wrap(err, "error while scanning raw replay file"))
+		r.log.Warn("error while scanning raw replay file", logging.Error(err))
 	}
 
 	r.log.Info("raw file playback complete")
@@ -292,3 +325,8 @@ func (r *RawFileEndpoint) SetOutput(mh endpoint.MessageHandler) {
 func (r *RawFileEndpoint) WriteFrame(_ can.Frame) {
 	// RawFileEndpoint is read-only, so this is a no-op
 }
+
+// WriteFrameContext returns endpoint.ErrWriteNotSupported as RawFileEndpoint is read-only.
+func (r *RawFileEndpoint) WriteFrameContext(_ context.Context, _ can.Frame) error {
+	return endpoint.ErrWriteNotSupported
+}
@@ -2,6 +2,7 @@ package rawendpoint
 
 import (
 	"context"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"testing"
@@ -10,7 +11,6 @@ import (
 	"github.com/boatkit-io/n2k/internal/converter"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,7 +25,7 @@ func (h *blockingHandler) HandleMessage(endpoint.Message) {
 }
 
 func TestRawEndpointCloseStopsRunAndIsTerminal(t *testing.T) {
-	ep := NewRawEndpoint("", logrus.New())
+	ep := NewRawEndpoint("", slog.Default())
 	runDone := make(chan error, 1)
 	go func() {
 		runDone <- ep.Run(context.Background())
@@ -49,9 +49,9 @@ func TestRawEndpointCloseStopsRunAndIsTerminal(t *testing.T) {
 func TestRawFileRunRejectsConcurrentPlayback(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "replay.raw")
 	frame := can.Frame{ID: can.MaskEff | 0x19f80123, Length: 1, Data: [8]byte{1}}
-	require.NoError(t, os.WriteFile(path, []byte(converter.RawFromCanFrame(frame)), 0o600))
+	require.NoError(t, os.WriteFile(path, []byte(converter.RawFromCanFrame(frame, time.Now())), 0o600))
 	handler := &blockingHandler{entered: make(chan struct{}), release: make(chan struct{})}
-	ep := NewRawFileEndpoint(path, logrus.New())
+	ep := NewRawFileEndpoint(path, slog.Default())
 	ep.SetOutput(handler)
 
 	runDone := make(chan error, 1)
@@ -71,7 +71,7 @@ func TestRawFileRunRejectsConcurrentPlayback(t *testing.T) {
 func TestRawFileClosePreventsRunFromReopeningFile(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "replay.raw")
 	require.NoError(t, os.WriteFile(path, nil, 0o600))
-	ep := NewRawFileEndpoint(path, logrus.New())
+	ep := NewRawFileEndpoint(path, slog.Default())
 
 	require.NoError(t, ep.Start(context.Background()))
 	require.NoError(t, ep.Close())
@@ -11,30 +11,41 @@ package socketcanendpoint
 import (
 	"context"
 	stderrors "errors"
+	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
-	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
-	"github.com/boatkit-io/n2k/pkg/pgn"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/tugboat/pkg/canbus"
 	"github.com/brutella/can"
 	pkgerrors "github.com/pkg/errors"
-
-	"github.com/sirupsen/logrus"
 )
 
 const (
 	socketCANOutboundQueueSize = 2048
 	socketCANOutboundLagTTL    = 5 * time.Second
+
+	// socketCANRetryInitialDelay and socketCANRetryMaxDelay bound the backoff while the
+	// interface's transmit buffer is full
+	socketCANRetryInitialDelay = time.Millisecond
+	socketCANRetryMaxDelay     = 50 * time.Millisecond
+	// socketCANRetryLimit is how long a frame is retried before it is dropped. A buffer
+	// that stays full, such as on a bus where no other device acknowledges frames or
+	// after bus-off, must not hold up every frame behind it.
+	socketCANRetryLimit = 100 * time.Millisecond
 )
 
 // SocketCANEndpoint is an endpoint backed by a live SocketCAN interface, pulling down CAN frames
 type SocketCANEndpoint struct {
-	log *logrus.Logger
+	log *slog.Logger
+	// clock times outbound lag and retries; nil is the wall clock
+	clock clock.Clock
 
 	channel canbus.Interface
 
@@ -46,8 +57,9 @@ type SocketCANEndpoint struct {
 	doneOnce sync.Once
 
 	outboundOnce sync.Once
-	outboundHigh chan outboundSocketCANFrame
-	outboundLow  chan outboundSocketCANFrame
+	// outbound holds frames in the order they were written; ordering by priority is left
+	// to the CAN adapter's transmit queue
+	outbound chan outboundSocketCANFrame
 
 	outboundWriterMutex  sync.Mutex
 	outboundWriterCancel context.CancelFunc
@@ -60,17 +72,40 @@ type SocketCANEndpoint struct {
 type outboundSocketCANFrame struct {
 	frame      can.Frame
 	enqueuedAt time.Time
-	attempt    int
+	// ctx, result and bufferFull are set for frames written with WriteFrameContext
+	ctx        context.Context
+	result     chan error
+	bufferFull *atomic.Bool
+}
+
+// finish reports the outcome of a WriteFrameContext frame.
+func (item *outboundSocketCANFrame) finish(err error) {
+	if item.result != nil {
+		item.result <- err
+	}
 }
 
-type socketCANRetryPolicy struct {
-	initialDelay time.Duration
-	maxDelay     time.Duration
-	requeueDelay bool
+// canceled reports the error for a WriteFrameContext frame whose context ended before
+// it was sent.
+func (item *outboundSocketCANFrame) canceled() error {
+	if item.ctx == nil || item.ctx.Err() == nil {
+		return nil
+	}
+	return item.contextError()
 }
 
-// NewSocketCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name
-func NewSocketCANEndpoint(log *logrus.Logger, canInterfaceName string) endpoint.Endpoint {
+// contextError wraps the frame's context error, noting a full transmit buffer.
+func (item *outboundSocketCANFrame) contextError() error {
+	if item.bufferFull.Load() {
+		return fmt.Errorf("%w: %w", endpoint.ErrTxBufferFull, item.ctx.Err())
+	}
+	return item.ctx.Err()
+}
+
+// NewSocketCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name. A
+// nil log uses slog.Default().
+func NewSocketCANEndpoint(log *slog.Logger, canInterfaceName string) endpoint.Endpoint {
+	log = logging.OrDefault(log).With(logging.Endpoint("socketcan"), "interface", canInterfaceName)
 	c := SocketCANEndpoint{
 		log:  log,
 		done: make(chan struct{}),
@@ -84,12 +119,18 @@ func NewSocketCANEndpoint(log *logrus.Logger, canInterfaceName string) endpoint.
 		MessageHandler: c.frameReady,
 	}
 
-	c.channel = canbus.NewSocketCANChannel(log, channelOpts)
+	c.channel = canbus.NewSocketCANChannel(logging.ToLogrus(log), channelOpts)
 	c.initOutboundQueues()
 
 	return &c
 }
 
+// SetClock sets the clock that outbound lag and retry delays are timed by. A nil clock
+// is the wall clock. Call it before Run.
+func (c *SocketCANEndpoint) SetClock(clk clock.Clock) {
+	c.clock = clk
+}
+
 // Start synchronously opens the SocketCAN channel.
 func (c *SocketCANEndpoint) Start(ctx context.Context) error {
 	if c.closed.Load() {
@@ -200,44 +241,67 @@ func (c *SocketCANEndpoint) stopOutboundWriter() {
 	<-done
 }
 
-// WriteFrame sends a CAN frame to the SocketCAN interface
+// WriteFrame queues a CAN frame for the SocketCAN interface and returns. A frame that
+// does not fit in the queue is dropped; use WriteFrameContext to wait until a frame is
+// sent.
 func (c *SocketCANEndpoint) WriteFrame(frame can.Frame) {
 	if c.channel == nil || c.closed.Load() {
 		return
 	}
 	c.initOutboundQueues()
+	item := outboundSocketCANFrame{
+		frame:      frame,
+		enqueuedAt: clock.OrReal(c.clock).Now(),
+	}
+	select {
+	case c.outbound <- item:
+	default:
+		c.log.Warn("dropping SocketCAN frame because the outbound queue is full")
+	}
+}
+
+// WriteFrameContext queues a CAN frame and waits until it has been written to the
+// SocketCAN interface. A full queue applies backpressure until ctx ends.
+func (c *SocketCANEndpoint) WriteFrameContext(ctx context.Context, frame can.Frame) error {
+	if c.channel == nil || c.closed.Load() {
+		return endpoint.ErrClosed
+	}
+	c.initOutboundQueues()
 	done := c.done
 	item := outboundSocketCANFrame{
 		frame:      frame,
-		enqueuedAt: time.Now(),
+		enqueuedAt: clock.OrReal(c.clock).Now(),
+		ctx:        ctx,
+		result:     make(chan error, 1),
+		bufferFull: &atomic.Bool{},
 	}
 
-	if isLowPrioritySocketCANFrame(frame) {
-		select {
-		case c.outboundLow <- item:
-		case <-done:
-		default:
-			c.log.Warn("dropping low-priority SocketCAN frame because the outbound queue is full")
-		}
-		return
+	select {
+	case c.outbound <- item:
+	case <-done:
+		return endpoint.ErrClosed
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %w", endpoint.ErrTxBufferFull, ctx.Err())
 	}
 
 	select {
-	case c.outboundHigh <- item:
+	case err := <-item.result:
+		return err
 	case <-done:
-	default:
-		c.log.Warn("SocketCAN outbound queue is full; applying backpressure")
 		select {
-		case c.outboundHigh <- item:
-		case <-done:
+		case err := <-item.result:
+			return err
+		default:
+			return endpoint.ErrClosed
 		}
+	case <-ctx.Done():
+		return item.contextError()
 	}
 }
 
 func (c *SocketCANEndpoint) initOutboundQueues() {
 	c.outboundOnce.Do(func() {
-		c.outboundHigh = make(chan outboundSocketCANFrame, socketCANOutboundQueueSize)
-		c.outboundLow = make(chan outboundSocketCANFrame, socketCANOutboundQueueSize)
+		c.outbound = make(chan outboundSocketCANFrame, socketCANOutboundQueueSize)
 		if c.done == nil {
 			c.done = make(chan struct{})
 		}
@@ -248,24 +312,7 @@ func (c *SocketCANEndpoint) runOutboundWriter(ctx context.Context) {
 	c.initOutboundQueues()
 	for {
 		select {
-		case <-ctx.Done():
-			return
-		case <-c.done:
-			return
-		default:
-		}
-
-		select {
-		case item := <-c.outboundHigh:
-			c.writeQueuedFrame(ctx, item)
-			continue
-		default:
-		}
-
-		select {
-		case item := <-c.outboundHigh:
-			c.writeQueuedFrame(ctx, item)
-		case item := <-c.outboundLow:
+		case item := <-c.outbound:
 			c.writeQueuedFrame(ctx, item)
 		case <-ctx.Done():
 			return
@@ -275,91 +322,79 @@ func (c *SocketCANEndpoint) runOutboundWriter(ctx context.Context) {
 	}
 }
 
+// writeQueuedFrame writes a frame, retrying with backoff while the interface's transmit
+// buffer is full. Frames behind it wait, so the frames of a fast packet stay in order. A
+// frame the buffer has no room for within socketCANRetryLimit is dropped with
+// endpoint.ErrTxBufferFull.
 func (c *SocketCANEndpoint) writeQueuedFrame(ctx context.Context, item outboundSocketCANFrame) {
-	policy := retryPolicyForSocketCANFrame(item.frame)
 	if item.enqueuedAt.IsZero() {
-		item.enqueuedAt = time.Now()
+		item.enqueuedAt = clock.OrReal(c.clock).Now()
 	}
 
-	for {
+	var itemDone <-chan struct{}
+	if item.ctx != nil {
+		itemDone = item.ctx.Done()
+	}
+	var giveUp time.Time
+	for attempt := 0; ; attempt++ {
+		if err := item.canceled(); err != nil {
+			item.finish(err)
+			return
+		}
 		err := c.channel.WriteFrame(item.frame)
 		if err == nil {
 			c.recordOutboundLag(item)
+			item.finish(nil)
 			return
 		}
 		if !isSocketCANTxBufferFull(err) {
-			c.log.WithError(err).Error("failed to send frame to SocketCAN interface")
+			c.log.Error("failed to send frame to SocketCAN interface", logging.Error(err))
+			item.finish(err)
 			return
 		}
 		c.recordOutboundLag(item)
-
-		delay := policy.delay(item.attempt)
-		if policy.requeueDelay {
-			item.attempt++
-			c.requeueLowPriorityFrame(ctx, item, delay)
+		if item.bufferFull != nil {
+			item.bufferFull.Store(true)
+		}
+		now := clock.OrReal(c.clock).Now()
+		if giveUp.IsZero() {
+			giveUp = now.Add(socketCANRetryLimit)
+		} else if !now.Before(giveUp) {
+			c.log.Warn("dropping SocketCAN frame because the transmit buffer stayed full", logging.Error(err))
+			item.finish(fmt.Errorf("%w: %w", endpoint.ErrTxBufferFull, err))
 			return
 		}
 
-		timer := time.NewTimer(delay)
+		timer := clock.OrReal(c.clock).NewTimer(socketCANRetryDelay(attempt))
 		select {
-		case <-timer.C:
+		case <-timer.C():
+		case <-itemDone:
+			timer.Stop()
 		case <-ctx.Done():
 			timer.Stop()
+			item.finish(endpoint.ErrClosed)
 			return
 		}
-		item.attempt++
 	}
 }
 
-func retryPolicyForSocketCANFrame(frame can.Frame) socketCANRetryPolicy {
-	if isLowPrioritySocketCANFrame(frame) {
-		return socketCANRetryPolicy{
-			initialDelay: 250 * time.Millisecond,
-			maxDelay:     2 * time.Second,
-			requeueDelay: true,
-		}
-	}
-	return socketCANRetryPolicy{
-		initialDelay: time.Millisecond,
-		maxDelay:     50 * time.Millisecond,
-	}
-}
-
-func (p socketCANRetryPolicy) delay(attempt int) time.Duration {
-	delay := p.initialDelay
+// socketCANRetryDelay returns how long to wait before retry attempt+1 of a frame the
+// interface had no buffer space for.
+func socketCANRetryDelay(attempt int) time.Duration {
+	delay := socketCANRetryInitialDelay
 	for range attempt {
 		delay *= 2
-		if delay >= p.maxDelay {
-			return p.maxDelay
+		if delay >= socketCANRetryMaxDelay {
+			return socketCANRetryMaxDelay
 		}
 	}
 	return delay
 }
 
-func (c *SocketCANEndpoint) requeueLowPriorityFrame(ctx context.Context, item outboundSocketCANFrame, delay time.Duration) {
-	go func() {
-		timer := time.NewTimer(delay)
-		defer timer.Stop()
-
-		select {
-		case <-timer.C:
-		case <-ctx.Done():
-			return
-		}
-
-		select {
-		case c.outboundLow <- item:
-		default:
-			c.log.Warn("dropping low-priority SocketCAN frame because the outbound queue is full")
-		case <-ctx.Done():
-		}
-	}()
-}
-
 // OutboundQueueLag returns recent SocketCAN outbound queue/send latency.
 func (c *SocketCANEndpoint) OutboundQueueLag() time.Duration {
 	updated := c.outboundLagUpdatedNano.Load()
-	if updated == 0 || time.Since(time.Unix(0, updated)) > socketCANOutboundLagTTL {
+	if updated == 0 || clock.OrReal(c.clock).Now().Sub(time.Unix(0, updated)) > socketCANOutboundLagTTL {
 		return 0
 	}
 	return time.Duration(c.outboundLagNano.Load())
@@ -369,50 +404,19 @@ func (c *SocketCANEndpoint) recordOutboundLag(item outboundSocketCANFrame) {
 	if item.enqueuedAt.IsZero() {
 		return
 	}
-	lag := time.Since(item.enqueuedAt)
+	now := clock.OrReal(c.clock).Now()
+	lag := now.Sub(item.enqueuedAt)
 	if lag < 0 {
 		lag = 0
 	}
 	c.outboundLagNano.Store(int64(lag))
-	c.outboundLagUpdatedNano.Store(time.Now().UnixNano())
+	c.outboundLagUpdatedNano.Store(now.UnixNano())
 }
 
 func isSocketCANTxBufferFull(err error) bool {
 	return stderrors.Is(err, syscall.ENOBUFS) || strings.Contains(err.Error(), "no buffer space available")
 }
 
-func isLowPrioritySocketCANFrame(frame can.Frame) bool {
-	header := converter.DecodeCanID(frame.ID)
-	switch header.PGN {
-	case pgn.ISORequestPGN:
-		requestedPGN, ok := requestedPGNFromISORequestFrame(frame)
-		return ok && isDiscoveryRequestedPGN(requestedPGN)
-	case pgn.ProductInformationPGN, pgn.ConfigurationInformationPGN, pgn.PGNListTransmitAndReceivePGN:
-		return true
-	default:
-		return false
-	}
-}
-
-func requestedPGNFromISORequestFrame(frame can.Frame) (uint32, bool) {
-	if frame.Length < 3 {
-		return 0, false
-	}
-	return uint32(frame.Data[0]) | uint32(frame.Data[1])<<8 | uint32(frame.Data[2])<<16, true
-}
-
-func isDiscoveryRequestedPGN(requestedPGN uint32) bool {
-	switch requestedPGN {
-	case pgn.ISOAddressClaimPGN,
-		pgn.ProductInformationPGN,
-		pgn.ConfigurationInformationPGN,
-		pgn.PGNListTransmitAndReceivePGN:
-		return true
-	default:
-		return false
-	}
-}
-
 // frameReady is a helper to handle passing completed frames to the handler
 func (c *SocketCANEndpoint) frameReady(frame can.Frame) {
 	if c.handler != nil {
@@ -2,7 +2,7 @@ package socketcanendpoint
 
 import (
 	"context"
-	"io"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -10,9 +10,9 @@ import (
 	"time"
 
 	"github.com/boatkit-io/n2k/internal/converter"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
 	"github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,9 +32,10 @@ func (c *retryTestChannel) WriteFrame(can.Frame) error {
 	return nil
 }
 
-type requeueTestChannel struct {
-	failedLow atomic.Bool
-	writes    chan uint32
+// orderTestChannel records the PGN of every write attempt, failing the first with ENOBUFS.
+type orderTestChannel struct {
+	failed atomic.Bool
+	writes chan uint32
 }
 
 type blockingTestChannel struct {
@@ -59,12 +60,12 @@ func (c *blockingTestChannel) Close() error {
 }
 func (*blockingTestChannel) WriteFrame(can.Frame) error { return nil }
 
-func (c *requeueTestChannel) Start(context.Context) error { return nil }
-func (c *requeueTestChannel) Run(context.Context) error   { return nil }
-func (c *requeueTestChannel) Close() error                { return nil }
-func (c *requeueTestChannel) WriteFrame(frame can.Frame) error {
+func (c *orderTestChannel) Start(context.Context) error { return nil }
+func (c *orderTestChannel) Run(context.Context) error   { return nil }
+func (c *orderTestChannel) Close() error                { return nil }
+func (c *orderTestChannel) WriteFrame(frame can.Frame) error {
 	c.writes <- converter.DecodeCanID(frame.ID).PGN
-	if isLowPrioritySocketCANFrame(frame) && !c.failedLow.Swap(true) {
+	if !c.failed.Swap(true) {
 		return syscall.ENOBUFS
 	}
 	return nil
@@ -108,7 +109,7 @@ func TestRunRejectsConcurrentSocketCANReadersAndCloseIsTerminal(t *testing.T) {
 	assert.ErrorContains(t, ep.Start(context.Background()), "closed")
 }
 
-func TestCloseReleasesWriterBlockedByFullOutboundQueue(t *testing.T) {
+func TestWriteFrameDropsWhenOutboundQueueIsFull(t *testing.T) {
 	ep := &SocketCANEndpoint{
 		log:     discardLogger(),
 		channel: &retryTestChannel{},
@@ -125,67 +126,83 @@ func TestCloseReleasesWriterBlockedByFullOutboundQueue(t *testing.T) {
 	}()
 	select {
 	case <-writeDone:
-		t.Fatal("WriteFrame returned before queue space or shutdown was available")
+	case <-time.After(time.Second):
+		t.Fatal("WriteFrame blocked on a full outbound queue")
+	}
+	assert.Len(t, ep.outbound, socketCANOutboundQueueSize)
+}
+
+func TestCloseReleasesWaitingWriteFrameContext(t *testing.T) {
+	ep := &SocketCANEndpoint{
+		log:     discardLogger(),
+		channel: &retryTestChannel{},
+	}
+	assert.NoError(t, ep.Start(context.Background()))
+
+	// Without a running writer the frame is never sent
+	writeDone := make(chan error, 1)
+	go func() {
+		writeDone <- ep.WriteFrameContext(context.Background(), can.Frame{})
+	}()
+	select {
+	case <-writeDone:
+		t.Fatal("WriteFrameContext returned before the frame was sent or the endpoint closed")
 	case <-time.After(20 * time.Millisecond):
 	}
 
 	assert.NoError(t, ep.Close())
 	select {
-	case <-writeDone:
+	case err := <-writeDone:
+		assert.ErrorIs(t, err, endpoint.ErrClosed)
 	case <-time.After(time.Second):
 		t.Fatal("Close did not release blocked SocketCAN writer")
 	}
 }
 
-func TestLowPriorityBackoffDoesNotBlockHighPriorityFrames(t *testing.T) {
-	channel := &requeueTestChannel{writes: make(chan uint32, 4)}
-	endpoint := &SocketCANEndpoint{
-		log:     discardLogger(),
-		channel: channel,
-	}
+func TestBufferFullRetryIsBounded(t *testing.T) {
+	ep := &SocketCANEndpoint{log: discardLogger(), channel: &errorTestChannel{err: syscall.ENOBUFS}}
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	go endpoint.runOutboundWriter(ctx)
-
-	endpoint.WriteFrame(isoRequestFrame(pgn.ProductInformationPGN))
-	assert.Equal(t, uint32(pgn.ISORequestPGN), <-channel.writes)
-
-	endpoint.WriteFrame(pgnFrame(pgn.HeartbeatPGN))
-	select {
-	case writtenPGN := <-channel.writes:
-		assert.Equal(t, uint32(pgn.HeartbeatPGN), writtenPGN)
-	case <-time.After(100 * time.Millisecond):
-		t.Fatal("high-priority frame was blocked behind low-priority retry backoff")
-	}
+	go ep.runOutboundWriter(ctx)
+
+	// A buffer that stays full drops the frame rather than holding up the frames behind it
+	start := time.Now()
+	err := ep.WriteFrameContext(context.Background(), can.Frame{})
+	assert.ErrorIs(t, err, endpoint.ErrTxBufferFull)
+	assert.ErrorIs(t, err, syscall.ENOBUFS)
+	assert.GreaterOrEqual(t, time.Since(start), socketCANRetryLimit)
+	assert.Less(t, time.Since(start), socketCANRetryLimit+time.Second)
 }
 
-func TestWriteFrameQueuesDiscoveryRequestsAsLowPriority(t *testing.T) {
-	endpoint := &SocketCANEndpoint{
+func TestBufferFullRetryKeepsFrameOrder(t *testing.T) {
+	channel := &orderTestChannel{writes: make(chan uint32, 8)}
+	ep := &SocketCANEndpoint{
 		log:     discardLogger(),
-		channel: &retryTestChannel{},
+		channel: channel,
 	}
-
-	endpoint.WriteFrame(isoRequestFrame(pgn.ProductInformationPGN))
-
-	assert.Len(t, endpoint.outboundHigh, 0)
-	assert.Len(t, endpoint.outboundLow, 1)
-}
-
-func TestWriteFrameQueuesOperationalFramesAsHighPriority(t *testing.T) {
-	endpoint := &SocketCANEndpoint{
-		log:     discardLogger(),
-		channel: &retryTestChannel{},
+	ep.initOutboundQueues()
+	frames := []can.Frame{pgnFrame(pgn.ProductInformationPGN), pgnFrame(pgn.ProductInformationPGN), pgnFrame(pgn.HeartbeatPGN)}
+	results := make([]chan error, len(frames))
+	for i, frame := range frames {
+		results[i] = make(chan error, 1)
+		ep.outbound <- outboundSocketCANFrame{frame: frame, ctx: context.Background(), result: results[i], bufferFull: &atomic.Bool{}}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go ep.runOutboundWriter(ctx)
+	for _, result := range results {
+		assert.NoError(t, <-result)
 	}
 
-	endpoint.WriteFrame(pgnFrame(pgn.HeartbeatPGN))
-
-	assert.Len(t, endpoint.outboundHigh, 1)
-	assert.Len(t, endpoint.outboundLow, 0)
+	// The frame the interface had no room for is retried before the frames behind it
+	info, heartbeat := uint32(pgn.ProductInformationPGN), uint32(pgn.HeartbeatPGN)
+	assert.Equal(t, []uint32{info, info, info, heartbeat}, []uint32{<-channel.writes, <-channel.writes, <-channel.writes, <-channel.writes})
 }
 
-func TestRetryPolicyUsesLongerBackoffForDiscoveryFrames(t *testing.T) {
-	assert.Equal(t, time.Millisecond, retryPolicyForSocketCANFrame(pgnFrame(pgn.HeartbeatPGN)).delay(0))
-	assert.Equal(t, 250*time.Millisecond, retryPolicyForSocketCANFrame(isoRequestFrame(pgn.ProductInformationPGN)).delay(0))
+func TestRetryDelayBacksOff(t *testing.T) {
+	assert.Equal(t, time.Millisecond, socketCANRetryDelay(0))
+	assert.Equal(t, 4*time.Millisecond, socketCANRetryDelay(2))
+	assert.Equal(t, socketCANRetryMaxDelay, socketCANRetryDelay(10))
 }
 
 func TestOutboundQueueLagRecordsSendLatency(t *testing.T) {
@@ -212,19 +229,8 @@ func TestOutboundQueueLagExpires(t *testing.T) {
 	assert.Zero(t, endpoint.OutboundQueueLag())
 }
 
-func discardLogger() *logrus.Logger {
-	log := logrus.New()
-	log.SetOutput(io.Discard)
-	return log
-}
-
-func isoRequestFrame(requestedPGN uint32) can.Frame {
-	frame := pgnFrame(pgn.ISORequestPGN)
-	frame.Length = 3
-	frame.Data[0] = uint8(requestedPGN)
-	frame.Data[1] = uint8(requestedPGN >> 8)
-	frame.Data[2] = uint8(requestedPGN >> 16)
-	return frame
+func discardLogger() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
 }
 
 func pgnFrame(pgnNum uint32) can.Frame {
@@ -233,3 +239,52 @@ func pgnFrame(pgnNum uint32) can.Frame {
 		Length: 8,
 	}
 }
+
+type errorTestChannel struct {
+	err error
+}
+
+func (c *errorTestChannel) Start(context.Context) error { return nil }
+func (c *errorTestChannel) Run(context.Context) error   { return nil }
+func (c *errorTestChannel) Close() error                { return nil }
+func (c *errorTestChannel) WriteFrame(can.Frame) error  { return c.err }
+
+func TestWriteFrameContextReportsOutcome(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	channel := &retryTestChannel{failures: 2}
+	ep := &SocketCANEndpoint{log: discardLogger(), channel: channel}
+	go ep.runOutboundWriter(ctx)
+	assert.NoError(t, ep.WriteFrameContext(ctx, can.Frame{}))
+	assert.Equal(t, int32(3), channel.writes.Load())
+
+	ep = &SocketCANEndpoint{log: discardLogger(), channel: &errorTestChannel{err: syscall.ENETDOWN}}
+	go ep.runOutboundWriter(ctx)
+	assert.ErrorIs(t, ep.WriteFrameContext(ctx, can.Frame{}), syscall.ENETDOWN)
+
+	ep = &SocketCANEndpoint{log: discardLogger(), channel: &errorTestChannel{err: syscall.ENOBUFS}}
+	go ep.runOutboundWriter(ctx)
+	timeout, cancelTimeout := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancelTimeout()
+	err := ep.WriteFrameContext(timeout, can.Frame{})
+	assert.ErrorIs(t, err, endpoint.ErrTxBufferFull)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.NoError(t, ep.Close())
+	assert.ErrorIs(t, ep.WriteFrameContext(ctx, can.Frame{}), endpoint.ErrClosed)
+}
+
+func TestWriteFrameContextAppliesQueueAdmission(t *testing.T) {
+	ep := &SocketCANEndpoint{log: discardLogger(), channel: &retryTestChannel{}}
+	assert.NoError(t, ep.Start(context.Background()))
+	for range socketCANOutboundQueueSize {
+		ep.outbound <- outboundSocketCANFrame{frame: can.Frame{}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := ep.WriteFrameContext(ctx, can.Frame{})
+	assert.ErrorIs(t, err, endpoint.ErrTxBufferFull)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -12,20 +12,20 @@ package usbcanendpoint
 import (
 	"context"
 	stderrors "errors"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/logging"
 	"github.com/boatkit-io/tugboat/pkg/canbus"
 	"github.com/brutella/can"
 	pkgerrors "github.com/pkg/errors"
-
-	"github.com/sirupsen/logrus"
 )
 
 // USBCANEndpoint is an endpoint backed by a USBCAN interface, pulling down CAN frames
 type USBCANEndpoint struct {
-	log *logrus.Logger
+	log *slog.Logger
 
 	channel canbus.Interface
 
@@ -35,8 +35,10 @@ type USBCANEndpoint struct {
 	running bool
 }
 
-// NewUSBCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name
-func NewUSBCANEndpoint(log *logrus.Logger, serialPortName string) endpoint.Endpoint {
+// NewUSBCANEndpoint builds a new SocketCANEndpoint for the given CAN interface name. A nil
+// log uses slog.Default().
+func NewUSBCANEndpoint(log *slog.Logger, serialPortName string) endpoint.Endpoint {
+	log = logging.OrDefault(log).With(logging.Endpoint("usbcan"), "port", serialPortName)
 	c := USBCANEndpoint{
 		log: log,
 	}
@@ -48,7 +50,7 @@ func NewUSBCANEndpoint(log *logrus.Logger, serialPortName string) endpoint.Endpo
 		FrameHandler:   c.frameReady,
 	}
 
-	c.channel = canbus.NewUSBCANChannel(log, channelOpts)
+	c.channel = canbus.NewUSBCANChannel(logging.ToLogrus(log), channelOpts)
 
 	return &c
 }
@@ -123,11 +125,26 @@ func (c *USBCANEndpoint) Close() error {
 func (c *USBCANEndpoint) WriteFrame(frame can.Frame) {
 	if c.channel != nil && !c.closed.Load() {
 		if err := c.channel.WriteFrame(frame); err != nil {
-			c.log.WithError(err).Error("failed to send frame to USBCAN interface")
+			c.log.Error("failed to send frame to USBCAN interface", logging.Error(err))
 		}
 	}
 }
 
+// WriteFrameContext sends a CAN frame to the USBCAN interface and reports whether it
+// was written.
+func (c *USBCANEndpoint) WriteFrameContext(ctx context.Context, frame can.Frame) error {
+	if c.channel == nil || c.closed.Load() {
+		return endpoint.ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := c.channel.WriteFrame(frame); err != nil {
+		return pkgerrors.Wrap(err, "failed to send frame to USBCAN interface")
+	}
+	return nil
+}
+
 // frameReady is a helper to handle passing completed frames to the handler
 func (c *USBCANEndpoint) frameReady(frame can.Frame) {
 	if c.handler != nil {
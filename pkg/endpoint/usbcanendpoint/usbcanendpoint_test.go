@@ -3,13 +3,13 @@ package usbcanendpoint
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,7 +43,7 @@ func TestRunRejectsConcurrentReaderBeforeStartingChannelAgain(t *testing.T) {
 		runEntered: make(chan struct{}),
 		closed:     make(chan struct{}),
 	}
-	ep := &USBCANEndpoint{log: logrus.New(), channel: channel}
+	ep := &USBCANEndpoint{log: slog.Default(), channel: channel}
 	runDone := make(chan error, 1)
 	go func() {
 		runDone <- ep.Run(context.Background())
@@ -68,7 +68,7 @@ func TestRunRejectsConcurrentReaderBeforeStartingChannelAgain(t *testing.T) {
 func TestRunReleasesRunningStateAfterStartupFailure(t *testing.T) {
 	wantErr := errors.New("startup failed")
 	ep := &USBCANEndpoint{
-		log: logrus.New(),
+		log: slog.Default(),
 		channel: startErrorTestChannel{
 			err: wantErr,
 		},
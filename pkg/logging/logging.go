@@ -0,0 +1,76 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+// Package logging holds the attribute keys the library logs with and a bridge for
+// applications that log with logrus.
+//
+// The library logs to a *slog.Logger. Any logging backend can be used by giving it a
+// slog.Handler; FromLogrus does so for a *logrus.Logger.
+package logging
+
+import (
+	"log/slog"
+)
+
+// Attribute keys used consistently in the library's log records.
+const (
+	// KeyPGN is the PGN number of a message.
+	KeyPGN = "pgn"
+	// KeySource is the source address of a message.
+	KeySource = "src"
+	// KeyDestination is the destination address of a message.
+	KeyDestination = "dst"
+	// KeyEndpoint is the kind of endpoint logging, such as "socketcan".
+	KeyEndpoint = "endpoint"
+	// KeyBus is the ID of a bus of an endpoint with several.
+	KeyBus = "bus"
+	// KeyNodeAddress is the source address a node currently holds.
+	KeyNodeAddress = "node_address"
+)
+
+// PGN returns the attribute of a PGN number.
+func PGN(pgn uint32) slog.Attr {
+	return slog.Uint64(KeyPGN, uint64(pgn))
+}
+
+// Source returns the attribute of a source address.
+func Source(address uint8) slog.Attr {
+	return slog.Uint64(KeySource, uint64(address))
+}
+
+// Destination returns the attribute of a destination address.
+func Destination(address uint8) slog.Attr {
+	return slog.Uint64(KeyDestination, uint64(address))
+}
+
+// Endpoint returns the attribute of an endpoint kind.
+func Endpoint(kind string) slog.Attr {
+	return slog.String(KeyEndpoint, kind)
+}
+
+// Bus returns the attribute of a bus ID.
+func Bus(id string) slog.Attr {
+	return slog.String(KeyBus, id)
+}
+
+// NodeAddress returns the attribute of a node's source address.
+func NodeAddress(address uint8) slog.Attr {
+	return slog.Uint64(KeyNodeAddress, uint64(address))
+}
+
+// Error returns the attribute of an error, under the key "error".
+func Error(err error) slog.Attr {
+	return slog.Any("error", err)
+}
+
+// OrDefault returns log, or slog.Default() when log is nil.
+func OrDefault(log *slog.Logger) *slog.Logger {
+	if log == nil {
+		return slog.Default()
+	}
+	return log
+}
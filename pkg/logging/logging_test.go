@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromLogrusWritesFieldsAndLevels(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.InfoLevel)
+	log := FromLogrus(logger).With(Endpoint("socketcan"))
+
+	log.Debug("hidden")
+	assert.Empty(t, hook.AllEntries())
+
+	failed := errors.New("bus off")
+	log.WithGroup("frame").Warn("write failed", PGN(129025), Source(3), Error(failed))
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, logrus.WarnLevel, entry.Level)
+	assert.Equal(t, "write failed", entry.Message)
+	assert.Equal(t, logrus.Fields{
+		KeyEndpoint:          "socketcan",
+		"frame." + KeyPGN:    uint64(129025),
+		"frame." + KeySource: uint64(3),
+		"frame.error":        failed,
+	}, entry.Data)
+}
+
+func TestToLogrusWritesToSlog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := ToLogrus(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	assert.Equal(t, logrus.InfoLevel, logger.GetLevel())
+
+	logger.Debug("hidden")
+	logger.WithField("channel", "can0").Warn("interface down")
+	assert.NotContains(t, buf.String(), "hidden")
+	assert.Contains(t, buf.String(), `level=WARN msg="interface down" channel=can0`)
+}
+
+func TestOrDefault(t *testing.T) {
+	assert.Same(t, slog.Default(), OrDefault(nil))
+	log := slog.New(slog.DiscardHandler)
+	assert.Same(t, log, OrDefault(log))
+}
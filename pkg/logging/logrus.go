@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FromLogrus returns a *slog.Logger writing to log, for applications that still log
+// with logrus. Attributes become logrus fields, with the names of enclosing groups
+// joined by dots.
+func FromLogrus(log *logrus.Logger) *slog.Logger {
+	return slog.New(NewLogrusHandler(log))
+}
+
+// LogrusHandler is a slog.Handler writing to a *logrus.Logger.
+type LogrusHandler struct {
+	log    *logrus.Logger
+	fields logrus.Fields
+	group  string
+}
+
+// NewLogrusHandler returns a handler writing to log.
+func NewLogrusHandler(log *logrus.Logger) *LogrusHandler {
+	return &LogrusHandler{log: log}
+}
+
+// Enabled reports whether log writes records of level.
+func (h *LogrusHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.log.IsLevelEnabled(logrusLevel(level))
+}
+
+// Handle writes a record as a logrus entry.
+func (h *LogrusHandler) Handle(_ context.Context, record slog.Record) error {
+	fields := make(logrus.Fields, len(h.fields)+record.NumAttrs())
+	for key, value := range h.fields {
+		fields[key] = value
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		addField(fields, h.group, attr)
+		return true
+	})
+	entry := h.log.WithFields(fields)
+	if !record.Time.IsZero() {
+		entry = entry.WithTime(record.Time)
+	}
+	entry.Log(logrusLevel(record.Level), record.Message)
+	return nil
+}
+
+// WithAttrs returns a handler adding attrs to every record.
+func (h *LogrusHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := make(logrus.Fields, len(h.fields)+len(attrs))
+	for key, value := range h.fields {
+		fields[key] = value
+	}
+	for _, attr := range attrs {
+		addField(fields, h.group, attr)
+	}
+	return &LogrusHandler{log: h.log, fields: fields, group: h.group}
+}
+
+// WithGroup returns a handler qualifying later attributes with name.
+func (h *LogrusHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &LogrusHandler{log: h.log, fields: h.fields, group: joinKey(h.group, name)}
+}
+
+func addField(fields logrus.Fields, group string, attr slog.Attr) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		prefix := group
+		if attr.Key != "" {
+			prefix = joinKey(group, attr.Key)
+		}
+		for _, member := range value.Group() {
+			addField(fields, prefix, member)
+		}
+		return
+	}
+	if attr.Key == "" {
+		return
+	}
+	fields[joinKey(group, attr.Key)] = value.Any()
+}
+
+func joinKey(group, key string) string {
+	if group == "" {
+		return key
+	}
+	return group + "." + key
+}
+
+// logrusLevel maps a slog level to the closest logrus level.
+func logrusLevel(level slog.Level) logrus.Level {
+	switch {
+	case level >= slog.LevelError:
+		return logrus.ErrorLevel
+	case level >= slog.LevelWarn:
+		return logrus.WarnLevel
+	case level >= slog.LevelInfo:
+		return logrus.InfoLevel
+	case level >= slog.LevelDebug:
+		return logrus.DebugLevel
+	default:
+		return logrus.TraceLevel
+	}
+}
+
+// ToLogrus returns a *logrus.Logger writing to log, for dependencies that take one.
+// Its level is the lowest that log has enabled when ToLogrus is called.
+func ToLogrus(log *slog.Logger) *logrus.Logger {
+	log = OrDefault(log)
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	logger.SetLevel(logrus.ErrorLevel)
+	for _, level := range []logrus.Level{logrus.TraceLevel, logrus.DebugLevel, logrus.InfoLevel, logrus.WarnLevel} {
+		if log.Enabled(context.Background(), slogLevel(level)) {
+			logger.SetLevel(level)
+			break
+		}
+	}
+	logger.AddHook(slogHook{log: log})
+	return logger
+}
+
+// slogHook passes logrus entries on to a *slog.Logger.
+type slogHook struct {
+	log *slog.Logger
+}
+
+func (h slogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h slogHook) Fire(entry *logrus.Entry) error {
+	ctx := entry.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	level := slogLevel(entry.Level)
+	if !h.log.Enabled(ctx, level) {
+		return nil
+	}
+	keys := make([]string, 0, len(entry.Data))
+	for key := range entry.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.Any(key, entry.Data[key]))
+	}
+	h.log.LogAttrs(ctx, level, entry.Message, attrs...)
+	return nil
+}
+
+// slogLevel maps a logrus level to the closest slog level.
+func slogLevel(level logrus.Level) slog.Level {
+	switch level {
+	case logrus.TraceLevel:
+		return slog.LevelDebug - 4
+	case logrus.DebugLevel:
+		return slog.LevelDebug
+	case logrus.InfoLevel:
+		return slog.LevelInfo
+	case logrus.WarnLevel:
+		return slog.LevelWarn
+	default:
+		return slog.LevelError
+	}
+}
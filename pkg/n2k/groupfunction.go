@@ -0,0 +1,30 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2k
+
+import (
+	internalpgn "github.com/boatkit-io/n2k/internal/pgn"
+)
+
+// DecodeGroupFunctionValue converts the Value bytes of an NMEA group-function parameter
+// (PGN 126208 Repeating1/Repeating2 entries) into the type of the field it refers to,
+// identified by the referenced PGN and one-based field order. Scaled numbers decode to
+// float64, integers to int64 or uint64, lookups to uint64, strings to string, and other
+// fields to []uint8. A numeric field marked not available decodes to nil.
+func DecodeGroupFunctionValue(referencedPGN uint32, fieldOrder uint8, value []uint8) (any, error) {
+	return internalpgn.DecodeGroupFunctionValue(referencedPGN, fieldOrder, value)
+}
+
+// EncodeGroupFunctionValue converts a value into the Value bytes of an NMEA group-function
+// parameter that refers to the given PGN field, so that request, command, and write-fields
+// group functions can be built for any PGN. Numeric fields accept any Go integer or float
+// type, including lookup constants, or nil for not available; string fields accept a
+// string; other fields accept []uint8.
+func EncodeGroupFunctionValue(referencedPGN uint32, fieldOrder uint8, value any) ([]uint8, error) {
+	return internalpgn.EncodeGroupFunctionValue(referencedPGN, fieldOrder, value)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2k
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	internalpgn "github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// PGNRegistration describes an application-owned PGN type, such as an internal
+// proprietary PGN that will never be part of canboat.
+type PGNRegistration[T any] struct {
+	// PGN is the parameter group number carried in the CAN ID.
+	PGN uint32
+
+	// ManufacturerCode and IndustryCode select which payloads of PGN decode into T.
+	// Proprietary PGNs must set ManufacturerCode; nil matches any value.
+	ManufacturerCode *pgn.ManufacturerCodeConst
+	IndustryCode     *pgn.IndustryCodeConst
+
+	// FastPacket marks the PGN as a fast-packet PGN. PGNs already known to the
+	// generated tables must keep their generated setting.
+	FastPacket bool
+
+	// Decode converts a complete payload, including any proprietary header, into T.
+	// Returning an error delivers the message as pgn.UnknownPGN. data is Decode's own
+	// copy, so T may keep it.
+	Decode func(info pgn.MessageInfo, data []uint8) (T, error)
+
+	// Encode converts T into its message info and complete payload. A zero PGN in
+	// the returned info is replaced by the registered PGN.
+	Encode func(msg T) (pgn.MessageInfo, []uint8, error)
+}
+
+// RegisterPGN registers T so that every N2kService decodes, dispatches, and writes it
+// exactly like a generated PGN type. Registered decoders take precedence over the
+// generated manufacturer-proprietary decoders for the same PGN. T must be a struct type.
+func RegisterPGN[T any](reg PGNRegistration[T]) error {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("RegisterPGN requires a struct type, got %s", t)
+	}
+	if reg.Decode == nil || reg.Encode == nil {
+		return fmt.Errorf("RegisterPGN for %s requires both Decode and Encode", t)
+	}
+
+	return internalpgn.RegisterPGN(internalpgn.RegisteredPGN{
+		PGN:              reg.PGN,
+		Type:             t,
+		ManufacturerCode: reg.ManufacturerCode,
+		IndustryCode:     reg.IndustryCode,
+		Fast:             reg.FastPacket,
+		Decode: func(info pgn.MessageInfo, data []uint8) (any, error) {
+			msg, err := reg.Decode(info, data)
+			if err != nil {
+				return nil, err
+			}
+			return msg, nil
+		},
+		Encode: func(v any) (*pgn.MessageInfo, []uint8, error) {
+			var msg T
+			switch m := v.(type) {
+			case T:
+				msg = m
+			case *T:
+				if m == nil {
+					return nil, nil, errors.New("cannot encode nil registered PGN struct")
+				}
+				msg = *m
+			default:
+				return nil, nil, fmt.Errorf("registered encoder for %s received %T", t, v)
+			}
+			info, data, err := reg.Encode(msg)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &info, data, nil
+		},
+	})
+}
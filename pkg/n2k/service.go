@@ -11,12 +11,15 @@ package n2k
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/boatkit-io/n2k/internal/n2kinternal"
+	"github.com/boatkit-io/n2k/pkg/clock"
 	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/pgn"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 )
 
 // DefaultMessageQueueMaxAge is the default maximum live CAN message lag allowed
@@ -26,6 +29,28 @@ const DefaultMessageQueueMaxAge = n2kinternal.DefaultMessageQueueMaxAge
 type serviceOptions struct {
 	messageQueueMaxAge    time.Duration
 	hasMessageQueueMaxAge bool
+	strictWrites          bool
+	partialDecodes        bool
+	retainPayloads        bool
+	latestCacheSize       int
+	streamTracking        bool
+	streamStaleFactor     float64
+	streamIntervals       map[uint32]time.Duration
+	interceptors          []n2kinternal.ServiceOption
+	busStatistics         bool
+	busBitrate            int
+	busWindow             time.Duration
+	decodeWorkers         int
+	clock                 clock.Clock
+	transmitRate          float64
+	transmitBurst         int
+	pgnTransmitLimits     map[uint32]transmitLimit
+	writePolicy           *WritePolicy
+}
+
+type transmitLimit struct {
+	framesPerSecond float64
+	burst           int
 }
 
 // ServiceOption configures an N2K service.
@@ -40,6 +65,259 @@ func WithMessageQueueMaxAge(maxAge time.Duration) ServiceOption {
 	}
 }
 
+// WithStrictWrites makes Write validate each PGN struct with Validate and return a
+// *pgn.ValidationError instead of clamping or truncating out-of-range fields.
+func WithStrictWrites() ServiceOption {
+	return func(options *serviceOptions) {
+		options.strictWrites = true
+	}
+}
+
+// WithPartialDecodes delivers payloads that end or fail partway through a PGN, such as
+// those from older firmware that omits trailing fields, as partially decoded structs.
+// Fields after the failure are left nil and Info.DecodeWarnings() describes the failure.
+// Without this option such payloads are delivered as pgn.UnknownPGN.
+func WithPartialDecodes() ServiceOption {
+	return func(options *serviceOptions) {
+		options.partialDecodes = true
+	}
+}
+
+// WithRetainedPayloads keeps a copy of each decoded struct's original payload in
+// Info.Details. Writing such a struct reproduces the original bytes exactly when no
+// field changed, and preserves reserved bits and trailing bytes when some did, which
+// suits bridges and proxies that forward traffic. Clear Info.Details to encode from the
+// field values alone.
+func WithRetainedPayloads() ServiceOption {
+	return func(options *serviceOptions) {
+		options.retainPayloads = true
+	}
+}
+
+// WithLatestCache keeps the most recent message for each message type, source address
+// and instance, up to maxEntries entries, so that Latest and LatestValues can answer
+// queries such as "the current depth" without a subscription. When the cache is full
+// the entry updated least recently is evicted.
+func WithLatestCache(maxEntries int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.latestCacheSize = maxEntries
+	}
+}
+
+// WithStreamTracking tracks every stream of messages of one PGN from one source address
+// and instance. The expected interval of each stream is learned from the messages that
+// arrive, and a stream is reported stale when no message arrives for staleFactor
+// intervals. A staleFactor of zero uses DefaultStreamStaleFactor. Stale streams are
+// detected while the service is running.
+func WithStreamTracking(staleFactor float64) ServiceOption {
+	return func(options *serviceOptions) {
+		options.streamTracking = true
+		options.streamStaleFactor = staleFactor
+	}
+}
+
+// WithStreamInterval sets the expected interval of a PGN instead of learning it, so its
+// streams can go stale from the first message. It enables stream tracking.
+func WithStreamInterval(pgnNumber uint32, interval time.Duration) ServiceOption {
+	return func(options *serviceOptions) {
+		options.streamTracking = true
+		if options.streamIntervals == nil {
+			options.streamIntervals = make(map[uint32]time.Duration)
+		}
+		options.streamIntervals[pgnNumber] = interval
+	}
+}
+
+const (
+	// DefaultBusBitrate is the NMEA 2000 bitrate in bits per second.
+	DefaultBusBitrate = n2kinternal.DefaultBusBitrate
+	// DefaultBusStatisticsWindow is the period over which bus statistics are averaged.
+	DefaultBusStatisticsWindow = n2kinternal.DefaultBusStatisticsWindow
+)
+
+// WithBusStatistics measures bus utilization and the traffic of each source address and
+// PGN, for a bus running at bitrate bits per second, averaged over window. Zero values
+// use DefaultBusBitrate and DefaultBusStatisticsWindow. See N2kService.BusStatistics.
+func WithBusStatistics(bitrate int, window time.Duration) ServiceOption {
+	return func(options *serviceOptions) {
+		options.busStatistics = true
+		options.busBitrate = bitrate
+		options.busWindow = window
+	}
+}
+
+// WithDecodeWorkers reassembles and decodes received frames on workers goroutines
+// instead of one, for busy buses on slow processors. Frames are assigned to a worker by
+// bus and source address, so the messages of each source are still delivered in order,
+// but subscriber callbacks and inbound interceptors may run concurrently for different
+// sources. Each worker has its own queue; MessageQueueLag reports the slowest, and only
+// the sources of a worker that is behind are dropped.
+func WithDecodeWorkers(workers int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.decodeWorkers = workers
+	}
+}
+
+// WithClock times the service by c instead of the wall clock: message timestamps, queue
+// ages, metrics, statistics, stream tracking, scheduled PGNs and subscription rate
+// limits. Endpoints implementing endpoint.ClockSetter, such as the file replay
+// endpoints, are given c too, so a *clock.Fake drives a whole replay deterministically
+// at whatever speed it is advanced.
+func WithClock(c clock.Clock) ServiceOption {
+	return func(options *serviceOptions) {
+		options.clock = c
+	}
+}
+
+// WithTransmitRateLimit limits the frames written to the endpoint to framesPerSecond,
+// allowing bursts of up to burst frames. Every write passes through a transmit queue that
+// sends messages in order of CAN priority, lowest number first, and never interleaves the
+// frames of two fast packets; messages held back by a limit wait there. A Write returns
+// once its message is queued, while WriteContext waits for it to be sent.
+func WithTransmitRateLimit(framesPerSecond float64, burst int) ServiceOption {
+	return func(options *serviceOptions) {
+		options.transmitRate = framesPerSecond
+		options.transmitBurst = burst
+	}
+}
+
+// WithPGNTransmitRateLimit limits the frames written for one PGN to framesPerSecond,
+// allowing bursts of up to burst frames, such as to keep a burst of PGN List responses
+// from crowding the bus. Messages of other PGNs are sent past those it holds back.
+func WithPGNTransmitRateLimit(pgnNumber uint32, framesPerSecond float64, burst int) ServiceOption {
+	return func(options *serviceOptions) {
+		if options.pgnTransmitLimits == nil {
+			options.pgnTransmitLimits = make(map[uint32]transmitLimit)
+		}
+		options.pgnTransmitLimits[pgnNumber] = transmitLimit{framesPerSecond: framesPerSecond, burst: burst}
+	}
+}
+
+// WithWritePolicy restricts the writes of control and safety-critical PGNs, such as
+// switch bank and autopilot commands, to those policy allows. Each of them is audited,
+// and a denied write returns a *WriteDeniedError.
+func WithWritePolicy(policy WritePolicy) ServiceOption {
+	return func(options *serviceOptions) {
+		options.writePolicy = &policy
+	}
+}
+
+// WriteClass says how much harm writing a PGN can do.
+type WriteClass = n2kinternal.WriteClass
+
+const (
+	// WriteInformational PGNs report state and are always written.
+	WriteInformational = n2kinternal.WriteInformational
+	// WriteControl PGNs operate equipment, such as switching circuits or lights.
+	WriteControl = n2kinternal.WriteControl
+	// WriteSafetyCritical PGNs steer, propel or anchor the boat, such as autopilot,
+	// thruster and windlass commands.
+	WriteSafetyCritical = n2kinternal.WriteSafetyCritical
+)
+
+// WritePolicy restricts the writes of control and safety-critical PGNs. Informational
+// PGNs are always written. A control write needs a WriteRule allowing it, and a
+// safety-critical write a rule that also sets SafetyCritical. DryRun writes everything
+// while auditing what would be denied.
+type WritePolicy = n2kinternal.WritePolicy
+
+// WriteRule allows writes by PGN, destination and caller tag.
+type WriteRule = n2kinternal.WriteRule
+
+// WriteAudit records a control or safety-critical write, allowed or denied.
+type WriteAudit = n2kinternal.WriteAudit
+
+// WriteDeniedError reports a write refused by a WritePolicy.
+type WriteDeniedError = n2kinternal.WriteDeniedError
+
+// WriteGuard applies a WritePolicy outside of a service.
+type WriteGuard = n2kinternal.WriteGuard
+
+// NewWriteGuard returns a guard applying policy, logging to log when the policy has no
+// Audit function and timing audit entries by clk. nil uses slog.Default() and the wall
+// clock.
+func NewWriteGuard(policy WritePolicy, log *slog.Logger, clk clock.Clock) *WriteGuard {
+	return n2kinternal.NewWriteGuard(policy, log, clk)
+}
+
+// ClassifyWrite returns the class a WritePolicy gives a PGN struct unless its Classes
+// override it.
+func ClassifyWrite(pgnStruct any) WriteClass {
+	return n2kinternal.ClassifyWrite(pgnStruct)
+}
+
+// ClassifyPGN returns the highest class of the struct types of a PGN, for traffic that
+// has not been decoded.
+func ClassifyPGN(pgnNumber uint32) WriteClass {
+	return n2kinternal.ClassifyPGN(pgnNumber)
+}
+
+// WithCallerTag returns a context naming the caller writing with it, for WriteRule.Tags
+// and the audit log. Use it with WriteTagged, WriteContext or WriteTo; Write is untagged.
+func WithCallerTag(ctx context.Context, tag string) context.Context {
+	return n2kinternal.WithCallerTag(ctx, tag)
+}
+
+// MarkWriteAudited returns a context noting that a write has already been authorized and
+// audited by another guard, such as a node's. The service's policy still refuses the
+// writes it denies, but does not audit the ones it allows a second time.
+func MarkWriteAudited(ctx context.Context) context.Context {
+	return n2kinternal.MarkWriteAudited(ctx)
+}
+
+// Direction tells an interceptor whether a message is being received or sent.
+type Direction = n2kinternal.Direction
+
+const (
+	// Inbound messages were received from the endpoint or replayed.
+	Inbound = n2kinternal.Inbound
+	// Outbound messages are being written to the endpoint.
+	Outbound = n2kinternal.Outbound
+)
+
+// Packet is a complete PGN payload with its message info. Inbound fast packets have
+// been reassembled, and outbound ones are not yet split into frames.
+type Packet = n2kinternal.Packet
+
+// FrameInterceptor intercepts CAN frames. It passes a frame on by calling next, after
+// modifying it if needed, drops it by not calling next, and injects frames by calling
+// next again. Outbound, ctx is the writer's context and next returns the endpoint's
+// error, which the interceptor should return.
+type FrameInterceptor = n2kinternal.FrameInterceptor
+
+// PacketInterceptor intercepts complete PGN payloads. See FrameInterceptor.
+type PacketInterceptor = n2kinternal.PacketInterceptor
+
+// StructInterceptor intercepts decoded PGN structs, such as to redact fields before
+// subscribers see them. Outbound structs are intercepted before they are validated and
+// encoded. See FrameInterceptor.
+type StructInterceptor = n2kinternal.StructInterceptor
+
+// WithFrameInterceptor adds a CAN frame interceptor. Interceptors at each level run in
+// the order they are added, for both received and written messages. Received messages
+// pass the frame, packet and struct levels in that order, and written messages the
+// reverse. Inbound interceptors run on the service's processing goroutine, or its decode
+// workers; errors they return are logged.
+func WithFrameInterceptor(interceptor FrameInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors = append(options.interceptors, n2kinternal.WithFrameInterceptor(interceptor))
+	}
+}
+
+// WithPacketInterceptor adds a PGN payload interceptor. See WithFrameInterceptor.
+func WithPacketInterceptor(interceptor PacketInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors = append(options.interceptors, n2kinternal.WithPacketInterceptor(interceptor))
+	}
+}
+
+// WithStructInterceptor adds a decoded struct interceptor. See WithFrameInterceptor.
+func WithStructInterceptor(interceptor StructInterceptor) ServiceOption {
+	return func(options *serviceOptions) {
+		options.interceptors = append(options.interceptors, n2kinternal.WithStructInterceptor(interceptor))
+	}
+}
+
 // N2kService provides the main public API for NMEA 2000 operations
 type N2kService struct {
 	impl *n2kinternal.N2kService
@@ -51,8 +329,9 @@ type RunHandle struct {
 	impl *n2kinternal.RunHandle
 }
 
-// NewN2kService creates a new N2K service with the specified endpoint
-func NewN2kService(ep endpoint.Endpoint, log *logrus.Logger, opts ...ServiceOption) *N2kService {
+// NewN2kService creates a new N2K service with the specified endpoint. It logs to log,
+// or slog.Default() when log is nil; logging.FromLogrus adapts a *logrus.Logger.
+func NewN2kService(ep endpoint.Endpoint, log *slog.Logger, opts ...ServiceOption) *N2kService {
 	options := serviceOptions{}
 	for _, opt := range opts {
 		opt(&options)
@@ -61,6 +340,43 @@ func NewN2kService(ep endpoint.Endpoint, log *logrus.Logger, opts ...ServiceOpti
 	if options.hasMessageQueueMaxAge {
 		internalOptions = append(internalOptions, n2kinternal.WithMessageQueueMaxAge(options.messageQueueMaxAge))
 	}
+	if options.strictWrites {
+		internalOptions = append(internalOptions, n2kinternal.WithStrictWrites())
+	}
+	if options.partialDecodes {
+		internalOptions = append(internalOptions, n2kinternal.WithPartialDecodes())
+	}
+	if options.retainPayloads {
+		internalOptions = append(internalOptions, n2kinternal.WithRetainedPayloads())
+	}
+	if options.latestCacheSize > 0 {
+		internalOptions = append(internalOptions, n2kinternal.WithLatestCache(options.latestCacheSize))
+	}
+	if options.streamTracking {
+		internalOptions = append(internalOptions, n2kinternal.WithStreamTracking(options.streamStaleFactor))
+		for pgnNumber, interval := range options.streamIntervals {
+			internalOptions = append(internalOptions, n2kinternal.WithStreamInterval(pgnNumber, interval))
+		}
+	}
+	if options.busStatistics {
+		internalOptions = append(internalOptions, n2kinternal.WithBusStatistics(options.busBitrate, options.busWindow))
+	}
+	if options.decodeWorkers > 1 {
+		internalOptions = append(internalOptions, n2kinternal.WithDecodeWorkers(options.decodeWorkers))
+	}
+	if options.clock != nil {
+		internalOptions = append(internalOptions, n2kinternal.WithClock(options.clock))
+	}
+	if options.transmitRate > 0 {
+		internalOptions = append(internalOptions, n2kinternal.WithTransmitRateLimit(options.transmitRate, options.transmitBurst))
+	}
+	for pgnNumber, limit := range options.pgnTransmitLimits {
+		internalOptions = append(internalOptions, n2kinternal.WithPGNTransmitRateLimit(pgnNumber, limit.framesPerSecond, limit.burst))
+	}
+	if options.writePolicy != nil {
+		internalOptions = append(internalOptions, n2kinternal.WithWritePolicy(*options.writePolicy))
+	}
+	internalOptions = append(internalOptions, options.interceptors...)
 
 	return &N2kService{
 		impl: n2kinternal.NewN2kService(ep, log, internalOptions...),
@@ -72,6 +388,54 @@ func (s *N2kService) Write(pgnStruct any) error {
 	return s.impl.Write(pgnStruct)
 }
 
+// WriteTagged sends a PGN struct to the bus like Write, without waiting for the endpoint,
+// but is checked against the write policy as the caller of ctx, such as one tagged with
+// WithCallerTag. ctx does not bound the write.
+func (s *N2kService) WriteTagged(ctx context.Context, pgnStruct any) error {
+	return s.impl.WriteTagged(ctx, pgnStruct)
+}
+
+// WriteContext sends a PGN struct to the bus and waits until the endpoint has sent it or
+// ctx is done, returning any write error. Endpoints report a full transmit buffer as
+// endpoint.ErrTxBufferFull and a closed endpoint as endpoint.ErrClosed; a fast packet
+// that was only partly sent returns an *endpoint.PartialWriteError. Read-only endpoints,
+// such as file replay endpoints, return endpoint.ErrWriteNotSupported.
+func (s *N2kService) WriteContext(ctx context.Context, pgnStruct any) error {
+	return s.impl.WriteContext(ctx, pgnStruct)
+}
+
+// WriteTo is WriteContext for a multiendpoint.MultiEndpoint, sending the PGN struct only
+// to the named buses, or to every bus when none are named. Naming a bus the endpoint does
+// not have returns an error. Write and WriteContext send to every bus.
+func (s *N2kService) WriteTo(ctx context.Context, pgnStruct any, buses ...string) error {
+	return s.impl.WriteTo(ctx, pgnStruct, buses...)
+}
+
+// DefaultRequestTimeout bounds a request whose context has no deadline. Global requests
+// collect responses for this long.
+const DefaultRequestTimeout = n2kinternal.DefaultRequestTimeout
+
+// RequestError reports a request that the destination rejected with an ISO
+// Acknowledgement, such as a NAK for a PGN it does not support.
+type RequestError = n2kinternal.RequestError
+
+// Request sends an ISO Request (PGN 59904) for the PGN requested to destination and
+// returns the decoded response structs. An addressed request returns the first response
+// from destination, or a *RequestError when destination rejects the request. A request to
+// the global address 255 collects responses from every device until ctx's deadline, or
+// DefaultRequestTimeout when ctx has none, and returns what it collected.
+//
+//	responses, err := svc.Request(ctx, pgn.ProductInformationPGN, 0x23)
+func (s *N2kService) Request(ctx context.Context, requested uint32, destination uint8) ([]any, error) {
+	return s.impl.Request(ctx, requested, destination)
+}
+
+// SendRequest is Request for a caller-built ISO Request, such as one with a source
+// address set. Its destination is request.Info.TargetId.
+func (s *N2kService) SendRequest(ctx context.Context, request *pgn.ISORequest) ([]any, error) {
+	return s.impl.SendRequest(ctx, request)
+}
+
 // Start begins processing messages from the endpoint
 func (s *N2kService) Start(ctx context.Context) error {
 	return s.impl.Start(ctx)
@@ -124,22 +488,396 @@ func (s *N2kService) Unsubscribe(id uint) error {
 	return s.impl.Unsubscribe(id)
 }
 
+// Subscription is a subscription created by Subscribe.
+type Subscription struct {
+	// ID identifies the subscription and can be passed to N2kService.Unsubscribe.
+	ID uint
+
+	svc *N2kService
+}
+
+// Unsubscribe cancels the subscription.
+func (sub Subscription) Unsubscribe() error {
+	if sub.svc == nil {
+		return errors.New("subscription has no service")
+	}
+	return sub.svc.Unsubscribe(sub.ID)
+}
+
+type subscribeOptions struct {
+	buses        []string
+	sources      []uint8
+	names        []uint64
+	destinations []uint8
+	instances    []uint8
+
+	queueSize int
+	overflow  OverflowPolicy
+
+	minInterval time.Duration
+	keepLatest  bool
+	deadbands   []deadband
+}
+
+type deadband struct {
+	field string
+	delta float64
+}
+
+// OverflowPolicy selects what an asynchronous subscription does with a new message
+// when its queue is full.
+type OverflowPolicy = n2kinternal.OverflowPolicy
+
+const (
+	// DropOldest discards the oldest queued message to make room for the new one.
+	DropOldest = n2kinternal.DropOldest
+	// DropNewest discards the new message.
+	DropNewest = n2kinternal.DropNewest
+	// CoalesceLatest replaces a queued message from the same source address and
+	// instance with the new one, so the callback always sees the latest value per
+	// device and instance. Messages with a new source and instance drop the oldest
+	// queued message when the queue is full.
+	CoalesceLatest = n2kinternal.CoalesceLatest
+)
+
+// SubscribeOption filters the messages delivered to a subscription created by
+// Subscribe. Different options must all match; the values given to one option are
+// alternatives.
+type SubscribeOption func(*subscribeOptions)
+
+// FromBus delivers only messages received on one of the named buses of a
+// multiendpoint.MultiEndpoint. See pgn.MessageInfo.Bus.
+func FromBus(buses ...string) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.buses = append(options.buses, buses...)
+	}
+}
+
+// FromSource delivers only messages sent from one of the given source addresses.
+func FromSource(addresses ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.sources = append(options.sources, addresses...)
+	}
+}
+
+// FromDevice delivers only messages sent by one of the devices with the given 64-bit
+// NAMEs. The service resolves source addresses from the address claims it observes, so
+// the subscription follows a device when it claims a new address. Messages from a
+// device are not delivered until its address claim has been seen.
+func FromDevice(names ...uint64) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.names = append(options.names, names...)
+	}
+}
+
+// ToDestination delivers only messages addressed to one of the given destination
+// addresses. Broadcast messages have destination 255.
+func ToDestination(addresses ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.destinations = append(options.destinations, addresses...)
+	}
+}
+
+// ForInstance delivers only messages whose instance field, such as the engine, battery
+// or tank instance, is one of the given values. Messages without an instance field, or
+// with the instance not available, are not delivered.
+func ForInstance(instances ...uint8) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.instances = append(options.instances, instances...)
+	}
+}
+
+// WithAsyncDelivery makes a subscription asynchronous. Messages are queued, up to
+// queueSize of them, and the callback runs on a goroutine owned by the subscription, so
+// a slow callback does not delay other subscribers or the message processor. policy
+// selects what happens when the queue is full. Queue lag and drops are reported with
+// the service's processing metrics.
+func WithAsyncDelivery(queueSize int, policy OverflowPolicy) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.queueSize = queueSize
+		options.overflow = policy
+	}
+}
+
+// WithMinInterval delivers at most one message per interval for each source address and
+// instance, so a 10 Hz PGN can be consumed at 1 Hz. Messages arriving sooner are
+// dropped unless WithLatestValue is also set.
+func WithMinInterval(interval time.Duration) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.minInterval = interval
+	}
+}
+
+// WithLatestValue makes WithMinInterval keep the latest message that arrived during an
+// interval and deliver it when the interval ends, so the final value is never lost. For
+// subscriptions without WithAsyncDelivery such messages are delivered from a timer
+// goroutine; calls for one subscription never overlap.
+func WithLatestValue() SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.keepLatest = true
+	}
+}
+
+// WithDeadband delivers a message only when the named numeric field, such as "Heading",
+// differs by at least delta from the last message delivered for the same source address
+// and instance, or becomes available or unavailable. With several deadbands a change in
+// any of the fields is delivered. Fields holding units are compared in the unit NMEA
+// 2000 encodes them in, such as meters, kelvin or pascals. Subscribe returns an error
+// when the field does not exist or is not numeric.
+func WithDeadband(field string, delta float64) SubscribeOption {
+	return func(options *subscribeOptions) {
+		options.deadbands = append(options.deadbands, deadband{field: field, delta: delta})
+	}
+}
+
+// Subscribe subscribes to PGN struct type T, such as pgn.VesselHeading, and calls the
+// callback with each decoded message of that type. Unlike SubscribeToStruct the callback
+// type is checked at compile time and dispatch uses a generated table instead of
+// reflection. Both kinds of subscription can be used together. Options filter the
+// messages before the callback is called.
+func Subscribe[T any](svc *N2kService, callback func(T), opts ...SubscribeOption) (Subscription, error) {
+	options := subscribeOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	sub, err := n2kinternal.Subscribe(svc.impl, callback, options.internal()...)
+	if err != nil {
+		return Subscription{}, err
+	}
+	return Subscription{ID: uint(sub.ID), svc: svc}, nil
+}
+
+// internal maps the options to the internal service's subscribe options.
+func (options *subscribeOptions) internal() []n2kinternal.SubscribeOption {
+	internalOptions := []n2kinternal.SubscribeOption{}
+	if len(options.buses) > 0 {
+		internalOptions = append(internalOptions, n2kinternal.FromBus(options.buses...))
+	}
+	if len(options.sources) > 0 {
+		internalOptions = append(internalOptions, n2kinternal.FromSource(options.sources...))
+	}
+	if len(options.names) > 0 {
+		internalOptions = append(internalOptions, n2kinternal.FromDevice(options.names...))
+	}
+	if len(options.destinations) > 0 {
+		internalOptions = append(internalOptions, n2kinternal.ToDestination(options.destinations...))
+	}
+	if len(options.instances) > 0 {
+		internalOptions = append(internalOptions, n2kinternal.ForInstance(options.instances...))
+	}
+	if options.queueSize != 0 {
+		internalOptions = append(internalOptions, n2kinternal.WithAsyncDelivery(options.queueSize, options.overflow))
+	}
+	if options.minInterval != 0 {
+		internalOptions = append(internalOptions, n2kinternal.WithMinInterval(options.minInterval))
+	}
+	if options.keepLatest {
+		internalOptions = append(internalOptions, n2kinternal.WithLatestValue())
+	}
+	for _, band := range options.deadbands {
+		internalOptions = append(internalOptions, n2kinternal.WithDeadband(band.field, band.delta))
+	}
+	return internalOptions
+}
+
+// Latest returns the most recently received message of type T that matches the filter
+// options, such as FromSource or ForInstance, and when it was received. ok is false when
+// no cached message matches or the service was created without WithLatestCache. Options
+// other than filters are ignored.
+func Latest[T any](svc *N2kService, filters ...SubscribeOption) (value T, received time.Time, ok bool) {
+	options := subscribeOptions{}
+	for _, opt := range filters {
+		opt(&options)
+	}
+	return n2kinternal.Latest[T](svc.impl, options.internal()...)
+}
+
+// LatestValue is one entry of the latest-value cache.
+type LatestValue = n2kinternal.LatestValue
+
+// LatestValues returns a snapshot of every message in the latest-value cache, ordered
+// by type name, source address and instance. It returns nil when the service was created
+// without WithLatestCache.
+func (s *N2kService) LatestValues() []LatestValue {
+	return s.impl.LatestValues()
+}
+
+// Scheduler writes registered PGNs periodically, staggering them so that PGNs
+// registered together do not go out in one burst.
+type Scheduler = n2kinternal.Scheduler
+
+// ScheduledPGN is one PGN registered with a Scheduler. Trigger sends it immediately,
+// such as when its value changes; SetInterval and Stop change or remove the schedule.
+type ScheduledPGN = n2kinternal.ScheduledPGN
+
+// NewScheduler creates a running scheduler that sends with write and logs failed writes
+// to log. Most applications use N2kService.Schedule or node.Node.Schedule instead.
+func NewScheduler(write func(any) error, log *slog.Logger) *Scheduler {
+	return n2kinternal.NewScheduler(write, log)
+}
+
+// Schedule writes the struct returned by produce every interval while the service is
+// running, replacing a hand-written ticker per PGN. produce returning nil skips a
+// transmission. The struct is written as returned, so it must carry its own source
+// address; node.Node.Schedule fills in the node's claimed address instead.
+//
+//	heading, err := svc.Schedule(pgn.VesselHeadingPGN, 100*time.Millisecond, func() any {
+//	    return &pgn.VesselHeading{Heading: sensor.Heading()}
+//	})
+func (s *N2kService) Schedule(pgnNumber uint32, interval time.Duration, produce func() any) (*ScheduledPGN, error) {
+	return s.impl.Schedule(pgnNumber, interval, produce)
+}
+
+// DefaultStreamStaleFactor is the number of expected intervals a stream may go without a
+// message before it is reported stale.
+const DefaultStreamStaleFactor = n2kinternal.DefaultStreamStaleFactor
+
+// StreamKey identifies a stream of one PGN from one source address and instance.
+type StreamKey = n2kinternal.StreamKey
+
+// StreamStatus describes a tracked stream.
+type StreamStatus = n2kinternal.StreamStatus
+
+// StreamEvent reports a stream going stale, resuming, or expiring.
+type StreamEvent = n2kinternal.StreamEvent
+
+// StreamEventKind identifies a stream event.
+type StreamEventKind = n2kinternal.StreamEventKind
+
+const (
+	// StreamStale reports a stream that stopped arriving.
+	StreamStale = n2kinternal.StreamStale
+	// StreamResumed reports a stale stream that started arriving again.
+	StreamResumed = n2kinternal.StreamResumed
+	// StreamExpired reports a stale stream that has been silent long enough to be
+	// forgotten. If it starts arriving again it is tracked as a new stream.
+	StreamExpired = n2kinternal.StreamExpired
+)
+
+// SubscribeToStreamEvents calls callback when a tracked stream goes stale, resumes, or
+// expires. Stale and expired events are delivered from the service's staleness checker
+// and resumed events from the message processor; calls never overlap. It fails when the
+// service was created without WithStreamTracking or WithStreamInterval.
+func (s *N2kService) SubscribeToStreamEvents(callback func(StreamEvent)) (uint, error) {
+	return s.impl.SubscribeToStreamEvents(callback)
+}
+
+// UnsubscribeStreamEvents removes a stream event subscription by its ID.
+func (s *N2kService) UnsubscribeStreamEvents(id uint) error {
+	return s.impl.UnsubscribeStreamEvents(id)
+}
+
+// Streams returns a snapshot of every tracked stream, ordered by PGN, source address and
+// instance. It returns nil when the service was created without stream tracking.
+func (s *N2kService) Streams() []StreamStatus {
+	return s.impl.Streams()
+}
+
 // SetReceivedCANFrameHook registers a callback invoked for each live CAN frame before decode.
+//
+// Deprecated: Use WithFrameInterceptor, which can also modify, drop and inject frames.
 func (s *N2kService) SetReceivedCANFrameHook(fn func(*can.Frame)) {
 	s.impl.SetReceivedCANFrameHook(fn)
 }
 
+// BusStatistics describes bus load over the statistics window, for the whole bus and for
+// each source address and PGN.
+type BusStatistics = n2kinternal.BusStatistics
+
+// BusTraffic is the frame rate, data rate and share of the bitrate of some part of the
+// bus.
+type BusTraffic = n2kinternal.BusTraffic
+
+// SourceTraffic is the traffic from one source address, with its decode error and fast
+// packet reassembly failure rates.
+type SourceTraffic = n2kinternal.SourceTraffic
+
+// PGNTraffic is the traffic of one PGN.
+type PGNTraffic = n2kinternal.PGNTraffic
+
+// BusStatistics returns bus utilization and per-device traffic. Utilization counts the
+// bits of each received and written frame on the wire, including frame overhead and stuff
+// bits. With a multiendpoint.MultiEndpoint the traffic of every bus is added together;
+// use BusStatisticsFor for one bus. ok is false when the service was created without
+// WithBusStatistics.
+func (s *N2kService) BusStatistics() (stats BusStatistics, ok bool) {
+	return s.impl.BusStatistics()
+}
+
+// BusStatisticsFor returns the utilization and per-device traffic of one bus of a
+// multiendpoint.MultiEndpoint. ok is false when the service was created without
+// WithBusStatistics or no traffic has been seen on the bus.
+func (s *N2kService) BusStatisticsFor(bus string) (stats BusStatistics, ok bool) {
+	return s.impl.BusStatisticsFor(bus)
+}
+
+// Metrics is a snapshot of a service's counters since it was created, and of its
+// queues. WritePrometheus formats it for scraping.
+type Metrics = n2kinternal.Metrics
+
+// PGNMetrics counts the frames, payloads and structs of one PGN.
+type PGNMetrics = n2kinternal.PGNMetrics
+
+// CallbackMetrics describes the calls to one subscriber callback.
+type CallbackMetrics = n2kinternal.CallbackMetrics
+
+// DropReason identifies why received messages were dropped.
+type DropReason = n2kinternal.DropReason
+
+const (
+	// DropBacklog counts frames rejected because the handler queue was too far behind.
+	DropBacklog = n2kinternal.DropBacklog
+	// DropStale counts queued frames discarded because they waited too long.
+	DropStale = n2kinternal.DropStale
+	// DropSubscriberOverflow counts structs discarded by asynchronous subscribers.
+	DropSubscriberOverflow = n2kinternal.DropSubscriberOverflow
+)
+
+// Metrics returns per-PGN traffic, decode failure, drop and subscriber callback counters
+// since the service was created, along with its current queue depth and lag.
+func (s *N2kService) Metrics() Metrics {
+	return s.impl.Metrics()
+}
+
+// MetricsHandler returns an http.Handler that serves Metrics in the Prometheus text
+// exposition format, for example at /metrics.
+func (s *N2kService) MetricsHandler() http.Handler {
+	return s.impl.MetricsHandler()
+}
+
 // MessageQueueLag returns the current age of the oldest live CAN message waiting
 // in or moving through the serial handler path.
 func (s *N2kService) MessageQueueLag() time.Duration {
 	return s.impl.MessageQueueLag()
 }
 
+// Clock returns the clock the service is timed by, the wall clock unless set with
+// WithClock.
+func (s *N2kService) Clock() clock.Clock {
+	return s.impl.Clock()
+}
+
+// MessageQueuePending returns the number of received messages queued or being decoded.
+// A driver of a clock.Fake given with WithClock waits for it to reach zero before moving
+// the clock on, so that messages are decoded at the time they were received.
+func (s *N2kService) MessageQueuePending() int {
+	return s.impl.MessageQueuePending()
+}
+
 // OutboundQueueLag returns recent outbound endpoint queue/send latency.
 func (s *N2kService) OutboundQueueLag() time.Duration {
 	return s.impl.OutboundQueueLag()
 }
 
+// TransmitQueueStats describes the transmit queue: the messages waiting in it, the age of
+// the oldest, and how long sent messages waited.
+type TransmitQueueStats = n2kinternal.TransmitQueueStats
+
+// TransmitQueueStats returns the state of the transmit queue.
+func (s *N2kService) TransmitQueueStats() TransmitQueueStats {
+	return s.impl.TransmitQueueStats()
+}
+
 // MessageQueueMaxAge returns the configured maximum tolerated live CAN message queue lag.
 func (s *N2kService) MessageQueueMaxAge() time.Duration {
 	return s.impl.MessageQueueMaxAge()
@@ -0,0 +1,22 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package n2k
+
+import (
+	internalpgn "github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
+
+// Validate checks a PGN struct (or pointer to one) against canboat field ranges, domain
+// limits, reserved values, string lengths, and the maximum payload size. It returns every
+// field that Write would clamp, truncate, or reject; an empty result means the struct
+// encodes as given. Registered application types are not checked. An error is returned
+// only when the value is not a PGN struct.
+func Validate(p any) ([]pgn.FieldViolation, error) {
+	return internalpgn.Validate(p)
+}
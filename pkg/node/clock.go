@@ -8,40 +8,16 @@
 // Package node provides standard NMEA 2000 node behavior.
 package node
 
-import "time"
+import "github.com/boatkit-io/n2k/pkg/clock"
 
-// Ticker is an interface that abstracts *time.Ticker for testing.
-type Ticker interface {
-	C() <-chan time.Time
-	Stop()
-}
-
-// Clock is an interface that abstracts the time package for testing.
-type Clock interface {
-	NewTicker(d time.Duration) Ticker
-}
+// Ticker abstracts *time.Ticker for testing.
+type Ticker = clock.Ticker
 
-// realTicker is a wrapper for *time.Ticker that implements the Ticker interface.
-type realTicker struct {
-	ticker *time.Ticker
-}
-
-func (rt *realTicker) C() <-chan time.Time {
-	return rt.ticker.C
-}
-
-func (rt *realTicker) Stop() {
-	rt.ticker.Stop()
-}
-
-// realClock is an implementation of the Clock interface that uses the standard time package.
-type realClock struct{}
-
-func (rc *realClock) NewTicker(d time.Duration) Ticker {
-	return &realTicker{ticker: time.NewTicker(d)}
-}
+// Clock abstracts the time package for testing. It is the clock of package clock, so a
+// *clock.Fake can drive a node's address claims, heartbeats and scheduled PGNs.
+type Clock = clock.Clock
 
 // NewRealClock creates a new clock that uses the standard time package.
 func NewRealClock() Clock {
-	return &realClock{}
+	return clock.Real()
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
-	"time"
 
 	"github.com/boatkit-io/n2k/pkg/pgn"
 )
@@ -160,49 +159,3 @@ func (m *mockPublisher) waitForWrite() {
 func (m *mockPublisher) expectWrite() {
 	m.wg.Add(1)
 }
-
-// --- Mock Clock ---
-
-type mockTicker struct {
-	c    chan time.Time
-	stop chan struct{}
-}
-
-func (mt *mockTicker) C() <-chan time.Time {
-	return mt.c
-}
-
-func (mt *mockTicker) Stop() {
-	close(mt.stop)
-}
-
-type mockClock struct {
-	tickers []*mockTicker
-}
-
-func newMockClock() *mockClock {
-	return &mockClock{
-		tickers: make([]*mockTicker, 0),
-	}
-}
-
-func (mc *mockClock) NewTicker(_ time.Duration) Ticker {
-	ticker := &mockTicker{
-		c:    make(chan time.Time, 1),
-		stop: make(chan struct{}),
-	}
-	mc.tickers = append(mc.tickers, ticker)
-	return ticker
-}
-
-// Advance simulates the passage of time, triggering any active tickers.
-func (mc *mockClock) Advance() {
-	for _, ticker := range mc.tickers {
-		select {
-		case <-ticker.stop:
-			// Ticker was stopped, do nothing
-		default:
-			ticker.c <- time.Now()
-		}
-	}
-}
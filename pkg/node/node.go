@@ -11,6 +11,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"reflect"
 	"sort"
 	"sync"
@@ -18,8 +19,9 @@ import (
 	"time"
 
 	internalpgn "github.com/boatkit-io/n2k/internal/pgn"
+	"github.com/boatkit-io/n2k/pkg/logging"
+	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/pgn"
-	"github.com/sirupsen/logrus"
 )
 
 // Subscriber is an interface that abstracts bus subscriptions for testing.
@@ -33,6 +35,19 @@ type Publisher interface {
 	Write(pgnStruct any) error
 }
 
+// TaggedPublisher is implemented by Publishers that check writes against a write policy
+// as the caller of a context, without waiting for them to be sent. The node writes
+// through it so that its caller tag reaches the service's write policy.
+type TaggedPublisher interface {
+	WriteTagged(ctx context.Context, pgnStruct any) error
+}
+
+// Requester is implemented by Publishers that can send an ISO Request and wait for the
+// responses to it.
+type Requester interface {
+	SendRequest(ctx context.Context, request *pgn.ISORequest) ([]any, error)
+}
+
 // DeviceInfo contains the fields required to compute the NMEA 2000 NAME,
 // which uniquely identifies a device on the network.
 type DeviceInfo struct {
@@ -127,6 +142,14 @@ const ReadOnlyAddress uint8 = 255
 // nodePGNQueueSize accommodates discovery responses from a full 254-address NMEA 2000 network.
 const nodePGNQueueSize = 2048
 
+// minTransmissionInterval is the shortest interval a group function may set for a
+// scheduled PGN.
+const minTransmissionInterval = 10 * time.Millisecond
+
+// addressClaimPeriod is how long a node waits for a contending claim before it holds the
+// address it claimed.
+const addressClaimPeriod = 250 * time.Millisecond
+
 // Node represents a generic NMEA 2000 device, handling standard behaviors
 // required for any device on the network.
 type Node struct {
@@ -162,7 +185,11 @@ type Node struct {
 	pgnQueueDropped                atomic.Uint64
 	mutex                          sync.RWMutex
 	wakeUp                         chan struct{}
-	logger                         *logrus.Logger
+	logger                         *slog.Logger
+	scheduler                      *n2k.Scheduler
+	writePolicy                    *n2k.WritePolicy
+	writeGuard                     *n2k.WriteGuard
+	callerTag                      string
 }
 
 type toSend struct {
@@ -170,12 +197,14 @@ type toSend struct {
 	dest uint8
 }
 
-// NewNode creates a new Node instance with the given dependencies.
+// NewNode creates a new Node instance with the given dependencies. A nil clock is the
+// wall clock; give a node the clock its service was given with n2k.WithClock so that
+// both follow one clock.
 func NewNode(subscriber Subscriber, publisher Publisher, clock Clock) *Node {
 	if clock == nil {
 		clock = NewRealClock()
 	}
-	return &Node{
+	n := &Node{
 		subscriber:                     subscriber,
 		publisher:                      publisher,
 		clock:                          clock,
@@ -196,15 +225,49 @@ func NewNode(subscriber Subscriber, publisher Publisher, clock Clock) *Node {
 		pgnIn:                          make(chan any, nodePGNQueueSize),
 		mutex:                          sync.RWMutex{},
 		wakeUp:                         make(chan struct{}, 1),
-		logger:                         logrus.New(),
+		logger:                         slog.Default(),
+	}
+	// Scheduled PGNs are only sent while the node holds an address
+	n.scheduler = n2k.NewScheduler(n.writeScheduled, n.logger)
+	n.scheduler.Pause()
+	n.scheduler.SetClock(clock)
+	return n
+}
+
+// SetLogger replaces the node's logger, slog.Default() unless set. logging.FromLogrus
+// adapts a *logrus.Logger.
+func (n *Node) SetLogger(logger *slog.Logger) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.logger = logging.OrDefault(logger)
+	n.scheduler.SetLogger(n.logger)
+	if n.writePolicy != nil {
+		n.writeGuard = n2k.NewWriteGuard(*n.writePolicy, n.logger, n.clock)
 	}
 }
 
-// SetLogger allows overriding the default logger for debugging.
-func (n *Node) SetLogger(logger *logrus.Logger) {
+// SetWritePolicy restricts the node's writes of control and safety-critical PGNs to
+// those policy allows, auditing each of them to policy.Audit or the node's logger. A
+// denied Write returns a *n2k.WriteDeniedError. A nil policy allows every write.
+func (n *Node) SetWritePolicy(policy *n2k.WritePolicy) {
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
-	n.logger = logger
+	n.writePolicy = nil
+	n.writeGuard = nil
+	if policy != nil {
+		copied := *policy
+		n.writePolicy = &copied
+		n.writeGuard = n2k.NewWriteGuard(copied, n.logger, n.clock)
+	}
+}
+
+// SetCallerTag sets the caller tag the node's write policy matches against
+// n2k.WriteRule.Tags. It is also passed to the service's write policy when the publisher
+// is a TaggedPublisher, as the one NewFromService creates is.
+func (n *Node) SetCallerTag(tag string) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.callerTag = tag
 }
 
 func (n *Node) handleIsoRequest(p pgn.ISORequest) {
@@ -234,28 +297,22 @@ func (n *Node) handleNmeaCommandGroupFunction(p pgn.NMEACommandGroupFunction) {
 	if p.PGN != nil {
 		requestedPGN = *p.PGN
 	}
-	n.logger.Infof(
-		"received NMEA Command Group Function: source=0x%02x target=0x%02x pgn=%d parameters=%d",
-		p.Info.SourceId, p.Info.TargetId, requestedPGN, len(p.Repeating1),
-	)
+	n.logger.Info("received NMEA Command Group Function", logging.Source(p.Info.SourceId),
+		logging.Destination(p.Info.TargetId), logging.PGN(requestedPGN), "parameters", len(p.Repeating1))
 	n.enqueuePgn(p)
 }
 
 //nolint:gocritic // Subscriber callbacks must accept value PGNs.
 func (n *Node) handleNmeaWriteFieldsGroupFunction(p pgn.NMEAWriteFieldsGroupFunction) {
-	n.logger.Infof(
-		"received NMEA Write Fields: source=0x%02x target=0x%02x pgn=%v parameters=%d",
-		p.Info.SourceId, p.Info.TargetId, p.PGN, len(p.Repeating2),
-	)
+	n.logger.Info("received NMEA Write Fields", logging.Source(p.Info.SourceId),
+		logging.Destination(p.Info.TargetId), optionalPGN(p.PGN), "parameters", len(p.Repeating2))
 	n.enqueuePgn(p)
 }
 
 //nolint:gocritic // Subscriber callbacks must accept value PGNs.
 func (n *Node) handleNmeaReadFieldsGroupFunction(p pgn.NMEAReadFieldsGroupFunction) {
-	n.logger.Infof(
-		"received NMEA Read Fields: source=0x%02x target=0x%02x pgn=%v parameters=%d",
-		p.Info.SourceId, p.Info.TargetId, p.PGN, len(p.Repeating2),
-	)
+	n.logger.Info("received NMEA Read Fields", logging.Source(p.Info.SourceId),
+		logging.Destination(p.Info.TargetId), optionalPGN(p.PGN), "parameters", len(p.Repeating2))
 	n.enqueuePgn(p)
 }
 
@@ -365,7 +422,7 @@ func (n *Node) Stop() error {
 
 	for _, sub := range n.subscriptions {
 		if err := n.subscriber.Unsubscribe(sub); err != nil {
-			n.logger.Warnf("failed to unsubscribe node subscription %d: %v", sub, err)
+			n.logger.Warn("failed to unsubscribe node subscription", "subscription", sub, logging.Error(err))
 		}
 	}
 	n.subscriptions = make([]SubscriptionID, 0)
@@ -376,6 +433,7 @@ func (n *Node) Stop() error {
 	n.mutex.Unlock()
 
 	n.wg.Wait()
+	n.scheduler.Pause()
 
 	n.mutex.Lock()
 	n.started = false
@@ -396,7 +454,7 @@ func (n *Node) ClaimAddress(preferredAddress uint8) error {
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
 	if preferredAddress == ReadOnlyAddress {
-		n.logger.Infof("N2K address claim disabled; entering passive mode")
+		n.logger.Info("N2K address claim disabled; entering passive mode")
 		n.preferredAddress = preferredAddress
 		n.networkAddress = ReadOnlyAddress
 		n.addressState = stateUnclaimed
@@ -414,7 +472,7 @@ func (n *Node) ClaimAddress(preferredAddress uint8) error {
 	if preferredAddress > 253 {
 		return fmt.Errorf("preferred address %d is out of range (0-253)", preferredAddress)
 	}
-	n.logger.Infof("starting N2K address claim for preferred address %d", preferredAddress)
+	n.logger.Info("starting N2K address claim", "preferred_address", preferredAddress)
 	n.preferredAddress = preferredAddress
 	n.readOnly = false
 	n.addressState = stateClaiming
@@ -500,6 +558,80 @@ func (n *Node) WriteTo(pgnStruct any, destination uint8) error {
 }
 
 func (n *Node) write(pgnStruct any, destination uint8) error {
+	networkAddress, publisher, err := n.writableAddress("write PGN")
+	if err != nil {
+		return err
+	}
+
+	if err := setMessageInfo(pgnStruct, networkAddress, destination); err != nil {
+		return fmt.Errorf("failed to set message info: %w", err)
+	}
+
+	n.mutex.RLock()
+	guard, tag := n.writeGuard, n.callerTag
+	n.mutex.RUnlock()
+	ctx := n2k.WithCallerTag(context.Background(), tag)
+	if guard != nil {
+		if err := guard.Authorize(ctx, pgnStruct); err != nil {
+			return err
+		}
+		ctx = n2k.MarkWriteAudited(ctx)
+	}
+	if taggedPublisher, ok := publisher.(TaggedPublisher); ok {
+		return taggedPublisher.WriteTagged(ctx, pgnStruct)
+	}
+	return publisher.Write(pgnStruct)
+}
+
+// Request sends an ISO Request for the PGN requested to destination from the node's
+// claimed address and returns the decoded responses. Requests to 255 collect responses
+// from every device until ctx's deadline. It needs a Publisher that implements Requester,
+// such as the one NewFromService creates.
+func (n *Node) Request(ctx context.Context, requested uint32, destination uint8) ([]any, error) {
+	networkAddress, publisher, err := n.writableAddress("send request")
+	if err != nil {
+		return nil, err
+	}
+	requester, ok := publisher.(Requester)
+	if !ok {
+		return nil, fmt.Errorf("cannot send request, publisher does not implement Requester")
+	}
+
+	return requester.SendRequest(ctx, &pgn.ISORequest{
+		Info: pgn.MessageInfo{
+			PGN:      pgn.ISORequestPGN,
+			SourceId: networkAddress,
+			TargetId: destination,
+			Priority: 6,
+		},
+		PGN: &requested,
+	})
+}
+
+// Schedule writes the struct returned by produce every interval from the node's claimed
+// address. Transmission pauses automatically while the node has no claimed address, and
+// the PGN is listed in the node's transmit PGN list, sent in response to ISO Requests for
+// it, and its interval can be changed by NMEA Request group functions. produce may
+// return a struct or a pointer to one; returning nil skips a transmission.
+func (n *Node) Schedule(pgnNumber uint32, interval time.Duration, produce func() any) (*n2k.ScheduledPGN, error) {
+	return n.scheduler.Schedule(pgnNumber, interval, produce)
+}
+
+// writeScheduled broadcasts a scheduled PGN from the node's address.
+func (n *Node) writeScheduled(pgnStruct any) error {
+	v := reflect.ValueOf(pgnStruct)
+	if v.Kind() == reflect.Struct {
+		// setMessageInfo needs an addressable struct
+		ptr := reflect.New(v.Type())
+		ptr.Elem().Set(v)
+		pgnStruct = ptr.Interface()
+	}
+	return n.write(pgnStruct, 255)
+}
+
+// writableAddress returns the address and publisher to send from, or an error naming
+// action when the node cannot send.
+func (n *Node) writableAddress(action string) (uint8, Publisher, error) {
 	n.mutex.RLock()
 	addressClaimed := n.addressClaimed
 	networkAddress := n.networkAddress
@@ -508,17 +640,12 @@ func (n *Node) write(pgnStruct any, destination uint8) error {
 	n.mutex.RUnlock()
 
 	if readOnly {
-		return fmt.Errorf("cannot write PGN, node is read-only")
+		return 0, nil, fmt.Errorf("cannot %s, node is read-only", action)
 	}
 	if !addressClaimed {
-		return fmt.Errorf("cannot write PGN, address not claimed")
-	}
-
-	if err := setMessageInfo(pgnStruct, networkAddress, destination); err != nil {
-		return fmt.Errorf("failed to set message info: %w", err)
+		return 0, nil, fmt.Errorf("cannot %s, address not claimed", action)
 	}
-
-	return publisher.Write(pgnStruct)
+	return networkAddress, publisher, nil
 }
 
 // SetDeviceInfo configures the fields used to compute this node's NAME.
@@ -583,7 +710,7 @@ func (n *Node) enqueuePgn(p any) {
 	if n.ctx != nil {
 		select {
 		case <-n.ctx.Done():
-			n.logger.Debugf("node context done, dropping PGN %T", p)
+			n.logger.Debug("node context done, dropping PGN", "type", fmt.Sprintf("%T", p))
 			return
 		default:
 		}
@@ -593,12 +720,11 @@ func (n *Node) enqueuePgn(p any) {
 	default:
 		dropped := n.pgnQueueDropped.Add(1)
 		if dropped == 1 || dropped%100 == 0 {
-			n.logger.WithFields(logrus.Fields{
-				"capacity":     cap(n.pgnIn),
-				"depth":        len(n.pgnIn),
-				"droppedTotal": dropped,
-				"pgnType":      fmt.Sprintf("%T", p),
-			}).Warn("dropping N2K node-management PGN because its queue is full")
+			n.logger.Warn("dropping N2K node-management PGN because its queue is full",
+				"capacity", cap(n.pgnIn),
+				"depth", len(n.pgnIn),
+				"droppedTotal", dropped,
+				"pgnType", fmt.Sprintf("%T", p))
 		}
 	}
 }
@@ -657,7 +783,7 @@ func (n *Node) processIsoRequest(req pgn.ISORequest) []toSend {
 		responses = append(responses, toSend{pgn: responsePgn, dest: req.Info.SourceId})
 
 	case pgn.PGNListTransmitAndReceivePGN:
-		transmitPGNs = managedTransmitPGNs(transmitPGNs, configProvider != nil, heartbeatEnabled)
+		transmitPGNs = mergePGNs(managedTransmitPGNs(transmitPGNs, configProvider != nil, heartbeatEnabled), n.scheduler.PGNs())
 		receivePGNs = managedReceivePGNs(receivePGNs)
 
 		txRepeating := make([]pgn.PGNListTransmitAndReceiveRepeating1, len(transmitPGNs))
@@ -699,7 +825,7 @@ func (n *Node) processIsoRequest(req pgn.ISORequest) []toSend {
 		}
 		configInfo, err := configProvider.GetConfigurationInfo()
 		if err != nil {
-			n.logger.Errorf("processIsoRequest: failed to get configuration information: %v", err)
+			n.logger.Error("processIsoRequest: failed to get configuration information", logging.Error(err))
 			responses = append(responses, toSend{pgn: buildIsoNak(networkAddress, req.Info.SourceId, *req.PGN), dest: req.Info.SourceId})
 			break
 		}
@@ -714,6 +840,9 @@ func (n *Node) processIsoRequest(req pgn.ISORequest) []toSend {
 			ManufacturerInformation:  configInfo.ManufacturerInformation,
 		}
 		responses = append(responses, toSend{pgn: responsePgn, dest: req.Info.SourceId})
+
+	default:
+		n.scheduler.Trigger(*req.PGN)
 	}
 
 	return responses
@@ -774,6 +903,9 @@ func (n *Node) processNmeaRequestGroupFunction(req *pgn.NMEARequestGroupFunction
 	if req.NumberOfParameters != nil && *req.NumberOfParameters != 0 {
 		return n.processUnsupportedGroupFunction(req.Info, *req.PGN, pgn.ReadOrWriteNotSupported)
 	}
+	if req.TransmissionInterval != nil {
+		return n.processTransmissionIntervalRequest(req)
+	}
 
 	return n.processIsoRequest(pgn.ISORequest{
 		Info: req.Info,
@@ -781,12 +913,62 @@ func (n *Node) processNmeaRequestGroupFunction(req *pgn.NMEARequestGroupFunction
 	})
 }
 
+// processTransmissionIntervalRequest changes the interval of a scheduled PGN and sends
+// it, acknowledging the change to the requester.
+func (n *Node) processTransmissionIntervalRequest(req *pgn.NMEARequestGroupFunction) []toSend {
+	n.mutex.RLock()
+	addressClaimed := n.addressClaimed
+	networkAddress := n.networkAddress
+	readOnly := n.readOnly
+	n.mutex.RUnlock()
+
+	if readOnly || !addressClaimed {
+		return nil
+	}
+	if req.Info.TargetId != 255 && req.Info.TargetId != networkAddress {
+		return nil
+	}
+
+	pgnError := pgn.Acknowledge_6
+	intervalError := pgn.Acknowledge_2
+	interval := time.Duration(float64(*req.TransmissionInterval) * float64(time.Second))
+	switch {
+	case interval < minTransmissionInterval:
+		intervalError = pgn.TransmitIntervalTooLow
+	case !n.scheduler.SetInterval(*req.PGN, interval):
+		pgnError = pgn.PGNNotSupported
+		intervalError = pgn.TransmitIntervalPriorityNotSup
+	default:
+		n.logger.Info("transmission interval set", logging.PGN(*req.PGN), "interval", interval, logging.Source(req.Info.SourceId))
+		n.scheduler.Trigger(*req.PGN)
+	}
+	if req.Info.TargetId == 255 {
+		return nil
+	}
+
+	zero := uint8(0)
+	ack := &pgn.NMEAAcknowledgeGroupFunction{
+		Info: pgn.MessageInfo{
+			PGN:      pgn.NMEAAcknowledgeGroupFunctionPGN,
+			SourceId: networkAddress,
+			TargetId: req.Info.SourceId,
+			Priority: 3,
+		},
+		FunctionCode:                          pgn.Acknowledge_5,
+		PGN:                                   req.PGN,
+		PGNErrorCode:                          pgnError,
+		TransmissionIntervalPriorityErrorCode: intervalError,
+		NumberOfParameters:                    &zero,
+	}
+	return []toSend{{pgn: ack, dest: req.Info.SourceId}}
+}
+
 func (n *Node) processNmeaCommandGroupFunction(cmd *pgn.NMEACommandGroupFunction) []toSend {
 	if cmd.PGN == nil {
 		n.logger.Warn("ignoring NMEA Command Group Function without PGN")
 		return nil
 	}
-	n.logger.Infof("processing NMEA command for PGN %d with %d parameters", *cmd.PGN, len(cmd.Repeating1))
+	n.logger.Info("processing NMEA command", logging.PGN(*cmd.PGN), "parameters", len(cmd.Repeating1))
 	if *cmd.PGN == pgn.ConfigurationInformationPGN && len(cmd.Repeating1) > 0 {
 		if len(cmd.Repeating1) > 255 {
 			return n.processUnsupportedGroupFunction(cmd.Info, *cmd.PGN, pgn.InvalidParameterField)
@@ -838,10 +1020,9 @@ func (n *Node) processNmeaWriteFieldsGroupFunction(req *pgn.NMEAWriteFieldsGroup
 	readOnly := n.readOnly
 	n.mutex.RUnlock()
 	if readOnly || !addressClaimed || provider == nil || req.Info.TargetId != networkAddress || len(req.Repeating1) != 0 {
-		n.logger.Infof(
-			"rejecting configuration write: readOnly=%t claimed=%t provider=%t target=0x%02x node=0x%02x selections=%d",
-			readOnly, addressClaimed, provider != nil, req.Info.TargetId, networkAddress, len(req.Repeating1),
-		)
+		n.logger.Info("rejecting configuration write", "readOnly", readOnly, "claimed", addressClaimed,
+			"provider", provider != nil, logging.Destination(req.Info.TargetId), logging.NodeAddress(networkAddress),
+			"selections", len(req.Repeating1))
 		return nil
 	}
 
@@ -854,9 +1035,9 @@ func (n *Node) processNmeaWriteFieldsGroupFunction(req *pgn.NMEAWriteFieldsGroup
 		if field.Parameter == nil {
 			continue
 		}
-		value, decodeErr := decodeGroupFunctionLAU(field.Value)
+		value, decodeErr := decodeConfigurationString(*field.Parameter, field.Value)
 		if decodeErr != nil {
-			n.logger.Infof("invalid configuration value for parameter %d: bytes=%v error=%v", *field.Parameter, field.Value, decodeErr)
+			n.logger.Info("invalid configuration value", "parameter", *field.Parameter, "bytes", field.Value, logging.Error(decodeErr))
 			return n.processUnsupportedGroupFunction(req.Info, *req.PGN, pgn.InvalidParameterField)
 		}
 		switch *field.Parameter {
@@ -921,7 +1102,7 @@ func (n *Node) processNmeaReadFieldsGroupFunction(req *pgn.NMEAReadFieldsGroupFu
 			return nil
 		}
 		parameter := *field.Parameter
-		encoded, encodeErr := pgn.EncodeStringLAU(value)
+		encoded, encodeErr := internalpgn.EncodeGroupFunctionValue(pgn.ConfigurationInformationPGN, parameter, value)
 		if encodeErr != nil {
 			return n.processUnsupportedGroupFunction(req.Info, *req.PGN, pgn.InvalidParameterField)
 		}
@@ -933,8 +1114,18 @@ func (n *Node) processNmeaReadFieldsGroupFunction(req *pgn.NMEAReadFieldsGroupFu
 	return []toSend{{pgn: reply, dest: req.Info.SourceId}}
 }
 
-func decodeGroupFunctionLAU(value []byte) (string, error) {
-	return internalpgn.DecodeStringLAU(value)
+// decodeConfigurationString decodes a group-function value for a string field of
+// ConfigurationInformation.
+func decodeConfigurationString(parameter uint8, value []byte) (string, error) {
+	decoded, err := internalpgn.DecodeGroupFunctionValue(pgn.ConfigurationInformationPGN, parameter, value)
+	if err != nil {
+		return "", err
+	}
+	str, ok := decoded.(string)
+	if !ok {
+		return "", fmt.Errorf("configuration field %d is not a string", parameter)
+	}
+	return str, nil
 }
 
 func (n *Node) processUnsupportedGroupFunction(info pgn.MessageInfo, requestedPgn uint32, parameterError pgn.ParameterFieldConst) []toSend {
@@ -984,7 +1175,7 @@ func (n *Node) processIsoCommandedAddress(cmd *pgn.ISOCommandedAddress) {
 		return
 	}
 
-	n.logger.Infof("received commanded N2K address %d; restarting address claim", *cmd.NewSourceAddress)
+	n.logger.Info("received commanded N2K address; restarting address claim", "commanded_address", *cmd.NewSourceAddress)
 
 	n.mutex.Lock()
 	n.preferredAddress = *cmd.NewSourceAddress
@@ -1012,24 +1203,24 @@ func (n *Node) processIsoAddressClaim(claim *pgn.ISOAddressClaim) {
 	}
 
 	if incomingName < currentName {
-		n.logger.Warnf("N2K address %d conflict: device NAME %016x has higher priority than ours %016x; yielding",
-			currentAddress, incomingName, currentName)
+		n.logger.Warn("N2K address conflict: device NAME has higher priority than ours; yielding",
+			logging.NodeAddress(currentAddress), "name", fmt.Sprintf("%016x", incomingName), "our_name", fmt.Sprintf("%016x", currentName))
 		n.mutex.Lock()
 		n.addressClaimed = false
 		if n.deviceInfo.ArbitraryAddressCapable {
 			if nextAddress, ok := n.nextAvailableAddressLocked(currentAddress); ok {
 				n.preferredAddress = nextAddress
 				n.addressState = stateClaiming
-				n.logger.Infof("retrying N2K address claim with address %d", nextAddress)
+				n.logger.Info("retrying N2K address claim", logging.NodeAddress(nextAddress))
 			} else {
 				n.addressState = stateLost
 				n.networkAddress = 255
-				n.logger.Warnf("lost N2K address %d conflict and no available address found", currentAddress)
+				n.logger.Warn("lost N2K address conflict and no available address found", logging.NodeAddress(currentAddress))
 			}
 		} else {
 			n.addressState = stateLost
 			n.networkAddress = 255
-			n.logger.Warnf("lost N2K address %d conflict and this node cannot choose another address", currentAddress)
+			n.logger.Warn("lost N2K address conflict and this node cannot choose another address", logging.NodeAddress(currentAddress))
 		}
 		n.mutex.Unlock()
 		select {
@@ -1037,8 +1228,8 @@ func (n *Node) processIsoAddressClaim(claim *pgn.ISOAddressClaim) {
 		default:
 		}
 	} else {
-		n.logger.Infof("N2K address %d conflict: our NAME %016x has higher priority than incoming %016x; reasserting claim",
-			currentAddress, currentName, incomingName)
+		n.logger.Info("N2K address conflict: our NAME has higher priority than incoming; reasserting claim",
+			logging.NodeAddress(currentAddress), "name", fmt.Sprintf("%016x", incomingName), "our_name", fmt.Sprintf("%016x", currentName))
 		n.sendAddressClaim()
 	}
 }
@@ -1047,7 +1238,7 @@ func (n *Node) updateKnownDeviceFromClaim(claim *pgn.ISOAddressClaim, name uint6
 	var changes []DeviceChange
 	n.mutex.Lock()
 
-	now := time.Now()
+	now := n.clock.Now()
 	address := claim.Info.SourceId
 	device := n.knownDevices[name]
 	_, knownName := n.knownDevices[name]
@@ -1092,7 +1283,7 @@ func (n *Node) updateKnownDeviceFromProductInfo(info *pgn.ProductInformation) {
 
 	device := n.knownDeviceForAddressLocked(info.Info.SourceId)
 	device.Address = info.Info.SourceId
-	device.LastSeen = time.Now()
+	device.LastSeen = n.clock.Now()
 
 	productCode := uint16(0)
 	if info.ProductCode != nil {
@@ -1136,7 +1327,7 @@ func (n *Node) updateKnownDeviceFromConfigurationInfo(info *pgn.ConfigurationInf
 
 	device := n.knownDeviceForAddressLocked(info.Info.SourceId)
 	device.Address = info.Info.SourceId
-	device.LastSeen = time.Now()
+	device.LastSeen = n.clock.Now()
 	configInfo := ConfigurationInfo{
 		InstallationDescription1: info.InstallationDescription1,
 		InstallationDescription2: info.InstallationDescription2,
@@ -1161,7 +1352,7 @@ func (n *Node) updateKnownDeviceFromPgnList(info *pgn.PGNListTransmitAndReceive)
 
 	device := n.knownDeviceForAddressLocked(info.Info.SourceId)
 	device.Address = info.Info.SourceId
-	device.LastSeen = time.Now()
+	device.LastSeen = n.clock.Now()
 
 	pgns := knownDevicePGNListValues(info.Repeating1)
 	var changedPGNs []uint32
@@ -1323,9 +1514,9 @@ func (n *Node) sendAddressClaim() {
 	}
 
 	claim := buildAddressClaim(deviceInfoCopy, networkAddressCopy)
-	n.logger.Infof("claiming N2K address %d", networkAddressCopy)
+	n.logger.Info("claiming N2K address", logging.NodeAddress(networkAddressCopy))
 	if err := publisher.Write(claim); err != nil {
-		n.logger.Errorf("failed to write N2K address claim: %v", err)
+		n.logger.Error("failed to write N2K address claim", logging.NodeAddress(networkAddressCopy), logging.Error(err))
 	}
 }
 
@@ -1407,7 +1598,7 @@ func (n *Node) sendHeartbeat() {
 	}
 
 	if err := n.Write(hb); err != nil {
-		n.logger.Errorf("sendHeartbeat: failed to write heartbeat: %v", err)
+		n.logger.Error("sendHeartbeat: failed to write heartbeat", logging.Error(err))
 	}
 
 	n.mutex.Lock()
@@ -1428,7 +1619,7 @@ func (n *Node) processPGN(p any) []toSend {
 	case pgn.NMEAReadFieldsGroupFunction:
 		return n.processNmeaReadFieldsGroupFunction(&v)
 	case pgn.ISOAcknowledgement:
-		n.logger.Debugf("received ISO acknowledgement for PGN %v", v.PGN)
+		n.logger.Debug("received ISO acknowledgement", optionalPGN(v.PGN), logging.Source(v.Info.SourceId))
 	case pgn.ISOAddressClaim:
 		n.processIsoAddressClaim(&v)
 	case pgn.ISOCommandedAddress:
@@ -1440,7 +1631,7 @@ func (n *Node) processPGN(p any) []toSend {
 	case pgn.PGNListTransmitAndReceive:
 		n.updateKnownDeviceFromPgnList(&v)
 	default:
-		n.logger.Debugf("received unhandled PGN type %T", p)
+		n.logger.Debug("received unhandled PGN", "type", fmt.Sprintf("%T", p))
 	}
 	return nil
 }
@@ -1458,17 +1649,18 @@ func (n *Node) sendProcessResponses(toSendList []toSend) {
 		return
 	}
 	for _, ts := range toSendList {
-		n.logger.Debugf("sending node response PGN %T to %d", ts.pgn, ts.dest)
+		n.logger.Debug("sending node response PGN", "type", fmt.Sprintf("%T", ts.pgn), logging.Destination(ts.dest))
 		if err := publisher.Write(ts.pgn); err != nil {
-			n.logger.Errorf("failed to write node response PGN %T to %d: %v", ts.pgn, ts.dest, err)
+			n.logger.Error("failed to write node response PGN", "type", fmt.Sprintf("%T", ts.pgn),
+				logging.Destination(ts.dest), logging.Error(err))
 		}
 	}
 }
 
 func (n *Node) process() {
 	defer n.wg.Done()
-	n.logger.Debugf("node process goroutine started")
-	defer n.logger.Debugf("node process goroutine stopped")
+	n.logger.Debug("node process goroutine started")
+	defer n.logger.Debug("node process goroutine stopped")
 
 	var claimTicker Ticker
 	var heartbeatTicker Ticker
@@ -1484,7 +1676,7 @@ func (n *Node) process() {
 				claimTicker = nil
 			}
 			if claimTicker == nil {
-				claimTicker = n.clock.NewTicker(250 * time.Millisecond)
+				claimTicker = n.clock.NewTicker(addressClaimPeriod)
 				n.networkAddress = n.preferredAddress
 				shouldSendClaim = true
 			}
@@ -1502,8 +1694,15 @@ func (n *Node) process() {
 			heartbeatTicker.Stop()
 			heartbeatTicker = nil
 		}
+		canTransmit := n.addressClaimed && !n.readOnly
 		n.mutex.Unlock()
 
+		if canTransmit {
+			n.scheduler.Resume()
+		} else {
+			n.scheduler.Pause()
+		}
+
 		if shouldSendClaim {
 			n.sendAddressClaim()
 		}
@@ -1525,7 +1724,7 @@ func (n *Node) process() {
 		select {
 		case p, ok := <-n.pgnIn:
 			if !ok {
-				n.logger.Debugf("node PGN channel closed")
+				n.logger.Debug("node PGN channel closed")
 				return
 			}
 			n.sendProcessResponses(n.processPGN(p))
@@ -1535,7 +1734,7 @@ func (n *Node) process() {
 			if n.addressState == stateClaiming {
 				n.addressState = stateClaimed
 				n.addressClaimed = true
-				n.logger.Infof("claimed N2K address %d", n.networkAddress)
+				n.logger.Info("claimed N2K address", logging.NodeAddress(n.networkAddress))
 			}
 			n.mutex.Unlock()
 
@@ -1600,36 +1799,7 @@ func computeName(d DeviceInfo) (uint64, error) {
 }
 
 func computeNameFromClaim(claim *pgn.ISOAddressClaim) uint64 {
-	var name uint64
-	if claim.UniqueNumber != nil {
-		name |= uint64(*claim.UniqueNumber)
-	}
-	if claim.ManufacturerCode != 0 {
-		name |= uint64(claim.ManufacturerCode) << 21
-	}
-	if claim.DeviceInstanceLower != nil {
-		name |= uint64(*claim.DeviceInstanceLower) << 32
-	}
-	if claim.DeviceInstanceUpper != nil {
-		name |= uint64(*claim.DeviceInstanceUpper) << 35
-	}
-	if claim.DeviceFunction != 0 {
-		name |= uint64(claim.DeviceFunction) << 40
-	}
-	// Reserved bit at 48 is 0
-	if claim.DeviceClass != 0 {
-		name |= uint64(claim.DeviceClass) << 49
-	}
-	if claim.SystemInstance != nil {
-		name |= uint64(*claim.SystemInstance) << 56
-	}
-	if claim.IndustryGroup != 0 {
-		name |= uint64(claim.IndustryGroup) << 60
-	}
-	if claim.ArbitraryAddressCapable == pgn.Yes_2 {
-		name |= 1 << 63
-	}
-	return name
+	return pgn.NameFromAddressClaim(claim)
 }
 
 func computeNameFromCommand(cmd *pgn.ISOCommandedAddress) uint64 {
@@ -1692,3 +1862,11 @@ func setMessageInfo(s any, source, destination uint8) error {
 
 	return nil
 }
+
+// optionalPGN returns the log attribute of a PGN field that may be unset.
+func optionalPGN(pgnNumber *uint32) slog.Attr {
+	if pgnNumber == nil {
+		return slog.Any(logging.KeyPGN, nil)
+	}
+	return logging.PGN(*pgnNumber)
+}
@@ -5,6 +5,7 @@ package node
 import (
 	"context"
 	"flag"
+	"log/slog"
 	"os"
 	"testing"
 	"time"
@@ -12,7 +13,6 @@ import (
 	"github.com/boatkit-io/n2k/pkg/endpoint/socketcanendpoint"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/pgn"
-	"github.com/sirupsen/logrus"
 )
 
 var canInterface string
@@ -33,8 +33,7 @@ func TestNodeIntegration(t *testing.T) {
 		t.Skip("skipping integration test: -iface flag not provided")
 	}
 
-	log := logrus.StandardLogger()
-	log.SetLevel(logrus.InfoLevel)
+	log := slog.Default()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
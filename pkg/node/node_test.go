@@ -2,12 +2,17 @@ package node
 
 import (
 	"bytes"
+	"context"
+	"log/slog"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/boatkit-io/n2k/pkg/clock"
+	"github.com/boatkit-io/n2k/pkg/endpoint"
+	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/boatkit-io/n2k/pkg/pgn"
-	"github.com/sirupsen/logrus"
+	"github.com/brutella/can"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -294,7 +299,7 @@ func TestClaimAddressRequiresDeviceInfo(t *testing.T) {
 func TestClaimAddressReadOnly(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	n := NewNode(sub, pub, newMockClock())
+	n := NewNode(sub, pub, clock.NewFake(time.Now()))
 
 	err := n.ClaimAddress(ReadOnlyAddress)
 	assert.NoError(t, err)
@@ -332,7 +337,7 @@ func TestClaimAddressReadOnly(t *testing.T) {
 func TestDefaultReadOnlyIgnoresCommandedAddress(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	n := NewNode(sub, pub, newMockClock())
+	n := NewNode(sub, pub, clock.NewFake(time.Now()))
 	err := n.SetDeviceInfo(DeviceInfo{UniqueNumber: 1})
 	assert.NoError(t, err)
 
@@ -357,8 +362,8 @@ func TestDefaultReadOnlyIgnoresCommandedAddress(t *testing.T) {
 func TestClaimAddressZeroRemainsClaimable(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	clock := newMockClock()
-	n := NewNode(sub, pub, clock)
+	clk := clock.NewFake(time.Now())
+	n := NewNode(sub, pub, clk)
 	_ = n.SetDeviceInfo(DeviceInfo{UniqueNumber: 1})
 
 	err := n.Start()
@@ -370,7 +375,7 @@ func TestClaimAddressZeroRemainsClaimable(t *testing.T) {
 	assert.NoError(t, err)
 	pub.waitForWrite()
 
-	clock.Advance()
+	clk.Advance(addressClaimPeriod)
 	assert.Eventually(t, n.IsAddressClaimed, time.Second, time.Millisecond)
 	assert.Equal(t, uint8(0), n.GetNetworkAddress())
 	assert.False(t, n.readOnly)
@@ -379,8 +384,8 @@ func TestClaimAddressZeroRemainsClaimable(t *testing.T) {
 func TestEnableHeartbeatAfterClaimWakesProcess(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	clock := newMockClock()
-	n := NewNode(sub, pub, clock)
+	clk := clock.NewFake(time.Now())
+	n := NewNode(sub, pub, clk)
 	_ = n.SetDeviceInfo(DeviceInfo{UniqueNumber: 1})
 
 	err := n.Start()
@@ -392,7 +397,7 @@ func TestEnableHeartbeatAfterClaimWakesProcess(t *testing.T) {
 	assert.NoError(t, err)
 	pub.waitForWrite()
 
-	clock.Advance()
+	clk.Advance(addressClaimPeriod)
 	assert.Eventually(t, n.IsAddressClaimed, time.Second, time.Millisecond)
 	pub.clear()
 
@@ -461,8 +466,8 @@ func TestLifecycleAndResponses(t *testing.T) {
 	// Setup
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	clock := newMockClock()
-	n := NewNode(sub, pub, clock)
+	clk := clock.NewFake(time.Now())
+	n := NewNode(sub, pub, clk)
 	_ = n.SetDeviceInfo(DeviceInfo{UniqueNumber: 1})
 
 	err := n.Start()
@@ -484,8 +489,8 @@ func TestLifecycleAndResponses(t *testing.T) {
 	_ = n.ClaimAddress(50)
 	pub.waitForWrite() // 2. Wait for the node to send the claim
 
-	// 3. Now, advance the clock to allow the 250ms claim period to complete
-	clock.Advance()
+	// 3. Now, advance the clock to allow the claim period to complete
+	clk.Advance(addressClaimPeriod)
 	// Add a small sleep to allow the process goroutine to run and update the state
 	time.Sleep(10 * time.Millisecond)
 
@@ -697,7 +702,7 @@ func TestLifecycleAndResponses(t *testing.T) {
 func TestKnownDevices(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	n := NewNode(sub, pub, newMockClock())
+	n := NewNode(sub, pub, clock.NewFake(time.Now()))
 
 	err := n.Start()
 	assert.NoError(t, err)
@@ -793,7 +798,7 @@ func TestKnownDevices(t *testing.T) {
 func TestKnownDevicesTracksNameAcrossAddressChanges(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	n := NewNode(sub, pub, newMockClock())
+	n := NewNode(sub, pub, clock.NewFake(time.Now()))
 
 	var changesMu sync.Mutex
 	var changes []DeviceChange
@@ -858,7 +863,7 @@ func TestKnownDevicesTracksNameAcrossAddressChanges(t *testing.T) {
 func TestKnownDevicesMergesPreClaimMetadata(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	n := NewNode(sub, pub, newMockClock())
+	n := NewNode(sub, pub, clock.NewFake(time.Now()))
 
 	err := n.Start()
 	assert.NoError(t, err)
@@ -889,11 +894,9 @@ func TestKnownDevicesMergesPreClaimMetadata(t *testing.T) {
 
 func TestOtherAddressClaimDoesNotLogInfoNoise(t *testing.T) {
 	var logOutput bytes.Buffer
-	logger := logrus.New()
-	logger.SetLevel(logrus.InfoLevel)
-	logger.SetOutput(&logOutput)
+	logger := slog.New(slog.NewTextHandler(&logOutput, &slog.HandlerOptions{Level: slog.LevelInfo}))
 
-	n := NewNode(newMockSubscriber(), newMockPublisher(), newMockClock())
+	n := NewNode(newMockSubscriber(), newMockPublisher(), clock.NewFake(time.Now()))
 	n.SetLogger(logger)
 	err := n.SetDeviceInfo(DeviceInfo{
 		UniqueNumber:            100,
@@ -937,8 +940,8 @@ func testKnownDeviceClaim(sourceID uint8) pgn.ISOAddressClaim {
 func TestAddressClaimRetriesNextKnownFreeAddress(t *testing.T) {
 	sub := newMockSubscriber()
 	pub := newMockPublisher()
-	clock := newMockClock()
-	n := NewNode(sub, pub, clock)
+	clk := clock.NewFake(time.Now())
+	n := NewNode(sub, pub, clk)
 	err := n.SetDeviceInfo(DeviceInfo{
 		UniqueNumber:            100,
 		ManufacturerCode:        pgn.Garmin,
@@ -976,7 +979,232 @@ func TestAddressClaimRetriesNextKnownFreeAddress(t *testing.T) {
 	assert.Equal(t, uint8(51), n.GetNetworkAddress())
 	assert.False(t, n.IsAddressClaimed())
 
-	clock.Advance()
+	clk.Advance(addressClaimPeriod)
 	assert.Eventually(t, n.IsAddressClaimed, time.Second, time.Millisecond)
 	assert.Equal(t, uint8(51), n.GetNetworkAddress())
 }
+
+type requestingPublisher struct {
+	*mockPublisher
+	request *pgn.ISORequest
+}
+
+func (p *requestingPublisher) SendRequest(_ context.Context, request *pgn.ISORequest) ([]any, error) {
+	p.request = request
+	return []any{pgn.ProductInformation{Info: pgn.MessageInfo{SourceId: request.Info.TargetId}}}, nil
+}
+
+func TestRequestSendsFromClaimedAddress(t *testing.T) {
+	n := NewNode(newMockSubscriber(), newMockPublisher(), clock.NewFake(time.Now()))
+	_, err := n.Request(context.Background(), pgn.ProductInformationPGN, 0x23)
+	assert.ErrorContains(t, err, "read-only")
+
+	n.readOnly = false
+	n.addressClaimed = true
+	n.networkAddress = 0x42
+	_, err = n.Request(context.Background(), pgn.ProductInformationPGN, 0x23)
+	assert.ErrorContains(t, err, "Requester")
+
+	pub := &requestingPublisher{mockPublisher: newMockPublisher()}
+	n.publisher = pub
+	responses, err := n.Request(context.Background(), pgn.ProductInformationPGN, 0x23)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, uint8(0x42), pub.request.Info.SourceId)
+	assert.Equal(t, uint8(0x23), pub.request.Info.TargetId)
+	assert.Equal(t, uint32(pgn.ISORequestPGN), pub.request.Info.PGN)
+	assert.Equal(t, uint32(pgn.ProductInformationPGN), *pub.request.PGN)
+}
+
+type recordingPublisher struct {
+	mu      sync.Mutex
+	written []any
+}
+
+func (p *recordingPublisher) Write(pgnStruct any) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.written = append(p.written, pgnStruct)
+	return nil
+}
+
+func (p *recordingPublisher) headings() []*pgn.VesselHeading {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var headings []*pgn.VesselHeading
+	for _, written := range p.written {
+		if heading, ok := written.(*pgn.VesselHeading); ok {
+			headings = append(headings, heading)
+		}
+	}
+	return headings
+}
+
+func TestScheduledPGNsWaitForClaimedAddress(t *testing.T) {
+	pub := &recordingPublisher{}
+	clk := clock.NewFake(time.Now())
+	n := NewNode(newMockSubscriber(), pub, clk)
+	require.NoError(t, n.SetDeviceInfo(DeviceInfo{UniqueNumber: 1}))
+	scheduled, err := n.Schedule(pgn.VesselHeadingPGN, time.Millisecond, func() any {
+		return pgn.VesselHeading{Info: pgn.MessageInfo{PGN: pgn.VesselHeadingPGN}}
+	})
+	require.NoError(t, err)
+	defer scheduled.Stop()
+
+	require.NoError(t, n.Start())
+	defer func() { _ = n.Stop() }()
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, pub.headings())
+
+	require.NoError(t, n.ClaimAddress(40))
+	// The claim ticker exists once the address claim has been written
+	assert.Eventually(t, func() bool {
+		pub.mu.Lock()
+		defer pub.mu.Unlock()
+		return len(pub.written) > 0
+	}, time.Second, time.Millisecond)
+	clk.Advance(addressClaimPeriod)
+	// Scheduled PGNs follow the node's clock once the claim resumes the scheduler
+	assert.Eventually(t, func() bool {
+		clk.Advance(time.Millisecond)
+		return len(pub.headings()) > 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, uint8(40), pub.headings()[0].Info.SourceId)
+	assert.Equal(t, uint8(255), pub.headings()[0].Info.TargetId)
+
+	require.NoError(t, n.ClaimAddress(ReadOnlyAddress))
+	assert.Eventually(t, n.scheduler.Paused, time.Second, time.Millisecond)
+}
+
+func TestTransmissionIntervalRequestChangesSchedule(t *testing.T) {
+	pub := &recordingPublisher{}
+	n := NewNode(nil, pub, nil)
+	n.networkAddress = 44
+	n.addressClaimed = true
+	n.readOnly = false
+	n.scheduler.Resume()
+	scheduled, err := n.Schedule(pgn.VesselHeadingPGN, time.Hour, func() any { return pgn.VesselHeading{} })
+	require.NoError(t, err)
+	defer scheduled.Stop()
+
+	request := func(target uint32, seconds float32) *pgn.NMEAAcknowledgeGroupFunction {
+		responses := n.processNmeaRequestGroupFunction(&pgn.NMEARequestGroupFunction{
+			Info:                 pgn.MessageInfo{SourceId: 33, TargetId: 44},
+			FunctionCode:         pgn.Request,
+			PGN:                  &target,
+			TransmissionInterval: &seconds,
+		})
+		require.Len(t, responses, 1)
+		ack, ok := responses[0].pgn.(*pgn.NMEAAcknowledgeGroupFunction)
+		require.True(t, ok)
+		return ack
+	}
+
+	ack := request(pgn.VesselHeadingPGN, 0.5)
+	assert.Equal(t, pgn.Acknowledge_6, ack.PGNErrorCode)
+	assert.Equal(t, pgn.Acknowledge_2, ack.TransmissionIntervalPriorityErrorCode)
+	assert.Equal(t, 500*time.Millisecond, scheduled.Interval())
+	// The request also asks for the PGN itself
+	assert.Len(t, pub.headings(), 1)
+
+	ack = request(pgn.VesselHeadingPGN, 0.001)
+	assert.Equal(t, pgn.TransmitIntervalTooLow, ack.TransmissionIntervalPriorityErrorCode)
+	assert.Equal(t, 500*time.Millisecond, scheduled.Interval())
+
+	ack = request(pgn.WindDataPGN, 1)
+	assert.Equal(t, pgn.PGNNotSupported, ack.PGNErrorCode)
+	assert.Equal(t, pgn.TransmitIntervalPriorityNotSup, ack.TransmissionIntervalPriorityErrorCode)
+
+	responses := n.processIsoRequest(pgn.ISORequest{Info: pgn.MessageInfo{SourceId: 33, TargetId: 44}, PGN: uint32Ptr(pgn.PGNListTransmitAndReceivePGN)})
+	require.NotEmpty(t, responses)
+	assert.Contains(t, pgnListValues(responses[0].pgn.(*pgn.PGNListTransmitAndReceive).Repeating1), uint32(pgn.VesselHeadingPGN))
+}
+
+func TestWritePolicyRestrictsNodeWrites(t *testing.T) {
+	publisher := newMockPublisher()
+	n := NewNode(nil, publisher, nil)
+	n.networkAddress = 44
+	n.addressClaimed = true
+	n.readOnly = false
+	var audits []n2k.WriteAudit
+	n.SetWritePolicy(&n2k.WritePolicy{
+		Allow: []n2k.WriteRule{{PGNs: []uint32{pgn.SwitchBankControlPGN}, Destinations: []uint8{0x20}, Tags: []string{"helm"}}},
+		Audit: func(audit n2k.WriteAudit) { audits = append(audits, audit) },
+	})
+	instance := uint8(1)
+	command := &pgn.SwitchBankControl{Instance: &instance, Switch1: pgn.On_2}
+
+	var denied *n2k.WriteDeniedError
+	require.ErrorAs(t, n.WriteTo(command, 0x20), &denied)
+	assert.Empty(t, publisher.written)
+
+	n.SetCallerTag("helm")
+	require.ErrorAs(t, n.WriteTo(command, 0x21), &denied)
+	publisher.expectWrite()
+	require.NoError(t, n.WriteTo(command, 0x20))
+	assert.Len(t, publisher.written, 1)
+
+	require.Len(t, audits, 3)
+	assert.Equal(t, uint8(44), audits[2].Source)
+	assert.Equal(t, "helm", audits[2].Tag)
+	assert.True(t, audits[2].Allowed)
+
+	n.SetWritePolicy(nil)
+	publisher.expectWrite()
+	require.NoError(t, n.WriteTo(command, 0x21))
+}
+
+// frameSink is an endpoint that keeps the frames written to it.
+type frameSink struct {
+	mu     sync.Mutex
+	frames []can.Frame
+}
+
+func (*frameSink) Start(context.Context) error       { return nil }
+func (*frameSink) Run(context.Context) error         { return nil }
+func (*frameSink) Close() error                      { return nil }
+func (*frameSink) SetOutput(endpoint.MessageHandler) {}
+func (f *frameSink) WriteFrame(frame can.Frame) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.frames = append(f.frames, frame)
+}
+
+func TestNodeWritesCarryCallerTagToServicePolicy(t *testing.T) {
+	var serviceAudits, nodeAudits []n2k.WriteAudit
+	sink := &frameSink{}
+	svc := n2k.NewN2kService(sink, slog.Default(), n2k.WithWritePolicy(n2k.WritePolicy{
+		Allow: []n2k.WriteRule{{Destinations: []uint8{0x20}, Tags: []string{"helm"}}},
+		Audit: func(audit n2k.WriteAudit) { serviceAudits = append(serviceAudits, audit) },
+	}))
+	n := NewFromService(svc)
+	n.networkAddress = 44
+	n.addressClaimed = true
+	n.readOnly = false
+	n.SetCallerTag("helm")
+	instance := uint8(1)
+	command := &pgn.SwitchBankControl{Instance: &instance, Switch1: pgn.On_2}
+
+	require.NoError(t, n.WriteTo(command, 0x20))
+	require.Len(t, serviceAudits, 1)
+	assert.Equal(t, "helm", serviceAudits[0].Tag)
+	assert.True(t, serviceAudits[0].Allowed)
+
+	// A write the node's own policy has audited is not audited again by the service
+	n.SetWritePolicy(&n2k.WritePolicy{
+		Allow: []n2k.WriteRule{{Tags: []string{"helm"}}},
+		Audit: func(audit n2k.WriteAudit) { nodeAudits = append(nodeAudits, audit) },
+	})
+	require.NoError(t, n.WriteTo(command, 0x20))
+	assert.Len(t, nodeAudits, 1)
+	assert.Len(t, serviceAudits, 1)
+
+	// but the service still enforces its own rules
+	var denied *n2k.WriteDeniedError
+	require.ErrorAs(t, n.WriteTo(command, 0x21), &denied)
+	assert.Len(t, nodeAudits, 2)
+	require.Len(t, serviceAudits, 2)
+	assert.False(t, serviceAudits[1].Allowed)
+	assert.Equal(t, "helm", serviceAudits[1].Tag)
+	assert.Len(t, sink.frames, 2)
+}
@@ -8,15 +8,20 @@
 // Package node provides standard NMEA 2000 node behavior.
 package node
 
-import "github.com/boatkit-io/n2k/pkg/n2k"
+import (
+	"context"
+
+	"github.com/boatkit-io/n2k/pkg/n2k"
+	"github.com/boatkit-io/n2k/pkg/pgn"
+)
 
 // SubscriptionID identifies a subscription managed by a Subscriber.
 type SubscriptionID uint
 
-// NewFromService creates a Node backed by the public N2kService API.
-// This is the intended entry point for clients such as goatkit.
+// NewFromService creates a Node backed by the public N2kService API, timed by the
+// service's clock. This is the intended entry point for clients such as goatkit.
 func NewFromService(svc *n2k.N2kService) *Node {
-	return NewNode(newN2kServiceSubscriber(svc), newN2kServicePublisher(svc), nil)
+	return NewNode(newN2kServiceSubscriber(svc), newN2kServicePublisher(svc), svc.Clock())
 }
 
 type n2kServiceSubscriber struct {
@@ -47,3 +52,11 @@ func newN2kServicePublisher(svc *n2k.N2kService) *n2kServicePublisher {
 func (p *n2kServicePublisher) Write(pgnStruct any) error {
 	return p.svc.Write(pgnStruct)
 }
+
+func (p *n2kServicePublisher) WriteTagged(ctx context.Context, pgnStruct any) error {
+	return p.svc.WriteTagged(ctx, pgnStruct)
+}
+
+func (p *n2kServicePublisher) SendRequest(ctx context.Context, request *pgn.ISORequest) ([]any, error) {
+	return p.svc.SendRequest(ctx, request)
+}
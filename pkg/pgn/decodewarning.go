@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+// DecodeWarning records a field that could not be decoded in a partially decoded PGN.
+// Fields from this one onward are left nil (or zero for non-pointer fields).
+type DecodeWarning struct {
+	// Field is the "<PGN type>-<field>" name of the first field that failed.
+	Field string
+
+	// Reason is the error returned while reading the field.
+	Reason error
+}
+
+// DecodeDetails holds what a decode records beyond a message's fields.
+type DecodeDetails struct {
+	// Warnings lists the fields that could not be decoded, set only on partially
+	// decoded structs.
+	Warnings []DecodeWarning
+
+	// Payload is the original payload, retained only when requested. Encoding a struct
+	// that still carries it reproduces the reserved bits and trailing bytes of the
+	// original.
+	Payload []uint8
+}
+
+// DecodeWarnings returns the fields that could not be decoded, if the struct was
+// partially decoded.
+func (i MessageInfo) DecodeWarnings() []DecodeWarning {
+	if i.Details == nil {
+		return nil
+	}
+	return i.Details.Warnings
+}
+
+// Payload returns the original payload, if it was retained.
+func (i MessageInfo) Payload() []uint8 {
+	if i.Details == nil {
+		return nil
+	}
+	return i.Details.Payload
+}
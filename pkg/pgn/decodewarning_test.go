@@ -0,0 +1,32 @@
+// Copyright (C) 2026 Boatkit
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageInfoStaysComparable(t *testing.T) {
+	details := &DecodeDetails{
+		Warnings: []DecodeWarning{{Field: "VesselHeading-Deviation", Reason: errors.New("short")}},
+		Payload:  []uint8{1, 2, 3},
+	}
+	a := VesselHeading{Info: MessageInfo{PGN: VesselHeadingPGN, Details: details}}
+	b := a
+
+	// generated structs are used as map keys, which needs every MessageInfo field to be comparable
+	seen := map[VesselHeading]bool{a: true}
+	assert.True(t, seen[b])
+	assert.Equal(t, details.Warnings, b.Info.DecodeWarnings())
+	assert.Equal(t, details.Payload, b.Info.Payload())
+}
+
+func TestMessageInfoWithoutDetails(t *testing.T) {
+	var info MessageInfo
+	assert.Nil(t, info.DecodeWarnings())
+	assert.Nil(t, info.Payload())
+}
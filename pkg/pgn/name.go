@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+// NameFromAddressClaim returns the 64-bit NMEA 2000 NAME announced by an address claim.
+// Fields that are not available contribute zero bits.
+func NameFromAddressClaim(claim *ISOAddressClaim) uint64 {
+	var name uint64
+	if claim.UniqueNumber != nil {
+		name |= uint64(*claim.UniqueNumber)
+	}
+	if claim.ManufacturerCode != 0 {
+		name |= uint64(claim.ManufacturerCode) << 21
+	}
+	if claim.DeviceInstanceLower != nil {
+		name |= uint64(*claim.DeviceInstanceLower) << 32
+	}
+	if claim.DeviceInstanceUpper != nil {
+		name |= uint64(*claim.DeviceInstanceUpper) << 35
+	}
+	if claim.DeviceFunction != 0 {
+		name |= uint64(claim.DeviceFunction) << 40
+	}
+	// Reserved bit at 48 is 0
+	if claim.DeviceClass != 0 {
+		name |= uint64(claim.DeviceClass) << 49
+	}
+	if claim.SystemInstance != nil {
+		name |= uint64(*claim.SystemInstance) << 56
+	}
+	if claim.IndustryGroup != 0 {
+		name |= uint64(claim.IndustryGroup) << 60
+	}
+	if claim.ArbitraryAddressCapable == Yes_2 {
+		name |= 1 << 63
+	}
+	return name
+}
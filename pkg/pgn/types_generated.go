@@ -22,6 +22,14 @@ type MessageInfo struct {
 
 	// target address, when relevant (PGNs with PF < 240)
 	TargetId uint8
+
+	// ID of the bus the message was received on, set when the endpoint has several;
+	// ignored when writing
+	Bus string
+
+	// decode warnings and the retained payload, set only on partially decoded structs
+	// and when payloads are retained; a pointer so that MessageInfo stays comparable
+	Details *DecodeDetails
 }
 
 
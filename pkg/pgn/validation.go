@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Boatkit
+//
+// This work is licensed under the terms of the MIT license. For a copy,
+// see <https://opensource.org/licenses/MIT>.
+//
+// SPDX-License-Identifier: MIT
+
+package pgn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ViolationKind classifies a field that cannot be written as given.
+type ViolationKind int
+
+const (
+	// ViolationOutOfRange means the value does not fit the field's encodable or domain range.
+	ViolationOutOfRange ViolationKind = iota + 1
+	// ViolationReservedValue means the value encodes to one of the field's reserved raw values.
+	ViolationReservedValue
+	// ViolationStringTooLong means a string is longer than the field can carry.
+	ViolationStringTooLong
+	// ViolationRepeatingCountTooLarge means a repeating set has more entries than fit in the payload.
+	ViolationRepeatingCountTooLarge
+)
+
+// String returns a short name for the kind.
+func (k ViolationKind) String() string {
+	switch k {
+	case ViolationOutOfRange:
+		return "out of range"
+	case ViolationReservedValue:
+		return "reserved value"
+	case ViolationStringTooLong:
+		return "string too long"
+	case ViolationRepeatingCountTooLarge:
+		return "repeating count too large"
+	default:
+		return fmt.Sprintf("ViolationKind(%d)", int(k))
+	}
+}
+
+// FieldViolation describes one field of a PGN struct that would be clamped, rejected,
+// or misread if it were written to the bus.
+type FieldViolation struct {
+	// Field is the struct path of the field, e.g. "Repeating1[2].PGN".
+	Field string
+	Kind  ViolationKind
+	// Value is the offending value as found in the struct.
+	Value any
+	// Min and Max give the accepted range for range violations, or the limit for
+	// string and repeating violations (in Max).
+	Min float64
+	Max float64
+}
+
+// Error describes the violation.
+func (v FieldViolation) Error() string {
+	switch v.Kind {
+	case ViolationOutOfRange:
+		return fmt.Sprintf("%s: %v is %s [%g, %g]", v.Field, v.Value, v.Kind, v.Min, v.Max)
+	case ViolationReservedValue:
+		return fmt.Sprintf("%s: %v is a %s (valid up to %g)", v.Field, v.Value, v.Kind, v.Max)
+	case ViolationStringTooLong, ViolationRepeatingCountTooLarge:
+		return fmt.Sprintf("%s: %v (%v, max %g)", v.Field, v.Kind, v.Value, v.Max)
+	default:
+		return fmt.Sprintf("%s: %v", v.Field, v.Kind)
+	}
+}
+
+// ValidationError is returned by strict writes of a PGN struct with field violations.
+type ValidationError struct {
+	PGNType    string
+	Violations []FieldViolation
+}
+
+// Error lists every violation.
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Error())
+	}
+	return fmt.Sprintf("invalid %s: %s", e.PGNType, strings.Join(parts, "; "))
+}
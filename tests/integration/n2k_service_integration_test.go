@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -14,7 +15,6 @@ import (
 	"github.com/boatkit-io/n2k/pkg/endpoint/n2kfileendpoint"
 	"github.com/boatkit-io/n2k/pkg/n2k"
 	"github.com/brutella/can"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -74,7 +74,7 @@ func TestN2kServiceIntegration(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Create the n2kfileendpoint
-	log := logrus.New()
+	log := slog.Default()
 	ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, log)
 
 	// Create N2kService using the public interface
@@ -131,7 +131,7 @@ func TestN2kServiceWithSubscription(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Create the n2kfileendpoint
-	log := logrus.New()
+	log := slog.Default()
 	ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, log)
 
 	// Create N2kService using the public interface
@@ -178,7 +178,7 @@ func TestN2kServiceWrite(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Create the n2kfileendpoint
-	log := logrus.New()
+	log := slog.Default()
 	ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, log)
 
 	// Create N2kService using the public interface
@@ -200,7 +200,7 @@ func TestN2kServiceUpdateEndpoint(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Create the initial n2kfileendpoint
-	log := logrus.New()
+	log := slog.Default()
 	ep1 := n2kfileendpoint.NewN2kFileEndpoint(testFile, log)
 
 	// Create N2kService using the public interface
@@ -245,7 +245,7 @@ func TestN2kServiceUpdateEndpointWhileRunning(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Create the initial n2kfileendpoint
-	log := logrus.New()
+	log := slog.Default()
 	ep1 := n2kfileendpoint.NewN2kFileEndpoint(testFile, log)
 
 	// Create N2kService using the public interface
@@ -273,7 +273,7 @@ func TestN2kServiceUpdateEndpointWhileRunning(t *testing.T) {
 func TestN2kServiceHandleReplayCANFrame(t *testing.T) {
 	skipReplayIntegrationInShortMode(t)
 
-	log := logrus.New()
+	log := slog.Default()
 	ep := n2kfileendpoint.NewN2kFileEndpoint(requireReplayFile(t), log)
 	service := n2k.NewN2kService(ep, log)
 
@@ -295,7 +295,7 @@ func TestN2kServiceHandleReplayCANFrame(t *testing.T) {
 func TestN2kServiceReceivedCANFrameHook(t *testing.T) {
 	skipReplayIntegrationInShortMode(t)
 
-	log := logrus.New()
+	log := slog.Default()
 	ep := n2kfileendpoint.NewN2kFileEndpoint(requireReplayFile(t), log)
 	service := n2k.NewN2kService(ep, log)
 
@@ -1,8 +1,10 @@
 package integration
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"log/slog"
 	"path/filepath"
 	"reflect"
 	"sort"
@@ -17,7 +19,6 @@ import (
 	"github.com/boatkit-io/n2k/pkg/endpoint/n2kfileendpoint"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,8 +35,8 @@ func TestPGNSerializationFromN2K(t *testing.T) {
 	testFile := requireReplayFile(t)
 
 	// Setup the file endpoint
-	ca := canadapter.NewCANAdapter(logrus.New())
-	ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, logrus.New())
+	ca := canadapter.NewCANAdapter(slog.Default())
+	ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, slog.Default())
 
 	// Create subscriber
 	subs := subscribe.New()
@@ -150,6 +151,49 @@ func TestPGNSerializationFromN2K(t *testing.T) {
 	}
 }
 
+func TestPGNPayloadRoundTripFromN2K(t *testing.T) {
+	skipReplayIntegrationInShortMode(t)
+
+	for _, testFile := range requireReplayFiles(t) {
+		t.Run(filepath.Base(testFile), func(t *testing.T) {
+			ca := canadapter.NewCANAdapter(slog.Default())
+			ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, slog.Default())
+
+			subs := subscribe.New()
+			ps := pkt.NewPacketStruct()
+			ps.SetRetainPayloads(true)
+			ps.SetOutput(subs)
+			ca.SetOutput(ps)
+			ep.SetOutput(ca)
+
+			var messageCount, mismatchCount int
+			_, err := subs.SubscribeToAllStructs(func(p any) {
+				// UnknownPGN already carries its raw payload and is never re-encoded.
+				if _, isUnknown := p.(pgn.UnknownPGN); isUnknown {
+					return
+				}
+				messageCount++
+
+				info := reflect.ValueOf(p).FieldByName("Info").Interface().(pgn.MessageInfo)
+				stream := pgn.NewDataStream(make([]uint8, pgn.MaxPGNLength))
+				_, err := pgn.EncodeStruct(p, stream)
+				if !assert.NoError(t, err, "encoding %T", p) {
+					mismatchCount++
+					return
+				}
+				if !bytes.Equal(stream.GetData(), info.Payload()) {
+					mismatchCount++
+					assert.Failf(t, "payload round trip changed bytes", "%T: encoded % x, want % x", p, stream.GetData(), info.Payload())
+				}
+			})
+			assert.NoError(t, err)
+
+			assert.NoError(t, ep.Run(context.Background()))
+			assert.Zero(t, mismatchCount, "%d of %d messages did not round-trip byte for byte", mismatchCount, messageCount)
+		})
+	}
+}
+
 func TestComprehensivePerformanceProfiling(t *testing.T) {
 	skipReplayIntegrationInShortMode(t)
 
@@ -172,8 +216,8 @@ func TestComprehensivePerformanceProfiling(t *testing.T) {
 		fmt.Printf("=== Processing %s ===\n", fileName)
 
 		// Setup the file endpoint
-		ca := canadapter.NewCANAdapter(logrus.New())
-		ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, logrus.New())
+		ca := canadapter.NewCANAdapter(slog.Default())
+		ep := n2kfileendpoint.NewN2kFileEndpoint(testFile, slog.Default())
 
 		// Create subscriber
 		subs := subscribe.New()